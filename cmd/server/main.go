@@ -5,19 +5,33 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	common_auth "sumni-finance-backend/internal/common/auth"
 	common_db "sumni-finance-backend/internal/common/db"
 	"sumni-finance-backend/internal/common/logs"
 	"sumni-finance-backend/internal/common/server"
+	"sumni-finance-backend/internal/common/server/idempotency"
+	"sumni-finance-backend/internal/config"
 	finance_app "sumni-finance-backend/internal/finance/app"
 	"sumni-finance-backend/internal/finance/ports"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 )
 
+// The advisory locks shared by every instance running a worker, each worker leads with its own key.
+const (
+	periodRolloverLockKey        int64 = 7_001
+	recurringMaterializerLockKey int64 = 7_002
+	statementArchiveLockKey      int64 = 7_003
+	outboxRelayLockKey           int64 = 7_004
+	webhookDeliveryLockKey       int64 = 7_005
+)
+
 func main() {
 	logs.Init()
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	// TODO: Uncomment when enable authentication
 	/*
@@ -45,11 +59,53 @@ func main() {
 
 	financeServer := ports.NewHttpServer(financeApp)
 
+	rolloverWorker := ports.NewPeriodRolloverWorker(
+		financeApp,
+		common_db.NewAdvisoryLock(pgPool, periodRolloverLockKey),
+		time.Duration(config.GetConfig().Scheduler().PeriodRolloverInterval())*time.Minute,
+	)
+	go rolloverWorker.Run(ctx)
+
+	materializerWorker := ports.NewRecurringMaterializerWorker(
+		financeApp,
+		common_db.NewAdvisoryLock(pgPool, recurringMaterializerLockKey),
+		time.Duration(config.GetConfig().Scheduler().RecurringMaterializeInterval())*time.Minute,
+	)
+	go materializerWorker.Run(ctx)
+
+	archiveWorker := ports.NewStatementArchiveWorker(
+		financeApp,
+		common_db.NewAdvisoryLock(pgPool, statementArchiveLockKey),
+		time.Duration(config.GetConfig().Scheduler().StatementArchiveInterval())*time.Minute,
+	)
+	go archiveWorker.Run(ctx)
+
+	outboxRelayWorker := ports.NewOutboxRelayWorker(
+		financeApp,
+		common_db.NewAdvisoryLock(pgPool, outboxRelayLockKey),
+		time.Duration(config.GetConfig().Scheduler().OutboxRelayInterval())*time.Second,
+	)
+	go outboxRelayWorker.Run(ctx)
+
+	webhookDeliveryWorker := ports.NewWebhookDeliveryWorker(
+		financeApp,
+		common_db.NewAdvisoryLock(pgPool, webhookDeliveryLockKey),
+		time.Duration(config.GetConfig().Scheduler().WebhookDeliveryInterval())*time.Second,
+	)
+	go webhookDeliveryWorker.Run(ctx)
+
 	server.RunHTTPServer(func(router chi.Router) http.Handler {
 		// HealthCheck
 		router.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusOK)
-			render.JSON(w, r, map[string]string{"status": "ok"})
+			render.JSON(w, r, map[string]any{
+				"status":                "ok",
+				"periodRollover":        rolloverWorker.Status(),
+				"recurringMaterializer": materializerWorker.Status(),
+				"statementArchive":      archiveWorker.Status(),
+				"outboxRelay":           outboxRelayWorker.Status(),
+				"webhookDelivery":       webhookDeliveryWorker.Status(),
+			})
 		})
 
 		// TODO: Uncomment when enable authentication
@@ -63,6 +119,13 @@ func main() {
 			/*
 				protectedRoute.Use(authHandler.AuthMiddleware)
 			*/
+			protectedRoute.Use(common_auth.DefaultUserMiddleware(config.GetConfig().App().DefaultUserID()))
+			protectedRoute.Use(idempotency.Middleware(
+				common_db.NewIdempotencyStore(pgPool),
+				time.Duration(config.GetConfig().App().IdempotencyTTL())*time.Hour,
+				// Bank statements are uploaded whole
+				"POST /api/v1/wallets/{walletId}/imports",
+			))
 			ports.HandlerFromMux(financeServer, protectedRoute)
 		})
 
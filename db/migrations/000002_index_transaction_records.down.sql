@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_transaction_records_wallet_id_id;
+
+COMMIT;
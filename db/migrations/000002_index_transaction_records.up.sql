@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Transaction history is read per wallet, newest first, paginated on the UUIDv7 id
+CREATE INDEX IF NOT EXISTS idx_transaction_records_wallet_id_id
+    ON finance.transaction_records (wallet_id, id DESC);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE finance.transaction_records
+    DROP COLUMN IF EXISTS recorded_at,
+    DROP COLUMN IF EXISTS occurred_at,
+    DROP COLUMN IF EXISTS description;
+
+COMMIT;
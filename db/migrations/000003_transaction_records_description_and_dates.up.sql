@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE finance.transaction_records
+    ADD COLUMN description text NOT NULL DEFAULT '',
+    ADD COLUMN occurred_at timestamp,
+    ADD COLUMN recorded_at timestamp NOT NULL DEFAULT now();
+
+-- Existing records have no business date, fall back to the start of their accounting period
+UPDATE finance.transaction_records tr
+SET occurred_at = ap.end_time - make_interval(months => ap.interval)
+FROM finance.accounting_periods ap
+WHERE ap.id = tr.accounting_periods_id;
+
+ALTER TABLE finance.transaction_records
+    ALTER COLUMN occurred_at SET NOT NULL;
+
+COMMIT;
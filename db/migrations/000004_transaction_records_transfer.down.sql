@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_transaction_records_linked_id;
+
+ALTER TABLE finance.transaction_records
+    DROP COLUMN IF EXISTS linked_id;
+
+ALTER TABLE finance.transaction_records
+    ALTER COLUMN transaction_type TYPE varchar(10);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- TRANSFER_OUT / TRANSFER_IN do not fit in varchar(10)
+ALTER TABLE finance.transaction_records
+    ALTER COLUMN transaction_type TYPE varchar(20);
+
+-- Counterpart record of a transfer, both sides reference each other
+ALTER TABLE finance.transaction_records
+    ADD COLUMN linked_id uuid;
+
+CREATE INDEX IF NOT EXISTS idx_transaction_records_linked_id
+    ON finance.transaction_records (linked_id)
+    WHERE linked_id IS NOT NULL;
+
+COMMIT;
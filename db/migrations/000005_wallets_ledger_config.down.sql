@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE finance.wallets
+    DROP CONSTRAINT IF EXISTS chk_wallets_period_interval,
+    DROP CONSTRAINT IF EXISTS chk_wallets_period_start_day;
+
+ALTER TABLE finance.wallets
+    DROP COLUMN IF EXISTS period_interval,
+    DROP COLUMN IF EXISTS period_start_day;
+
+COMMIT;
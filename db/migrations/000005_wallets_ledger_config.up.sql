@@ -0,0 +1,14 @@
+BEGIN;
+
+-- Accounting period layout of the wallet, copied into every period it opens
+ALTER TABLE finance.wallets
+    ADD COLUMN period_start_day int NOT NULL DEFAULT 1,
+    ADD COLUMN period_interval int NOT NULL DEFAULT 1;
+
+ALTER TABLE finance.wallets
+    ADD CONSTRAINT chk_wallets_period_start_day
+        CHECK (period_start_day BETWEEN 1 AND 28),
+    ADD CONSTRAINT chk_wallets_period_interval
+        CHECK (period_interval IN (1, 2, 3));
+
+COMMIT;
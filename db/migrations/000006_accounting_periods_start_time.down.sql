@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_accounting_periods_wallet_end_time;
+
+ALTER TABLE finance.accounting_periods
+    DROP COLUMN IF EXISTS start_time;
+
+COMMIT;
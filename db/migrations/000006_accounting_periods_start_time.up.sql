@@ -0,0 +1,24 @@
+BEGIN;
+
+-- The first period after a ledger config change starts at the end of the previous one
+-- instead of on its own start day, so the start can no longer be derived from year_month
+ALTER TABLE finance.accounting_periods
+    ADD COLUMN start_time timestamp;
+
+UPDATE finance.accounting_periods
+SET start_time = make_timestamp(
+    split_part(year_month, ',', 1)::int,
+    split_part(year_month, ',', 2)::int,
+    start_date,
+    0,
+    0,
+    0
+);
+
+ALTER TABLE finance.accounting_periods
+    ALTER COLUMN start_time SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_accounting_periods_wallet_end_time
+    ON finance.accounting_periods (wallet_id, end_time DESC);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_transaction_records_reversal_of_id;
+
+ALTER TABLE finance.transaction_records
+    DROP COLUMN IF EXISTS reversed_at,
+    DROP COLUMN IF EXISTS reversed_by_id,
+    DROP COLUMN IF EXISTS reversal_of_id;
+
+COMMIT;
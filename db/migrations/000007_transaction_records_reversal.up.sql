@@ -0,0 +1,14 @@
+BEGIN;
+
+-- A reversal references the record it compensates, the reversed record references its reversal
+ALTER TABLE finance.transaction_records
+    ADD COLUMN reversal_of_id uuid,
+    ADD COLUMN reversed_by_id uuid,
+    ADD COLUMN reversed_at timestamp;
+
+-- A record can only be reversed once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transaction_records_reversal_of_id
+    ON finance.transaction_records (reversal_of_id)
+    WHERE reversal_of_id IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE finance.accounting_periods
+    DROP COLUMN IF EXISTS total_expense,
+    DROP COLUMN IF EXISTS total_income;
+
+-- Transfer fees and reversals go back to the type matching the way they move the balance
+UPDATE finance.transaction_records
+SET transaction_type = CASE
+    WHEN direction = 'IN' THEN 'DEPOSIT'
+    ELSE 'WITHDRAWAL'
+END
+WHERE reversal_of_id IS NOT NULL
+    OR transaction_type = 'FEE';
+
+ALTER TABLE finance.transaction_records
+    DROP CONSTRAINT IF EXISTS chk_transaction_records_transaction_type,
+    DROP CONSTRAINT IF EXISTS chk_transaction_records_direction,
+    DROP COLUMN IF EXISTS direction;
+
+COMMIT;
@@ -0,0 +1,74 @@
+BEGIN;
+
+-- An ADJUSTMENT can go either way and a reversal goes against its type, the direction is stored per record
+ALTER TABLE finance.transaction_records
+    ADD COLUMN direction varchar(3);
+
+UPDATE finance.transaction_records
+SET direction = CASE
+    WHEN transaction_type IN ('DEPOSIT', 'TRANSFER_IN') THEN 'IN'
+    ELSE 'OUT'
+END;
+
+ALTER TABLE finance.transaction_records
+    ALTER COLUMN direction SET NOT NULL,
+    ADD CONSTRAINT chk_transaction_records_direction
+        CHECK (direction IN ('IN', 'OUT')),
+    ADD CONSTRAINT chk_transaction_records_transaction_type
+        CHECK (transaction_type IN (
+            'DEPOSIT',
+            'WITHDRAWAL',
+            'FEE',
+            'INTEREST',
+            'REFUND',
+            'ADJUSTMENT',
+            'TRANSFER_IN',
+            'TRANSFER_OUT'
+        ));
+
+-- The fee of a transfer was booked as a WITHDRAWAL linked to the TRANSFER_OUT record
+UPDATE finance.transaction_records fee
+SET transaction_type = 'FEE'
+FROM finance.transaction_records transfer_out
+WHERE fee.linked_id = transfer_out.id
+    AND fee.transaction_type = 'WITHDRAWAL'
+    AND transfer_out.transaction_type = 'TRANSFER_OUT';
+
+-- A reversal was booked with the opposite type, it now keeps the type of the reversed record and goes
+-- against its direction, so it cancels the original in the totals. A reversal booked outside the period
+-- of the original cannot take it off that period and becomes an ADJUSTMENT.
+UPDATE finance.transaction_records reversal
+SET
+    transaction_type = CASE
+        WHEN reversal.accounting_periods_id = original.accounting_periods_id THEN original.transaction_type
+        ELSE 'ADJUSTMENT'
+    END,
+    direction = CASE
+        WHEN original.direction = 'IN' THEN 'OUT'
+        ELSE 'IN'
+    END
+FROM finance.transaction_records original
+WHERE reversal.reversal_of_id = original.id;
+
+-- Income and expense exclude transfers and adjustments, unlike total_debit / total_credit
+ALTER TABLE finance.accounting_periods
+    ADD COLUMN total_income bigint NOT NULL DEFAULT 0,
+    ADD COLUMN total_expense bigint NOT NULL DEFAULT 0;
+
+UPDATE finance.accounting_periods ap
+SET
+    total_income = totals.income,
+    total_expense = totals.expense
+FROM (
+    SELECT
+        accounting_periods_id,
+        COALESCE(SUM(CASE WHEN direction = 'IN' THEN amount ELSE -amount END)
+            FILTER (WHERE transaction_type = 'DEPOSIT'), 0) AS income,
+        COALESCE(SUM(CASE WHEN direction = 'OUT' THEN amount ELSE -amount END)
+            FILTER (WHERE transaction_type IN ('WITHDRAWAL', 'FEE')), 0) AS expense
+    FROM finance.transaction_records
+    GROUP BY accounting_periods_id
+) totals
+WHERE totals.accounting_periods_id = ap.id;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_transaction_records_category_id;
+
+ALTER TABLE finance.transaction_records
+    DROP CONSTRAINT IF EXISTS fk_transaction_records_category,
+    DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS finance.categories;
+
+COMMIT;
@@ -0,0 +1,40 @@
+BEGIN;
+
+-- Two level category tree of a user, a sub category shares the kind of its parent
+CREATE TABLE finance.categories (
+    id uuid PRIMARY KEY NOT NULL,
+    user_id varchar(255) NOT NULL,
+    parent_id uuid,
+    name varchar(100) NOT NULL,
+    kind varchar(10) NOT NULL,
+    version int NOT NULL DEFAULT 0,
+
+    CONSTRAINT chk_categories_kind
+        CHECK (kind IN ('INCOME', 'EXPENSE')),
+
+    -- A parent can not be removed while it has sub categories
+    CONSTRAINT fk_categories_parent
+        FOREIGN KEY (parent_id)
+            REFERENCES finance.categories (id)
+            ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_categories_user_id
+    ON finance.categories (user_id);
+
+-- Names are unique among the siblings of a user
+CREATE UNIQUE INDEX IF NOT EXISTS uq_categories_user_parent_name
+    ON finance.categories (user_id, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), lower(name));
+
+ALTER TABLE finance.transaction_records
+    ADD COLUMN category_id uuid,
+    ADD CONSTRAINT fk_transaction_records_category
+        FOREIGN KEY (category_id)
+            REFERENCES finance.categories (id)
+            ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transaction_records_category_id
+    ON finance.transaction_records (category_id)
+    WHERE category_id IS NOT NULL;
+
+COMMIT;
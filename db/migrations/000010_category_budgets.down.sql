@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS finance.category_budgets;
+
+COMMIT;
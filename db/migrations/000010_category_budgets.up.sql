@@ -0,0 +1,25 @@
+BEGIN;
+
+-- Amount planned per category for an accounting period, in the currency of the wallet
+CREATE TABLE finance.category_budgets (
+    accounting_period_id uuid NOT NULL,
+    category_id uuid NOT NULL,
+    amount bigint NOT NULL,
+
+    PRIMARY KEY (accounting_period_id, category_id),
+
+    CONSTRAINT chk_category_budgets_amount
+        CHECK (amount > 0),
+
+    CONSTRAINT fk_category_budgets_accounting_period
+        FOREIGN KEY (accounting_period_id)
+            REFERENCES finance.accounting_periods (id)
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_category_budgets_category
+        FOREIGN KEY (category_id)
+            REFERENCES finance.categories (id)
+            ON DELETE CASCADE
+);
+
+COMMIT;
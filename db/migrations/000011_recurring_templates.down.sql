@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS finance.recurring_occurrences;
+DROP TABLE IF EXISTS finance.recurring_templates;
+
+COMMIT;
@@ -0,0 +1,74 @@
+BEGIN;
+
+-- Transaction posted into the open accounting period of a wallet on every date of a schedule
+CREATE TABLE finance.recurring_templates (
+    id uuid PRIMARY KEY NOT NULL,
+    user_id varchar(255) NOT NULL,
+    wallet_id uuid NOT NULL,
+    fp_id uuid NOT NULL,
+    amount bigint NOT NULL,
+    transaction_type varchar(10) NOT NULL,
+    category_id uuid,
+    description text NOT NULL DEFAULT '',
+
+    frequency varchar(10) NOT NULL,
+    interval int NOT NULL DEFAULT 1,
+    day_of_month int NOT NULL DEFAULT 0,
+    start_date timestamp NOT NULL,
+
+    status varchar(10) NOT NULL,
+    paused_at timestamp,
+    version int NOT NULL DEFAULT 0,
+
+    CONSTRAINT chk_recurring_templates_amount
+        CHECK (amount > 0),
+
+    CONSTRAINT chk_recurring_templates_frequency
+        CHECK (frequency IN ('MONTHLY', 'WEEKLY')),
+
+    CONSTRAINT chk_recurring_templates_status
+        CHECK (status IN ('ACTIVE', 'PAUSED')),
+
+    CONSTRAINT fk_recurring_templates_wallet
+        FOREIGN KEY (wallet_id)
+            REFERENCES finance.wallets (id)
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_recurring_templates_fund_provider
+        FOREIGN KEY (fp_id)
+            REFERENCES finance.fund_providers (id)
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_recurring_templates_category
+        FOREIGN KEY (category_id)
+            REFERENCES finance.categories (id)
+            ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_templates_wallet_id
+    ON finance.recurring_templates (wallet_id);
+
+-- A date of a template is handled once, the primary key makes the materializer idempotent
+CREATE TABLE finance.recurring_occurrences (
+    template_id uuid NOT NULL,
+    occurrence_date timestamp NOT NULL,
+    status varchar(10) NOT NULL,
+    transaction_record_id uuid,
+
+    PRIMARY KEY (template_id, occurrence_date),
+
+    CONSTRAINT chk_recurring_occurrences_status
+        CHECK (status IN ('POSTED', 'SKIPPED')),
+
+    CONSTRAINT fk_recurring_occurrences_template
+        FOREIGN KEY (template_id)
+            REFERENCES finance.recurring_templates (id)
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_recurring_occurrences_transaction_record
+        FOREIGN KEY (transaction_record_id)
+            REFERENCES finance.transaction_records (id)
+            ON DELETE SET NULL
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_transaction_records_fp_id_transaction_no;
+
+DROP TABLE IF EXISTS finance.import_profiles;
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN;
+
+-- Saved column mappings of the CSV exports of banks, per user
+CREATE TABLE finance.import_profiles (
+    id uuid PRIMARY KEY NOT NULL,
+    user_id varchar(255) NOT NULL,
+    name varchar(100) NOT NULL,
+    delimiter varchar(1) NOT NULL DEFAULT ',',
+    skip_rows int NOT NULL DEFAULT 0,
+    date_column varchar(100) NOT NULL,
+    date_format varchar(50) NOT NULL,
+    amount_sign varchar(10) NOT NULL,
+    amount_column varchar(100) NOT NULL DEFAULT '',
+    debit_column varchar(100) NOT NULL DEFAULT '',
+    credit_column varchar(100) NOT NULL DEFAULT '',
+    description_column varchar(100) NOT NULL DEFAULT '',
+    reference_column varchar(100) NOT NULL DEFAULT '',
+    thousands_separator varchar(1) NOT NULL DEFAULT '',
+    version int NOT NULL DEFAULT 0,
+
+    CONSTRAINT chk_import_profiles_amount_sign
+        CHECK (amount_sign IN ('SIGNED', 'INVERTED', 'SPLIT')),
+
+    CONSTRAINT chk_import_profiles_skip_rows
+        CHECK (skip_rows >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_import_profiles_user_name
+    ON finance.import_profiles (user_id, lower(name));
+
+-- Imports look up the bank references already recorded against a fund provider
+CREATE INDEX IF NOT EXISTS idx_transaction_records_fp_id_transaction_no
+    ON finance.transaction_records (fp_id, transaction_no)
+    WHERE transaction_no IS NOT NULL AND transaction_no <> '';
+
+COMMIT;
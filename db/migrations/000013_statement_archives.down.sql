@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS finance.statement_archives;
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+
+-- Rendered PDF statements of closed accounting periods, kept as they were first printed
+CREATE TABLE finance.statement_archives (
+    id uuid PRIMARY KEY NOT NULL,
+    wallet_id uuid NOT NULL,
+    accounting_period_id uuid NOT NULL,
+    year_month varchar(100) NOT NULL,
+    file_name varchar(255) NOT NULL,
+    content bytea NOT NULL,
+    sha256 char(64) NOT NULL,
+    archived_at timestamp NOT NULL,
+
+    CONSTRAINT uq_statement_archives_accounting_period
+        UNIQUE (accounting_period_id),
+
+    CONSTRAINT fk_statement_archives_wallet
+        FOREIGN KEY (wallet_id)
+            REFERENCES finance.wallets (id)
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_statement_archives_accounting_period
+        FOREIGN KEY (accounting_period_id)
+            REFERENCES finance.accounting_periods (id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_statement_archives_wallet_id_year_month
+    ON finance.statement_archives (wallet_id, year_month);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS finance.reconciliation_records;
+DROP TABLE IF EXISTS finance.reconciliations;
+
+COMMIT;
@@ -0,0 +1,52 @@
+BEGIN;
+
+-- Matching of the records of a fund provider against the closing balance of a bank statement
+CREATE TABLE finance.reconciliations (
+    id uuid PRIMARY KEY NOT NULL,
+    fp_id uuid NOT NULL,
+    statement_date timestamp NOT NULL,
+    statement_balance bigint NOT NULL,
+    status varchar(20) NOT NULL,
+    created_at timestamp NOT NULL,
+    finalised_at timestamp,
+    version int NOT NULL DEFAULT 0,
+
+    CONSTRAINT chk_reconciliations_status
+        CHECK (status IN ('IN_PROGRESS', 'FINALISED', 'CANCELLED')),
+
+    CONSTRAINT fk_reconciliations_fund_provider
+        FOREIGN KEY (fp_id)
+            REFERENCES finance.fund_providers (id)
+            ON DELETE CASCADE
+);
+
+-- A fund provider is reconciled against one statement at a time
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reconciliations_fp_id_in_progress
+    ON finance.reconciliations (fp_id)
+    WHERE status = 'IN_PROGRESS';
+
+CREATE INDEX IF NOT EXISTS idx_reconciliations_fp_id_statement_date
+    ON finance.reconciliations (fp_id, statement_date);
+
+-- Records cleared by a reconciliation, the primary key lets a record be cleared by a single one.
+-- The records of a finalised reconciliation are locked
+CREATE TABLE finance.reconciliation_records (
+    transaction_record_id uuid PRIMARY KEY NOT NULL,
+    reconciliation_id uuid NOT NULL,
+    is_adjustment boolean NOT NULL DEFAULT false,
+
+    CONSTRAINT fk_reconciliation_records_reconciliation
+        FOREIGN KEY (reconciliation_id)
+            REFERENCES finance.reconciliations (id)
+            ON DELETE CASCADE,
+
+    CONSTRAINT fk_reconciliation_records_transaction_record
+        FOREIGN KEY (transaction_record_id)
+            REFERENCES finance.transaction_records (id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliation_records_reconciliation_id
+    ON finance.reconciliation_records (reconciliation_id);
+
+COMMIT;
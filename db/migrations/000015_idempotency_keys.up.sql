@@ -0,0 +1,21 @@
+BEGIN;
+
+-- First response of a command request, replayed to the retries carrying the same Idempotency-Key
+CREATE TABLE finance.idempotency_keys (
+    user_id varchar(255) NOT NULL,
+    route varchar(512) NOT NULL,
+    idempotency_key varchar(255) NOT NULL,
+    request_hash char(64) NOT NULL,
+    status_code int,
+    content_type varchar(255),
+    response_body bytea,
+    created_at timestamp NOT NULL,
+    expires_at timestamp NOT NULL,
+
+    PRIMARY KEY (user_id, route, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_user_id_expires_at
+    ON finance.idempotency_keys (user_id, expires_at);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS finance.idx_transaction_records_fp_id_amount_occurred_at;
+
+DROP INDEX IF EXISTS finance.uq_transaction_records_fp_id_transaction_no;
+
+CREATE INDEX IF NOT EXISTS idx_transaction_records_fp_id_transaction_no
+    ON finance.transaction_records (fp_id, transaction_no)
+    WHERE transaction_no IS NOT NULL AND transaction_no <> '';
+
+COMMIT;
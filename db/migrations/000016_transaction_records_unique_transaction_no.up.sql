@@ -0,0 +1,36 @@
+BEGIN;
+
+-- A bank reference books a single record per fund provider. The legs of a transfer and its fee are linked
+-- to each other and share the reference, a reversed record gives its reference back.
+DROP INDEX IF EXISTS finance.idx_transaction_records_fp_id_transaction_no;
+
+-- References pasted twice before the index existed keep the earliest record, the later ones get a suffix
+-- so they still show where they came from and can be reversed by hand
+UPDATE finance.transaction_records tr
+SET transaction_no = LEFT(dup.transaction_no, 240) || '-DUP-' || dup.rn
+FROM (
+    SELECT
+        id,
+        transaction_no,
+        ROW_NUMBER() OVER (PARTITION BY fp_id, transaction_no ORDER BY recorded_at, id) AS rn
+    FROM finance.transaction_records
+    WHERE transaction_no IS NOT NULL
+        AND transaction_no <> ''
+        AND linked_id IS NULL
+        AND reversed_by_id IS NULL
+) dup
+WHERE tr.id = dup.id
+    AND dup.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_transaction_records_fp_id_transaction_no
+    ON finance.transaction_records (fp_id, transaction_no)
+    WHERE transaction_no IS NOT NULL
+        AND transaction_no <> ''
+        AND linked_id IS NULL
+        AND reversed_by_id IS NULL;
+
+-- Recording looks up the records of the same amount around the date of the new ones
+CREATE INDEX IF NOT EXISTS idx_transaction_records_fp_id_amount_occurred_at
+    ON finance.transaction_records (fp_id, amount, occurred_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS finance.outbox_events;
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN;
+
+-- Domain events written by the transaction that changed the aggregate, relayed to the subscribers afterwards
+CREATE TABLE finance.outbox_events (
+    id uuid PRIMARY KEY NOT NULL,
+    aggregate_type varchar(50) NOT NULL,
+    aggregate_id uuid NOT NULL,
+    event_name varchar(100) NOT NULL,
+    payload jsonb NOT NULL,
+    occurred_at timestamp NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'PENDING',
+    attempts int NOT NULL DEFAULT 0,
+    last_error text,
+    next_attempt_at timestamp NOT NULL,
+    dispatched_at timestamp,
+
+    CONSTRAINT chk_outbox_events_status
+        CHECK (status IN ('PENDING', 'DISPATCHED', 'FAILED'))
+);
+
+-- The relay only looks at the pending events that are due
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending_next_attempt_at
+    ON finance.outbox_events (next_attempt_at)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate
+    ON finance.outbox_events (aggregate_type, aggregate_id);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS finance.webhook_delivery_attempts;
+DROP TABLE IF EXISTS finance.webhook_deliveries;
+DROP TABLE IF EXISTS finance.webhook_subscriptions;
+
+COMMIT;
@@ -0,0 +1,70 @@
+BEGIN;
+
+-- Endpoints of a user receiving the domain events, an empty event_types subscribes to every event
+CREATE TABLE finance.webhook_subscriptions (
+    id uuid PRIMARY KEY NOT NULL,
+    user_id varchar(255) NOT NULL,
+    url varchar(2000) NOT NULL,
+    secret varchar(255) NOT NULL,
+    event_types text[] NOT NULL DEFAULT '{}',
+    created_at timestamp NOT NULL,
+    version int NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_user_id
+    ON finance.webhook_subscriptions (user_id);
+
+-- An event posted to the endpoint of a subscription, a DEAD delivery is not attempted anymore
+CREATE TABLE finance.webhook_deliveries (
+    id uuid PRIMARY KEY NOT NULL,
+    subscription_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    event_name varchar(100) NOT NULL,
+    payload jsonb NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'PENDING',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamp NOT NULL,
+    last_status_code int,
+    last_error text,
+    created_at timestamp NOT NULL,
+    delivered_at timestamp,
+
+    CONSTRAINT chk_webhook_deliveries_status
+        CHECK (status IN ('PENDING', 'SUCCEEDED', 'DEAD')),
+
+    CONSTRAINT fk_webhook_deliveries_subscription
+        FOREIGN KEY (subscription_id)
+            REFERENCES finance.webhook_subscriptions (id)
+            ON DELETE CASCADE
+);
+
+-- An event relayed again by the outbox is scheduled once per subscription
+CREATE UNIQUE INDEX IF NOT EXISTS uq_webhook_deliveries_subscription_id_event_id
+    ON finance.webhook_deliveries (subscription_id, event_id);
+
+-- The worker only looks at the pending deliveries that are due
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending_next_attempt_at
+    ON finance.webhook_deliveries (next_attempt_at)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id_created_at
+    ON finance.webhook_deliveries (subscription_id, created_at);
+
+-- Log of every POST of a delivery, status_code is null when the endpoint could not be reached
+CREATE TABLE finance.webhook_delivery_attempts (
+    delivery_id uuid NOT NULL,
+    attempt int NOT NULL,
+    attempted_at timestamp NOT NULL,
+    status_code int,
+    error text,
+    duration_ms int NOT NULL,
+
+    PRIMARY KEY (delivery_id, attempt),
+
+    CONSTRAINT fk_webhook_delivery_attempts_delivery
+        FOREIGN KEY (delivery_id)
+            REFERENCES finance.webhook_deliveries (id)
+            ON DELETE CASCADE
+);
+
+COMMIT;
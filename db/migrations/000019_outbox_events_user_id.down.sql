@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE finance.outbox_events
+    DROP COLUMN IF EXISTS user_id;
+
+COMMIT;
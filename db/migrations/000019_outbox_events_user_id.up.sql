@@ -0,0 +1,8 @@
+BEGIN;
+
+-- The user whose action raised the event, only their webhook subscriptions receive it.
+-- The events stored before the column existed have no user and are not posted to any webhook.
+ALTER TABLE finance.outbox_events
+    ADD COLUMN IF NOT EXISTS user_id varchar(255);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE finance.wallets
+    DROP COLUMN IF EXISTS user_id;
+
+COMMIT;
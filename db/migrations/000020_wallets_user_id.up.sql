@@ -0,0 +1,8 @@
+BEGIN;
+
+-- The user owning the wallet, the events of the jobs changing the wallet are raised on their behalf.
+-- The wallets created before the column existed have no owner, their jobs raise events posted to no webhook.
+ALTER TABLE finance.wallets
+    ADD COLUMN IF NOT EXISTS user_id varchar(255);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+UPDATE finance.accounting_periods ap
+SET
+    total_income = ap.total_income + refunds.net,
+    total_expense = ap.total_expense + refunds.net
+FROM (
+    SELECT
+        accounting_periods_id,
+        SUM(CASE WHEN direction = 'IN' THEN amount ELSE -amount END) AS net
+    FROM finance.transaction_records
+    WHERE transaction_type = 'REFUND'
+    GROUP BY accounting_periods_id
+) refunds
+WHERE refunds.accounting_periods_id = ap.id;
+
+COMMIT;
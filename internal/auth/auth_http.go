@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	common_auth "sumni-finance-backend/internal/common/auth"
 	"sumni-finance-backend/internal/common/server/httperr"
 	"sumni-finance-backend/internal/config"
 
@@ -42,7 +43,7 @@ func HandleAuthFromMux(r chi.Router, si AuthHandlerInterface) http.Handler {
 
 type Oauth2Client interface {
 	GetAuthorizationCodeURL(state string, codeChallenge string) string
-	Authenticate(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error)
+	Authenticate(ctx context.Context, token *oauth2.Token) (*oauth2.Token, string, error)
 	GetLogoutURL(ctx context.Context, token *oauth2.Token) (string, error)
 	ExchangeCode(ctx context.Context, code string, codeVerifier string) (*oauth2.Token, error)
 }
@@ -173,7 +174,7 @@ func (handler *authHandler) AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		freshToken, err := handler.oauth2Client.Authenticate(r.Context(), token)
+		freshToken, subject, err := handler.oauth2Client.Authenticate(r.Context(), token)
 		if err != nil {
 			httperr.Unauthorised("failed-to-authenticate-token", err, w, r)
 			return
@@ -188,7 +189,7 @@ func (handler *authHandler) AuthMiddleware(next http.Handler) http.Handler {
 			}
 		}
 
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(common_auth.WithUser(r.Context(), common_auth.User{ID: subject})))
 	})
 }
 
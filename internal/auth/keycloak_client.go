@@ -69,23 +69,24 @@ func (k *keycloakClient) GetAuthorizationCodeURL(state string, codeChallenge str
 	)
 }
 
-func (k *keycloakClient) Authenticate(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error) {
+// Authenticate refreshes the token when needed and returns it with the subject of the verified id_token.
+func (k *keycloakClient) Authenticate(ctx context.Context, token *oauth2.Token) (*oauth2.Token, string, error) {
 	freshToken, err := k.getFreshToken(ctx, token)
 	if err != nil {
-		return nil, fmt.Errorf("token refresh failed: %w", err)
+		return nil, "", fmt.Errorf("token refresh failed: %w", err)
 	}
 
 	rawIDToken, exist := freshToken.Extra("id_token").(string)
 	if !exist {
-		return nil, errors.New("missing id_token from token response")
+		return nil, "", errors.New("missing id_token from token response")
 	}
 
-	_, err = k.verifier.Verify(ctx, rawIDToken)
+	idToken, err := k.verifier.Verify(ctx, rawIDToken)
 	if err != nil {
-		return nil, errors.New("token verification failed")
+		return nil, "", errors.New("token verification failed")
 	}
 
-	return freshToken, nil
+	return freshToken, idToken.Subject, nil
 }
 
 func (k *keycloakClient) GetLogoutURL(ctx context.Context, token *oauth2.Token) (string, error) {
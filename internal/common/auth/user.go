@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+var ErrNoUserInContext = httperr.NewAuthorizationError(errors.New("no user in context"), "no-user-found")
+
+type User struct {
+	ID string
+}
+
+type ctxKey int
+
+const userContextKey ctxKey = iota
+
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey, user)
+}
+
+func UserFromCtx(ctx context.Context) (User, error) {
+	user, ok := ctx.Value(userContextKey).(User)
+	if !ok || user.ID == "" {
+		return User{}, ErrNoUserInContext
+	}
+
+	return user, nil
+}
+
+// DefaultUserMiddleware puts the given user into the context of the requests that have no user yet.
+// It stands in for the authentication middleware while authentication is disabled,
+// a user set by the authentication middleware is never replaced.
+func DefaultUserMiddleware(userID string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, err := UserFromCtx(r.Context()); err == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), User{ID: userID})))
+		})
+	}
+}
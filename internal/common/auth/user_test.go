@@ -0,0 +1,40 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sumni-finance-backend/internal/common/auth"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultUserMiddleware(t *testing.T) {
+	serve := func(t *testing.T, r *http.Request) auth.User {
+		t.Helper()
+
+		var user auth.User
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			user, err = auth.UserFromCtx(r.Context())
+			require.NoError(t, err)
+		})
+
+		auth.DefaultUserMiddleware("default-user")(next).ServeHTTP(httptest.NewRecorder(), r)
+		return user
+	}
+
+	t.Run("sets the default user when the request has none", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/categories", nil)
+
+		assert.Equal(t, "default-user", serve(t, r).ID)
+	})
+
+	t.Run("keeps the user set by the authentication", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/categories", nil)
+		r = r.WithContext(auth.WithUser(r.Context(), auth.User{ID: "user-1"}))
+
+		assert.Equal(t, "user-1", serve(t, r).ID)
+	})
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdvisoryLock elects a single leader between server instances sharing the same database.
+// The lock is a session level Postgres advisory lock, held on a dedicated pool connection
+// while the leader runs and released as soon as it is done.
+type AdvisoryLock struct {
+	pgxPool *pgxpool.Pool
+	key     int64
+}
+
+func NewAdvisoryLock(pgxPool *pgxpool.Pool, key int64) *AdvisoryLock {
+	return &AdvisoryLock{
+		pgxPool: pgxPool,
+		key:     key,
+	}
+}
+
+// TryRun runs fn only when the lock could be taken without waiting.
+// It reports whether this instance was the leader.
+func (l *AdvisoryLock) TryRun(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	conn, err := l.pgxPool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("try advisory lock: %w", err)
+	}
+
+	if !locked {
+		return false, nil
+	}
+
+	defer l.unlock(ctx, conn)
+
+	return true, fn(ctx)
+}
+
+// unlock releases the lock held by the session of conn, even if ctx is already cancelled. When the unlock fails
+// the session may still hold the lock, so the connection is closed instead of going back to the pool,
+// which ends the session and releases the lock with it.
+func (l *AdvisoryLock) unlock(ctx context.Context, conn *pgxpool.Conn) {
+	ctx = context.WithoutCancel(ctx)
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		slog.Error("failed to release advisory lock, closing its connection", "key", l.key, "error", err)
+
+		if err := conn.Hijack().Close(ctx); err != nil {
+			slog.Error("failed to close advisory lock connection", "key", l.key, "error", err)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package db
 
-import "github.com/jackc/pgx/v5/pgtype"
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
 
 func ToPgText(s string) pgtype.Text {
 	if s == "" {
@@ -19,3 +23,15 @@ func ToPgInt8(value int64) pgtype.Int8 {
 		Valid: true,
 	}
 }
+
+// ToPgTimestamp maps the zero time to NULL.
+func ToPgTimestamp(t time.Time) pgtype.Timestamp {
+	if t.IsZero() {
+		return pgtype.Timestamp{Valid: false}
+	}
+
+	return pgtype.Timestamp{
+		Time:  t,
+		Valid: true,
+	}
+}
@@ -1,8 +1,40 @@
 package db
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
 
 var (
 	// Concurrency
 	ErrConcurrentModification = errors.New("concurrent modification detected")
+
+	// Lookup
+	ErrNotFound = errors.New("record not found")
+)
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	foreignKeyViolationCode = "23503"
+	uniqueViolationCode     = "23505"
 )
+
+func IsUniqueViolation(err error) bool {
+	return hasPgErrorCode(err, uniqueViolationCode)
+}
+
+// IsUniqueViolationOn reports whether err violates the unique constraint or index named constraint.
+func IsUniqueViolationOn(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == constraint
+}
+
+func IsForeignKeyViolation(err error) bool {
+	return hasPgErrorCode(err, foreignKeyViolationCode)
+}
+
+func hasPgErrorCode(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
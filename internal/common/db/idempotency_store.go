@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/server/idempotency"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// IdempotencyStore keeps the responses of idempotent requests in finance.idempotency_keys.
+type IdempotencyStore struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewIdempotencyStore(pgxPool *pgxpool.Pool) *IdempotencyStore {
+	return &IdempotencyStore{
+		pgxPool: pgxPool,
+	}
+}
+
+func (s *IdempotencyStore) Reserve(
+	ctx context.Context,
+	key idempotency.Key,
+	requestHash string,
+	now time.Time,
+	expiresAt time.Time,
+) (idempotency.Record, bool, error) {
+	// Expired keys of the user are dropped on the way, so a reused key is reserved again
+	if _, err := s.pgxPool.Exec(ctx,
+		`DELETE FROM finance.idempotency_keys WHERE user_id = $1 AND expires_at <= $2`,
+		key.UserID, now,
+	); err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+
+	tag, err := s.pgxPool.Exec(ctx,
+		`INSERT INTO finance.idempotency_keys (user_id, route, idempotency_key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, route, idempotency_key) DO NOTHING`,
+		key.UserID, key.Route, key.Key, requestHash, now, expiresAt,
+	)
+	if err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("insert idempotency key: %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return idempotency.Record{RequestHash: requestHash}, true, nil
+	}
+
+	var (
+		record      idempotency.Record
+		statusCode  *int32
+		contentType *string
+		body        []byte
+	)
+	err = s.pgxPool.QueryRow(ctx,
+		`SELECT request_hash, status_code, content_type, response_body
+		FROM finance.idempotency_keys
+		WHERE user_id = $1 AND route = $2 AND idempotency_key = $3`,
+		key.UserID, key.Route, key.Key,
+	).Scan(&record.RequestHash, &statusCode, &contentType, &body)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The first request failed and released the key in the meantime, it is as good as in progress
+		return idempotency.Record{RequestHash: requestHash}, false, nil
+	}
+	if err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	if statusCode != nil {
+		record.Response = &idempotency.Response{
+			StatusCode: int(*statusCode),
+			Body:       body,
+		}
+		if contentType != nil {
+			record.Response.ContentType = *contentType
+		}
+	}
+
+	return record, false, nil
+}
+
+func (s *IdempotencyStore) Complete(ctx context.Context, key idempotency.Key, response idempotency.Response) error {
+	body := response.Body
+	if body == nil {
+		body = []byte{}
+	}
+
+	if _, err := s.pgxPool.Exec(ctx,
+		`UPDATE finance.idempotency_keys
+		SET status_code = $4, content_type = $5, response_body = $6
+		WHERE user_id = $1 AND route = $2 AND idempotency_key = $3`,
+		key.UserID, key.Route, key.Key, response.StatusCode, response.ContentType, body,
+	); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (s *IdempotencyStore) Release(ctx context.Context, key idempotency.Key) error {
+	if _, err := s.pgxPool.Exec(ctx,
+		`DELETE FROM finance.idempotency_keys WHERE user_id = $1 AND route = $2 AND idempotency_key = $3`,
+		key.UserID, key.Route, key.Key,
+	); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+// Package pdf writes PDF documents of text and rules with embedded TrueType fonts.
+// Text is drawn through the Unicode cmap of the font, so any script the font covers prints as is,
+// Vietnamese included, and stays searchable and copyable in viewers.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// A4 portrait in points
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document holds the pages of a PDF until it is written.
+type Document struct {
+	title     string
+	createdAt time.Time
+	pages     []*Page
+	fonts     []*fontResource
+}
+
+// fontResource is a font as used by a document, the glyphs it draws and the characters they stand for.
+type fontResource struct {
+	font  *Font
+	name  string
+	runes map[uint16]rune
+}
+
+func NewDocument(title string, createdAt time.Time) *Document {
+	return &Document{
+		title:     title,
+		createdAt: createdAt,
+	}
+}
+
+// AddPage appends an empty A4 page.
+func (d *Document) AddPage() *Page {
+	p := &Page{doc: d, fonts: make(map[*fontResource]bool)}
+	d.pages = append(d.pages, p)
+
+	return p
+}
+
+// Pages returns the pages in order.
+func (d *Document) Pages() []*Page { return d.pages }
+
+func (d *Document) resource(f *Font) *fontResource {
+	for _, res := range d.fonts {
+		if res.font == f {
+			return res
+		}
+	}
+
+	res := &fontResource{font: f, name: fmt.Sprintf("F%d", len(d.fonts)+1), runes: make(map[uint16]rune)}
+	d.fonts = append(d.fonts, res)
+
+	return res
+}
+
+// Page is a page of the document. Coordinates are in points from the top left corner of the page.
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+	fonts   map[*fontResource]bool
+}
+
+// Text draws s in font f of size points, starting at x on the baseline y.
+func (p *Page) Text(f *Font, size float64, x float64, y float64, s string) {
+	res := p.doc.resource(f)
+	p.fonts[res] = true
+
+	var hex strings.Builder
+	for _, r := range norm.NFC.String(s) {
+		gid := f.glyph(r)
+		if _, ok := res.runes[gid]; !ok {
+			res.runes[gid] = r
+		}
+		fmt.Fprintf(&hex, "%04X", gid)
+	}
+
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td <%s> Tj ET\n", res.name, num(size), num(x), num(PageHeight-y), hex.String())
+}
+
+// TextRight draws s so that it ends at x, as for amounts in a column.
+func (p *Page) TextRight(f *Font, size float64, x float64, y float64, s string) {
+	p.Text(f, size, x-f.Width(s, size), y, s)
+}
+
+// Line draws a rule of width points from (x1, y1) to (x2, y2) in the given gray, 0 black and 1 white.
+func (p *Page) Line(x1 float64, y1 float64, x2 float64, y2 float64, width float64, gray float64) {
+	fmt.Fprintf(&p.content, "%s G %s w %s %s m %s %s l S\n",
+		num(gray), num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// FillRect fills the rectangle of width w and height h whose top left corner is (x, y) in the given gray.
+func (p *Page) FillRect(x float64, y float64, w float64, h float64, gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f 0 g\n", num(gray), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// WriteTo writes the document, the fonts are embedded as subsets of the glyphs drawn on the pages.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	pw := &writer{w: w}
+	pw.printf("%%PDF-1.7\n%%\xe2\xe3\xcf\xd3\n")
+
+	// Object numbers: 1 catalog, 2 page tree, 3 info, then 5 per font and 2 per page
+	const catalog, pageTree, info = 1, 2, 3
+	fontObjects := make(map[*fontResource]int)
+	next := 4
+	for _, res := range d.fonts {
+		fontObjects[res] = next
+		next += 5
+	}
+	firstPage := next
+
+	pw.object(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pageTree))
+
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPage+2*i))
+	}
+	pw.object(pageTree, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+
+	pw.object(info, fmt.Sprintf("<< /Title %s /Producer (sumni-finance) /CreationDate (%s) >>",
+		textString(d.title), pdfDate(d.createdAt)))
+
+	for _, res := range d.fonts {
+		d.writeFont(pw, res, fontObjects[res])
+	}
+
+	for i, page := range d.pages {
+		names := make([]string, 0, len(page.fonts))
+		for _, res := range d.fonts {
+			if page.fonts[res] {
+				names = append(names, fmt.Sprintf("/%s %d 0 R", res.name, fontObjects[res]))
+			}
+		}
+
+		pageObject := firstPage + 2*i
+		pw.object(pageObject, fmt.Sprintf(
+			"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			pageTree, num(PageWidth), num(PageHeight), strings.Join(names, " "), pageObject+1,
+		))
+		pw.stream(pageObject+1, "", page.content.Bytes())
+	}
+
+	xref := pw.n
+	size := firstPage + 2*len(d.pages)
+	pw.printf("xref\n0 %d\n0000000000 65535 f \n", size)
+	for object := 1; object < size; object++ {
+		pw.printf("%010d 00000 n \n", pw.offsets[object])
+	}
+	pw.printf("trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", size, catalog, info, xref)
+
+	return pw.n, pw.err
+}
+
+// writeFont writes the Type0 font at object, followed by its CID font, descriptor, font file and ToUnicode map.
+func (d *Document) writeFont(pw *writer, res *fontResource, object int) {
+	f := res.font
+	cidFont, descriptor, fontFile, toUnicode := object+1, object+2, object+3, object+4
+
+	gids := slices.Sorted(maps.Keys(res.runes))
+	used := make(map[uint16]bool, len(gids))
+	for _, gid := range gids {
+		used[gid] = true
+	}
+
+	baseFont := subsetTag(f.Name(), gids) + "+" + psName(f.Name())
+
+	pw.object(object, fmt.Sprintf(
+		"<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+		baseFont, cidFont, toUnicode,
+	))
+
+	var widths strings.Builder
+	for _, gid := range gids {
+		fmt.Fprintf(&widths, "%d [%d] ", gid, f.scale(float64(f.advances[gid])))
+	}
+	pw.object(cidFont, fmt.Sprintf(
+		"<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /DW %d /W [%s] /CIDToGIDMap /Identity >>",
+		baseFont, descriptor, f.scale(float64(f.advances[0])), strings.TrimSpace(widths.String()),
+	))
+
+	// Symbolic, the font is used through glyph ids rather than a standard character set
+	flags := 4
+	if f.fixedPitch {
+		flags |= 1
+	}
+	if f.italic != 0 {
+		flags |= 64
+	}
+	pw.object(descriptor, fmt.Sprintf(
+		"<< /Type /FontDescriptor /FontName /%s /Flags %d /FontBBox [%d %d %d %d] /ItalicAngle %s "+
+			"/Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		baseFont, flags,
+		f.scale(float64(f.bbox[0])), f.scale(float64(f.bbox[1])), f.scale(float64(f.bbox[2])), f.scale(float64(f.bbox[3])),
+		num(f.italic), f.scale(float64(f.ascent)), f.scale(float64(f.descent)), f.scale(float64(f.capHeight)), fontFile,
+	))
+
+	file := f.subset(used)
+	pw.stream(fontFile, fmt.Sprintf("/Length1 %d", len(file)), file)
+
+	pw.stream(toUnicode, "", toUnicodeCMap(res.runes, gids))
+}
+
+// toUnicodeCMap maps the glyphs back to the characters they were drawn for, for search and copy.
+func toUnicodeCMap(runes map[uint16]rune, gids []uint16) []byte {
+	var b bytes.Buffer
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+
+	// A bfchar block holds 100 entries at most
+	for chunk := range slices.Chunk(gids, 100) {
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(chunk))
+		for _, gid := range chunk {
+			var unicode strings.Builder
+			for _, unit := range utf16.Encode([]rune{runes[gid]}) {
+				fmt.Fprintf(&unicode, "%04X", unit)
+			}
+			fmt.Fprintf(&b, "<%04X> <%s>\n", gid, unicode.String())
+		}
+		b.WriteString("endbfchar\n")
+	}
+
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+
+	return b.Bytes()
+}
+
+// subsetTag is the six capital letters naming a subset, derived from the glyphs it holds.
+func subsetTag(name string, gids []uint16) string {
+	h := sha256.New()
+	h.Write([]byte(name))
+	for _, gid := range gids {
+		h.Write([]byte{byte(gid >> 8), byte(gid)})
+	}
+
+	sum := h.Sum(nil)
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + sum[i]%26
+	}
+
+	return string(tag)
+}
+
+// psName keeps the characters a PDF name can hold without escaping.
+func psName(name string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if r > ' ' && r < '~' && !strings.ContainsRune("()<>[]{}/%#", r) {
+			return r
+		}
+		return -1
+	}, name)
+
+	if cleaned == "" {
+		return "Font"
+	}
+
+	return cleaned
+}
+
+// textString encodes s as a PDF text string, UTF-16BE with a byte order mark.
+func textString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, unit := range utf16.Encode([]rune(norm.NFC.String(s))) {
+		fmt.Fprintf(&b, "%04X", unit)
+	}
+	b.WriteString(">")
+
+	return b.String()
+}
+
+// pdfDate formats t as D:YYYYMMDDHHmmSS+HH'mm'.
+func pdfDate(t time.Time) string {
+	_, offset := t.Zone()
+
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+
+	return fmt.Sprintf("D:%s%c%02d'%02d'", t.Format("20060102150405"), sign, offset/3600, offset%3600/60)
+}
+
+// num formats a coordinate with at most two decimals.
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// writer tracks the byte offset of every object for the cross reference table.
+type writer struct {
+	w       io.Writer
+	n       int64
+	err     error
+	offsets map[int]int64
+}
+
+func (pw *writer) printf(format string, args ...any) {
+	if pw.err != nil {
+		return
+	}
+
+	n, err := fmt.Fprintf(pw.w, format, args...)
+	pw.n += int64(n)
+	pw.err = err
+}
+
+func (pw *writer) object(number int, body string) {
+	if pw.offsets == nil {
+		pw.offsets = make(map[int]int64)
+	}
+	pw.offsets[number] = pw.n
+	pw.printf("%d 0 obj\n%s\nendobj\n", number, body)
+}
+
+// stream writes data compressed, extra holds the entries of the stream dictionary besides its length and filter.
+func (pw *writer) stream(number int, extra string, data []byte) {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil && pw.err == nil {
+		pw.err = err
+	}
+	if err := zw.Close(); err != nil && pw.err == nil {
+		pw.err = err
+	}
+
+	if extra != "" {
+		extra = " " + extra
+	}
+
+	pw.object(number, fmt.Sprintf("<< /Length %d /Filter /FlateDecode%s >>\nstream\n%s\nendstream",
+		compressed.Len(), extra, compressed.Bytes()))
+}
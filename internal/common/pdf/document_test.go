@@ -0,0 +1,106 @@
+package pdf_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/common/pdf"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDejaVuSans(t *testing.T) {
+	for _, load := range []func() (*pdf.Font, error){pdf.DejaVuSans, pdf.DejaVuSansBold} {
+		f, err := load()
+		require.NoError(t, err)
+
+		assert.Contains(t, f.Name(), "DejaVuSans")
+		assert.True(t, f.HasGlyphs("Số dư cuối kỳ: 1.234.567 ₫, Đã hủy, Chưa phân loại, Nạp tiền, Rút tiền"))
+		// Decomposed diacritics are composed before they are drawn
+		assert.True(t, f.HasGlyphs("Tie\u0302\u0301n"))
+		assert.False(t, f.HasGlyphs("金"))
+	}
+}
+
+func TestFont_Width(t *testing.T) {
+	f, err := pdf.DejaVuSans()
+	require.NoError(t, err)
+
+	assert.InDelta(t, 2*f.Width("0", 10), f.Width("00", 10), 0.001)
+	assert.InDelta(t, f.Width("ế", 10), f.Width("e\u0302\u0301", 10), 0.001)
+	assert.Greater(t, f.Width("0", 20), f.Width("0", 10))
+}
+
+func TestParseTrueType(t *testing.T) {
+	_, err := pdf.ParseTrueType([]byte("OTTO not a TrueType font"))
+	assert.ErrorIs(t, err, pdf.ErrUnsupportedFont)
+}
+
+func TestDocument_WriteTo(t *testing.T) {
+	f, err := pdf.DejaVuSans()
+	require.NoError(t, err)
+
+	doc := pdf.NewDocument("Sao kê ví", time.Date(2026, time.May, 1, 8, 0, 0, 0, time.FixedZone("ICT", 7*3600)))
+	first := doc.AddPage()
+	first.Text(f, 12, 40, 60, "Số dư đầu kỳ")
+	first.FillRect(40, 70, 100, 20, 0.9)
+	doc.AddPage().TextRight(f, 8, 555, 800, "Trang 2/2")
+
+	var buf bytes.Buffer
+	n, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	out := buf.Bytes()
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.7\n")))
+	require.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), "/CreationDate (D:20260501080000+07'00')")
+
+	t.Run("cross references point at their objects", func(t *testing.T) {
+		startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+		require.NotNil(t, startxref)
+		xref, err := strconv.Atoi(string(startxref[1]))
+		require.NoError(t, err)
+
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+		require.NotEmpty(t, entries)
+		for i, entry := range entries {
+			offset, err := strconv.Atoi(string(entry[1]))
+			require.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(out[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n")), "object %d", i+1)
+		}
+	})
+
+	t.Run("text is drawn as glyphs of the embedded font", func(t *testing.T) {
+		var contents []string
+		for _, stream := range regexp.MustCompile(`(?s)/Length (\d+) /Filter /FlateDecode[^>]*>>\nstream\n`).FindAllSubmatchIndex(out, -1) {
+			length, err := strconv.Atoi(string(out[stream[2]:stream[3]]))
+			require.NoError(t, err)
+
+			r, err := zlib.NewReader(bytes.NewReader(out[stream[1] : stream[1]+length]))
+			require.NoError(t, err)
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			contents = append(contents, string(data))
+		}
+
+		var pages []string
+		for _, c := range contents {
+			if regexp.MustCompile(`Tj ET`).MatchString(c) {
+				pages = append(pages, c)
+			}
+		}
+		require.Len(t, pages, 2)
+		assert.Regexp(t, `BT /F1 12 Tf 40 781.89 Td <[0-9A-F]{48}> Tj ET`, pages[0])
+		assert.Contains(t, pages[0], "0.9 g 40 751.89 100 20 re f 0 g")
+		// ToUnicode maps the glyphs back to Vietnamese for search and copy
+		assert.Contains(t, strings.Join(contents, ""), "<1EF3>")
+	})
+}
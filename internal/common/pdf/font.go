@@ -0,0 +1,319 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrUnsupportedFont = errors.New("unsupported TrueType font")
+
+// Font is a parsed TrueType font. It is immutable and can be shared between documents,
+// every document embeds the subset of the glyphs it draws.
+type Font struct {
+	name       string
+	data       []byte
+	tables     map[string][]byte
+	unitsPerEm float64
+	ascent     int16
+	descent    int16
+	capHeight  int16
+	bbox       [4]int16
+	italic     float64
+	fixedPitch bool
+	glyphs     map[rune]uint16
+	advances   []uint16
+}
+
+// ParseTrueType reads a TrueType font, a font with CFF outlines or a font collection is refused.
+func ParseTrueType(data []byte) (*Font, error) {
+	if len(data) < 12 {
+		return nil, fmt.Errorf("%w: file too short", ErrUnsupportedFont)
+	}
+
+	if version := binary.BigEndian.Uint32(data); version != 0x00010000 && version != 0x74727565 {
+		return nil, fmt.Errorf("%w: not a TrueType outline font", ErrUnsupportedFont)
+	}
+
+	f := &Font{data: data, tables: make(map[string][]byte)}
+
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	for i := range numTables {
+		record := 12 + 16*i
+		if record+16 > len(data) {
+			return nil, fmt.Errorf("%w: truncated table directory", ErrUnsupportedFont)
+		}
+
+		tag := string(data[record : record+4])
+		offset := int(binary.BigEndian.Uint32(data[record+8:]))
+		length := int(binary.BigEndian.Uint32(data[record+12:]))
+		if offset+length > len(data) {
+			return nil, fmt.Errorf("%w: table %s out of bounds", ErrUnsupportedFont, tag)
+		}
+
+		f.tables[tag] = data[offset : offset+length]
+	}
+
+	for _, tag := range []string{"head", "hhea", "maxp", "hmtx", "cmap", "loca", "glyf"} {
+		if _, ok := f.tables[tag]; !ok {
+			return nil, fmt.Errorf("%w: missing %s table", ErrUnsupportedFont, tag)
+		}
+	}
+
+	if err := f.parseMetrics(); err != nil {
+		return nil, err
+	}
+
+	glyphs, err := parseCmap(f.tables["cmap"])
+	if err != nil {
+		return nil, err
+	}
+	f.glyphs = glyphs
+	f.name = parseName(f.tables["name"])
+
+	return f, nil
+}
+
+func (f *Font) parseMetrics() error {
+	head, hhea, maxp := f.tables["head"], f.tables["hhea"], f.tables["maxp"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return fmt.Errorf("%w: truncated head, hhea or maxp table", ErrUnsupportedFont)
+	}
+
+	f.unitsPerEm = float64(binary.BigEndian.Uint16(head[18:]))
+	if f.unitsPerEm == 0 {
+		return fmt.Errorf("%w: units per em is zero", ErrUnsupportedFont)
+	}
+
+	for i := range f.bbox {
+		f.bbox[i] = int16(binary.BigEndian.Uint16(head[36+2*i:]))
+	}
+
+	f.ascent = int16(binary.BigEndian.Uint16(hhea[4:]))
+	f.descent = int16(binary.BigEndian.Uint16(hhea[6:]))
+	f.capHeight = f.ascent
+
+	if os2 := f.tables["OS/2"]; len(os2) >= 90 && binary.BigEndian.Uint16(os2) >= 2 {
+		f.capHeight = int16(binary.BigEndian.Uint16(os2[88:]))
+	}
+
+	if post := f.tables["post"]; len(post) >= 16 {
+		f.italic = float64(int32(binary.BigEndian.Uint32(post[4:]))) / 65536
+		f.fixedPitch = binary.BigEndian.Uint32(post[12:]) != 0
+	}
+
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:]))
+	numMetrics := int(binary.BigEndian.Uint16(hhea[34:]))
+	hmtx := f.tables["hmtx"]
+	if numMetrics == 0 || len(hmtx) < 4*numMetrics {
+		return fmt.Errorf("%w: truncated hmtx table", ErrUnsupportedFont)
+	}
+
+	// The glyphs past the long metrics share the advance of the last one, as in monospaced fonts
+	f.advances = make([]uint16, numGlyphs)
+	for gid := range f.advances {
+		f.advances[gid] = binary.BigEndian.Uint16(hmtx[4*min(gid, numMetrics-1):])
+	}
+
+	return nil
+}
+
+// parseCmap reads the Unicode subtable of the font, the full repertoire (3,10) format 12 one when there is one,
+// the basic multilingual plane (3,1) or (0,x) format 4 one otherwise.
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, fmt.Errorf("%w: truncated cmap table", ErrUnsupportedFont)
+	}
+
+	var format4, format12 []byte
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := range numTables {
+		record := 4 + 8*i
+		if record+8 > len(cmap) {
+			break
+		}
+
+		platform := binary.BigEndian.Uint16(cmap[record:])
+		encoding := binary.BigEndian.Uint16(cmap[record+2:])
+		offset := int(binary.BigEndian.Uint32(cmap[record+4:]))
+		if offset+4 > len(cmap) {
+			continue
+		}
+
+		subtable := cmap[offset:]
+		switch format := binary.BigEndian.Uint16(subtable); {
+		case format == 12 && (platform == 0 || platform == 3 && encoding == 10):
+			format12 = subtable
+		case format == 4 && (platform == 0 || platform == 3 && encoding == 1):
+			format4 = subtable
+		}
+	}
+
+	switch {
+	case format12 != nil:
+		return parseCmapFormat12(format12)
+	case format4 != nil:
+		return parseCmapFormat4(format4)
+	}
+
+	return nil, fmt.Errorf("%w: no Unicode cmap subtable", ErrUnsupportedFont)
+}
+
+func parseCmapFormat4(subtable []byte) (map[rune]uint16, error) {
+	if len(subtable) < 14 {
+		return nil, fmt.Errorf("%w: truncated cmap format 4", ErrUnsupportedFont)
+	}
+
+	segCount := int(binary.BigEndian.Uint16(subtable[6:])) / 2
+	endCodes := 14
+	startCodes := endCodes + 2*segCount + 2
+	idDeltas := startCodes + 2*segCount
+	idRangeOffsets := idDeltas + 2*segCount
+	if idRangeOffsets+2*segCount > len(subtable) {
+		return nil, fmt.Errorf("%w: truncated cmap format 4", ErrUnsupportedFont)
+	}
+
+	glyphs := make(map[rune]uint16)
+	for i := range segCount {
+		end := int(binary.BigEndian.Uint16(subtable[endCodes+2*i:]))
+		start := int(binary.BigEndian.Uint16(subtable[startCodes+2*i:]))
+		delta := binary.BigEndian.Uint16(subtable[idDeltas+2*i:])
+		rangeOffset := int(binary.BigEndian.Uint16(subtable[idRangeOffsets+2*i:]))
+
+		for c := start; c <= end && c != 0xFFFF; c++ {
+			var gid uint16
+			if rangeOffset == 0 {
+				gid = uint16(c) + delta
+			} else {
+				// The offset is relative to the position of the idRangeOffset itself
+				addr := idRangeOffsets + 2*i + rangeOffset + 2*(c-start)
+				if addr+2 > len(subtable) {
+					continue
+				}
+
+				if gid = binary.BigEndian.Uint16(subtable[addr:]); gid != 0 {
+					gid += delta
+				}
+			}
+
+			if gid != 0 {
+				glyphs[rune(c)] = gid
+			}
+		}
+	}
+
+	return glyphs, nil
+}
+
+func parseCmapFormat12(subtable []byte) (map[rune]uint16, error) {
+	if len(subtable) < 16 {
+		return nil, fmt.Errorf("%w: truncated cmap format 12", ErrUnsupportedFont)
+	}
+
+	numGroups := int(binary.BigEndian.Uint32(subtable[12:]))
+	if 16+12*numGroups > len(subtable) {
+		return nil, fmt.Errorf("%w: truncated cmap format 12", ErrUnsupportedFont)
+	}
+
+	glyphs := make(map[rune]uint16)
+	for i := range numGroups {
+		group := subtable[16+12*i:]
+		start := binary.BigEndian.Uint32(group)
+		end := binary.BigEndian.Uint32(group[4:])
+		startGlyph := binary.BigEndian.Uint32(group[8:])
+
+		for c := start; c <= end && c <= 0x10FFFF; c++ {
+			if gid := startGlyph + c - start; gid != 0 && gid <= 0xFFFF {
+				glyphs[rune(c)] = uint16(gid)
+			}
+		}
+	}
+
+	return glyphs, nil
+}
+
+// parseName returns the PostScript name of the font, name id 6, empty when the font does not give one.
+func parseName(name []byte) string {
+	if len(name) < 6 {
+		return ""
+	}
+
+	count := int(binary.BigEndian.Uint16(name[2:]))
+	storage := int(binary.BigEndian.Uint16(name[4:]))
+	for i := range count {
+		record := 6 + 12*i
+		if record+12 > len(name) {
+			break
+		}
+
+		platform := binary.BigEndian.Uint16(name[record:])
+		nameID := binary.BigEndian.Uint16(name[record+6:])
+		length := int(binary.BigEndian.Uint16(name[record+8:]))
+		offset := storage + int(binary.BigEndian.Uint16(name[record+10:]))
+		if nameID != 6 || offset+length > len(name) {
+			continue
+		}
+
+		value := name[offset : offset+length]
+		if platform == 1 {
+			return string(value)
+		}
+
+		// Windows and Unicode platform names are UTF-16BE, a PostScript name is ASCII
+		ascii := make([]byte, 0, length/2)
+		for j := 1; j < len(value); j += 2 {
+			ascii = append(ascii, value[j])
+		}
+
+		return string(ascii)
+	}
+
+	return ""
+}
+
+// Name returns the PostScript name of the font.
+func (f *Font) Name() string { return f.name }
+
+// Width returns the advance width of s set in size points.
+func (f *Font) Width(s string, size float64) float64 {
+	var units float64
+	for _, gid := range f.glyphIDs(s) {
+		units += float64(f.advances[gid])
+	}
+
+	return units * size / f.unitsPerEm
+}
+
+// HasGlyphs reports whether the font draws every character of s once composed, such as ế or ₫.
+func (f *Font) HasGlyphs(s string) bool {
+	return !slices.Contains(f.glyphIDs(s), 0)
+}
+
+// glyphIDs maps the characters of s, composed first, to glyphs. A character missing from the font is
+// drawn as glyph 0, the .notdef box.
+func (f *Font) glyphIDs(s string) []uint16 {
+	s = norm.NFC.String(s)
+
+	gids := make([]uint16, 0, len(s))
+	for _, r := range s {
+		gids = append(gids, f.glyph(r))
+	}
+
+	return gids
+}
+
+func (f *Font) glyph(r rune) uint16 {
+	if gid := f.glyphs[r]; int(gid) < len(f.advances) {
+		return gid
+	}
+
+	return 0
+}
+
+// scale converts font units to the thousandths of an em PDF uses for font metrics.
+func (f *Font) scale(units float64) int {
+	return int(units * 1000 / f.unitsPerEm)
+}
@@ -0,0 +1,21 @@
+package pdf
+
+import (
+	_ "embed"
+	"sync"
+)
+
+// DejaVu Sans covers Latin, Vietnamese included, Greek and Cyrillic, its license is in fonts/LICENSE.
+var (
+	//go:embed fonts/DejaVuSans.ttf
+	dejaVuSans []byte
+	//go:embed fonts/DejaVuSans-Bold.ttf
+	dejaVuSansBold []byte
+)
+
+var (
+	// DejaVuSans returns the regular weight of DejaVu Sans, parsed once.
+	DejaVuSans = sync.OnceValues(func() (*Font, error) { return ParseTrueType(dejaVuSans) })
+	// DejaVuSansBold returns the bold weight of DejaVu Sans, parsed once.
+	DejaVuSansBold = sync.OnceValues(func() (*Font, error) { return ParseTrueType(dejaVuSansBold) })
+)
@@ -0,0 +1,197 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// Flags of the components of a composite glyph
+const (
+	argsAreWords   = 0x0001
+	haveScale      = 0x0008
+	moreComponents = 0x0020
+	haveXYScale    = 0x0040
+	haveTwoByTwo   = 0x0080
+)
+
+// subsetTables are the tables a TrueType font embedded in a PDF needs, a CID font maps its glyphs
+// without the cmap of the font.
+var subsetTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// subset returns a font keeping the outlines of glyph 0 and of used, together with the glyphs the composite
+// ones are made of. The other glyphs keep their id and metrics but have no outline, so the CIDs drawn
+// in the document are the glyph ids of the original font.
+func (f *Font) subset(used map[uint16]bool) []byte {
+	glyphs := f.outlines()
+
+	kept := map[uint16]bool{0: true}
+	pending := []uint16{0}
+	for gid := range used {
+		if !kept[gid] {
+			kept[gid] = true
+			pending = append(pending, gid)
+		}
+	}
+
+	for len(pending) > 0 {
+		gid := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		for _, component := range components(glyphs[gid]) {
+			if int(component) < len(glyphs) && !kept[component] {
+				kept[component] = true
+				pending = append(pending, component)
+			}
+		}
+	}
+
+	var glyf []byte
+	loca := make([]byte, 4*(len(glyphs)+1))
+	for gid, outline := range glyphs {
+		if kept[uint16(gid)] {
+			glyf = append(glyf, outline...)
+			// Glyphs start on 4 byte boundaries
+			for len(glyf)%4 != 0 {
+				glyf = append(glyf, 0)
+			}
+		}
+		binary.BigEndian.PutUint32(loca[4*(gid+1):], uint32(len(glyf)))
+	}
+
+	head := slices.Clone(f.tables["head"])
+	binary.BigEndian.PutUint32(head[8:], 0)  // checkSumAdjustment, set once the font is assembled
+	binary.BigEndian.PutUint16(head[50:], 1) // indexToLocFormat, long offsets
+
+	tables := map[string][]byte{"glyf": glyf, "loca": loca, "head": head}
+	for _, tag := range subsetTables {
+		if _, ok := tables[tag]; !ok {
+			if table, exist := f.tables[tag]; exist {
+				tables[tag] = table
+			}
+		}
+	}
+
+	return assemble(tables)
+}
+
+// outlines splits the glyf table into the outline of every glyph.
+func (f *Font) outlines() [][]byte {
+	loca, glyf := f.tables["loca"], f.tables["glyf"]
+	longOffsets := binary.BigEndian.Uint16(f.tables["head"][50:]) == 1
+
+	offset := func(gid int) int {
+		if longOffsets {
+			if 4*gid+4 > len(loca) {
+				return len(glyf)
+			}
+			return int(binary.BigEndian.Uint32(loca[4*gid:]))
+		}
+
+		if 2*gid+2 > len(loca) {
+			return len(glyf)
+		}
+		return 2 * int(binary.BigEndian.Uint16(loca[2*gid:]))
+	}
+
+	outlines := make([][]byte, len(f.advances))
+	for gid := range outlines {
+		start, end := offset(gid), offset(gid+1)
+		if start < end && end <= len(glyf) {
+			outlines[gid] = glyf[start:end]
+		}
+	}
+
+	return outlines
+}
+
+// components returns the glyphs a composite glyph is made of, none for a simple glyph.
+func components(outline []byte) []uint16 {
+	if len(outline) < 10 || int16(binary.BigEndian.Uint16(outline)) >= 0 {
+		return nil
+	}
+
+	var gids []uint16
+	for pos := 10; pos+4 <= len(outline); {
+		flags := binary.BigEndian.Uint16(outline[pos:])
+		gids = append(gids, binary.BigEndian.Uint16(outline[pos+2:]))
+		pos += 4
+
+		if flags&argsAreWords != 0 {
+			pos += 4
+		} else {
+			pos += 2
+		}
+
+		switch {
+		case flags&haveScale != 0:
+			pos += 2
+		case flags&haveXYScale != 0:
+			pos += 4
+		case flags&haveTwoByTwo != 0:
+			pos += 8
+		}
+
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+
+	return gids
+}
+
+// assemble writes a TrueType font of tables, sorted by tag as the format asks.
+func assemble(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+
+	numTables := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	font := make([]byte, 12+16*numTables)
+	binary.BigEndian.PutUint32(font, 0x00010000)
+	binary.BigEndian.PutUint16(font[4:], uint16(numTables))
+	binary.BigEndian.PutUint16(font[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(font[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(font[10:], uint16(16*numTables-searchRange))
+
+	headOffset := 0
+	for i, tag := range tags {
+		table := tables[tag]
+		if tag == "head" {
+			headOffset = len(font)
+		}
+
+		record := font[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(font)))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+
+		font = append(font, table...)
+		for len(font)%4 != 0 {
+			font = append(font, 0)
+		}
+	}
+
+	binary.BigEndian.PutUint32(font[headOffset+8:], 0xB1B0AFBA-checksum(font))
+
+	return font
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+
+	return sum
+}
@@ -101,8 +101,8 @@ func addCorsMiddleware(router *chi.Mux) {
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   allowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Idempotency-Key", "X-CSRF-Token"},
+		ExposedHeaders:   []string{"Idempotent-Replayed", "Link"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	})
@@ -13,6 +13,7 @@ var (
 	ErrorTypeUnknown        = ErrorType{"unknown"}
 	ErrorTypeAuthorization  = ErrorType{"authorization"}
 	ErrorTypeIncorrectInput = ErrorType{"incorrect-input"}
+	ErrorTypeNotFound       = ErrorType{"not-found"}
 )
 
 type SlugError struct {
@@ -77,3 +78,12 @@ func NewIncorrectInputError(err error, slug string) SlugError {
 		wrappedErr: err,
 	}
 }
+
+func NewNotFoundError(err error, slug string) SlugError {
+	return SlugError{
+		logMsg:     err.Error(),
+		slug:       slug,
+		errorType:  ErrorTypeNotFound,
+		wrappedErr: err,
+	}
+}
@@ -21,6 +21,18 @@ func BadRequest(slug string, err error, w http.ResponseWriter, r *http.Request)
 	httpRespondWithError(err, slug, w, r, err.Error(), http.StatusBadRequest)
 }
 
+func NotFound(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, err.Error(), http.StatusNotFound)
+}
+
+func Conflict(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, err.Error(), http.StatusConflict)
+}
+
+func UnprocessableEntity(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, err.Error(), http.StatusUnprocessableEntity)
+}
+
 func RespondWithSlugError(err error, w http.ResponseWriter, r *http.Request) {
 	slugError, ok := err.(SlugError)
 	if !ok {
@@ -33,6 +45,8 @@ func RespondWithSlugError(err error, w http.ResponseWriter, r *http.Request) {
 		Unauthorised(slugError.Slug(), slugError, w, r)
 	case ErrorTypeIncorrectInput:
 		BadRequest(slugError.Slug(), slugError, w, r)
+	case ErrorTypeNotFound:
+		NotFound(slugError.Slug(), slugError, w, r)
 	default:
 		InternalError(slugError.Slug(), slugError, w, r)
 	}
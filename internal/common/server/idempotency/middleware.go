@@ -0,0 +1,277 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/logs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	// maxBodySize bounds the payload buffered to fingerprint the request
+	maxBodySize = 1 << 20
+	// maxLargeBodySize bounds the payload of the routes uploading files, such as statement imports
+	maxLargeBodySize = 8 << 20
+)
+
+// Key identifies a request, the same key sent by another user or to another route is another request.
+type Key struct {
+	UserID string
+	Route  string
+	Key    string
+}
+
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Record is what the store holds for a key, its Response stays nil while the first request is running.
+type Record struct {
+	RequestHash string
+	Response    *Response
+}
+
+type Store interface {
+	// Reserve stores the key with the request hash unless it exists and has not expired yet.
+	// It reports whether the key was reserved, otherwise it returns the record already stored.
+	Reserve(ctx context.Context, key Key, requestHash string, now time.Time, expiresAt time.Time) (Record, bool, error)
+
+	// Complete stores the response of the request that reserved the key.
+	Complete(ctx context.Context, key Key, response Response) error
+
+	// Release forgets the key so that a retry runs the request again.
+	Release(ctx context.Context, key Key) error
+}
+
+// Middleware honours the Idempotency-Key header of command requests.
+// The first response to a key is stored for ttl and replayed to the retries of the same request,
+// a request reusing the key with another payload is rejected. Server errors are not stored,
+// the key is released so the retry runs the request again. Once the request ran, the key is kept
+// even if its response could not be stored, the retries are then answered with a conflict.
+// Requests without the header pass through.
+// The body of the largeBodyRoutes, given as "METHOD /route/{pattern}", may be up to 8 MiB instead of 1 MiB.
+func Middleware(store Store, ttl time.Duration, largeBodyRoutes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idempotencyKey := r.Header.Get(HeaderKey)
+			if idempotencyKey == "" || !isCommand(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(idempotencyKey) > maxKeyLength {
+				httperr.BadRequest("invalid-idempotency-key", fmt.Errorf("idempotency key must be at most %d characters", maxKeyLength), w, r)
+				return
+			}
+
+			user, err := auth.UserFromCtx(r.Context())
+			if err != nil {
+				httperr.RespondWithSlugError(err, w, r)
+				return
+			}
+
+			bodySize := int64(maxBodySize)
+			if slices.Contains(largeBodyRoutes, r.Method+" "+routePattern(r)) {
+				bodySize = maxLargeBodySize
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, bodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					httperr.BadRequest("request-body-too-large", err, w, r)
+					return
+				}
+
+				httperr.BadRequest("failed-to-read-request-body", err, w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			key := Key{
+				UserID: user.ID,
+				Route:  r.Method + " " + r.URL.Path,
+				Key:    idempotencyKey,
+			}
+			requestHash := hashRequest(r, body)
+
+			now := time.Now()
+			record, reserved, err := store.Reserve(r.Context(), key, requestHash, now, now.Add(ttl))
+			if err != nil {
+				httperr.InternalError("failed-to-reserve-idempotency-key", err, w, r)
+				return
+			}
+
+			if !reserved {
+				replay(w, r, record, requestHash)
+				return
+			}
+
+			run(w, r, next, store, key)
+		})
+	}
+}
+
+// run serves the request that reserved the key and stores its response.
+func run(w http.ResponseWriter, r *http.Request, next http.Handler, store Store, key Key) {
+	logger := logs.FromContext(r.Context())
+	// The response is already on its way, the store is updated even if the client went away
+	ctx := context.WithoutCancel(r.Context())
+
+	release := true
+	defer func() {
+		if !release {
+			return
+		}
+
+		// Server errors and panics let the retry run the request again
+		if err := store.Release(ctx, key); err != nil {
+			logger.Error("failed to release idempotency key", "error", err)
+		}
+	}()
+
+	var body bytes.Buffer
+	ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+	ww.Tee(&body)
+
+	next.ServeHTTP(ww, r)
+
+	// A handler writing nothing answers with the implicit 200
+	status := ww.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	if status >= http.StatusInternalServerError {
+		return
+	}
+
+	// The request ran, releasing the key now would let a retry run it a second time.
+	// A response that could not be stored leaves the key reserved, so the retries get a conflict until it expires.
+	release = false
+
+	if err := store.Complete(ctx, key, Response{
+		StatusCode:  status,
+		ContentType: ww.Header().Get("Content-Type"),
+		Body:        body.Bytes(),
+	}); err != nil {
+		logger.Error("failed to store idempotent response", "error", err)
+	}
+}
+
+// replay answers a retry with the stored response of the key.
+func replay(w http.ResponseWriter, r *http.Request, record Record, requestHash string) {
+	if record.RequestHash != requestHash {
+		httperr.UnprocessableEntity("idempotency-key-reused", errors.New("idempotency key was already used with another payload"), w, r)
+		return
+	}
+
+	if record.Response == nil {
+		httperr.Conflict("idempotency-key-in-progress", errors.New("request with the same idempotency key is still in progress or its response was not stored"), w, r)
+		return
+	}
+
+	if record.Response.ContentType != "" {
+		w.Header().Set("Content-Type", record.Response.ContentType)
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(record.Response.StatusCode)
+
+	if _, err := w.Write(record.Response.Body); err != nil {
+		logs.FromContext(r.Context()).Error("failed to replay idempotent response", "error", err)
+	}
+}
+
+func isCommand(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// routePattern returns the pattern of the route matched by the router, or the path outside of one.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		return rctx.RoutePattern()
+	}
+
+	return r.URL.Path
+}
+
+// hashRequest fingerprints the payload of the request, the route is already part of the key.
+// A multipart form is fingerprinted by its fields and files, its boundary changes with every retry.
+func hashRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+
+	if parts, ok := readMultipartForm(r, body); ok {
+		for _, p := range parts {
+			fmt.Fprintf(h, "%s\x00%d\x00", p.name, len(p.content))
+			h.Write(p.content)
+		}
+	} else {
+		h.Write(body)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type formPart struct {
+	name    string
+	content []byte
+}
+
+// readMultipartForm returns the fields and files of a multipart form sorted by name,
+// it reports false when the body is not a well formed multipart form.
+func readMultipartForm(r *http.Request, body []byte) ([]formPart, bool) {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return nil, false
+	}
+
+	var parts []formPart
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+
+		content, err := io.ReadAll(p)
+		if err != nil {
+			return nil, false
+		}
+
+		parts = append(parts, formPart{name: p.FormName(), content: content})
+	}
+
+	slices.SortStableFunc(parts, func(a, b formPart) int { return strings.Compare(a.name, b.name) })
+	return parts, true
+}
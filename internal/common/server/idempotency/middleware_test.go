@@ -0,0 +1,281 @@
+package idempotency_test
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/idempotency"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[idempotency.Key]idempotency.Record
+	// completeErr makes Complete fail, as when the database is unreachable
+	completeErr error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[idempotency.Key]idempotency.Record{}}
+}
+
+func (s *memoryStore) Reserve(_ context.Context, key idempotency.Key, requestHash string, _ time.Time, _ time.Time) (idempotency.Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		return record, false, nil
+	}
+
+	s.records[key] = idempotency.Record{RequestHash: requestHash}
+	return s.records[key], true, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, key idempotency.Key, response idempotency.Response) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.completeErr != nil {
+		return s.completeErr
+	}
+
+	record := s.records[key]
+	record.Response = &response
+	s.records[key] = record
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key idempotency.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// countingHandler answers with the given status and the number of times it ran.
+func countingHandler(status int) (http.Handler, *int) {
+	calls := 0
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"calls":` + strconv.Itoa(calls) + `}`))
+	}), &calls
+}
+
+func newRequest(method string, key string, body string) *http.Request {
+	r := httptest.NewRequest(method, "/api/v1/wallets/allocate", strings.NewReader(body))
+	r = r.WithContext(auth.WithUser(r.Context(), auth.User{ID: "local-user"}))
+	if key != "" {
+		r.Header.Set(idempotency.HeaderKey, key)
+	}
+
+	return r
+}
+
+func serve(h http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("replays the first response to a retry", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusCreated)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		first := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+		retry := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, http.StatusCreated, retry.Code)
+		assert.Equal(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+		assert.Equal(t, "true", retry.Header().Get(idempotency.HeaderReplayed))
+		assert.Empty(t, first.Header().Get(idempotency.HeaderReplayed))
+	})
+
+	t.Run("rejects a key reused with another payload", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusCreated)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+		conflicting := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":200}`))
+
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, http.StatusUnprocessableEntity, conflicting.Code)
+		assert.Contains(t, conflicting.Body.String(), "idempotency-key-reused")
+	})
+
+	t.Run("rejects a retry while the first request is running", func(t *testing.T) {
+		calls := 0
+		var retry *httptest.ResponseRecorder
+		var h http.Handler
+		h = idempotency.Middleware(newMemoryStore(), time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			// The client gives up waiting and retries before the first request answered
+			retry = serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		first := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusCreated, first.Code)
+		require.NotNil(t, retry)
+		assert.Equal(t, http.StatusConflict, retry.Code)
+	})
+
+	t.Run("runs the request again after a server error", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusInternalServerError)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+		serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("runs the request again after a panic", func(t *testing.T) {
+		calls := 0
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				panic("boom")
+			}
+
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		assert.Panics(t, func() { serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`)) })
+		retry := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, http.StatusCreated, retry.Code)
+	})
+
+	t.Run("keeps the key when the response could not be stored", func(t *testing.T) {
+		store := newMemoryStore()
+		store.completeErr = assert.AnError
+
+		next, calls := countingHandler(http.StatusCreated)
+		h := idempotency.Middleware(store, time.Hour)(next)
+
+		first := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+		retry := serve(h, newRequest(http.MethodPost, "key-1", `{"amount":100}`))
+
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, http.StatusConflict, retry.Code)
+	})
+
+	t.Run("keeps the keys of the routes apart", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusOK)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		serve(h, newRequest(http.MethodPost, "key-1", ``))
+		serve(h, newRequest(http.MethodDelete, "key-1", ``))
+
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("passes through queries and requests without key", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusOK)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		serve(h, newRequest(http.MethodGet, "key-1", ``))
+		serve(h, newRequest(http.MethodGet, "key-1", ``))
+		serve(h, newRequest(http.MethodPost, "", ``))
+		serve(h, newRequest(http.MethodPost, "", ``))
+
+		assert.Equal(t, 4, *calls)
+	})
+
+	t.Run("returns error when the key is too long", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusOK)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		w := serve(h, newRequest(http.MethodPost, strings.Repeat("k", 256), ``))
+
+		assert.Equal(t, 0, *calls)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("returns error when the body is too large", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusOK)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		w := serve(h, newRequest(http.MethodPost, "key-1", strings.Repeat("a", 1<<20+1)))
+
+		assert.Equal(t, 0, *calls)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "request-body-too-large")
+	})
+
+	t.Run("lets a large body route take a larger body", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusCreated)
+		router := chi.NewRouter()
+		router.Group(func(r chi.Router) {
+			r.Use(idempotency.Middleware(newMemoryStore(), time.Hour, "POST /api/v1/wallets/{walletId}/imports"))
+			r.Post("/api/v1/wallets/{walletId}/imports", next.ServeHTTP)
+			r.Post("/api/v1/wallets/allocate", next.ServeHTTP)
+		})
+
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/wallets/wallet-1/imports", strings.NewReader(strings.Repeat("a", 8<<20)))
+		r = r.WithContext(auth.WithUser(r.Context(), auth.User{ID: "local-user"}))
+		r.Header.Set(idempotency.HeaderKey, "key-1")
+		w := serve(router, r)
+
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		w = serve(router, newRequest(http.MethodPost, "key-2", strings.Repeat("a", 1<<20+1)))
+
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("replays a multipart form sent again with another boundary", func(t *testing.T) {
+		next, calls := countingHandler(http.StatusCreated)
+		h := idempotency.Middleware(newMemoryStore(), time.Hour)(next)
+
+		serve(h, newMultipartRequest(t, "key-1", "statement-1"))
+		retry := serve(h, newMultipartRequest(t, "key-1", "statement-1"))
+		conflicting := serve(h, newMultipartRequest(t, "key-1", "statement-2"))
+
+		assert.Equal(t, 1, *calls)
+		assert.Equal(t, "true", retry.Header().Get(idempotency.HeaderReplayed))
+		assert.Equal(t, http.StatusUnprocessableEntity, conflicting.Code)
+	})
+}
+
+// newMultipartRequest uploads a statement file, every request gets a random boundary.
+func newMultipartRequest(t *testing.T, key string, statement string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("format", "CSV"))
+	fw, err := mw.CreateFormFile("file", "statement.csv")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(statement))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	r := newRequest(http.MethodPost, key, body.String())
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+
+	return r
+}
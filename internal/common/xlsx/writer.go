@@ -0,0 +1,181 @@
+// Package xlsx writes Office Open XML workbooks of a single worksheet without holding the rows in memory.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidSheetName = errors.New("sheet name must have 1 to 31 characters and none of : \\ / ? * [ ]")
+
+const (
+	contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+
+	// styles holds the default cell format and, at index 1, the built in date time format 22
+	styles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="22" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+		`</styleSheet>`
+
+	sheetStart = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	sheetEnd   = `</sheetData></worksheet>`
+
+	dateTimeStyle = 1
+)
+
+// excelEpoch is day zero of the serial dates of Excel, shifted by the leap day Excel wrongly counts in 1900.
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// Writer writes the rows of a worksheet as they come, the worksheet is the last part of the archive
+// so every row goes straight to the underlying writer. Close must be called to complete the workbook.
+type Writer struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	row     int
+}
+
+// NewWriter starts a workbook whose only worksheet is named sheetName.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	if sheetName == "" || len([]rune(sheetName)) > 31 || strings.ContainsAny(sheetName, `:\/?*[]`) {
+		return nil, ErrInvalidSheetName
+	}
+
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+
+	workbook := xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + name.String() + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	archive := zip.NewWriter(w)
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", workbook},
+		{"xl/_rels/workbook.xml.rels", workbookRels},
+		{"xl/styles.xml", styles},
+	} {
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", part.name, err)
+		}
+
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+
+	f, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worksheet: %w", err)
+	}
+
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(sheetStart); err != nil {
+		return nil, fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	return &Writer{
+		archive: archive,
+		sheet:   sheet,
+	}, nil
+}
+
+// WriteRow appends a row. A cell is a string, an integer, a float, a time.Time, shown as a date time
+// in its own zone, or nil for an empty cell.
+func (w *Writer) WriteRow(cells ...any) error {
+	w.row++
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, w.row)
+
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(w.row)
+
+		switch v := cell.(type) {
+		case nil:
+			continue
+		case string:
+			fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(&b, []byte(v)); err != nil {
+				return err
+			}
+			b.WriteString(`</t></is></c>`)
+		case int:
+			fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int32:
+			fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int64:
+			fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case float64:
+			fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(v, 'f', -1, 64))
+		case time.Time:
+			fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, dateTimeStyle, strconv.FormatFloat(serialDate(v), 'f', -1, 64))
+		default:
+			return fmt.Errorf("cell %s: unsupported type %T", ref, cell)
+		}
+	}
+
+	b.WriteString(`</row>`)
+
+	_, err := w.sheet.WriteString(b.String())
+	return err
+}
+
+// Close completes the worksheet and the archive, it does not close the underlying writer.
+func (w *Writer) Close() error {
+	if _, err := w.sheet.WriteString(sheetEnd); err != nil {
+		return err
+	}
+
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return w.archive.Close()
+}
+
+// columnName returns the letters of the zero based column i, A to Z then AA and so on.
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}
+
+// serialDate returns the days since the Excel epoch of the wall clock of t.
+func serialDate(t time.Time) float64 {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+
+	return float64(wall.Sub(excelEpoch)) / float64(24*time.Hour)
+}
@@ -0,0 +1,99 @@
+package xlsx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"sumni-finance-backend/internal/common/xlsx"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sheetCell struct {
+	Ref    string `xml:"r,attr"`
+	Type   string `xml:"t,attr"`
+	Style  string `xml:"s,attr"`
+	Value  string `xml:"v"`
+	Inline string `xml:"is>t"`
+}
+
+type sheetRow struct {
+	Ref   string      `xml:"r,attr"`
+	Cells []sheetCell `xml:"c"`
+}
+
+type worksheet struct {
+	Rows []sheetRow `xml:"sheetData>row"`
+}
+
+func readPart(t *testing.T, archive *zip.Reader, name string) []byte {
+	t.Helper()
+
+	f, err := archive.Open(name)
+	require.NoError(t, err, name)
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	return content
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("refuses an invalid sheet name", func(t *testing.T) {
+		for _, name := range []string{"", "2026/4", "a sheet name longer than thirty one"} {
+			_, err := xlsx.NewWriter(io.Discard, name)
+			assert.ErrorIs(t, err, xlsx.ErrInvalidSheetName, name)
+		}
+	})
+
+	t.Run("writes a workbook of typed cells", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := xlsx.NewWriter(&buf, "Ledger 2026-4")
+		require.NoError(t, err)
+
+		require.NoError(t, w.WriteRow("Mô tả", "<Lương> & thưởng", nil, int64(-150000)))
+		require.NoError(t, w.WriteRow(time.Date(2026, time.April, 5, 12, 0, 0, 0, time.FixedZone("ICT", 7*3600))))
+
+		wide := make([]any, 28)
+		wide[27] = 1
+		require.NoError(t, w.WriteRow(wide...))
+		require.NoError(t, w.Close())
+
+		archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+
+		for _, part := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+			var root struct{ XMLName xml.Name }
+			assert.NoError(t, xml.Unmarshal(readPart(t, archive, part), &root), part)
+		}
+		assert.Contains(t, string(readPart(t, archive, "xl/workbook.xml")), `name="Ledger 2026-4"`)
+
+		var sheet worksheet
+		require.NoError(t, xml.Unmarshal(readPart(t, archive, "xl/worksheets/sheet1.xml"), &sheet))
+		require.Len(t, sheet.Rows, 3)
+
+		first := sheet.Rows[0]
+		assert.Equal(t, "1", first.Ref)
+		require.Len(t, first.Cells, 3)
+		assert.Equal(t, sheetCell{Ref: "A1", Type: "inlineStr", Inline: "Mô tả"}, first.Cells[0])
+		assert.Equal(t, "<Lương> & thưởng", first.Cells[1].Inline)
+		assert.Equal(t, sheetCell{Ref: "D1", Value: "-150000"}, first.Cells[2])
+
+		// 2026-04-05 is day 46117 of Excel, noon is half a day
+		assert.Equal(t, sheetCell{Ref: "A2", Style: "1", Value: "46117.5"}, sheet.Rows[1].Cells[0])
+
+		assert.Equal(t, sheetCell{Ref: "AB3", Value: "1"}, sheet.Rows[2].Cells[0])
+	})
+
+	t.Run("refuses an unsupported cell", func(t *testing.T) {
+		w, err := xlsx.NewWriter(io.Discard, "Sheet")
+		require.NoError(t, err)
+
+		assert.Error(t, w.WriteRow(true))
+	})
+}
@@ -37,13 +37,21 @@ type AppConfig struct {
 	port           string
 	allowedOrigins []string
 	env            string
+	defaultUserID  string // used while authentication is disabled
+	idempotencyTTL int32  // hour, how long the responses of idempotent requests are replayed
 }
 
 func (a AppConfig) Port() string { return a.port }
 func (a AppConfig) Env() string  { return a.env }
+func (a AppConfig) DefaultUserID() string {
+	return a.defaultUserID
+}
 func (a AppConfig) AllowedOrigins() []string {
 	return a.allowedOrigins
 }
+func (a AppConfig) IdempotencyTTL() int32 {
+	return a.idempotencyTTL
+}
 
 // Keycloak CONFIG
 type KeycloakConfig struct {
@@ -62,16 +70,33 @@ func (k KeycloakConfig) PostLoginURL() string  { return k.postLoginURL }
 func (k KeycloakConfig) CallbackURL() string   { return k.callbackURL }
 func (k KeycloakConfig) PostLogoutURL() string { return k.postLogoutURL }
 
+// Scheduler CONFIG
+type SchedulerConfig struct {
+	periodRolloverInterval       int32 // minute, 0 disables the worker
+	recurringMaterializeInterval int32 // minute, 0 disables the worker
+	statementArchiveInterval     int32 // minute, 0 disables the worker
+	outboxRelayInterval          int32 // second, 0 disables the worker
+	webhookDeliveryInterval      int32 // second, 0 disables the worker
+}
+
+func (s SchedulerConfig) PeriodRolloverInterval() int32       { return s.periodRolloverInterval }
+func (s SchedulerConfig) RecurringMaterializeInterval() int32 { return s.recurringMaterializeInterval }
+func (s SchedulerConfig) StatementArchiveInterval() int32     { return s.statementArchiveInterval }
+func (s SchedulerConfig) OutboxRelayInterval() int32          { return s.outboxRelayInterval }
+func (s SchedulerConfig) WebhookDeliveryInterval() int32      { return s.webhookDeliveryInterval }
+
 // CONFIG ROOT
 type Config struct {
-	database DatabaseConfig
-	app      AppConfig
-	keycloak KeycloakConfig
+	database  DatabaseConfig
+	app       AppConfig
+	keycloak  KeycloakConfig
+	scheduler SchedulerConfig
 }
 
-func (c *Config) Database() DatabaseConfig { return c.database }
-func (c *Config) App() AppConfig           { return c.app }
-func (c *Config) Keycloak() KeycloakConfig { return c.keycloak }
+func (c *Config) Database() DatabaseConfig   { return c.database }
+func (c *Config) App() AppConfig             { return c.app }
+func (c *Config) Keycloak() KeycloakConfig   { return c.keycloak }
+func (c *Config) Scheduler() SchedulerConfig { return c.scheduler }
 
 var (
 	configInstance *Config
@@ -107,6 +132,8 @@ func loadConfig() *Config {
 			port:           getEnv("PORT", "8080"),
 			env:            getEnv("ENV", "dev"),
 			allowedOrigins: strings.Split(getEnv("CORS_ALLOWED_ORIGINS", "http://localhost:3000"), ","),
+			defaultUserID:  getEnv("DEFAULT_USER_ID", "local-user"),
+			idempotencyTTL: getEnvAsInt32("IDEMPOTENCY_TTL", 24),
 		},
 
 		keycloak: KeycloakConfig{
@@ -117,6 +144,14 @@ func loadConfig() *Config {
 			postLoginURL:  getEnv("POST_LOGIN_URL", "http://localhost:3000/wallets"),
 			postLogoutURL: getEnv("POST_LOGOUT_URL", "http://localhost:3000"),
 		},
+
+		scheduler: SchedulerConfig{
+			periodRolloverInterval:       getEnvAsInt32("PERIOD_ROLLOVER_INTERVAL", 15),
+			recurringMaterializeInterval: getEnvAsInt32("RECURRING_MATERIALIZE_INTERVAL", 15),
+			statementArchiveInterval:     getEnvAsInt32("STATEMENT_ARCHIVE_INTERVAL", 60),
+			outboxRelayInterval:          getEnvAsInt32("OUTBOX_RELAY_INTERVAL", 5),
+			webhookDeliveryInterval:      getEnvAsInt32("WEBHOOK_DELIVERY_INTERVAL", 10),
+		},
 	}
 }
 
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type accountingPeriodReadModel struct {
+	queries *store.Queries
+}
+
+func NewAccountingPeriodReadModel(queries *store.Queries) *accountingPeriodReadModel {
+	return &accountingPeriodReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *accountingPeriodReadModel) GetAccountingPeriodClosingReport(
+	ctx context.Context,
+	wID uuid.UUID,
+	yearMonth ledger.YearMonth,
+) (query.AccountingPeriodClosingReport, error) {
+	row, err := rm.queries.GetAccountingPeriodClosingReport(ctx, store.GetAccountingPeriodClosingReportParams{
+		WalletID:  wID,
+		YearMonth: yearMonth.String(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.AccountingPeriodClosingReport{}, fmt.Errorf("accounting period %s: %w", yearMonth.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.AccountingPeriodClosingReport{}, fmt.Errorf("failed to get closing report of period %s: %w", yearMonth.String(), err)
+	}
+
+	return query.AccountingPeriodClosingReport{
+		ID:               row.ID,
+		YearMonth:        row.YearMonth,
+		Status:           row.Status,
+		EndDate:          row.EndTime,
+		Currency:         row.Currency,
+		OpeningBalance:   row.WalletOpeningBalance,
+		TotalDebit:       row.TotalDebit,
+		TotalCredit:      row.TotalCredit,
+		TotalIncome:      row.TotalIncome,
+		TotalExpense:     row.TotalExpense,
+		ClosingBalance:   row.WalletClosingBalance,
+		TransactionCount: row.TransactionCount,
+	}, nil
+}
+
+func (rm *accountingPeriodReadModel) ListAccountingPeriods(
+	ctx context.Context,
+	wID uuid.UUID,
+) ([]query.AccountingPeriod, error) {
+	apModels, err := rm.queries.ListAccountingPeriodsByWalletID(ctx, wID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounting periods of wallet '%s': %w", wID.String(), err)
+	}
+
+	periods := make([]query.AccountingPeriod, 0, len(apModels))
+	for _, apModel := range apModels {
+		periods = append(periods, query.AccountingPeriod{
+			ID:             apModel.ID,
+			YearMonth:      apModel.YearMonth,
+			Status:         apModel.Status,
+			StartDay:       apModel.StartDate,
+			Interval:       apModel.Interval,
+			StartTime:      apModel.StartTime,
+			EndDate:        apModel.EndTime,
+			OpeningBalance: apModel.WalletOpeningBalance,
+			TotalDebit:     apModel.TotalDebit,
+			TotalCredit:    apModel.TotalCredit,
+			TotalIncome:    apModel.TotalIncome,
+			TotalExpense:   apModel.TotalExpense,
+			ClosingBalance: apModel.WalletClosingBalance,
+			Version:        apModel.Version,
+		})
+	}
+
+	return periods, nil
+}
+
+func (rm *accountingPeriodReadModel) ListWalletsWithEndedPeriod(
+	ctx context.Context,
+	endedBefore time.Time,
+) ([]query.WalletDueForRollover, error) {
+	wModels, err := rm.queries.ListWalletIDsWithEndedAccountingPeriod(ctx, endedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets with ended accounting period: %w", err)
+	}
+
+	wallets := make([]query.WalletDueForRollover, 0, len(wModels))
+	for _, wModel := range wModels {
+		wallets = append(wallets, query.WalletDueForRollover{
+			WalletID: wModel.WalletID,
+			UserID:   convert.SafeDeref(wModel.UserID, ""),
+		})
+	}
+
+	return wallets, nil
+}
+
+func (rm *accountingPeriodReadModel) GetBudgetReport(
+	ctx context.Context,
+	wID uuid.UUID,
+	yearMonth ledger.YearMonth,
+) (query.BudgetReport, error) {
+	period, err := rm.queries.GetAccountingPeriodClosingReport(ctx, store.GetAccountingPeriodClosingReportParams{
+		WalletID:  wID,
+		YearMonth: yearMonth.String(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.BudgetReport{}, fmt.Errorf("accounting period %s: %w", yearMonth.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.BudgetReport{}, fmt.Errorf("failed to get accounting period %s: %w", yearMonth.String(), err)
+	}
+
+	budgetModels, err := rm.queries.ListCategoryBudgetsWithName(ctx, period.ID)
+	if err != nil {
+		return query.BudgetReport{}, fmt.Errorf("failed to list category budgets of period %s: %w", yearMonth.String(), err)
+	}
+
+	spendingModels, err := rm.queries.ListCategorySpendingByAccountingPeriodID(ctx, period.ID)
+	if err != nil {
+		return query.BudgetReport{}, fmt.Errorf("failed to list category spending of period %s: %w", yearMonth.String(), err)
+	}
+
+	// A top level category also spends what its sub categories spend
+	spent := make(map[uuid.UUID]int64, len(spendingModels))
+	for _, spendingModel := range spendingModels {
+		spent[spendingModel.CategoryID] += spendingModel.Spent
+		if spendingModel.ParentID != nil {
+			spent[*spendingModel.ParentID] += spendingModel.Spent
+		}
+	}
+
+	categories := make([]query.CategoryBudgetStatus, 0, len(budgetModels))
+	for _, budgetModel := range budgetModels {
+		categories = append(categories, query.CategoryBudgetStatus{
+			CategoryID: budgetModel.CategoryID,
+			ParentID:   budgetModel.ParentID,
+			Name:       budgetModel.Name,
+			Budgeted:   budgetModel.Amount,
+			Actual:     spent[budgetModel.CategoryID],
+		})
+	}
+
+	return query.BudgetReport{
+		AccountingPeriodID: period.ID,
+		YearMonth:          period.YearMonth,
+		Currency:           period.Currency,
+		Categories:         categories,
+	}, nil
+}
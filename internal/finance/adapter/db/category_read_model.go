@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	"github.com/google/uuid"
+)
+
+type categoryReadModel struct {
+	queries *store.Queries
+}
+
+func NewCategoryReadModel(queries *store.Queries) *categoryReadModel {
+	return &categoryReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *categoryReadModel) ListCategories(ctx context.Context, userID string) ([]query.Category, error) {
+	cModels, err := rm.queries.ListCategoriesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories of user '%s': %w", userID, err)
+	}
+
+	// Top level categories come first, so every parent is indexed before its children
+	categories := make([]query.Category, 0, len(cModels))
+	parentIndex := make(map[uuid.UUID]int, len(cModels))
+	for _, cModel := range cModels {
+		c := query.Category{
+			ID:       cModel.ID,
+			ParentID: cModel.ParentID,
+			Name:     cModel.Name,
+			Kind:     cModel.Kind,
+			Version:  cModel.Version,
+		}
+
+		if cModel.ParentID == nil {
+			c.Children = []query.Category{}
+			parentIndex[c.ID] = len(categories)
+			categories = append(categories, c)
+			continue
+		}
+
+		i, exist := parentIndex[*cModel.ParentID]
+		if !exist {
+			return nil, fmt.Errorf("parent '%s' of category '%s' not found", cModel.ParentID.String(), cModel.ID.String())
+		}
+		categories[i].Children = append(categories[i].Children, c)
+	}
+
+	return categories, nil
+}
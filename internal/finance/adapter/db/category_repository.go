@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/category"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type categoryRepo struct {
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
+}
+
+func NewCategoryRepo(
+	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
+) (*categoryRepo, error) {
+	if queries == nil || transactionManager == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &categoryRepo{
+		queries:            queries,
+		transactionManager: transactionManager,
+	}, nil
+}
+
+func (r *categoryRepo) Create(ctx context.Context, c *category.Category) error {
+	return r.create(ctx, c, r.queries)
+}
+
+func (r *categoryRepo) create(ctx context.Context, c *category.Category, queries *store.Queries) error {
+	var parentIDPtr *uuid.UUID
+	if parentID := c.ParentID(); parentID != uuid.Nil {
+		parentIDPtr = &parentID
+	}
+
+	err := queries.CreateCategory(ctx, store.CreateCategoryParams{
+		ID:       c.ID(),
+		UserID:   c.UserID(),
+		ParentID: parentIDPtr,
+		Name:     c.Name(),
+		Kind:     c.Kind().String(),
+		Version:  c.Version(),
+	})
+	if common_db.IsUniqueViolation(err) {
+		return fmt.Errorf("category '%s': %w", c.Name(), category.ErrCategoryNameTaken)
+	}
+
+	return err
+}
+
+func (r *categoryRepo) CreateDefaults(ctx context.Context, userID string, categories []*category.Category) (bool, error) {
+	created := false
+
+	err := r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		count, err := txQueries.CountCategoriesByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to count categories: %w", err)
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		for _, c := range categories {
+			if err = r.create(ctx, c, txQueries); err != nil {
+				return err
+			}
+		}
+
+		created = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+func (r *categoryRepo) GetByID(ctx context.Context, userID string, cID uuid.UUID) (*category.Category, error) {
+	cModel, err := r.queries.GetCategoryByID(ctx, store.GetCategoryByIDParams{
+		UserID: userID,
+		ID:     cID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("category '%s': %w", cID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalCategory(cModel)
+}
+
+func (r *categoryRepo) GetByIDs(ctx context.Context, userID string, cIDs []uuid.UUID) ([]*category.Category, error) {
+	cModels, err := r.queries.GetCategoriesByIDs(ctx, store.GetCategoriesByIDsParams{
+		UserID: userID,
+		Ids:    cIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*category.Category, 0, len(cModels))
+	for _, cModel := range cModels {
+		c, err := unmarshalCategory(cModel)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepo) Update(
+	ctx context.Context,
+	userID string,
+	cID uuid.UUID,
+	updateFn func(c *category.Category) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		cModel, err := txQueries.GetCategoryByIDForUpdate(ctx, store.GetCategoryByIDForUpdateParams{
+			UserID: userID,
+			ID:     cID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("category '%s': %w", cID.String(), common_db.ErrNotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		c, err := unmarshalCategory(cModel)
+		if err != nil {
+			return err
+		}
+
+		if err = updateFn(c); err != nil {
+			return err
+		}
+
+		rows, err := txQueries.UpdateCategory(ctx, store.UpdateCategoryParams{
+			ID:      c.ID(),
+			Name:    c.Name(),
+			Version: c.Version(),
+		})
+		if common_db.IsUniqueViolation(err) {
+			return fmt.Errorf("category '%s': %w", c.Name(), category.ErrCategoryNameTaken)
+		}
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return common_db.ErrConcurrentModification
+		}
+
+		return nil
+	})
+}
+
+func (r *categoryRepo) Delete(ctx context.Context, userID string, cID uuid.UUID) error {
+	rows, err := r.queries.DeleteCategory(ctx, store.DeleteCategoryParams{
+		UserID: userID,
+		ID:     cID,
+	})
+	if common_db.IsForeignKeyViolation(err) {
+		return fmt.Errorf("category '%s': %w", cID.String(), category.ErrCategoryHasChildren)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("category '%s': %w", cID.String(), common_db.ErrNotFound)
+	}
+
+	return nil
+}
+
+func unmarshalCategory(cModel store.FinanceCategory) (*category.Category, error) {
+	return category.UnmarshalCategoryFromDatabase(
+		cModel.ID,
+		cModel.UserID,
+		convert.SafeDeref(cModel.ParentID, uuid.Nil),
+		cModel.Name,
+		cModel.Kind,
+		cModel.Version,
+	)
+}
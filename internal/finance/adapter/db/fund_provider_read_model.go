@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type fundProviderReadModel struct {
+	queries *store.Queries
+}
+
+func NewFundProviderReadModel(queries *store.Queries) *fundProviderReadModel {
+	return &fundProviderReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *fundProviderReadModel) GetFundProvider(ctx context.Context, fpID uuid.UUID) (query.FundProvider, error) {
+	fpModel, err := rm.queries.GetFundProviderByID(ctx, fpID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.FundProvider{}, fmt.Errorf("fund provider '%s': %w", fpID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.FundProvider{}, fmt.Errorf("failed to retrieve fund provider '%s': %w", fpID.String(), err)
+	}
+
+	allocationModels, err := rm.queries.ListFundProviderAllocations(ctx, fpID)
+	if err != nil {
+		return query.FundProvider{}, fmt.Errorf("failed to list allocations of fund provider '%s': %w", fpID.String(), err)
+	}
+
+	fp := rm.toFundProvider(store.ListFundProvidersRow(fpModel))
+	fp.Allocations = make([]query.FundProviderAllocation, 0, len(allocationModels))
+	for _, allocationModel := range allocationModels {
+		fp.Allocations = append(fp.Allocations, query.FundProviderAllocation{
+			WalletID:   allocationModel.WalletID,
+			WalletName: allocationModel.WalletName,
+			Allocated:  allocationModel.AllocatedAmount,
+		})
+	}
+
+	return fp, nil
+}
+
+func (rm *fundProviderReadModel) ListFundProviders(ctx context.Context) ([]query.FundProvider, error) {
+	fpModels, err := rm.queries.ListFundProviders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fund providers: %w", err)
+	}
+
+	fps := make([]query.FundProvider, 0, len(fpModels))
+	for _, fpModel := range fpModels {
+		fps = append(fps, rm.toFundProvider(fpModel))
+	}
+
+	return fps, nil
+}
+
+func (rm *fundProviderReadModel) toFundProvider(fpModel store.ListFundProvidersRow) query.FundProvider {
+	return query.FundProvider{
+		ID:                 fpModel.ID,
+		Name:               fpModel.Name,
+		Type:               fpModel.FpType,
+		Currency:           fpModel.Currency,
+		Balance:            fpModel.Balance,
+		AllocatedBalance:   fpModel.Balance - fpModel.UnallocatedAmount,
+		UnallocatedBalance: fpModel.UnallocatedAmount,
+		Version:            fpModel.Version,
+	}
+}
@@ -3,25 +3,31 @@ package db
 import (
 	"context"
 	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
 	"sumni-finance-backend/internal/finance/adapter/db/store"
 	"sumni-finance-backend/internal/finance/domain/fundprovider"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type fundProviderRepo struct {
-	queries *store.Queries
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
 }
 
 func NewFundProviderRepo(
 	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
 ) (*fundProviderRepo, error) {
-	if queries == nil {
+	if queries == nil || transactionManager == nil {
 		return nil, errors.New("missing dependencies")
 	}
 
 	return &fundProviderRepo{
-		queries: queries,
+		queries:            queries,
+		transactionManager: transactionManager,
 	}, nil
 }
 
@@ -29,19 +35,30 @@ func (r *fundProviderRepo) Create(
 	ctx context.Context,
 	fp *fundprovider.FundProvider,
 ) error {
-	return r.queries.CreateFundProvider(ctx, store.CreateFundProviderParams{
-		ID:                fp.ID(),
-		Name:              fp.Name(),
-		FpType:            fp.Type().String(),
-		Balance:           fp.Balance().Amount(),
-		Currency:          fp.Currency().Code(),
-		UnallocatedAmount: fp.UnallocatedBalance().Amount(),
-		Version:           fp.Version(),
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		if err := txQueries.CreateFundProvider(ctx, store.CreateFundProviderParams{
+			ID:                fp.ID(),
+			Name:              fp.Name(),
+			FpType:            fp.Type().String(),
+			Balance:           fp.Balance().Amount(),
+			Currency:          fp.Currency().Code(),
+			UnallocatedAmount: fp.UnallocatedBalance().Amount(),
+			Version:           fp.Version(),
+		}); err != nil {
+			return err
+		}
+
+		return saveEvents(ctx, txQueries, fp.PullEvents())
 	})
 }
 
 func (r *fundProviderRepo) GetByID(ctx context.Context, fpID uuid.UUID) (*fundprovider.FundProvider, error) {
 	fpModel, err := r.queries.GetFundProviderByID(ctx, fpID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("fund provider '%s': %w", fpID.String(), common_db.ErrNotFound)
+	}
 	if err != nil {
 		return nil, err
 	}
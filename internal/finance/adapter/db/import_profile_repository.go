@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/importing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type importProfileRepo struct {
+	queries *store.Queries
+}
+
+func NewImportProfileRepo(queries *store.Queries) (*importProfileRepo, error) {
+	if queries == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &importProfileRepo{
+		queries: queries,
+	}, nil
+}
+
+func (r *importProfileRepo) CreateProfile(ctx context.Context, p *importing.MappingProfile) error {
+	err := r.queries.CreateImportProfile(ctx, store.CreateImportProfileParams{
+		ID:                 p.ID(),
+		UserID:             p.UserID(),
+		Name:               p.Name(),
+		Delimiter:          p.Delimiter(),
+		SkipRows:           p.SkipRows(),
+		DateColumn:         p.DateColumn(),
+		DateFormat:         p.DateFormat(),
+		AmountSign:         p.AmountSign().String(),
+		AmountColumn:       p.AmountColumn(),
+		DebitColumn:        p.DebitColumn(),
+		CreditColumn:       p.CreditColumn(),
+		DescriptionColumn:  p.DescriptionColumn(),
+		ReferenceColumn:    p.ReferenceColumn(),
+		ThousandsSeparator: p.ThousandsSeparator(),
+		Version:            p.Version(),
+	})
+	if common_db.IsUniqueViolation(err) {
+		return fmt.Errorf("mapping profile '%s': %w", p.Name(), importing.ErrProfileNameTaken)
+	}
+
+	return err
+}
+
+func (r *importProfileRepo) GetProfile(ctx context.Context, userID string, pID uuid.UUID) (*importing.MappingProfile, error) {
+	pModel, err := r.queries.GetImportProfileByID(ctx, store.GetImportProfileByIDParams{
+		UserID: userID,
+		ID:     pID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("mapping profile '%s': %w", pID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return importing.UnmarshalMappingProfileFromDatabase(
+		pModel.ID,
+		pModel.UserID,
+		toProfileSpec(pModel),
+		pModel.Version,
+	)
+}
+
+func (r *importProfileRepo) DeleteProfile(ctx context.Context, userID string, pID uuid.UUID) error {
+	rows, err := r.queries.DeleteImportProfile(ctx, store.DeleteImportProfileParams{
+		UserID: userID,
+		ID:     pID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("mapping profile '%s': %w", pID.String(), common_db.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *importProfileRepo) ListRecordedTransactionNos(
+	ctx context.Context,
+	fpID uuid.UUID,
+	transactionNos []string,
+) ([]string, error) {
+	if len(transactionNos) == 0 {
+		return nil, nil
+	}
+
+	recorded, err := r.queries.ListRecordedTransactionNos(ctx, store.ListRecordedTransactionNosParams{
+		FpID:           fpID,
+		TransactionNos: transactionNos,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recorded transactionNos of fund provider '%s': %w", fpID.String(), err)
+	}
+
+	return recorded, nil
+}
+
+func toProfileSpec(pModel store.FinanceImportProfile) importing.ProfileSpec {
+	return importing.ProfileSpec{
+		Name:               pModel.Name,
+		Delimiter:          pModel.Delimiter,
+		SkipRows:           pModel.SkipRows,
+		DateColumn:         pModel.DateColumn,
+		DateFormat:         pModel.DateFormat,
+		AmountSign:         pModel.AmountSign,
+		AmountColumn:       pModel.AmountColumn,
+		DebitColumn:        pModel.DebitColumn,
+		CreditColumn:       pModel.CreditColumn,
+		DescriptionColumn:  pModel.DescriptionColumn,
+		ReferenceColumn:    pModel.ReferenceColumn,
+		ThousandsSeparator: pModel.ThousandsSeparator,
+	}
+}
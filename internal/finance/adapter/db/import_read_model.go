@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type importReadModel struct {
+	queries *store.Queries
+}
+
+func NewImportReadModel(queries *store.Queries) *importReadModel {
+	return &importReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *importReadModel) ListImportProfiles(ctx context.Context, userID string) ([]query.ImportProfile, error) {
+	pModels, err := rm.queries.ListImportProfilesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import profiles of user '%s': %w", userID, err)
+	}
+
+	profiles := make([]query.ImportProfile, 0, len(pModels))
+	for _, pModel := range pModels {
+		profiles = append(profiles, toImportProfileQuery(pModel))
+	}
+
+	return profiles, nil
+}
+
+func (rm *importReadModel) GetImportProfile(ctx context.Context, userID string, pID uuid.UUID) (query.ImportProfile, error) {
+	pModel, err := rm.queries.GetImportProfileByID(ctx, store.GetImportProfileByIDParams{
+		UserID: userID,
+		ID:     pID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.ImportProfile{}, fmt.Errorf("mapping profile '%s': %w", pID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.ImportProfile{}, fmt.Errorf("failed to get import profile '%s': %w", pID.String(), err)
+	}
+
+	return toImportProfileQuery(pModel), nil
+}
+
+func (rm *importReadModel) GetLatestAccountingPeriod(ctx context.Context, wID uuid.UUID) (query.AccountingPeriod, error) {
+	apModel, err := rm.queries.GetLatestAccountingPeriodByWalletID(ctx, wID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.AccountingPeriod{}, fmt.Errorf("latest accounting period of wallet '%s': %w", wID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.AccountingPeriod{}, fmt.Errorf("failed to get latest accounting period: %w", err)
+	}
+
+	return query.AccountingPeriod{
+		ID:             apModel.ID,
+		YearMonth:      apModel.YearMonth,
+		Status:         apModel.Status,
+		StartDay:       apModel.StartDate,
+		Interval:       apModel.Interval,
+		StartTime:      apModel.StartTime,
+		EndDate:        apModel.EndTime,
+		OpeningBalance: apModel.WalletOpeningBalance,
+		TotalDebit:     apModel.TotalDebit,
+		TotalCredit:    apModel.TotalCredit,
+		TotalIncome:    apModel.TotalIncome,
+		TotalExpense:   apModel.TotalExpense,
+		ClosingBalance: apModel.WalletClosingBalance,
+		Version:        apModel.Version,
+	}, nil
+}
+
+func (rm *importReadModel) ListRecordedTransactionNos(
+	ctx context.Context,
+	fpID uuid.UUID,
+	transactionNos []string,
+) ([]string, error) {
+	if len(transactionNos) == 0 {
+		return nil, nil
+	}
+
+	recorded, err := rm.queries.ListRecordedTransactionNos(ctx, store.ListRecordedTransactionNosParams{
+		FpID:           fpID,
+		TransactionNos: transactionNos,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recorded transactionNos of fund provider '%s': %w", fpID.String(), err)
+	}
+
+	return recorded, nil
+}
+
+func (rm *importReadModel) GetFundProviderBalance(ctx context.Context, fpID uuid.UUID) (int64, error) {
+	fpModel, err := rm.queries.GetFundProviderByID(ctx, fpID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("fund provider '%s': %w", fpID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve fund provider '%s': %w", fpID.String(), err)
+	}
+
+	return fpModel.Balance, nil
+}
+
+func toImportProfileQuery(pModel store.FinanceImportProfile) query.ImportProfile {
+	return query.ImportProfile{
+		ID:                 pModel.ID,
+		Name:               pModel.Name,
+		Delimiter:          pModel.Delimiter,
+		SkipRows:           pModel.SkipRows,
+		DateColumn:         pModel.DateColumn,
+		DateFormat:         pModel.DateFormat,
+		AmountSign:         pModel.AmountSign,
+		AmountColumn:       pModel.AmountColumn,
+		DebitColumn:        pModel.DebitColumn,
+		CreditColumn:       pModel.CreditColumn,
+		DescriptionColumn:  pModel.DescriptionColumn,
+		ReferenceColumn:    pModel.ReferenceColumn,
+		ThousandsSeparator: pModel.ThousandsSeparator,
+		Version:            pModel.Version,
+	}
+}
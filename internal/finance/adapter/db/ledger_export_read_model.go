@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// streamTransactionsByAccountingPeriodID is not a sqlc query, sqlc collects the rows of a :many query
+// into a slice while the export reads them one by one.
+const streamTransactionsByAccountingPeriodID = `
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.wallet_balance,
+    tr.fp_id,
+    tr.fp_balance,
+    tr.description,
+    tr.occurred_at,
+    tr.recorded_at,
+    tr.linked_id,
+    tr.reversal_of_id,
+    tr.reversed_by_id,
+    tr.reversed_at,
+    tr.category_id,
+    c.name           AS category_name,
+    fp.name          AS fp_name,
+    ap.year_month
+FROM finance.transaction_records tr
+INNER JOIN finance.accounting_periods ap
+    ON ap.id = tr.accounting_periods_id
+INNER JOIN finance.fund_providers fp
+    ON fp.id = tr.fp_id
+LEFT JOIN finance.categories c
+    ON c.id = tr.category_id
+WHERE tr.accounting_periods_id = $1
+ORDER BY tr.id`
+
+type ledgerExportReadModel struct {
+	*accountingPeriodReadModel
+	db store.DBTX
+}
+
+// NewLedgerExportReadModel creates the read model of the ledger export, db runs the queries streaming the records.
+func NewLedgerExportReadModel(queries *store.Queries, db store.DBTX) *ledgerExportReadModel {
+	return &ledgerExportReadModel{
+		accountingPeriodReadModel: NewAccountingPeriodReadModel(queries),
+		db:                        db,
+	}
+}
+
+func (rm *ledgerExportReadModel) StreamTransactionsByAccountingPeriodID(
+	ctx context.Context,
+	apID uuid.UUID,
+) iter.Seq2[query.Transaction, error] {
+	return func(yield func(query.Transaction, error) bool) {
+		rows, err := rm.db.Query(ctx, streamTransactionsByAccountingPeriodID, apID)
+		if err != nil {
+			yield(query.Transaction{}, fmt.Errorf("failed to stream transaction records of period '%s': %w", apID.String(), err))
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				tr            query.Transaction
+				transactionNo *string
+				reversedAt    pgtype.Timestamp
+			)
+			if err := rows.Scan(
+				&tr.ID,
+				&transactionNo,
+				&tr.TransactionType,
+				&tr.Direction,
+				&tr.Amount,
+				&tr.WalletBalance,
+				&tr.FundProviderID,
+				&tr.FpBalance,
+				&tr.Description,
+				&tr.OccurredAt,
+				&tr.RecordedAt,
+				&tr.LinkedID,
+				&tr.ReversalOfID,
+				&tr.ReversedByID,
+				&reversedAt,
+				&tr.CategoryID,
+				&tr.CategoryName,
+				&tr.FundProviderName,
+				&tr.YearMonth,
+			); err != nil {
+				yield(query.Transaction{}, fmt.Errorf("failed to scan transaction record of period '%s': %w", apID.String(), err))
+				return
+			}
+
+			if transactionNo != nil {
+				tr.TransactionNo = *transactionNo
+			}
+			if reversedAt.Valid {
+				tr.ReversedAt = &reversedAt.Time
+			}
+
+			if !yield(tr, nil) {
+				return
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			yield(query.Transaction{}, fmt.Errorf("failed to stream transaction records of period '%s': %w", apID.String(), err))
+		}
+	}
+}
@@ -2,19 +2,28 @@ package db
 
 import (
 	"context"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/valueobject"
 	"sumni-finance-backend/internal/finance/adapter/db/store"
 	"sumni-finance-backend/internal/finance/domain/ledger"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type ledgerRepository struct {
-	queries *store.Queries
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
 }
 
-func NewLedgerRepository(queries *store.Queries) *ledgerRepository {
+func NewLedgerRepository(
+	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
+) *ledgerRepository {
 	return &ledgerRepository{
-		queries: queries,
+		queries:            queries,
+		transactionManager: transactionManager,
 	}
 }
 
@@ -23,18 +32,150 @@ func (r *ledgerRepository) CreateAccountingPeriod(
 	wID uuid.UUID,
 	ap *ledger.AccountingPeriod,
 ) error {
-	return r.queries.CreateAccountingPeriod(ctx, store.CreateAccountingPeriodParams{
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		if err := createAccountingPeriod(ctx, txQueries, wID, ap); err != nil {
+			return err
+		}
+
+		return saveEvents(ctx, txQueries, ap.PullEvents())
+	})
+}
+
+// createAccountingPeriod inserts ap with its category budgets.
+func createAccountingPeriod(
+	ctx context.Context,
+	queries *store.Queries,
+	wID uuid.UUID,
+	ap *ledger.AccountingPeriod,
+) error {
+	if err := queries.CreateAccountingPeriod(ctx, newCreateAccountingPeriodParams(wID, ap)); err != nil {
+		return fmt.Errorf("failed to create accounting period %s: %w", ap.YearMonth().String(), err)
+	}
+
+	return insertCategoryBudgets(ctx, queries, ap)
+}
+
+func insertCategoryBudgets(ctx context.Context, queries *store.Queries, ap *ledger.AccountingPeriod) error {
+	budgets := ap.Budgets()
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	params := make([]store.BulkInsertCategoryBudgetsParams, 0, len(budgets))
+	for _, b := range budgets {
+		params = append(params, store.BulkInsertCategoryBudgetsParams{
+			AccountingPeriodID: ap.ID(),
+			CategoryID:         b.CategoryID(),
+			Amount:             b.Amount().Amount(),
+		})
+	}
+
+	if _, err := queries.BulkInsertCategoryBudgets(ctx, params); err != nil {
+		return fmt.Errorf("failed to insert category budgets of %s: %w", ap.YearMonth().String(), err)
+	}
+
+	return nil
+}
+
+// loadCategoryBudgets sets the persisted category budgets on ap.
+func loadCategoryBudgets(ctx context.Context, queries *store.Queries, ap *ledger.AccountingPeriod) error {
+	budgetModels, err := queries.ListCategoryBudgetsByAccountingPeriodID(ctx, ap.ID())
+	if err != nil {
+		return fmt.Errorf("failed to list category budgets of %s: %w", ap.YearMonth().String(), err)
+	}
+
+	budgets := make([]ledger.CategoryBudget, 0, len(budgetModels))
+	for _, budgetModel := range budgetModels {
+		amount, err := valueobject.NewMoney(budgetModel.Amount, ap.OpeningBalance().Currency())
+		if err != nil {
+			return err
+		}
+
+		b, err := ledger.NewCategoryBudget(budgetModel.CategoryID, amount)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal category budget %s: %w", budgetModel.CategoryID, err)
+		}
+		budgets = append(budgets, b)
+	}
+
+	ap.SetBudgets(budgets...)
+	return nil
+}
+
+func (r *ledgerRepository) UpdateCategoryBudgets(
+	ctx context.Context,
+	ap *ledger.AccountingPeriod,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		rows, err := txQueries.BumpOpenAccountingPeriodVersion(ctx, store.BumpOpenAccountingPeriodVersionParams{
+			ID:      ap.ID(),
+			Version: ap.Version(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update accounting period %s: %w", ap.YearMonth().String(), err)
+		}
+
+		if rows == 0 {
+			return fmt.Errorf("failed to update accounting period %s: %w", ap.YearMonth().String(), common_db.ErrConcurrentModification)
+		}
+
+		if err := txQueries.DeleteCategoryBudgetsByAccountingPeriodID(ctx, ap.ID()); err != nil {
+			return fmt.Errorf("failed to delete category budgets of %s: %w", ap.YearMonth().String(), err)
+		}
+
+		return insertCategoryBudgets(ctx, txQueries, ap)
+	})
+}
+
+func newCreateAccountingPeriodParams(wID uuid.UUID, ap *ledger.AccountingPeriod) store.CreateAccountingPeriodParams {
+	return store.CreateAccountingPeriodParams{
 		ID:                   ap.ID(),
 		YearMonth:            ap.YearMonth().String(),
 		StartDate:            ap.StartDate().Value(),
 		Interval:             ap.Interval(),
+		StartTime:            ap.StartTime(),
 		EndTime:              ap.EndDate(),
 		WalletOpeningBalance: ap.OpeningBalance().Amount(),
 		TotalDebit:           ap.TotalDebit().Amount(),
 		TotalCredit:          ap.TotalCredit().Amount(),
+		TotalIncome:          ap.TotalIncome().Amount(),
+		TotalExpense:         ap.TotalExpense().Amount(),
 		WalletClosingBalance: ap.ClosingBalance().Amount(),
 		Version:              ap.Version(),
 		Status:               ap.Status().String(),
 		WalletID:             wID,
+	}
+}
+
+func (r *ledgerRepository) UpdateAccountingPeriod(
+	ctx context.Context,
+	ap *ledger.AccountingPeriod,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		rows, err := txQueries.UpdateAccountingPeriod(ctx, store.UpdateAccountingPeriodParams{
+			TotalDebit:     ap.TotalDebit().Amount(),
+			TotalCredit:    ap.TotalCredit().Amount(),
+			TotalIncome:    ap.TotalIncome().Amount(),
+			TotalExpense:   ap.TotalExpense().Amount(),
+			ClosingBalance: ap.ClosingBalance().Amount(),
+			Status:         ap.Status().String(),
+			ID:             ap.ID(),
+			Version:        ap.Version(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update accounting period: %w", err)
+		}
+
+		if rows == 0 {
+			return fmt.Errorf("failed to update accounting period: %w", common_db.ErrConcurrentModification)
+		}
+
+		return saveEvents(ctx, txQueries, ap.PullEvents())
 	})
 }
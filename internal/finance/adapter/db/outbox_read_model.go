@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type outboxReadModel struct {
+	queries *store.Queries
+}
+
+func NewOutboxReadModel(queries *store.Queries) *outboxReadModel {
+	return &outboxReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *outboxReadModel) ListDueOutboxEventIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	ids, err := rm.queries.ListDueOutboxEventIDs(ctx, store.ListDueOutboxEventIDsParams{
+		Now:      now,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due outbox events: %w", err)
+	}
+
+	return ids, nil
+}
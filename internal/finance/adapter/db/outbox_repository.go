@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/outbox"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type outboxRepo struct {
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
+}
+
+func NewOutboxRepo(
+	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
+) (*outboxRepo, error) {
+	if queries == nil || transactionManager == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &outboxRepo{
+		queries:            queries,
+		transactionManager: transactionManager,
+	}, nil
+}
+
+func (r *outboxRepo) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(m *outbox.Message) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		eModel, err := txQueries.GetOutboxEventForUpdate(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("outbox event '%s': %w", id.String(), common_db.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get outbox event: %w", err)
+		}
+
+		m, err := outbox.UnmarshalMessageFromDatabase(
+			eModel.ID,
+			convert.SafeDeref(eModel.UserID, ""),
+			eModel.EventName,
+			eModel.Payload,
+			eModel.OccurredAt,
+			eModel.Status,
+			eModel.Attempts,
+			convert.SafeDeref(eModel.LastError, ""),
+			eModel.NextAttemptAt,
+			eModel.DispatchedAt.Time,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal outbox event %s: %w", eModel.ID, err)
+		}
+
+		if err = updateFn(m); err != nil {
+			return err
+		}
+
+		var lastError *string
+		if m.LastError() != "" {
+			lastError = convert.SafePtr(m.LastError())
+		}
+
+		rows, err := txQueries.UpdateOutboxEvent(ctx, store.UpdateOutboxEventParams{
+			ID:            m.ID(),
+			Status:        m.Status().String(),
+			Attempts:      m.Attempts(),
+			LastError:     lastError,
+			NextAttemptAt: m.NextAttemptAt(),
+			DispatchedAt:  common_db.ToPgTimestamp(m.DispatchedAt()),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update outbox event: %w", err)
+		}
+		if rows != 1 {
+			return fmt.Errorf("outbox event '%s': %w", m.ID().String(), common_db.ErrConcurrentModification)
+		}
+
+		return nil
+	})
+}
+
+// saveEvents writes events to the outbox with the queries of the transaction that persists the change they describe,
+// so they are stored if and only if that change is. The events are owned by the user acting in ctx, if any.
+func saveEvents(ctx context.Context, queries *store.Queries, events []event.Event) error {
+	occurredAt := time.Now()
+
+	var userID *string
+	if user, err := auth.UserFromCtx(ctx); err == nil {
+		userID = convert.SafePtr(user.ID)
+	}
+
+	for _, e := range events {
+		m, err := outbox.NewMessage(e, convert.SafeDeref(userID, ""), occurredAt)
+		if err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to encode event %s: %w", e.EventName(), err)
+		}
+
+		if err = queries.CreateOutboxEvent(ctx, store.CreateOutboxEventParams{
+			ID:            m.ID(),
+			AggregateType: e.AggregateType(),
+			AggregateID:   e.AggregateID(),
+			UserID:        userID,
+			EventName:     e.EventName(),
+			Payload:       payload,
+			OccurredAt:    m.OccurredAt(),
+			Status:        m.Status().String(),
+			Attempts:      m.Attempts(),
+			NextAttemptAt: m.NextAttemptAt(),
+		}); err != nil {
+			return fmt.Errorf("failed to store event %s in the outbox: %w", e.EventName(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type reconciliationReadModel struct {
+	queries *store.Queries
+}
+
+func NewReconciliationReadModel(queries *store.Queries) *reconciliationReadModel {
+	return &reconciliationReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *reconciliationReadModel) ListReconciliations(ctx context.Context, fpID uuid.UUID) ([]query.Reconciliation, error) {
+	rModels, err := rm.queries.ListReconciliationsByFpID(ctx, fpID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reconciliations of fund provider '%s': %w", fpID.String(), err)
+	}
+
+	reconciliations := make([]query.Reconciliation, 0, len(rModels))
+	for _, rModel := range rModels {
+		var finalisedAt *time.Time
+		if rModel.FinalisedAt.Valid {
+			finalisedAt = &rModel.FinalisedAt.Time
+		}
+
+		reconciliations = append(reconciliations, query.Reconciliation{
+			ID:               rModel.ID,
+			FundProviderID:   fpID,
+			StatementDate:    rModel.StatementDate,
+			StatementBalance: rModel.StatementBalance,
+			Status:           rModel.Status,
+			CreatedAt:        rModel.CreatedAt,
+			FinalisedAt:      finalisedAt,
+			ClearedCount:     int(rModel.ClearedCount),
+			Version:          rModel.Version,
+		})
+	}
+
+	return reconciliations, nil
+}
+
+func (rm *reconciliationReadModel) GetReconciliation(
+	ctx context.Context,
+	fpID uuid.UUID,
+	rID uuid.UUID,
+) (query.Reconciliation, error) {
+	rModel, err := rm.queries.GetReconciliation(ctx, store.GetReconciliationParams{
+		ID:   rID,
+		FpID: fpID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.Reconciliation{}, fmt.Errorf("reconciliation '%s': %w", rID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.Reconciliation{}, fmt.Errorf("failed to retrieve reconciliation '%s': %w", rID.String(), err)
+	}
+
+	var finalisedAt *time.Time
+	if rModel.FinalisedAt.Valid {
+		finalisedAt = &rModel.FinalisedAt.Time
+	}
+
+	r := query.Reconciliation{
+		ID:               rModel.ID,
+		FundProviderID:   rModel.FpID,
+		StatementDate:    rModel.StatementDate,
+		StatementBalance: rModel.StatementBalance,
+		Status:           rModel.Status,
+		CreatedAt:        rModel.CreatedAt,
+		FinalisedAt:      finalisedAt,
+		Version:          rModel.Version,
+		Currency:         rModel.FpCurrency,
+		BookBalance:      rModel.FpBalance,
+	}
+
+	// Once finalised the records it cleared are locked, they are no longer among the unlocked ones
+	if rModel.Status != reconciliation.StatusInProgress.String() {
+		trModels, err := rm.queries.ListReconciledRecords(ctx, rID)
+		if err != nil {
+			return query.Reconciliation{}, fmt.Errorf("failed to list records of reconciliation '%s': %w", rID.String(), err)
+		}
+
+		r.Records = make([]query.ReconciliationRecord, 0, len(trModels))
+		for _, trModel := range trModels {
+			r.Records = append(r.Records, query.ReconciliationRecord{
+				ID:              trModel.ID,
+				WalletID:        trModel.WalletID,
+				WalletName:      trModel.WalletName,
+				TransactionNo:   convert.SafeDeref(trModel.TransactionNo, ""),
+				TransactionType: trModel.TransactionType,
+				Direction:       trModel.Direction,
+				Amount:          trModel.Amount,
+				Description:     trModel.Description,
+				OccurredAt:      trModel.OccurredAt,
+				Cleared:         true,
+				Adjustment:      trModel.IsAdjustment,
+			})
+		}
+
+		return r, nil
+	}
+
+	trModels, err := rm.queries.ListReconciliationCandidateRecords(ctx, store.ListReconciliationCandidateRecordsParams{
+		ReconciliationID: rID,
+		FpID:             fpID,
+	})
+	if err != nil {
+		return query.Reconciliation{}, fmt.Errorf("failed to list records of reconciliation '%s': %w", rID.String(), err)
+	}
+
+	r.Records = make([]query.ReconciliationRecord, 0, len(trModels))
+	for _, trModel := range trModels {
+		r.Records = append(r.Records, query.ReconciliationRecord{
+			ID:              trModel.ID,
+			WalletID:        trModel.WalletID,
+			WalletName:      trModel.WalletName,
+			TransactionNo:   convert.SafeDeref(trModel.TransactionNo, ""),
+			TransactionType: trModel.TransactionType,
+			Direction:       trModel.Direction,
+			Amount:          trModel.Amount,
+			Description:     trModel.Description,
+			OccurredAt:      trModel.OccurredAt,
+			Cleared:         trModel.Cleared,
+			Adjustment:      trModel.IsAdjustment,
+		})
+	}
+
+	return r, nil
+}
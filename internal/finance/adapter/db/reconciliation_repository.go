@@ -0,0 +1,247 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type reconciliationRepo struct {
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
+	walletRepo         *walletRepo
+}
+
+func NewReconciliationRepo(
+	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
+	walletRepo *walletRepo,
+) (*reconciliationRepo, error) {
+	if queries == nil || transactionManager == nil || walletRepo == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &reconciliationRepo{
+		queries:            queries,
+		transactionManager: transactionManager,
+		walletRepo:         walletRepo,
+	}, nil
+}
+
+func (r *reconciliationRepo) Create(ctx context.Context, rec *reconciliation.Reconciliation) error {
+	err := r.queries.CreateReconciliation(ctx, store.CreateReconciliationParams{
+		ID:               rec.ID(),
+		FpID:             rec.FpID(),
+		StatementDate:    rec.StatementDate(),
+		StatementBalance: rec.StatementBalance(),
+		Status:           rec.Status().String(),
+		CreatedAt:        rec.CreatedAt(),
+		FinalisedAt:      common_db.ToPgTimestamp(rec.FinalisedAt()),
+		Version:          rec.Version(),
+	})
+	// The partial unique index allows a single reconciliation in progress per fund provider
+	if common_db.IsUniqueViolation(err) {
+		return fmt.Errorf("fund provider '%s': %w", rec.FpID().String(), reconciliation.ErrReconciliationInProgress)
+	}
+
+	return err
+}
+
+func (r *reconciliationRepo) Update(
+	ctx context.Context,
+	fpID uuid.UUID,
+	rID uuid.UUID,
+	updateFn func(rec *reconciliation.Reconciliation) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		rec, err := r.getForUpdate(ctx, txQueries, fpID, rID)
+		if err != nil {
+			return err
+		}
+
+		if err = updateFn(rec); err != nil {
+			return err
+		}
+
+		return r.save(ctx, txQueries, rec)
+	})
+}
+
+func (r *reconciliationRepo) Adjust(
+	ctx context.Context,
+	fpID uuid.UUID,
+	rID uuid.UUID,
+	wID uuid.UUID,
+	adjustFn func(rec *reconciliation.Reconciliation, w *wallet.Wallet) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		rec, err := r.getForUpdate(ctx, txQueries, fpID, rID)
+		if err != nil {
+			return err
+		}
+
+		w, err := r.walletRepo.getByIDWithProviders(ctx, wID, wallet.NewProviderMatchesAnySpec([]uuid.UUID{fpID}), txQueries)
+		if err != nil {
+			return err
+		}
+
+		if err = r.walletRepo.loadLatestAccountingPeriod(ctx, txQueries, w); err != nil {
+			return err
+		}
+
+		if err = adjustFn(rec, w); err != nil {
+			return err
+		}
+
+		if err := r.walletRepo.updateWalletBalance(ctx, w, txQueries); err != nil {
+			return err
+		}
+
+		if err := r.walletRepo.updateFundProviderAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+			return err
+		}
+
+		latest, _ := w.LedgerManager().LatestAccountingPeriod()
+		if err := r.walletRepo.saveAccountingPeriod(ctx, txQueries, w, latest.YearMonth()); err != nil {
+			return err
+		}
+
+		if err := saveEvents(ctx, txQueries, w.PullEvents()); err != nil {
+			return err
+		}
+
+		return r.save(ctx, txQueries, rec)
+	})
+}
+
+// getForUpdate locks the reconciliation and loads it with the balance of its fund provider,
+// the records no finalised reconciliation has locked and the ones it cleared.
+func (r *reconciliationRepo) getForUpdate(
+	ctx context.Context,
+	queries *store.Queries,
+	fpID uuid.UUID,
+	rID uuid.UUID,
+) (*reconciliation.Reconciliation, error) {
+	rModel, err := queries.GetReconciliationForUpdate(ctx, store.GetReconciliationForUpdateParams{
+		ID:   rID,
+		FpID: fpID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("reconciliation '%s': %w", rID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reconciliation: %w", err)
+	}
+
+	bookBalance, err := queries.GetFundProviderBalanceForShare(ctx, fpID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance of fund provider '%s': %w", fpID.String(), err)
+	}
+
+	trModels, err := queries.ListUnreconciledTransactionRecordsByFpID(ctx, fpID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unreconciled transaction records: %w", err)
+	}
+
+	records := make([]reconciliation.Record, 0, len(trModels))
+	for _, trModel := range trModels {
+		record, err := reconciliation.UnmarshalRecordFromDatabase(
+			trModel.ID,
+			trModel.WalletID,
+			trModel.Direction,
+			trModel.Amount,
+			trModel.OccurredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal transaction record %s: %w", trModel.ID, err)
+		}
+		records = append(records, record)
+	}
+
+	rrModels, err := queries.ListReconciliationRecordsByReconciliationID(ctx, rID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reconciliation records: %w", err)
+	}
+
+	clearedIDs := make([]uuid.UUID, 0, len(rrModels))
+	var adjustmentIDs []uuid.UUID
+	for _, rrModel := range rrModels {
+		clearedIDs = append(clearedIDs, rrModel.TransactionRecordID)
+		if rrModel.IsAdjustment {
+			adjustmentIDs = append(adjustmentIDs, rrModel.TransactionRecordID)
+		}
+	}
+
+	return reconciliation.UnmarshalReconciliationFromDatabase(
+		rModel.ID,
+		rModel.FpID,
+		rModel.StatementDate,
+		rModel.StatementBalance,
+		rModel.Status,
+		rModel.CreatedAt,
+		rModel.FinalisedAt.Time,
+		rModel.Version,
+		bookBalance,
+		records,
+		clearedIDs,
+		adjustmentIDs,
+	)
+}
+
+// save updates the status of the reconciliation and replaces its cleared records.
+func (r *reconciliationRepo) save(ctx context.Context, queries *store.Queries, rec *reconciliation.Reconciliation) error {
+	rows, err := queries.UpdateReconciliationStatus(ctx, store.UpdateReconciliationStatusParams{
+		ID:          rec.ID(),
+		Status:      rec.Status().String(),
+		FinalisedAt: common_db.ToPgTimestamp(rec.FinalisedAt()),
+		Version:     rec.Version(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("failed to update reconciliation: %w", common_db.ErrConcurrentModification)
+	}
+
+	if err = queries.DeleteReconciliationRecordsByReconciliationID(ctx, rec.ID()); err != nil {
+		return fmt.Errorf("failed to delete reconciliation records: %w", err)
+	}
+
+	clearedIDs := rec.ClearedIDs()
+	if len(clearedIDs) == 0 {
+		return nil
+	}
+
+	params := make([]store.BulkInsertReconciliationRecordsParams, 0, len(clearedIDs))
+	for _, txID := range clearedIDs {
+		params = append(params, store.BulkInsertReconciliationRecordsParams{
+			TransactionRecordID: txID,
+			ReconciliationID:    rec.ID(),
+			IsAdjustment:        rec.IsAdjustment(txID),
+		})
+	}
+
+	rowsInserted, err := queries.BulkInsertReconciliationRecords(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to bulk insert reconciliation records: %w", err)
+	}
+
+	if rowsInserted != int64(len(params)) {
+		return fmt.Errorf("failed to insert all reconciliation records: expected %d, inserted %d",
+			len(params), rowsInserted)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type recurringTemplateReadModel struct {
+	queries *store.Queries
+}
+
+func NewRecurringTemplateReadModel(queries *store.Queries) *recurringTemplateReadModel {
+	return &recurringTemplateReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *recurringTemplateReadModel) ListRecurringTemplates(
+	ctx context.Context,
+	wID uuid.UUID,
+) ([]query.RecurringTemplate, error) {
+	tModels, err := rm.queries.ListRecurringTemplatesByWalletID(ctx, wID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring templates of wallet '%s': %w", wID.String(), err)
+	}
+
+	templates := make([]query.RecurringTemplate, 0, len(tModels))
+	for _, tModel := range tModels {
+		var pausedAt *time.Time
+		if tModel.PausedAt.Valid {
+			pausedAt = &tModel.PausedAt.Time
+		}
+
+		templates = append(templates, query.RecurringTemplate{
+			ID:              tModel.ID,
+			FundProviderID:  tModel.FpID,
+			Amount:          tModel.Amount,
+			TransactionType: tModel.TransactionType,
+			CategoryID:      tModel.CategoryID,
+			CategoryName:    tModel.CategoryName,
+			Description:     tModel.Description,
+			Frequency:       tModel.Frequency,
+			Interval:        tModel.Interval,
+			DayOfMonth:      tModel.DayOfMonth,
+			StartDate:       tModel.StartDate,
+			Status:          tModel.Status,
+			PausedAt:        pausedAt,
+			Version:         tModel.Version,
+		})
+	}
+
+	return templates, nil
+}
+
+func (rm *recurringTemplateReadModel) ListRecurringOccurrences(
+	ctx context.Context,
+	wID uuid.UUID,
+	from time.Time,
+) ([]query.RecurringOccurrence, error) {
+	oModels, err := rm.queries.ListRecurringOccurrencesByWalletID(ctx, store.ListRecurringOccurrencesByWalletIDParams{
+		WalletID:     wID,
+		OccurredFrom: from,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring occurrences of wallet '%s': %w", wID.String(), err)
+	}
+
+	occurrences := make([]query.RecurringOccurrence, 0, len(oModels))
+	for _, oModel := range oModels {
+		occurrences = append(occurrences, query.RecurringOccurrence{
+			TemplateID: oModel.TemplateID,
+			Date:       oModel.OccurrenceDate,
+			Status:     oModel.Status,
+		})
+	}
+
+	return occurrences, nil
+}
+
+func (rm *recurringTemplateReadModel) ListActiveRecurringTemplates(
+	ctx context.Context,
+	startedBefore time.Time,
+) ([]query.RecurringTemplateDue, error) {
+	tModels, err := rm.queries.ListActiveRecurringTemplateIDs(ctx, startedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active recurring templates: %w", err)
+	}
+
+	templates := make([]query.RecurringTemplateDue, 0, len(tModels))
+	for _, tModel := range tModels {
+		templates = append(templates, query.RecurringTemplateDue{
+			TemplateID: tModel.ID,
+			UserID:     tModel.UserID,
+		})
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,287 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type recurringTemplateRepo struct {
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
+	walletRepo         *walletRepo
+}
+
+func NewRecurringTemplateRepo(
+	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
+	walletRepo *walletRepo,
+) (*recurringTemplateRepo, error) {
+	if queries == nil || transactionManager == nil || walletRepo == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &recurringTemplateRepo{
+		queries:            queries,
+		transactionManager: transactionManager,
+		walletRepo:         walletRepo,
+	}, nil
+}
+
+func (r *recurringTemplateRepo) Create(ctx context.Context, t *recurring.Template) error {
+	var categoryIDPtr *uuid.UUID
+	if c := t.Category(); c != nil {
+		categoryID := c.ID()
+		categoryIDPtr = &categoryID
+	}
+
+	schedule := t.Schedule()
+
+	return r.queries.CreateRecurringTemplate(ctx, store.CreateRecurringTemplateParams{
+		ID:              t.ID(),
+		UserID:          t.UserID(),
+		WalletID:        t.WalletID(),
+		FpID:            t.FpID(),
+		Amount:          t.Amount(),
+		TransactionType: t.TransactionType().String(),
+		CategoryID:      categoryIDPtr,
+		Description:     t.Description(),
+		Frequency:       schedule.Frequency().String(),
+		Interval:        schedule.Interval(),
+		DayOfMonth:      schedule.DayOfMonth(),
+		StartDate:       schedule.StartDate(),
+		Status:          t.Status().String(),
+		PausedAt:        common_db.ToPgTimestamp(t.PausedAt()),
+		Version:         t.Version(),
+	})
+}
+
+func (r *recurringTemplateRepo) Update(
+	ctx context.Context,
+	wID uuid.UUID,
+	tID uuid.UUID,
+	updateFn func(t *recurring.Template) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		t, err := r.getForUpdate(ctx, txQueries, tID)
+		if err != nil {
+			return err
+		}
+
+		if t.WalletID() != wID {
+			return fmt.Errorf("recurring template '%s': %w", tID.String(), common_db.ErrNotFound)
+		}
+
+		if err = updateFn(t); err != nil {
+			return err
+		}
+
+		return r.save(ctx, txQueries, t)
+	})
+}
+
+func (r *recurringTemplateRepo) Materialize(
+	ctx context.Context,
+	tID uuid.UUID,
+	materializeFn func(t *recurring.Template, w *wallet.Wallet) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		t, err := r.getForUpdate(ctx, txQueries, tID)
+		if err != nil {
+			return err
+		}
+
+		w, err := r.walletRepo.getByIDWithProviders(
+			ctx,
+			t.WalletID(),
+			wallet.NewProviderMatchesAnySpec([]uuid.UUID{t.FpID()}),
+			txQueries,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = r.walletRepo.loadLatestAccountingPeriod(ctx, txQueries, w); err != nil {
+			return err
+		}
+
+		if err = materializeFn(t, w); err != nil {
+			return err
+		}
+
+		if len(t.NewOccurrences()) == 0 {
+			return nil
+		}
+
+		if err := r.walletRepo.updateWalletBalance(ctx, w, txQueries); err != nil {
+			return err
+		}
+
+		if err := r.walletRepo.updateFundProviderAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+			return err
+		}
+
+		latest, _ := w.LedgerManager().LatestAccountingPeriod()
+		if err := r.walletRepo.saveAccountingPeriod(ctx, txQueries, w, latest.YearMonth()); err != nil {
+			return err
+		}
+
+		if err := saveEvents(ctx, txQueries, w.PullEvents()); err != nil {
+			return err
+		}
+
+		return r.insertOccurrences(ctx, txQueries, t)
+	})
+}
+
+// getForUpdate locks the template and loads it with its category and occurrences.
+func (r *recurringTemplateRepo) ListActiveTemplateIDs(
+	ctx context.Context,
+	wID uuid.UUID,
+	startedBefore time.Time,
+) ([]uuid.UUID, error) {
+	tIDs, err := r.queries.ListActiveRecurringTemplateIDsByWalletID(ctx, store.ListActiveRecurringTemplateIDsByWalletIDParams{
+		WalletID:      wID,
+		StartedBefore: startedBefore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active recurring templates of wallet %s: %w", wID, err)
+	}
+
+	return tIDs, nil
+}
+
+func (r *recurringTemplateRepo) getForUpdate(
+	ctx context.Context,
+	queries *store.Queries,
+	tID uuid.UUID,
+) (*recurring.Template, error) {
+	tModel, err := queries.GetRecurringTemplateForUpdate(ctx, tID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("recurring template '%s': %w", tID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurring template: %w", err)
+	}
+
+	var c *category.Category
+	if tModel.CategoryID != nil {
+		cModel, err := queries.GetCategoryByID(ctx, store.GetCategoryByIDParams{
+			UserID: tModel.UserID,
+			ID:     *tModel.CategoryID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category of recurring template: %w", err)
+		}
+
+		if c, err = unmarshalCategory(cModel); err != nil {
+			return nil, err
+		}
+	}
+
+	oModels, err := queries.ListRecurringOccurrencesByTemplateID(ctx, tID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring occurrences: %w", err)
+	}
+
+	occurrences := make([]recurring.Occurrence, 0, len(oModels))
+	for _, oModel := range oModels {
+		o, err := recurring.UnmarshalOccurrenceFromDatabase(
+			oModel.OccurrenceDate,
+			oModel.Status,
+			convert.SafeDeref(oModel.TransactionRecordID, uuid.Nil),
+		)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, o)
+	}
+
+	schedule, err := recurring.NewSchedule(tModel.Frequency, tModel.Interval, tModel.DayOfMonth, tModel.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schedule of recurring template %s: %w", tModel.ID, err)
+	}
+
+	return recurring.UnmarshalTemplateFromDatabase(
+		tModel.ID,
+		tModel.UserID,
+		tModel.WalletID,
+		tModel.FpID,
+		tModel.Amount,
+		tModel.TransactionType,
+		c,
+		tModel.Description,
+		schedule,
+		tModel.Status,
+		tModel.PausedAt.Time,
+		tModel.Version,
+		occurrences...,
+	)
+}
+
+func (r *recurringTemplateRepo) save(ctx context.Context, queries *store.Queries, t *recurring.Template) error {
+	rows, err := queries.UpdateRecurringTemplateStatus(ctx, store.UpdateRecurringTemplateStatusParams{
+		ID:       t.ID(),
+		Status:   t.Status().String(),
+		PausedAt: common_db.ToPgTimestamp(t.PausedAt()),
+		Version:  t.Version(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("failed to update recurring template: %w", common_db.ErrConcurrentModification)
+	}
+
+	return r.insertOccurrences(ctx, queries, t)
+}
+
+func (r *recurringTemplateRepo) insertOccurrences(ctx context.Context, queries *store.Queries, t *recurring.Template) error {
+	occurrences := t.NewOccurrences()
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	params := make([]store.BulkInsertRecurringOccurrencesParams, 0, len(occurrences))
+	for _, o := range occurrences {
+		var txIDPtr *uuid.UUID
+		if txID := o.TransactionID(); txID != uuid.Nil {
+			txIDPtr = &txID
+		}
+
+		params = append(params, store.BulkInsertRecurringOccurrencesParams{
+			TemplateID:          t.ID(),
+			OccurrenceDate:      o.Date(),
+			Status:              o.Status().String(),
+			TransactionRecordID: txIDPtr,
+		})
+	}
+
+	// The primary key rejects a date handled concurrently, the whole materialization is then rolled back
+	rowsInserted, err := queries.BulkInsertRecurringOccurrences(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to bulk insert recurring occurrences: %w", err)
+	}
+
+	if rowsInserted != int64(len(occurrences)) {
+		return fmt.Errorf("failed to insert all recurring occurrences: expected %d, inserted %d",
+			len(occurrences), rowsInserted)
+	}
+
+	return nil
+}
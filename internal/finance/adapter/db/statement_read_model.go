@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// statementReadModel reads the statements of the wallets, built from the same records as the ledger export.
+type statementReadModel struct {
+	*ledgerExportReadModel
+	*walletReadModel
+	queries *store.Queries
+}
+
+func NewStatementReadModel(queries *store.Queries, db store.DBTX) *statementReadModel {
+	return &statementReadModel{
+		ledgerExportReadModel: NewLedgerExportReadModel(queries, db),
+		walletReadModel:       NewWalletReadModel(queries),
+		queries:               queries,
+	}
+}
+
+func (rm *statementReadModel) GetStatementArchive(
+	ctx context.Context,
+	wID uuid.UUID,
+	yearMonth ledger.YearMonth,
+) (query.StatementArchive, error) {
+	aModel, err := rm.queries.GetStatementArchiveByWalletIDAndYearMonth(ctx, store.GetStatementArchiveByWalletIDAndYearMonthParams{
+		WalletID:  wID,
+		YearMonth: yearMonth.String(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.StatementArchive{}, fmt.Errorf(
+			"statement archive of wallet '%s' for '%s': %w",
+			wID.String(),
+			yearMonth.String(),
+			common_db.ErrNotFound,
+		)
+	}
+	if err != nil {
+		return query.StatementArchive{}, fmt.Errorf("failed to retrieve statement archive of wallet '%s': %w", wID.String(), err)
+	}
+
+	return query.StatementArchive{
+		ID:                 aModel.ID,
+		AccountingPeriodID: aModel.AccountingPeriodID,
+		YearMonth:          aModel.YearMonth,
+		FileName:           aModel.FileName,
+		Content:            aModel.Content,
+		SHA256:             aModel.Sha256,
+		ArchivedAt:         aModel.ArchivedAt,
+	}, nil
+}
+
+func (rm *statementReadModel) ListClosedPeriodsWithoutStatementArchive(ctx context.Context) ([]query.StatementPeriod, error) {
+	pModels, err := rm.queries.ListClosedAccountingPeriodsWithoutStatementArchive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list closed accounting periods without statement archive: %w", err)
+	}
+
+	periods := make([]query.StatementPeriod, 0, len(pModels))
+	for _, pModel := range pModels {
+		periods = append(periods, query.StatementPeriod{
+			WalletID:  pModel.WalletID,
+			YearMonth: pModel.YearMonth,
+		})
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/statement"
+)
+
+type statementRepo struct {
+	queries *store.Queries
+}
+
+func NewStatementRepo(queries *store.Queries) (*statementRepo, error) {
+	if queries == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &statementRepo{
+		queries: queries,
+	}, nil
+}
+
+func (r *statementRepo) CreateArchive(ctx context.Context, a *statement.Archive) error {
+	// The archive is only inserted when the period of the wallet is closed
+	rows, err := r.queries.CreateStatementArchive(ctx, store.CreateStatementArchiveParams{
+		ID:                 a.ID(),
+		FileName:           a.FileName(),
+		Content:            a.Content(),
+		Sha256:             a.SHA256(),
+		ArchivedAt:         a.ArchivedAt(),
+		WalletID:           a.WalletID(),
+		AccountingPeriodID: a.AccountingPeriodID(),
+	})
+	if common_db.IsUniqueViolation(err) {
+		return fmt.Errorf("accounting period '%s': %w", a.AccountingPeriodID().String(), statement.ErrAlreadyArchived)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to archive statement of accounting period '%s': %w", a.AccountingPeriodID().String(), err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("accounting period '%s': %w", a.AccountingPeriodID().String(), statement.ErrPeriodNotArchivable)
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: category.sql
+
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countCategoriesByUserID = `-- name: CountCategoriesByUserID :one
+SELECT COUNT(*)
+FROM finance.categories
+WHERE user_id = $1
+`
+
+func (q *Queries) CountCategoriesByUserID(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countCategoriesByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCategory = `-- name: CreateCategory :exec
+INSERT INTO finance.categories (
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+) VALUES (
+    $1, -- id
+    $2, -- user_id
+    $3, -- parent_id
+    $4, -- name
+    $5, -- kind
+    $6  -- version
+)
+`
+
+type CreateCategoryParams struct {
+	ID       uuid.UUID  `db:"id"`
+	UserID   string     `db:"user_id"`
+	ParentID *uuid.UUID `db:"parent_id"`
+	Name     string     `db:"name"`
+	Kind     string     `db:"kind"`
+	Version  int32      `db:"version"`
+}
+
+func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) error {
+	_, err := q.db.Exec(ctx, createCategory,
+		arg.ID,
+		arg.UserID,
+		arg.ParentID,
+		arg.Name,
+		arg.Kind,
+		arg.Version,
+	)
+	return err
+}
+
+const deleteCategory = `-- name: DeleteCategory :execrows
+DELETE FROM finance.categories
+WHERE user_id = $1
+    AND id = $2
+`
+
+type DeleteCategoryParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) DeleteCategory(ctx context.Context, arg DeleteCategoryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCategory, arg.UserID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCategoriesByIDs = `-- name: GetCategoriesByIDs :many
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+    AND id = ANY($2::uuid[])
+`
+
+type GetCategoriesByIDsParams struct {
+	UserID string      `db:"user_id"`
+	Ids    []uuid.UUID `db:"ids"`
+}
+
+func (q *Queries) GetCategoriesByIDs(ctx context.Context, arg GetCategoriesByIDsParams) ([]FinanceCategory, error) {
+	rows, err := q.db.Query(ctx, getCategoriesByIDs, arg.UserID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinanceCategory
+	for rows.Next() {
+		var i FinanceCategory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.Kind,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCategoryByID = `-- name: GetCategoryByID :one
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+    AND id = $2
+`
+
+type GetCategoryByIDParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) GetCategoryByID(ctx context.Context, arg GetCategoryByIDParams) (FinanceCategory, error) {
+	row := q.db.QueryRow(ctx, getCategoryByID, arg.UserID, arg.ID)
+	var i FinanceCategory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.Kind,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getCategoryByIDForUpdate = `-- name: GetCategoryByIDForUpdate :one
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+    AND id = $2
+FOR UPDATE
+`
+
+type GetCategoryByIDForUpdateParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) GetCategoryByIDForUpdate(ctx context.Context, arg GetCategoryByIDForUpdateParams) (FinanceCategory, error) {
+	row := q.db.QueryRow(ctx, getCategoryByIDForUpdate, arg.UserID, arg.ID)
+	var i FinanceCategory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.Kind,
+		&i.Version,
+	)
+	return i, err
+}
+
+const listCategoriesByUserID = `-- name: ListCategoriesByUserID :many
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+ORDER BY kind DESC, parent_id NULLS FIRST, name
+`
+
+func (q *Queries) ListCategoriesByUserID(ctx context.Context, userID string) ([]FinanceCategory, error) {
+	rows, err := q.db.Query(ctx, listCategoriesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinanceCategory
+	for rows.Next() {
+		var i FinanceCategory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.Kind,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCategory = `-- name: UpdateCategory :execrows
+UPDATE finance.categories
+SET
+    name = $1,
+    version = version + 1
+WHERE id = $2
+    AND version = $3
+`
+
+type UpdateCategoryParams struct {
+	Name    string    `db:"name"`
+	ID      uuid.UUID `db:"id"`
+	Version int32     `db:"version"`
+}
+
+func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCategory, arg.Name, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -9,6 +9,40 @@ import (
 	"context"
 )
 
+// iteratorForBulkInsertCategoryBudgets implements pgx.CopyFromSource.
+type iteratorForBulkInsertCategoryBudgets struct {
+	rows                 []BulkInsertCategoryBudgetsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBulkInsertCategoryBudgets) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBulkInsertCategoryBudgets) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].AccountingPeriodID,
+		r.rows[0].CategoryID,
+		r.rows[0].Amount,
+	}, nil
+}
+
+func (r iteratorForBulkInsertCategoryBudgets) Err() error {
+	return nil
+}
+
+func (q *Queries) BulkInsertCategoryBudgets(ctx context.Context, arg []BulkInsertCategoryBudgetsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"finance", "category_budgets"}, []string{"accounting_period_id", "category_id", "amount"}, &iteratorForBulkInsertCategoryBudgets{rows: arg})
+}
+
 // iteratorForBulkInsertFundAllocations implements pgx.CopyFromSource.
 type iteratorForBulkInsertFundAllocations struct {
 	rows                 []BulkInsertFundAllocationsParams
@@ -43,6 +77,75 @@ func (q *Queries) BulkInsertFundAllocations(ctx context.Context, arg []BulkInser
 	return q.db.CopyFrom(ctx, []string{"finance", "fund_provider_allocations"}, []string{"fp_id", "wallet_id", "allocated_amount"}, &iteratorForBulkInsertFundAllocations{rows: arg})
 }
 
+// iteratorForBulkInsertReconciliationRecords implements pgx.CopyFromSource.
+type iteratorForBulkInsertReconciliationRecords struct {
+	rows                 []BulkInsertReconciliationRecordsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBulkInsertReconciliationRecords) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBulkInsertReconciliationRecords) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TransactionRecordID,
+		r.rows[0].ReconciliationID,
+		r.rows[0].IsAdjustment,
+	}, nil
+}
+
+func (r iteratorForBulkInsertReconciliationRecords) Err() error {
+	return nil
+}
+
+func (q *Queries) BulkInsertReconciliationRecords(ctx context.Context, arg []BulkInsertReconciliationRecordsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"finance", "reconciliation_records"}, []string{"transaction_record_id", "reconciliation_id", "is_adjustment"}, &iteratorForBulkInsertReconciliationRecords{rows: arg})
+}
+
+// iteratorForBulkInsertRecurringOccurrences implements pgx.CopyFromSource.
+type iteratorForBulkInsertRecurringOccurrences struct {
+	rows                 []BulkInsertRecurringOccurrencesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForBulkInsertRecurringOccurrences) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForBulkInsertRecurringOccurrences) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TemplateID,
+		r.rows[0].OccurrenceDate,
+		r.rows[0].Status,
+		r.rows[0].TransactionRecordID,
+	}, nil
+}
+
+func (r iteratorForBulkInsertRecurringOccurrences) Err() error {
+	return nil
+}
+
+func (q *Queries) BulkInsertRecurringOccurrences(ctx context.Context, arg []BulkInsertRecurringOccurrencesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"finance", "recurring_occurrences"}, []string{"template_id", "occurrence_date", "status", "transaction_record_id"}, &iteratorForBulkInsertRecurringOccurrences{rows: arg})
+}
+
 // iteratorForBulkInsertTransactionRecords implements pgx.CopyFromSource.
 type iteratorForBulkInsertTransactionRecords struct {
 	rows                 []BulkInsertTransactionRecordsParams
@@ -72,6 +175,13 @@ func (r iteratorForBulkInsertTransactionRecords) Values() ([]interface{}, error)
 		r.rows[0].FpID,
 		r.rows[0].FpBalance,
 		r.rows[0].AccountingPeriodsID,
+		r.rows[0].Description,
+		r.rows[0].OccurredAt,
+		r.rows[0].RecordedAt,
+		r.rows[0].LinkedID,
+		r.rows[0].ReversalOfID,
+		r.rows[0].Direction,
+		r.rows[0].CategoryID,
 	}, nil
 }
 
@@ -80,5 +190,5 @@ func (r iteratorForBulkInsertTransactionRecords) Err() error {
 }
 
 func (q *Queries) BulkInsertTransactionRecords(ctx context.Context, arg []BulkInsertTransactionRecordsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"finance", "transaction_records"}, []string{"id", "transaction_no", "transaction_type", "amount", "wallet_balance", "wallet_id", "fp_id", "fp_balance", "accounting_periods_id"}, &iteratorForBulkInsertTransactionRecords{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"finance", "transaction_records"}, []string{"id", "transaction_no", "transaction_type", "amount", "wallet_balance", "wallet_id", "fp_id", "fp_balance", "accounting_periods_id", "description", "occurred_at", "recorded_at", "linked_id", "reversal_of_id", "direction", "category_id"}, &iteratorForBulkInsertTransactionRecords{rows: arg})
 }
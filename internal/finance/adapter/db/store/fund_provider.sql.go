@@ -235,3 +235,99 @@ func (q *Queries) GetFundProvidersByIDs(ctx context.Context, fpids []uuid.UUID)
 	}
 	return items, nil
 }
+
+const listFundProviderAllocations = `-- name: ListFundProviderAllocations :many
+SELECT
+    fpa.fp_id,
+    fpa.wallet_id,
+    fpa.allocated_amount,
+    w.name AS wallet_name
+FROM finance.fund_provider_allocations fpa
+INNER JOIN finance.wallets w
+    ON w.id = fpa.wallet_id
+WHERE fpa.fp_id = $1
+ORDER BY w.name
+`
+
+type ListFundProviderAllocationsRow struct {
+	FpID            uuid.UUID `db:"fp_id"`
+	WalletID        uuid.UUID `db:"wallet_id"`
+	AllocatedAmount int64     `db:"allocated_amount"`
+	WalletName      string    `db:"wallet_name"`
+}
+
+func (q *Queries) ListFundProviderAllocations(ctx context.Context, fpID uuid.UUID) ([]ListFundProviderAllocationsRow, error) {
+	rows, err := q.db.Query(ctx, listFundProviderAllocations, fpID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFundProviderAllocationsRow
+	for rows.Next() {
+		var i ListFundProviderAllocationsRow
+		if err := rows.Scan(
+			&i.FpID,
+			&i.WalletID,
+			&i.AllocatedAmount,
+			&i.WalletName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFundProviders = `-- name: ListFundProviders :many
+SELECT
+    id,
+    name,
+    fp_type,
+    balance,
+    unallocated_amount,
+    currency,
+    version
+FROM finance.fund_providers
+ORDER BY id
+`
+
+type ListFundProvidersRow struct {
+	ID                uuid.UUID `db:"id"`
+	Name              string    `db:"name"`
+	FpType            string    `db:"fp_type"`
+	Balance           int64     `db:"balance"`
+	UnallocatedAmount int64     `db:"unallocated_amount"`
+	Currency          string    `db:"currency"`
+	Version           int32     `db:"version"`
+}
+
+func (q *Queries) ListFundProviders(ctx context.Context) ([]ListFundProvidersRow, error) {
+	rows, err := q.db.Query(ctx, listFundProviders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFundProvidersRow
+	for rows.Next() {
+		var i ListFundProvidersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.FpType,
+			&i.Balance,
+			&i.UnallocatedAmount,
+			&i.Currency,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
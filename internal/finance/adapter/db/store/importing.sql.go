@@ -0,0 +1,249 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: importing.sql
+
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createImportProfile = `-- name: CreateImportProfile :exec
+INSERT INTO finance.import_profiles (
+    id,
+    user_id,
+    name,
+    delimiter,
+    skip_rows,
+    date_column,
+    date_format,
+    amount_sign,
+    amount_column,
+    debit_column,
+    credit_column,
+    description_column,
+    reference_column,
+    thousands_separator,
+    version
+) VALUES (
+    $1,  -- id
+    $2,  -- user_id
+    $3,  -- name
+    $4,  -- delimiter
+    $5,  -- skip_rows
+    $6,  -- date_column
+    $7,  -- date_format
+    $8,  -- amount_sign
+    $9,  -- amount_column
+    $10, -- debit_column
+    $11, -- credit_column
+    $12, -- description_column
+    $13, -- reference_column
+    $14, -- thousands_separator
+    $15  -- version
+)
+`
+
+type CreateImportProfileParams struct {
+	ID                 uuid.UUID `db:"id"`
+	UserID             string    `db:"user_id"`
+	Name               string    `db:"name"`
+	Delimiter          string    `db:"delimiter"`
+	SkipRows           int32     `db:"skip_rows"`
+	DateColumn         string    `db:"date_column"`
+	DateFormat         string    `db:"date_format"`
+	AmountSign         string    `db:"amount_sign"`
+	AmountColumn       string    `db:"amount_column"`
+	DebitColumn        string    `db:"debit_column"`
+	CreditColumn       string    `db:"credit_column"`
+	DescriptionColumn  string    `db:"description_column"`
+	ReferenceColumn    string    `db:"reference_column"`
+	ThousandsSeparator string    `db:"thousands_separator"`
+	Version            int32     `db:"version"`
+}
+
+func (q *Queries) CreateImportProfile(ctx context.Context, arg CreateImportProfileParams) error {
+	_, err := q.db.Exec(ctx, createImportProfile,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Delimiter,
+		arg.SkipRows,
+		arg.DateColumn,
+		arg.DateFormat,
+		arg.AmountSign,
+		arg.AmountColumn,
+		arg.DebitColumn,
+		arg.CreditColumn,
+		arg.DescriptionColumn,
+		arg.ReferenceColumn,
+		arg.ThousandsSeparator,
+		arg.Version,
+	)
+	return err
+}
+
+const deleteImportProfile = `-- name: DeleteImportProfile :execrows
+DELETE FROM finance.import_profiles
+WHERE user_id = $1
+    AND id = $2
+`
+
+type DeleteImportProfileParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) DeleteImportProfile(ctx context.Context, arg DeleteImportProfileParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteImportProfile, arg.UserID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getImportProfileByID = `-- name: GetImportProfileByID :one
+SELECT
+    id,
+    user_id,
+    name,
+    delimiter,
+    skip_rows,
+    date_column,
+    date_format,
+    amount_sign,
+    amount_column,
+    debit_column,
+    credit_column,
+    description_column,
+    reference_column,
+    thousands_separator,
+    version
+FROM finance.import_profiles
+WHERE user_id = $1
+    AND id = $2
+`
+
+type GetImportProfileByIDParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) GetImportProfileByID(ctx context.Context, arg GetImportProfileByIDParams) (FinanceImportProfile, error) {
+	row := q.db.QueryRow(ctx, getImportProfileByID, arg.UserID, arg.ID)
+	var i FinanceImportProfile
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Delimiter,
+		&i.SkipRows,
+		&i.DateColumn,
+		&i.DateFormat,
+		&i.AmountSign,
+		&i.AmountColumn,
+		&i.DebitColumn,
+		&i.CreditColumn,
+		&i.DescriptionColumn,
+		&i.ReferenceColumn,
+		&i.ThousandsSeparator,
+		&i.Version,
+	)
+	return i, err
+}
+
+const listImportProfilesByUserID = `-- name: ListImportProfilesByUserID :many
+SELECT
+    id,
+    user_id,
+    name,
+    delimiter,
+    skip_rows,
+    date_column,
+    date_format,
+    amount_sign,
+    amount_column,
+    debit_column,
+    credit_column,
+    description_column,
+    reference_column,
+    thousands_separator,
+    version
+FROM finance.import_profiles
+WHERE user_id = $1
+ORDER BY lower(name)
+`
+
+func (q *Queries) ListImportProfilesByUserID(ctx context.Context, userID string) ([]FinanceImportProfile, error) {
+	rows, err := q.db.Query(ctx, listImportProfilesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinanceImportProfile
+	for rows.Next() {
+		var i FinanceImportProfile
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Delimiter,
+			&i.SkipRows,
+			&i.DateColumn,
+			&i.DateFormat,
+			&i.AmountSign,
+			&i.AmountColumn,
+			&i.DebitColumn,
+			&i.CreditColumn,
+			&i.DescriptionColumn,
+			&i.ReferenceColumn,
+			&i.ThousandsSeparator,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecordedTransactionNos = `-- name: ListRecordedTransactionNos :many
+SELECT DISTINCT transaction_no::text
+FROM finance.transaction_records
+WHERE fp_id = $1
+    AND transaction_no = ANY($2::text[])
+    AND transaction_no <> ''
+    AND linked_id IS NULL
+    AND reversed_by_id IS NULL
+`
+
+type ListRecordedTransactionNosParams struct {
+	FpID           uuid.UUID `db:"fp_id"`
+	TransactionNos []string  `db:"transaction_nos"`
+}
+
+func (q *Queries) ListRecordedTransactionNos(ctx context.Context, arg ListRecordedTransactionNosParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listRecordedTransactionNos, arg.FpID, arg.TransactionNos)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var transaction_no string
+		if err := rows.Scan(&transaction_no); err != nil {
+			return nil, err
+		}
+		items = append(items, transaction_no)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -13,16 +13,51 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BulkInsertCategoryBudgetsParams struct {
+	AccountingPeriodID uuid.UUID `db:"accounting_period_id"`
+	CategoryID         uuid.UUID `db:"category_id"`
+	Amount             int64     `db:"amount"`
+}
+
 type BulkInsertTransactionRecordsParams struct {
-	ID                  uuid.UUID `db:"id"`
-	TransactionNo       *string   `db:"transaction_no"`
-	TransactionType     string    `db:"transaction_type"`
-	Amount              int64     `db:"amount"`
-	WalletBalance       int64     `db:"wallet_balance"`
-	WalletID            uuid.UUID `db:"wallet_id"`
-	FpID                uuid.UUID `db:"fp_id"`
-	FpBalance           int64     `db:"fp_balance"`
-	AccountingPeriodsID uuid.UUID `db:"accounting_periods_id"`
+	ID                  uuid.UUID  `db:"id"`
+	TransactionNo       *string    `db:"transaction_no"`
+	TransactionType     string     `db:"transaction_type"`
+	Amount              int64      `db:"amount"`
+	WalletBalance       int64      `db:"wallet_balance"`
+	WalletID            uuid.UUID  `db:"wallet_id"`
+	FpID                uuid.UUID  `db:"fp_id"`
+	FpBalance           int64      `db:"fp_balance"`
+	AccountingPeriodsID uuid.UUID  `db:"accounting_periods_id"`
+	Description         string     `db:"description"`
+	OccurredAt          time.Time  `db:"occurred_at"`
+	RecordedAt          time.Time  `db:"recorded_at"`
+	LinkedID            *uuid.UUID `db:"linked_id"`
+	ReversalOfID        *uuid.UUID `db:"reversal_of_id"`
+	Direction           string     `db:"direction"`
+	CategoryID          *uuid.UUID `db:"category_id"`
+}
+
+const bumpOpenAccountingPeriodVersion = `-- name: BumpOpenAccountingPeriodVersion :execrows
+UPDATE finance.accounting_periods
+SET version = version + 1
+WHERE id = $1
+    AND version = $2
+    AND status = 'OPEN'
+`
+
+type BumpOpenAccountingPeriodVersionParams struct {
+	ID      uuid.UUID `db:"id"`
+	Version int32     `db:"version"`
+}
+
+// Claims an open period before its budgets are replaced, so concurrent plans or a closing in between are detected
+func (q *Queries) BumpOpenAccountingPeriodVersion(ctx context.Context, arg BumpOpenAccountingPeriodVersionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, bumpOpenAccountingPeriodVersion, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const createAccountingPeriod = `-- name: CreateAccountingPeriod :exec
@@ -38,7 +73,10 @@ INSERT INTO finance.accounting_periods (
     wallet_closing_balance,
     version,
     wallet_id,
-    status
+    status,
+    start_time,
+    total_income,
+    total_expense
 ) VALUES (
     $1, -- id
     $2, -- year_month
@@ -51,7 +89,10 @@ INSERT INTO finance.accounting_periods (
     $9, -- wallet_closing_balance
     $10, -- version
     $11, -- wallet_id
-    $12 -- status
+    $12, -- status
+    $13, -- start_time
+    $14, -- total_income
+    $15 -- total_expense
 )
 `
 
@@ -68,6 +109,9 @@ type CreateAccountingPeriodParams struct {
 	Version              int32     `db:"version"`
 	WalletID             uuid.UUID `db:"wallet_id"`
 	Status               string    `db:"status"`
+	StartTime            time.Time `db:"start_time"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
 }
 
 func (q *Queries) CreateAccountingPeriod(ctx context.Context, arg CreateAccountingPeriodParams) error {
@@ -84,20 +128,231 @@ func (q *Queries) CreateAccountingPeriod(ctx context.Context, arg CreateAccounti
 		arg.Version,
 		arg.WalletID,
 		arg.Status,
+		arg.StartTime,
+		arg.TotalIncome,
+		arg.TotalExpense,
 	)
 	return err
 }
 
+const deleteCategoryBudgetsByAccountingPeriodID = `-- name: DeleteCategoryBudgetsByAccountingPeriodID :exec
+DELETE FROM finance.category_budgets
+WHERE accounting_period_id = $1
+`
+
+func (q *Queries) DeleteCategoryBudgetsByAccountingPeriodID(ctx context.Context, accountingPeriodID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCategoryBudgetsByAccountingPeriodID, accountingPeriodID)
+	return err
+}
+
+const getAccountingPeriodByID = `-- name: GetAccountingPeriodByID :one
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+    AND id = $2
+`
+
+type GetAccountingPeriodByIDParams struct {
+	WalletID uuid.UUID `db:"wallet_id"`
+	ID       uuid.UUID `db:"id"`
+}
+
+type GetAccountingPeriodByIDRow struct {
+	ID                   uuid.UUID `db:"id"`
+	YearMonth            string    `db:"year_month"`
+	StartDate            int32     `db:"start_date"`
+	Interval             int32     `db:"interval"`
+	StartTime            time.Time `db:"start_time"`
+	EndTime              time.Time `db:"end_time"`
+	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
+	TotalDebit           int64     `db:"total_debit"`
+	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+	WalletClosingBalance int64     `db:"wallet_closing_balance"`
+	Status               string    `db:"status"`
+	Version              int32     `db:"version"`
+}
+
+func (q *Queries) GetAccountingPeriodByID(ctx context.Context, arg GetAccountingPeriodByIDParams) (GetAccountingPeriodByIDRow, error) {
+	row := q.db.QueryRow(ctx, getAccountingPeriodByID, arg.WalletID, arg.ID)
+	var i GetAccountingPeriodByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.YearMonth,
+		&i.StartDate,
+		&i.Interval,
+		&i.StartTime,
+		&i.EndTime,
+		&i.WalletOpeningBalance,
+		&i.TotalDebit,
+		&i.TotalCredit,
+		&i.TotalIncome,
+		&i.TotalExpense,
+		&i.WalletClosingBalance,
+		&i.Status,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getAccountingPeriodClosingReport = `-- name: GetAccountingPeriodClosingReport :one
+SELECT
+    ap.id,
+    ap.year_month,
+    ap.status,
+    ap.end_time,
+    ap.wallet_opening_balance,
+    ap.total_debit,
+    ap.total_credit,
+    ap.total_income,
+    ap.total_expense,
+    ap.wallet_closing_balance,
+    w.currency,
+    COUNT(tr.id) AS transaction_count
+FROM finance.accounting_periods ap
+INNER JOIN finance.wallets w
+    ON w.id = ap.wallet_id
+LEFT JOIN finance.transaction_records tr
+    ON tr.accounting_periods_id = ap.id
+WHERE ap.wallet_id = $1
+    AND ap.year_month = $2
+GROUP BY ap.id, w.currency
+`
+
+type GetAccountingPeriodClosingReportParams struct {
+	WalletID  uuid.UUID `db:"wallet_id"`
+	YearMonth string    `db:"year_month"`
+}
+
+type GetAccountingPeriodClosingReportRow struct {
+	ID                   uuid.UUID `db:"id"`
+	YearMonth            string    `db:"year_month"`
+	Status               string    `db:"status"`
+	EndTime              time.Time `db:"end_time"`
+	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
+	TotalDebit           int64     `db:"total_debit"`
+	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+	WalletClosingBalance int64     `db:"wallet_closing_balance"`
+	Currency             string    `db:"currency"`
+	TransactionCount     int64     `db:"transaction_count"`
+}
+
+func (q *Queries) GetAccountingPeriodClosingReport(ctx context.Context, arg GetAccountingPeriodClosingReportParams) (GetAccountingPeriodClosingReportRow, error) {
+	row := q.db.QueryRow(ctx, getAccountingPeriodClosingReport, arg.WalletID, arg.YearMonth)
+	var i GetAccountingPeriodClosingReportRow
+	err := row.Scan(
+		&i.ID,
+		&i.YearMonth,
+		&i.Status,
+		&i.EndTime,
+		&i.WalletOpeningBalance,
+		&i.TotalDebit,
+		&i.TotalCredit,
+		&i.TotalIncome,
+		&i.TotalExpense,
+		&i.WalletClosingBalance,
+		&i.Currency,
+		&i.TransactionCount,
+	)
+	return i, err
+}
+
+const getAccountingPeriodCoveringByWalletID = `-- name: GetAccountingPeriodCoveringByWalletID :one
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+    AND start_time <= $2
+    AND end_time > $2
+`
+
+type GetAccountingPeriodCoveringByWalletIDParams struct {
+	WalletID   uuid.UUID `db:"wallet_id"`
+	OccurredAt time.Time `db:"occurred_at"`
+}
+
+type GetAccountingPeriodCoveringByWalletIDRow struct {
+	ID                   uuid.UUID `db:"id"`
+	YearMonth            string    `db:"year_month"`
+	StartDate            int32     `db:"start_date"`
+	Interval             int32     `db:"interval"`
+	StartTime            time.Time `db:"start_time"`
+	EndTime              time.Time `db:"end_time"`
+	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
+	TotalDebit           int64     `db:"total_debit"`
+	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+	WalletClosingBalance int64     `db:"wallet_closing_balance"`
+	Status               string    `db:"status"`
+	Version              int32     `db:"version"`
+}
+
+func (q *Queries) GetAccountingPeriodCoveringByWalletID(ctx context.Context, arg GetAccountingPeriodCoveringByWalletIDParams) (GetAccountingPeriodCoveringByWalletIDRow, error) {
+	row := q.db.QueryRow(ctx, getAccountingPeriodCoveringByWalletID, arg.WalletID, arg.OccurredAt)
+	var i GetAccountingPeriodCoveringByWalletIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.YearMonth,
+		&i.StartDate,
+		&i.Interval,
+		&i.StartTime,
+		&i.EndTime,
+		&i.WalletOpeningBalance,
+		&i.TotalDebit,
+		&i.TotalCredit,
+		&i.TotalIncome,
+		&i.TotalExpense,
+		&i.WalletClosingBalance,
+		&i.Status,
+		&i.Version,
+	)
+	return i, err
+}
+
 const getAccountingPeriodsByYearMonthAndWalletID = `-- name: GetAccountingPeriodsByYearMonthAndWalletID :one
 SELECT
     id,
     year_month,
     start_date,
     interval,
+    start_time,
     end_time,
     wallet_opening_balance,
     total_debit,
     total_credit,
+    total_income,
+    total_expense,
     wallet_closing_balance,
     status,
     version
@@ -117,10 +372,13 @@ type GetAccountingPeriodsByYearMonthAndWalletIDRow struct {
 	YearMonth            string    `db:"year_month"`
 	StartDate            int32     `db:"start_date"`
 	Interval             int32     `db:"interval"`
+	StartTime            time.Time `db:"start_time"`
 	EndTime              time.Time `db:"end_time"`
 	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
 	TotalDebit           int64     `db:"total_debit"`
 	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
 	WalletClosingBalance int64     `db:"wallet_closing_balance"`
 	Status               string    `db:"status"`
 	Version              int32     `db:"version"`
@@ -134,10 +392,13 @@ func (q *Queries) GetAccountingPeriodsByYearMonthAndWalletID(ctx context.Context
 		&i.YearMonth,
 		&i.StartDate,
 		&i.Interval,
+		&i.StartTime,
 		&i.EndTime,
 		&i.WalletOpeningBalance,
 		&i.TotalDebit,
 		&i.TotalCredit,
+		&i.TotalIncome,
+		&i.TotalExpense,
 		&i.WalletClosingBalance,
 		&i.Status,
 		&i.Version,
@@ -145,6 +406,151 @@ func (q *Queries) GetAccountingPeriodsByYearMonthAndWalletID(ctx context.Context
 	return i, err
 }
 
+const getLatestAccountingPeriodByWalletID = `-- name: GetLatestAccountingPeriodByWalletID :one
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+ORDER BY end_time DESC
+LIMIT 1
+`
+
+type GetLatestAccountingPeriodByWalletIDRow struct {
+	ID                   uuid.UUID `db:"id"`
+	YearMonth            string    `db:"year_month"`
+	StartDate            int32     `db:"start_date"`
+	Interval             int32     `db:"interval"`
+	StartTime            time.Time `db:"start_time"`
+	EndTime              time.Time `db:"end_time"`
+	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
+	TotalDebit           int64     `db:"total_debit"`
+	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+	WalletClosingBalance int64     `db:"wallet_closing_balance"`
+	Status               string    `db:"status"`
+	Version              int32     `db:"version"`
+}
+
+func (q *Queries) GetLatestAccountingPeriodByWalletID(ctx context.Context, walletID uuid.UUID) (GetLatestAccountingPeriodByWalletIDRow, error) {
+	row := q.db.QueryRow(ctx, getLatestAccountingPeriodByWalletID, walletID)
+	var i GetLatestAccountingPeriodByWalletIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.YearMonth,
+		&i.StartDate,
+		&i.Interval,
+		&i.StartTime,
+		&i.EndTime,
+		&i.WalletOpeningBalance,
+		&i.TotalDebit,
+		&i.TotalCredit,
+		&i.TotalIncome,
+		&i.TotalExpense,
+		&i.WalletClosingBalance,
+		&i.Status,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getTransactionRecordForUpdate = `-- name: GetTransactionRecordForUpdate :one
+SELECT
+    id,
+    transaction_no,
+    transaction_type,
+    direction,
+    amount,
+    wallet_balance,
+    fp_id,
+    fp_balance,
+    accounting_periods_id,
+    description,
+    occurred_at,
+    recorded_at,
+    linked_id,
+    reversal_of_id,
+    reversed_by_id,
+    reversed_at,
+    category_id,
+    EXISTS (
+        SELECT 1
+        FROM finance.reconciliation_records rr
+        JOIN finance.reconciliations r ON r.id = rr.reconciliation_id
+        WHERE rr.transaction_record_id = tr.id
+            AND r.status = 'FINALISED'
+    ) AS is_reconciled
+FROM finance.transaction_records tr
+WHERE tr.wallet_id = $1
+    AND tr.id = $2
+FOR UPDATE OF tr
+`
+
+type GetTransactionRecordForUpdateParams struct {
+	WalletID uuid.UUID `db:"wallet_id"`
+	ID       uuid.UUID `db:"id"`
+}
+
+type GetTransactionRecordForUpdateRow struct {
+	ID                  uuid.UUID        `db:"id"`
+	TransactionNo       *string          `db:"transaction_no"`
+	TransactionType     string           `db:"transaction_type"`
+	Direction           string           `db:"direction"`
+	Amount              int64            `db:"amount"`
+	WalletBalance       int64            `db:"wallet_balance"`
+	FpID                uuid.UUID        `db:"fp_id"`
+	FpBalance           int64            `db:"fp_balance"`
+	AccountingPeriodsID uuid.UUID        `db:"accounting_periods_id"`
+	Description         string           `db:"description"`
+	OccurredAt          time.Time        `db:"occurred_at"`
+	RecordedAt          time.Time        `db:"recorded_at"`
+	LinkedID            *uuid.UUID       `db:"linked_id"`
+	ReversalOfID        *uuid.UUID       `db:"reversal_of_id"`
+	ReversedByID        *uuid.UUID       `db:"reversed_by_id"`
+	ReversedAt          pgtype.Timestamp `db:"reversed_at"`
+	CategoryID          *uuid.UUID       `db:"category_id"`
+	IsReconciled        bool             `db:"is_reconciled"`
+}
+
+func (q *Queries) GetTransactionRecordForUpdate(ctx context.Context, arg GetTransactionRecordForUpdateParams) (GetTransactionRecordForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getTransactionRecordForUpdate, arg.WalletID, arg.ID)
+	var i GetTransactionRecordForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.TransactionNo,
+		&i.TransactionType,
+		&i.Direction,
+		&i.Amount,
+		&i.WalletBalance,
+		&i.FpID,
+		&i.FpBalance,
+		&i.AccountingPeriodsID,
+		&i.Description,
+		&i.OccurredAt,
+		&i.RecordedAt,
+		&i.LinkedID,
+		&i.ReversalOfID,
+		&i.ReversedByID,
+		&i.ReversedAt,
+		&i.CategoryID,
+		&i.IsReconciled,
+	)
+	return i, err
+}
+
 const getWalletWithAccountingPeriod = `-- name: GetWalletWithAccountingPeriod :one
 SELECT
     w.id             AS wallet_id,
@@ -152,14 +558,19 @@ SELECT
     w.balance        AS wallet_balance,
     w.currency       AS wallet_currency,
     w.version        AS wallet_version,
+    w.period_start_day AS wallet_period_start_day,
+    w.period_interval  AS wallet_period_interval,
     ap.id            AS period_id,
     ap.year_month    AS period_year_month,
     ap.start_date    AS period_start_date,
     ap.interval      AS period_interval,
+    ap.start_time    AS period_start_time,
     ap.end_time      AS period_end_time,
     ap.wallet_opening_balance,
     ap.total_debit,
     ap.total_credit,
+    ap.total_income,
+    ap.total_expense,
     ap.wallet_closing_balance,
     ap.status        AS period_status,
     ap.version       AS period_version
@@ -181,14 +592,19 @@ type GetWalletWithAccountingPeriodRow struct {
 	WalletBalance        int64            `db:"wallet_balance"`
 	WalletCurrency       string           `db:"wallet_currency"`
 	WalletVersion        int32            `db:"wallet_version"`
+	WalletPeriodStartDay int32            `db:"wallet_period_start_day"`
+	WalletPeriodInterval int32            `db:"wallet_period_interval"`
 	PeriodID             *uuid.UUID       `db:"period_id"`
 	PeriodYearMonth      *string          `db:"period_year_month"`
 	PeriodStartDate      *int32           `db:"period_start_date"`
 	PeriodInterval       *int32           `db:"period_interval"`
+	PeriodStartTime      pgtype.Timestamp `db:"period_start_time"`
 	PeriodEndTime        pgtype.Timestamp `db:"period_end_time"`
 	WalletOpeningBalance *int64           `db:"wallet_opening_balance"`
 	TotalDebit           *int64           `db:"total_debit"`
 	TotalCredit          *int64           `db:"total_credit"`
+	TotalIncome          *int64           `db:"total_income"`
+	TotalExpense         *int64           `db:"total_expense"`
 	WalletClosingBalance *int64           `db:"wallet_closing_balance"`
 	PeriodStatus         *string          `db:"period_status"`
 	PeriodVersion        *int32           `db:"period_version"`
@@ -203,14 +619,19 @@ func (q *Queries) GetWalletWithAccountingPeriod(ctx context.Context, arg GetWall
 		&i.WalletBalance,
 		&i.WalletCurrency,
 		&i.WalletVersion,
+		&i.WalletPeriodStartDay,
+		&i.WalletPeriodInterval,
 		&i.PeriodID,
 		&i.PeriodYearMonth,
 		&i.PeriodStartDate,
 		&i.PeriodInterval,
+		&i.PeriodStartTime,
 		&i.PeriodEndTime,
 		&i.WalletOpeningBalance,
 		&i.TotalDebit,
 		&i.TotalCredit,
+		&i.TotalIncome,
+		&i.TotalExpense,
 		&i.WalletClosingBalance,
 		&i.PeriodStatus,
 		&i.PeriodVersion,
@@ -218,21 +639,593 @@ func (q *Queries) GetWalletWithAccountingPeriod(ctx context.Context, arg GetWall
 	return i, err
 }
 
+const listAccountingPeriodsByWalletID = `-- name: ListAccountingPeriodsByWalletID :many
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+ORDER BY end_time DESC
+`
+
+type ListAccountingPeriodsByWalletIDRow struct {
+	ID                   uuid.UUID `db:"id"`
+	YearMonth            string    `db:"year_month"`
+	StartDate            int32     `db:"start_date"`
+	Interval             int32     `db:"interval"`
+	StartTime            time.Time `db:"start_time"`
+	EndTime              time.Time `db:"end_time"`
+	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
+	TotalDebit           int64     `db:"total_debit"`
+	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+	WalletClosingBalance int64     `db:"wallet_closing_balance"`
+	Status               string    `db:"status"`
+	Version              int32     `db:"version"`
+}
+
+func (q *Queries) ListAccountingPeriodsByWalletID(ctx context.Context, walletID uuid.UUID) ([]ListAccountingPeriodsByWalletIDRow, error) {
+	rows, err := q.db.Query(ctx, listAccountingPeriodsByWalletID, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountingPeriodsByWalletIDRow
+	for rows.Next() {
+		var i ListAccountingPeriodsByWalletIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YearMonth,
+			&i.StartDate,
+			&i.Interval,
+			&i.StartTime,
+			&i.EndTime,
+			&i.WalletOpeningBalance,
+			&i.TotalDebit,
+			&i.TotalCredit,
+			&i.TotalIncome,
+			&i.TotalExpense,
+			&i.WalletClosingBalance,
+			&i.Status,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryBudgetsByAccountingPeriodID = `-- name: ListCategoryBudgetsByAccountingPeriodID :many
+SELECT
+    category_id,
+    amount
+FROM finance.category_budgets
+WHERE accounting_period_id = $1
+ORDER BY category_id
+`
+
+type ListCategoryBudgetsByAccountingPeriodIDRow struct {
+	CategoryID uuid.UUID `db:"category_id"`
+	Amount     int64     `db:"amount"`
+}
+
+func (q *Queries) ListCategoryBudgetsByAccountingPeriodID(ctx context.Context, accountingPeriodID uuid.UUID) ([]ListCategoryBudgetsByAccountingPeriodIDRow, error) {
+	rows, err := q.db.Query(ctx, listCategoryBudgetsByAccountingPeriodID, accountingPeriodID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryBudgetsByAccountingPeriodIDRow
+	for rows.Next() {
+		var i ListCategoryBudgetsByAccountingPeriodIDRow
+		if err := rows.Scan(&i.CategoryID, &i.Amount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryBudgetsWithName = `-- name: ListCategoryBudgetsWithName :many
+SELECT
+    cb.category_id,
+    c.parent_id,
+    c.name,
+    cb.amount
+FROM finance.category_budgets cb
+INNER JOIN finance.categories c
+    ON c.id = cb.category_id
+WHERE cb.accounting_period_id = $1
+ORDER BY c.name
+`
+
+type ListCategoryBudgetsWithNameRow struct {
+	CategoryID uuid.UUID  `db:"category_id"`
+	ParentID   *uuid.UUID `db:"parent_id"`
+	Name       string     `db:"name"`
+	Amount     int64      `db:"amount"`
+}
+
+func (q *Queries) ListCategoryBudgetsWithName(ctx context.Context, accountingPeriodID uuid.UUID) ([]ListCategoryBudgetsWithNameRow, error) {
+	rows, err := q.db.Query(ctx, listCategoryBudgetsWithName, accountingPeriodID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryBudgetsWithNameRow
+	for rows.Next() {
+		var i ListCategoryBudgetsWithNameRow
+		if err := rows.Scan(
+			&i.CategoryID,
+			&i.ParentID,
+			&i.Name,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategorySpendingByAccountingPeriodID = `-- name: ListCategorySpendingByAccountingPeriodID :many
+SELECT
+    c.id AS category_id,
+    c.parent_id,
+    SUM(CASE WHEN tr.direction = 'OUT' THEN tr.amount ELSE -tr.amount END)::bigint AS spent
+FROM finance.transaction_records tr
+INNER JOIN finance.categories c
+    ON c.id = tr.category_id
+WHERE tr.accounting_periods_id = $1
+GROUP BY c.id, c.parent_id
+`
+
+type ListCategorySpendingByAccountingPeriodIDRow struct {
+	CategoryID uuid.UUID  `db:"category_id"`
+	ParentID   *uuid.UUID `db:"parent_id"`
+	Spent      int64      `db:"spent"`
+}
+
+// Spending per category of a period, outflows count and inflows such as refunds or reversals are deducted
+func (q *Queries) ListCategorySpendingByAccountingPeriodID(ctx context.Context, accountingPeriodsID uuid.UUID) ([]ListCategorySpendingByAccountingPeriodIDRow, error) {
+	rows, err := q.db.Query(ctx, listCategorySpendingByAccountingPeriodID, accountingPeriodsID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategorySpendingByAccountingPeriodIDRow
+	for rows.Next() {
+		var i ListCategorySpendingByAccountingPeriodIDRow
+		if err := rows.Scan(&i.CategoryID, &i.ParentID, &i.Spent); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDuplicateCandidates = `-- name: ListDuplicateCandidates :many
+SELECT
+    l.lookup_index::int AS lookup_index,
+    d.id,
+    d.wallet_id,
+    d.wallet_name,
+    d.transaction_no,
+    d.transaction_type,
+    d.direction,
+    d.amount,
+    d.description,
+    d.occurred_at,
+    d.year_month
+FROM unnest($1::uuid[]) WITH ORDINALITY AS l(fp_id, lookup_index)
+CROSS JOIN LATERAL (
+    SELECT
+        tr.id,
+        tr.wallet_id,
+        w.name AS wallet_name,
+        tr.transaction_no,
+        tr.transaction_type,
+        tr.direction,
+        tr.amount,
+        tr.description,
+        tr.occurred_at,
+        ap.year_month
+    FROM finance.transaction_records tr
+    INNER JOIN finance.wallets w
+        ON w.id = tr.wallet_id
+    INNER JOIN finance.accounting_periods ap
+        ON ap.id = tr.accounting_periods_id
+    WHERE tr.fp_id = l.fp_id
+        AND tr.amount = ($2::bigint[])[l.lookup_index]
+        AND tr.occurred_at >= ($3::timestamp[])[l.lookup_index]
+        AND tr.occurred_at <= ($4::timestamp[])[l.lookup_index]
+        AND tr.reversal_of_id IS NULL
+        AND tr.reversed_by_id IS NULL
+    ORDER BY tr.occurred_at, tr.id
+    LIMIT $5
+) d
+ORDER BY l.lookup_index, d.occurred_at, d.id
+`
+
+type ListDuplicateCandidatesParams struct {
+	FpIds         []uuid.UUID `db:"fp_ids"`
+	Amounts       []int64     `db:"amounts"`
+	OccurredFroms []time.Time `db:"occurred_froms"`
+	OccurredTos   []time.Time `db:"occurred_tos"`
+	MaxCandidates int32       `db:"max_candidates"`
+}
+
+type ListDuplicateCandidatesRow struct {
+	LookupIndex     int32     `db:"lookup_index"`
+	ID              uuid.UUID `db:"id"`
+	WalletID        uuid.UUID `db:"wallet_id"`
+	WalletName      string    `db:"wallet_name"`
+	TransactionNo   *string   `db:"transaction_no"`
+	TransactionType string    `db:"transaction_type"`
+	Direction       string    `db:"direction"`
+	Amount          int64     `db:"amount"`
+	Description     string    `db:"description"`
+	OccurredAt      time.Time `db:"occurred_at"`
+	YearMonth       string    `db:"year_month"`
+}
+
+// Looks up the candidates of every transaction at once, the arrays hold one lookup per position
+// and lookup_index is the 1-based position of the lookup
+func (q *Queries) ListDuplicateCandidates(ctx context.Context, arg ListDuplicateCandidatesParams) ([]ListDuplicateCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listDuplicateCandidates,
+		arg.FpIds,
+		arg.Amounts,
+		arg.OccurredFroms,
+		arg.OccurredTos,
+		arg.MaxCandidates,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDuplicateCandidatesRow
+	for rows.Next() {
+		var i ListDuplicateCandidatesRow
+		if err := rows.Scan(
+			&i.LookupIndex,
+			&i.ID,
+			&i.WalletID,
+			&i.WalletName,
+			&i.TransactionNo,
+			&i.TransactionType,
+			&i.Direction,
+			&i.Amount,
+			&i.Description,
+			&i.OccurredAt,
+			&i.YearMonth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenAccountingPeriodsByWalletID = `-- name: ListOpenAccountingPeriodsByWalletID :many
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+    AND status = 'OPEN'
+ORDER BY end_time DESC
+`
+
+type ListOpenAccountingPeriodsByWalletIDRow struct {
+	ID                   uuid.UUID `db:"id"`
+	YearMonth            string    `db:"year_month"`
+	StartDate            int32     `db:"start_date"`
+	Interval             int32     `db:"interval"`
+	StartTime            time.Time `db:"start_time"`
+	EndTime              time.Time `db:"end_time"`
+	WalletOpeningBalance int64     `db:"wallet_opening_balance"`
+	TotalDebit           int64     `db:"total_debit"`
+	TotalCredit          int64     `db:"total_credit"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+	WalletClosingBalance int64     `db:"wallet_closing_balance"`
+	Status               string    `db:"status"`
+	Version              int32     `db:"version"`
+}
+
+func (q *Queries) ListOpenAccountingPeriodsByWalletID(ctx context.Context, walletID uuid.UUID) ([]ListOpenAccountingPeriodsByWalletIDRow, error) {
+	rows, err := q.db.Query(ctx, listOpenAccountingPeriodsByWalletID, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOpenAccountingPeriodsByWalletIDRow
+	for rows.Next() {
+		var i ListOpenAccountingPeriodsByWalletIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.YearMonth,
+			&i.StartDate,
+			&i.Interval,
+			&i.StartTime,
+			&i.EndTime,
+			&i.WalletOpeningBalance,
+			&i.TotalDebit,
+			&i.TotalCredit,
+			&i.TotalIncome,
+			&i.TotalExpense,
+			&i.WalletClosingBalance,
+			&i.Status,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionRecords = `-- name: ListTransactionRecords :many
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.wallet_balance,
+    tr.fp_id,
+    tr.fp_balance,
+    tr.description,
+    tr.occurred_at,
+    tr.recorded_at,
+    tr.linked_id,
+    tr.reversal_of_id,
+    tr.reversed_by_id,
+    tr.reversed_at,
+    tr.category_id,
+    c.name           AS category_name,
+    fp.name          AS fp_name,
+    ap.year_month
+FROM finance.transaction_records tr
+INNER JOIN finance.accounting_periods ap
+    ON ap.id = tr.accounting_periods_id
+INNER JOIN finance.fund_providers fp
+    ON fp.id = tr.fp_id
+LEFT JOIN finance.categories c
+    ON c.id = tr.category_id
+WHERE tr.wallet_id = $1
+    AND ($2::uuid IS NULL OR tr.id < $2::uuid)
+    AND ($3::int IS NULL
+        OR split_part(ap.year_month, ',', 1)::int * 12 + split_part(ap.year_month, ',', 2)::int >= $3::int)
+    AND ($4::int IS NULL
+        OR split_part(ap.year_month, ',', 1)::int * 12 + split_part(ap.year_month, ',', 2)::int <= $4::int)
+    AND ($5::uuid IS NULL OR tr.fp_id = $5::uuid)
+    AND ($6::text IS NULL OR tr.transaction_type = $6::text)
+    AND ($7::bigint IS NULL OR tr.amount >= $7::bigint)
+    AND ($8::bigint IS NULL OR tr.amount <= $8::bigint)
+    AND ($9::text IS NULL OR tr.transaction_no = $9::text)
+    AND ($10::uuid IS NULL
+        OR tr.category_id = $10::uuid
+        OR c.parent_id = $10::uuid)
+ORDER BY tr.id DESC
+LIMIT $11
+`
+
+type ListTransactionRecordsParams struct {
+	WalletID        uuid.UUID  `db:"wallet_id"`
+	Cursor          *uuid.UUID `db:"cursor"`
+	FromPeriod      *int32     `db:"from_period"`
+	ToPeriod        *int32     `db:"to_period"`
+	FpID            *uuid.UUID `db:"fp_id"`
+	TransactionType *string    `db:"transaction_type"`
+	MinAmount       *int64     `db:"min_amount"`
+	MaxAmount       *int64     `db:"max_amount"`
+	TransactionNo   *string    `db:"transaction_no"`
+	CategoryID      *uuid.UUID `db:"category_id"`
+	PageSize        int32      `db:"page_size"`
+}
+
+type ListTransactionRecordsRow struct {
+	ID              uuid.UUID        `db:"id"`
+	TransactionNo   *string          `db:"transaction_no"`
+	TransactionType string           `db:"transaction_type"`
+	Direction       string           `db:"direction"`
+	Amount          int64            `db:"amount"`
+	WalletBalance   int64            `db:"wallet_balance"`
+	FpID            uuid.UUID        `db:"fp_id"`
+	FpBalance       int64            `db:"fp_balance"`
+	Description     string           `db:"description"`
+	OccurredAt      time.Time        `db:"occurred_at"`
+	RecordedAt      time.Time        `db:"recorded_at"`
+	LinkedID        *uuid.UUID       `db:"linked_id"`
+	ReversalOfID    *uuid.UUID       `db:"reversal_of_id"`
+	ReversedByID    *uuid.UUID       `db:"reversed_by_id"`
+	ReversedAt      pgtype.Timestamp `db:"reversed_at"`
+	CategoryID      *uuid.UUID       `db:"category_id"`
+	CategoryName    *string          `db:"category_name"`
+	FpName          string           `db:"fp_name"`
+	YearMonth       string           `db:"year_month"`
+}
+
+func (q *Queries) ListTransactionRecords(ctx context.Context, arg ListTransactionRecordsParams) ([]ListTransactionRecordsRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionRecords,
+		arg.WalletID,
+		arg.Cursor,
+		arg.FromPeriod,
+		arg.ToPeriod,
+		arg.FpID,
+		arg.TransactionType,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.TransactionNo,
+		arg.CategoryID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionRecordsRow
+	for rows.Next() {
+		var i ListTransactionRecordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransactionNo,
+			&i.TransactionType,
+			&i.Direction,
+			&i.Amount,
+			&i.WalletBalance,
+			&i.FpID,
+			&i.FpBalance,
+			&i.Description,
+			&i.OccurredAt,
+			&i.RecordedAt,
+			&i.LinkedID,
+			&i.ReversalOfID,
+			&i.ReversedByID,
+			&i.ReversedAt,
+			&i.CategoryID,
+			&i.CategoryName,
+			&i.FpName,
+			&i.YearMonth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWalletIDsWithEndedAccountingPeriod = `-- name: ListWalletIDsWithEndedAccountingPeriod :many
+SELECT
+    ap.wallet_id,
+    w.user_id
+FROM finance.accounting_periods ap
+    JOIN finance.wallets w ON w.id = ap.wallet_id
+WHERE ap.end_time <= $1
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.accounting_periods later
+        WHERE later.wallet_id = ap.wallet_id
+            AND later.end_time > ap.end_time
+    )
+ORDER BY ap.wallet_id
+`
+
+type ListWalletIDsWithEndedAccountingPeriodRow struct {
+	WalletID uuid.UUID `db:"wallet_id"`
+	UserID   *string   `db:"user_id"`
+}
+
+func (q *Queries) ListWalletIDsWithEndedAccountingPeriod(ctx context.Context, endedBefore time.Time) ([]ListWalletIDsWithEndedAccountingPeriodRow, error) {
+	rows, err := q.db.Query(ctx, listWalletIDsWithEndedAccountingPeriod, endedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWalletIDsWithEndedAccountingPeriodRow
+	for rows.Next() {
+		var i ListWalletIDsWithEndedAccountingPeriodRow
+		if err := rows.Scan(&i.WalletID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTransactionRecordReversed = `-- name: MarkTransactionRecordReversed :execrows
+UPDATE finance.transaction_records
+SET
+    reversed_by_id = $1,
+    reversed_at = $2
+WHERE id = $3
+    AND reversed_by_id IS NULL
+`
+
+type MarkTransactionRecordReversedParams struct {
+	ReversedByID *uuid.UUID       `db:"reversed_by_id"`
+	ReversedAt   pgtype.Timestamp `db:"reversed_at"`
+	ID           uuid.UUID        `db:"id"`
+}
+
+func (q *Queries) MarkTransactionRecordReversed(ctx context.Context, arg MarkTransactionRecordReversedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markTransactionRecordReversed, arg.ReversedByID, arg.ReversedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateAccountingPeriod = `-- name: UpdateAccountingPeriod :execrows
 UPDATE finance.accounting_periods ap
 SET
     total_debit = $1,
     total_credit = $2,
-    wallet_closing_balance = $3,
-    status = $4,
+    total_income = $3,
+    total_expense = $4,
+    wallet_closing_balance = $5,
+    status = $6,
     version = version + 1
-WHERE ap.id = $5
-    AND ap.version = $6
+WHERE ap.id = $7
+    AND ap.version = $8
 `
 
 type UpdateAccountingPeriodParams struct {
 	TotalDebit     int64     `db:"total_debit"`
 	TotalCredit    int64     `db:"total_credit"`
+	TotalIncome    int64     `db:"total_income"`
+	TotalExpense   int64     `db:"total_expense"`
 	ClosingBalance int64     `db:"closing_balance"`
 	Status         string    `db:"status"`
 	ID             uuid.UUID `db:"id"`
@@ -243,6 +1236,8 @@ func (q *Queries) UpdateAccountingPeriod(ctx context.Context, arg UpdateAccounti
 	result, err := q.db.Exec(ctx, updateAccountingPeriod,
 		arg.TotalDebit,
 		arg.TotalCredit,
+		arg.TotalIncome,
+		arg.TotalExpense,
 		arg.ClosingBalance,
 		arg.Status,
 		arg.ID,
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type FinanceAccountingPeriod struct {
@@ -23,6 +24,24 @@ type FinanceAccountingPeriod struct {
 	Status               string    `db:"status"`
 	WalletID             uuid.UUID `db:"wallet_id"`
 	Version              int32     `db:"version"`
+	StartTime            time.Time `db:"start_time"`
+	TotalIncome          int64     `db:"total_income"`
+	TotalExpense         int64     `db:"total_expense"`
+}
+
+type FinanceCategory struct {
+	ID       uuid.UUID  `db:"id"`
+	UserID   string     `db:"user_id"`
+	ParentID *uuid.UUID `db:"parent_id"`
+	Name     string     `db:"name"`
+	Kind     string     `db:"kind"`
+	Version  int32      `db:"version"`
+}
+
+type FinanceCategoryBudget struct {
+	AccountingPeriodID uuid.UUID `db:"accounting_period_id"`
+	CategoryID         uuid.UUID `db:"category_id"`
+	Amount             int64     `db:"amount"`
 }
 
 type FinanceFundProvider struct {
@@ -41,22 +60,166 @@ type FinanceFundProviderAllocation struct {
 	AllocatedAmount int64     `db:"allocated_amount"`
 }
 
+type FinanceIdempotencyKey struct {
+	UserID         string    `db:"user_id"`
+	Route          string    `db:"route"`
+	IdempotencyKey string    `db:"idempotency_key"`
+	RequestHash    string    `db:"request_hash"`
+	StatusCode     *int32    `db:"status_code"`
+	ContentType    *string   `db:"content_type"`
+	ResponseBody   []byte    `db:"response_body"`
+	CreatedAt      time.Time `db:"created_at"`
+	ExpiresAt      time.Time `db:"expires_at"`
+}
+
+type FinanceImportProfile struct {
+	ID                 uuid.UUID `db:"id"`
+	UserID             string    `db:"user_id"`
+	Name               string    `db:"name"`
+	Delimiter          string    `db:"delimiter"`
+	SkipRows           int32     `db:"skip_rows"`
+	DateColumn         string    `db:"date_column"`
+	DateFormat         string    `db:"date_format"`
+	AmountSign         string    `db:"amount_sign"`
+	AmountColumn       string    `db:"amount_column"`
+	DebitColumn        string    `db:"debit_column"`
+	CreditColumn       string    `db:"credit_column"`
+	DescriptionColumn  string    `db:"description_column"`
+	ReferenceColumn    string    `db:"reference_column"`
+	ThousandsSeparator string    `db:"thousands_separator"`
+	Version            int32     `db:"version"`
+}
+
+type FinanceOutboxEvent struct {
+	ID            uuid.UUID        `db:"id"`
+	AggregateType string           `db:"aggregate_type"`
+	AggregateID   uuid.UUID        `db:"aggregate_id"`
+	EventName     string           `db:"event_name"`
+	Payload       []byte           `db:"payload"`
+	OccurredAt    time.Time        `db:"occurred_at"`
+	Status        string           `db:"status"`
+	Attempts      int32            `db:"attempts"`
+	LastError     *string          `db:"last_error"`
+	NextAttemptAt time.Time        `db:"next_attempt_at"`
+	DispatchedAt  pgtype.Timestamp `db:"dispatched_at"`
+	UserID        *string          `db:"user_id"`
+}
+
+type FinanceReconciliation struct {
+	ID               uuid.UUID        `db:"id"`
+	FpID             uuid.UUID        `db:"fp_id"`
+	StatementDate    time.Time        `db:"statement_date"`
+	StatementBalance int64            `db:"statement_balance"`
+	Status           string           `db:"status"`
+	CreatedAt        time.Time        `db:"created_at"`
+	FinalisedAt      pgtype.Timestamp `db:"finalised_at"`
+	Version          int32            `db:"version"`
+}
+
+type FinanceReconciliationRecord struct {
+	TransactionRecordID uuid.UUID `db:"transaction_record_id"`
+	ReconciliationID    uuid.UUID `db:"reconciliation_id"`
+	IsAdjustment        bool      `db:"is_adjustment"`
+}
+
+type FinanceRecurringOccurrence struct {
+	TemplateID          uuid.UUID  `db:"template_id"`
+	OccurrenceDate      time.Time  `db:"occurrence_date"`
+	Status              string     `db:"status"`
+	TransactionRecordID *uuid.UUID `db:"transaction_record_id"`
+}
+
+type FinanceRecurringTemplate struct {
+	ID              uuid.UUID        `db:"id"`
+	UserID          string           `db:"user_id"`
+	WalletID        uuid.UUID        `db:"wallet_id"`
+	FpID            uuid.UUID        `db:"fp_id"`
+	Amount          int64            `db:"amount"`
+	TransactionType string           `db:"transaction_type"`
+	CategoryID      *uuid.UUID       `db:"category_id"`
+	Description     string           `db:"description"`
+	Frequency       string           `db:"frequency"`
+	Interval        int32            `db:"interval"`
+	DayOfMonth      int32            `db:"day_of_month"`
+	StartDate       time.Time        `db:"start_date"`
+	Status          string           `db:"status"`
+	PausedAt        pgtype.Timestamp `db:"paused_at"`
+	Version         int32            `db:"version"`
+}
+
+type FinanceStatementArchive struct {
+	ID                 uuid.UUID `db:"id"`
+	WalletID           uuid.UUID `db:"wallet_id"`
+	AccountingPeriodID uuid.UUID `db:"accounting_period_id"`
+	YearMonth          string    `db:"year_month"`
+	FileName           string    `db:"file_name"`
+	Content            []byte    `db:"content"`
+	Sha256             string    `db:"sha256"`
+	ArchivedAt         time.Time `db:"archived_at"`
+}
+
 type FinanceTransactionRecord struct {
-	ID                  uuid.UUID `db:"id"`
-	TransactionNo       *string   `db:"transaction_no"`
-	TransactionType     string    `db:"transaction_type"`
-	Amount              int64     `db:"amount"`
-	WalletBalance       int64     `db:"wallet_balance"`
-	WalletID            uuid.UUID `db:"wallet_id"`
-	FpID                uuid.UUID `db:"fp_id"`
-	FpBalance           int64     `db:"fp_balance"`
-	AccountingPeriodsID uuid.UUID `db:"accounting_periods_id"`
+	ID                  uuid.UUID        `db:"id"`
+	TransactionNo       *string          `db:"transaction_no"`
+	TransactionType     string           `db:"transaction_type"`
+	Amount              int64            `db:"amount"`
+	WalletBalance       int64            `db:"wallet_balance"`
+	WalletID            uuid.UUID        `db:"wallet_id"`
+	FpID                uuid.UUID        `db:"fp_id"`
+	FpBalance           int64            `db:"fp_balance"`
+	AccountingPeriodsID uuid.UUID        `db:"accounting_periods_id"`
+	Description         string           `db:"description"`
+	OccurredAt          time.Time        `db:"occurred_at"`
+	RecordedAt          time.Time        `db:"recorded_at"`
+	LinkedID            *uuid.UUID       `db:"linked_id"`
+	ReversalOfID        *uuid.UUID       `db:"reversal_of_id"`
+	ReversedByID        *uuid.UUID       `db:"reversed_by_id"`
+	ReversedAt          pgtype.Timestamp `db:"reversed_at"`
+	Direction           string           `db:"direction"`
+	CategoryID          *uuid.UUID       `db:"category_id"`
 }
 
 type FinanceWallet struct {
-	ID       uuid.UUID `db:"id"`
-	Name     string    `db:"name"`
-	Balance  int64     `db:"balance"`
-	Currency string    `db:"currency"`
-	Version  int32     `db:"version"`
+	ID             uuid.UUID `db:"id"`
+	Name           string    `db:"name"`
+	Balance        int64     `db:"balance"`
+	Currency       string    `db:"currency"`
+	Version        int32     `db:"version"`
+	PeriodStartDay int32     `db:"period_start_day"`
+	PeriodInterval int32     `db:"period_interval"`
+	UserID         *string   `db:"user_id"`
+}
+
+type FinanceWebhookDelivery struct {
+	ID             uuid.UUID        `db:"id"`
+	SubscriptionID uuid.UUID        `db:"subscription_id"`
+	EventID        uuid.UUID        `db:"event_id"`
+	EventName      string           `db:"event_name"`
+	Payload        []byte           `db:"payload"`
+	Status         string           `db:"status"`
+	Attempts       int32            `db:"attempts"`
+	NextAttemptAt  time.Time        `db:"next_attempt_at"`
+	LastStatusCode *int32           `db:"last_status_code"`
+	LastError      *string          `db:"last_error"`
+	CreatedAt      time.Time        `db:"created_at"`
+	DeliveredAt    pgtype.Timestamp `db:"delivered_at"`
+}
+
+type FinanceWebhookDeliveryAttempt struct {
+	DeliveryID  uuid.UUID `db:"delivery_id"`
+	Attempt     int32     `db:"attempt"`
+	AttemptedAt time.Time `db:"attempted_at"`
+	StatusCode  *int32    `db:"status_code"`
+	Error       *string   `db:"error"`
+	DurationMs  int32     `db:"duration_ms"`
+}
+
+type FinanceWebhookSubscription struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     string    `db:"user_id"`
+	Url        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+	Version    int32     `db:"version"`
 }
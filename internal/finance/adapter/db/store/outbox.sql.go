@@ -0,0 +1,186 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: outbox.sql
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO finance.outbox_events (
+    id,
+    aggregate_type,
+    aggregate_id,
+    user_id,
+    event_name,
+    payload,
+    occurred_at,
+    status,
+    attempts,
+    next_attempt_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+)
+`
+
+type CreateOutboxEventParams struct {
+	ID            uuid.UUID `db:"id"`
+	AggregateType string    `db:"aggregate_type"`
+	AggregateID   uuid.UUID `db:"aggregate_id"`
+	UserID        *string   `db:"user_id"`
+	EventName     string    `db:"event_name"`
+	Payload       []byte    `db:"payload"`
+	OccurredAt    time.Time `db:"occurred_at"`
+	Status        string    `db:"status"`
+	Attempts      int32     `db:"attempts"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, createOutboxEvent,
+		arg.ID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.UserID,
+		arg.EventName,
+		arg.Payload,
+		arg.OccurredAt,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+	)
+	return err
+}
+
+const getOutboxEventForUpdate = `-- name: GetOutboxEventForUpdate :one
+SELECT
+    id,
+    user_id,
+    event_name,
+    payload,
+    occurred_at,
+    status,
+    attempts,
+    last_error,
+    next_attempt_at,
+    dispatched_at
+FROM finance.outbox_events
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetOutboxEventForUpdateRow struct {
+	ID            uuid.UUID        `db:"id"`
+	UserID        *string          `db:"user_id"`
+	EventName     string           `db:"event_name"`
+	Payload       []byte           `db:"payload"`
+	OccurredAt    time.Time        `db:"occurred_at"`
+	Status        string           `db:"status"`
+	Attempts      int32            `db:"attempts"`
+	LastError     *string          `db:"last_error"`
+	NextAttemptAt time.Time        `db:"next_attempt_at"`
+	DispatchedAt  pgtype.Timestamp `db:"dispatched_at"`
+}
+
+func (q *Queries) GetOutboxEventForUpdate(ctx context.Context, id uuid.UUID) (GetOutboxEventForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getOutboxEventForUpdate, id)
+	var i GetOutboxEventForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.EventName,
+		&i.Payload,
+		&i.OccurredAt,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.DispatchedAt,
+	)
+	return i, err
+}
+
+const listDueOutboxEventIDs = `-- name: ListDueOutboxEventIDs :many
+SELECT id
+FROM finance.outbox_events
+WHERE status = 'PENDING'
+    AND next_attempt_at <= $1
+ORDER BY next_attempt_at, id
+LIMIT $2
+`
+
+type ListDueOutboxEventIDsParams struct {
+	Now      time.Time `db:"now"`
+	RowLimit int32     `db:"row_limit"`
+}
+
+func (q *Queries) ListDueOutboxEventIDs(ctx context.Context, arg ListDueOutboxEventIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listDueOutboxEventIDs, arg.Now, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateOutboxEvent = `-- name: UpdateOutboxEvent :execrows
+UPDATE finance.outbox_events
+SET
+    status = $1,
+    attempts = $2,
+    last_error = $3,
+    next_attempt_at = $4,
+    dispatched_at = $5
+WHERE id = $6
+`
+
+type UpdateOutboxEventParams struct {
+	Status        string           `db:"status"`
+	Attempts      int32            `db:"attempts"`
+	LastError     *string          `db:"last_error"`
+	NextAttemptAt time.Time        `db:"next_attempt_at"`
+	DispatchedAt  pgtype.Timestamp `db:"dispatched_at"`
+	ID            uuid.UUID        `db:"id"`
+}
+
+func (q *Queries) UpdateOutboxEvent(ctx context.Context, arg UpdateOutboxEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateOutboxEvent,
+		arg.Status,
+		arg.Attempts,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.DispatchedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
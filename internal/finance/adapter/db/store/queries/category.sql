@@ -0,0 +1,83 @@
+-- name: CreateCategory :exec
+INSERT INTO finance.categories (
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+) VALUES (
+    $1, -- id
+    $2, -- user_id
+    $3, -- parent_id
+    $4, -- name
+    $5, -- kind
+    $6  -- version
+);
+
+-- name: CountCategoriesByUserID :one
+SELECT COUNT(*)
+FROM finance.categories
+WHERE user_id = $1;
+
+-- name: GetCategoryByID :one
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+    AND id = $2;
+
+-- name: GetCategoryByIDForUpdate :one
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+    AND id = $2
+FOR UPDATE;
+
+-- name: GetCategoriesByIDs :many
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = sqlc.arg(user_id)
+    AND id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: UpdateCategory :execrows
+UPDATE finance.categories
+SET
+    name = sqlc.arg(name),
+    version = version + 1
+WHERE id = sqlc.arg(id)
+    AND version = sqlc.arg(version);
+
+-- name: DeleteCategory :execrows
+DELETE FROM finance.categories
+WHERE user_id = $1
+    AND id = $2;
+
+-- name: ListCategoriesByUserID :many
+SELECT
+    id,
+    user_id,
+    parent_id,
+    name,
+    kind,
+    version
+FROM finance.categories
+WHERE user_id = $1
+ORDER BY kind DESC, parent_id NULLS FIRST, name;
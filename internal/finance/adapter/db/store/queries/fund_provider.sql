@@ -71,3 +71,27 @@ FROM (
 ) as v
 WHERE fp.id = v.id
   AND fp.version = v.version;
+
+-- name: ListFundProviders :many
+SELECT
+    id,
+    name,
+    fp_type,
+    balance,
+    unallocated_amount,
+    currency,
+    version
+FROM finance.fund_providers
+ORDER BY id;
+
+-- name: ListFundProviderAllocations :many
+SELECT
+    fpa.fp_id,
+    fpa.wallet_id,
+    fpa.allocated_amount,
+    w.name AS wallet_name
+FROM finance.fund_provider_allocations fpa
+INNER JOIN finance.wallets w
+    ON w.id = fpa.wallet_id
+WHERE fpa.fp_id = $1
+ORDER BY w.name;
@@ -0,0 +1,90 @@
+-- name: CreateImportProfile :exec
+INSERT INTO finance.import_profiles (
+    id,
+    user_id,
+    name,
+    delimiter,
+    skip_rows,
+    date_column,
+    date_format,
+    amount_sign,
+    amount_column,
+    debit_column,
+    credit_column,
+    description_column,
+    reference_column,
+    thousands_separator,
+    version
+) VALUES (
+    $1,  -- id
+    $2,  -- user_id
+    $3,  -- name
+    $4,  -- delimiter
+    $5,  -- skip_rows
+    $6,  -- date_column
+    $7,  -- date_format
+    $8,  -- amount_sign
+    $9,  -- amount_column
+    $10, -- debit_column
+    $11, -- credit_column
+    $12, -- description_column
+    $13, -- reference_column
+    $14, -- thousands_separator
+    $15  -- version
+);
+
+-- name: GetImportProfileByID :one
+SELECT
+    id,
+    user_id,
+    name,
+    delimiter,
+    skip_rows,
+    date_column,
+    date_format,
+    amount_sign,
+    amount_column,
+    debit_column,
+    credit_column,
+    description_column,
+    reference_column,
+    thousands_separator,
+    version
+FROM finance.import_profiles
+WHERE user_id = $1
+    AND id = $2;
+
+-- name: ListImportProfilesByUserID :many
+SELECT
+    id,
+    user_id,
+    name,
+    delimiter,
+    skip_rows,
+    date_column,
+    date_format,
+    amount_sign,
+    amount_column,
+    debit_column,
+    credit_column,
+    description_column,
+    reference_column,
+    thousands_separator,
+    version
+FROM finance.import_profiles
+WHERE user_id = $1
+ORDER BY lower(name);
+
+-- name: DeleteImportProfile :execrows
+DELETE FROM finance.import_profiles
+WHERE user_id = $1
+    AND id = $2;
+
+-- name: ListRecordedTransactionNos :many
+SELECT DISTINCT transaction_no::text
+FROM finance.transaction_records
+WHERE fp_id = sqlc.arg(fp_id)
+    AND transaction_no = ANY(sqlc.arg(transaction_nos)::text[])
+    AND transaction_no <> ''
+    AND linked_id IS NULL
+    AND reversed_by_id IS NULL;
@@ -11,7 +11,10 @@ INSERT INTO finance.accounting_periods (
     wallet_closing_balance,
     version,
     wallet_id,
-    status
+    status,
+    start_time,
+    total_income,
+    total_expense
 ) VALUES (
     $1, -- id
     $2, -- year_month
@@ -24,7 +27,10 @@ INSERT INTO finance.accounting_periods (
     $9, -- wallet_closing_balance
     $10, -- version
     $11, -- wallet_id
-    $12 -- status
+    $12, -- status
+    $13, -- start_time
+    $14, -- total_income
+    $15 -- total_expense
 );
 
 -- name: UpdateAccountingPeriod :execrows
@@ -32,6 +38,8 @@ UPDATE finance.accounting_periods ap
 SET
     total_debit = sqlc.arg(total_debit),
     total_credit = sqlc.arg(total_credit),
+    total_income = sqlc.arg(total_income),
+    total_expense = sqlc.arg(total_expense),
     wallet_closing_balance = sqlc.arg(closing_balance),
     status = sqlc.arg(status),
     version = version + 1
@@ -44,10 +52,13 @@ SELECT
     year_month,
     start_date,
     interval,
+    start_time,
     end_time,
     wallet_opening_balance,
     total_debit,
     total_credit,
+    total_income,
+    total_expense,
     wallet_closing_balance,
     status,
     version
@@ -63,14 +74,19 @@ SELECT
     w.balance        AS wallet_balance,
     w.currency       AS wallet_currency,
     w.version        AS wallet_version,
+    w.period_start_day AS wallet_period_start_day,
+    w.period_interval  AS wallet_period_interval,
     ap.id            AS period_id,
     ap.year_month    AS period_year_month,
     ap.start_date    AS period_start_date,
     ap.interval      AS period_interval,
+    ap.start_time    AS period_start_time,
     ap.end_time      AS period_end_time,
     ap.wallet_opening_balance,
     ap.total_debit,
     ap.total_credit,
+    ap.total_income,
+    ap.total_expense,
     ap.wallet_closing_balance,
     ap.status        AS period_status,
     ap.version       AS period_version
@@ -90,7 +106,14 @@ INSERT INTO finance.transaction_records (
     wallet_id,
     fp_id,
     fp_balance,
-    accounting_periods_id
+    accounting_periods_id,
+    description,
+    occurred_at,
+    recorded_at,
+    linked_id,
+    reversal_of_id,
+    direction,
+    category_id
 ) VALUES (
     $1,
     $2,
@@ -100,5 +123,336 @@ INSERT INTO finance.transaction_records (
     $6,
     $7,
     $8,
-    $9
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15,
+    $16
 );
+
+-- name: GetTransactionRecordForUpdate :one
+SELECT
+    id,
+    transaction_no,
+    transaction_type,
+    direction,
+    amount,
+    wallet_balance,
+    fp_id,
+    fp_balance,
+    accounting_periods_id,
+    description,
+    occurred_at,
+    recorded_at,
+    linked_id,
+    reversal_of_id,
+    reversed_by_id,
+    reversed_at,
+    category_id,
+    EXISTS (
+        SELECT 1
+        FROM finance.reconciliation_records rr
+        JOIN finance.reconciliations r ON r.id = rr.reconciliation_id
+        WHERE rr.transaction_record_id = tr.id
+            AND r.status = 'FINALISED'
+    ) AS is_reconciled
+FROM finance.transaction_records tr
+WHERE tr.wallet_id = $1
+    AND tr.id = $2
+FOR UPDATE OF tr;
+
+-- name: MarkTransactionRecordReversed :execrows
+UPDATE finance.transaction_records
+SET
+    reversed_by_id = sqlc.arg(reversed_by_id),
+    reversed_at = sqlc.arg(reversed_at)
+WHERE id = sqlc.arg(id)
+    AND reversed_by_id IS NULL;
+
+-- name: GetAccountingPeriodClosingReport :one
+SELECT
+    ap.id,
+    ap.year_month,
+    ap.status,
+    ap.end_time,
+    ap.wallet_opening_balance,
+    ap.total_debit,
+    ap.total_credit,
+    ap.total_income,
+    ap.total_expense,
+    ap.wallet_closing_balance,
+    w.currency,
+    COUNT(tr.id) AS transaction_count
+FROM finance.accounting_periods ap
+INNER JOIN finance.wallets w
+    ON w.id = ap.wallet_id
+LEFT JOIN finance.transaction_records tr
+    ON tr.accounting_periods_id = ap.id
+WHERE ap.wallet_id = $1
+    AND ap.year_month = $2
+GROUP BY ap.id, w.currency;
+
+-- name: ListAccountingPeriodsByWalletID :many
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+ORDER BY end_time DESC;
+
+-- name: GetAccountingPeriodByID :one
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+    AND id = $2;
+
+-- name: GetAccountingPeriodCoveringByWalletID :one
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = sqlc.arg(wallet_id)
+    AND start_time <= sqlc.arg(occurred_at)
+    AND end_time > sqlc.arg(occurred_at);
+
+-- name: ListOpenAccountingPeriodsByWalletID :many
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+    AND status = 'OPEN'
+ORDER BY end_time DESC;
+
+-- name: GetLatestAccountingPeriodByWalletID :one
+SELECT
+    id,
+    year_month,
+    start_date,
+    interval,
+    start_time,
+    end_time,
+    wallet_opening_balance,
+    total_debit,
+    total_credit,
+    total_income,
+    total_expense,
+    wallet_closing_balance,
+    status,
+    version
+FROM finance.accounting_periods
+WHERE wallet_id = $1
+ORDER BY end_time DESC
+LIMIT 1;
+
+-- name: ListWalletIDsWithEndedAccountingPeriod :many
+SELECT
+    ap.wallet_id,
+    w.user_id
+FROM finance.accounting_periods ap
+    JOIN finance.wallets w ON w.id = ap.wallet_id
+WHERE ap.end_time <= sqlc.arg(ended_before)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.accounting_periods later
+        WHERE later.wallet_id = ap.wallet_id
+            AND later.end_time > ap.end_time
+    )
+ORDER BY ap.wallet_id;
+
+-- name: ListTransactionRecords :many
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.wallet_balance,
+    tr.fp_id,
+    tr.fp_balance,
+    tr.description,
+    tr.occurred_at,
+    tr.recorded_at,
+    tr.linked_id,
+    tr.reversal_of_id,
+    tr.reversed_by_id,
+    tr.reversed_at,
+    tr.category_id,
+    c.name           AS category_name,
+    fp.name          AS fp_name,
+    ap.year_month
+FROM finance.transaction_records tr
+INNER JOIN finance.accounting_periods ap
+    ON ap.id = tr.accounting_periods_id
+INNER JOIN finance.fund_providers fp
+    ON fp.id = tr.fp_id
+LEFT JOIN finance.categories c
+    ON c.id = tr.category_id
+WHERE tr.wallet_id = sqlc.arg(wallet_id)
+    AND (sqlc.narg(cursor)::uuid IS NULL OR tr.id < sqlc.narg(cursor)::uuid)
+    AND (sqlc.narg(from_period)::int IS NULL
+        OR split_part(ap.year_month, ',', 1)::int * 12 + split_part(ap.year_month, ',', 2)::int >= sqlc.narg(from_period)::int)
+    AND (sqlc.narg(to_period)::int IS NULL
+        OR split_part(ap.year_month, ',', 1)::int * 12 + split_part(ap.year_month, ',', 2)::int <= sqlc.narg(to_period)::int)
+    AND (sqlc.narg(fp_id)::uuid IS NULL OR tr.fp_id = sqlc.narg(fp_id)::uuid)
+    AND (sqlc.narg(transaction_type)::text IS NULL OR tr.transaction_type = sqlc.narg(transaction_type)::text)
+    AND (sqlc.narg(min_amount)::bigint IS NULL OR tr.amount >= sqlc.narg(min_amount)::bigint)
+    AND (sqlc.narg(max_amount)::bigint IS NULL OR tr.amount <= sqlc.narg(max_amount)::bigint)
+    AND (sqlc.narg(transaction_no)::text IS NULL OR tr.transaction_no = sqlc.narg(transaction_no)::text)
+    AND (sqlc.narg(category_id)::uuid IS NULL
+        OR tr.category_id = sqlc.narg(category_id)::uuid
+        OR c.parent_id = sqlc.narg(category_id)::uuid)
+ORDER BY tr.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListDuplicateCandidates :many
+-- Looks up the candidates of every transaction at once, the arrays hold one lookup per position
+-- and lookup_index is the 1-based position of the lookup
+SELECT
+    l.lookup_index::int AS lookup_index,
+    d.id,
+    d.wallet_id,
+    d.wallet_name,
+    d.transaction_no,
+    d.transaction_type,
+    d.direction,
+    d.amount,
+    d.description,
+    d.occurred_at,
+    d.year_month
+FROM unnest(sqlc.arg(fp_ids)::uuid[]) WITH ORDINALITY AS l(fp_id, lookup_index)
+CROSS JOIN LATERAL (
+    SELECT
+        tr.id,
+        tr.wallet_id,
+        w.name AS wallet_name,
+        tr.transaction_no,
+        tr.transaction_type,
+        tr.direction,
+        tr.amount,
+        tr.description,
+        tr.occurred_at,
+        ap.year_month
+    FROM finance.transaction_records tr
+    INNER JOIN finance.wallets w
+        ON w.id = tr.wallet_id
+    INNER JOIN finance.accounting_periods ap
+        ON ap.id = tr.accounting_periods_id
+    WHERE tr.fp_id = l.fp_id
+        AND tr.amount = (sqlc.arg(amounts)::bigint[])[l.lookup_index]
+        AND tr.occurred_at >= (sqlc.arg(occurred_froms)::timestamp[])[l.lookup_index]
+        AND tr.occurred_at <= (sqlc.arg(occurred_tos)::timestamp[])[l.lookup_index]
+        AND tr.reversal_of_id IS NULL
+        AND tr.reversed_by_id IS NULL
+    ORDER BY tr.occurred_at, tr.id
+    LIMIT sqlc.arg(max_candidates)
+) d
+ORDER BY l.lookup_index, d.occurred_at, d.id;
+
+-- name: ListCategoryBudgetsByAccountingPeriodID :many
+SELECT
+    category_id,
+    amount
+FROM finance.category_budgets
+WHERE accounting_period_id = $1
+ORDER BY category_id;
+
+-- Claims an open period before its budgets are replaced, so concurrent plans or a closing in between are detected
+-- name: BumpOpenAccountingPeriodVersion :execrows
+UPDATE finance.accounting_periods
+SET version = version + 1
+WHERE id = sqlc.arg(id)
+    AND version = sqlc.arg(version)
+    AND status = 'OPEN';
+
+-- name: DeleteCategoryBudgetsByAccountingPeriodID :exec
+DELETE FROM finance.category_budgets
+WHERE accounting_period_id = $1;
+
+-- name: BulkInsertCategoryBudgets :copyfrom
+INSERT INTO finance.category_budgets (
+    accounting_period_id,
+    category_id,
+    amount
+) VALUES (
+    $1,
+    $2,
+    $3
+);
+
+-- name: ListCategoryBudgetsWithName :many
+SELECT
+    cb.category_id,
+    c.parent_id,
+    c.name,
+    cb.amount
+FROM finance.category_budgets cb
+INNER JOIN finance.categories c
+    ON c.id = cb.category_id
+WHERE cb.accounting_period_id = $1
+ORDER BY c.name;
+
+-- Spending per category of a period, outflows count and inflows such as refunds or reversals are deducted
+-- name: ListCategorySpendingByAccountingPeriodID :many
+SELECT
+    c.id AS category_id,
+    c.parent_id,
+    SUM(CASE WHEN tr.direction = 'OUT' THEN tr.amount ELSE -tr.amount END)::bigint AS spent
+FROM finance.transaction_records tr
+INNER JOIN finance.categories c
+    ON c.id = tr.category_id
+WHERE tr.accounting_periods_id = $1
+GROUP BY c.id, c.parent_id;
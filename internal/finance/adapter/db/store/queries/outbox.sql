@@ -0,0 +1,58 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO finance.outbox_events (
+    id,
+    aggregate_type,
+    aggregate_id,
+    user_id,
+    event_name,
+    payload,
+    occurred_at,
+    status,
+    attempts,
+    next_attempt_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+);
+
+-- name: GetOutboxEventForUpdate :one
+SELECT
+    id,
+    user_id,
+    event_name,
+    payload,
+    occurred_at,
+    status,
+    attempts,
+    last_error,
+    next_attempt_at,
+    dispatched_at
+FROM finance.outbox_events
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateOutboxEvent :execrows
+UPDATE finance.outbox_events
+SET
+    status = sqlc.arg(status),
+    attempts = sqlc.arg(attempts),
+    last_error = sqlc.narg(last_error),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    dispatched_at = sqlc.narg(dispatched_at)
+WHERE id = sqlc.arg(id);
+
+-- name: ListDueOutboxEventIDs :many
+SELECT id
+FROM finance.outbox_events
+WHERE status = 'PENDING'
+    AND next_attempt_at <= sqlc.arg(now)
+ORDER BY next_attempt_at, id
+LIMIT sqlc.arg(row_limit);
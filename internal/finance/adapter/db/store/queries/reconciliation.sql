@@ -0,0 +1,179 @@
+-- name: CreateReconciliation :exec
+INSERT INTO finance.reconciliations (
+    id,
+    fp_id,
+    statement_date,
+    statement_balance,
+    status,
+    created_at,
+    finalised_at,
+    version
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+);
+
+-- name: GetReconciliationForUpdate :one
+SELECT
+    id,
+    fp_id,
+    statement_date,
+    statement_balance,
+    status,
+    created_at,
+    finalised_at,
+    version
+FROM finance.reconciliations
+WHERE id = sqlc.arg(id)
+    AND fp_id = sqlc.arg(fp_id)
+FOR UPDATE;
+
+-- The share lock keeps the balance in line with the records listed in the same transaction
+-- name: GetFundProviderBalanceForShare :one
+SELECT balance
+FROM finance.fund_providers
+WHERE id = $1
+FOR SHARE;
+
+-- name: ListUnreconciledTransactionRecordsByFpID :many
+SELECT
+    tr.id,
+    tr.wallet_id,
+    tr.direction,
+    tr.amount,
+    tr.occurred_at
+FROM finance.transaction_records tr
+WHERE tr.fp_id = $1
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.reconciliation_records rr
+        INNER JOIN finance.reconciliations r
+            ON r.id = rr.reconciliation_id
+        WHERE rr.transaction_record_id = tr.id
+            AND r.status = 'FINALISED'
+    )
+ORDER BY tr.occurred_at, tr.id;
+
+-- name: ListReconciliationRecordsByReconciliationID :many
+SELECT
+    transaction_record_id,
+    is_adjustment
+FROM finance.reconciliation_records
+WHERE reconciliation_id = $1
+ORDER BY transaction_record_id;
+
+-- name: UpdateReconciliationStatus :execrows
+UPDATE finance.reconciliations
+SET
+    status = sqlc.arg(status),
+    finalised_at = sqlc.arg(finalised_at),
+    version = version + 1
+WHERE id = sqlc.arg(id)
+    AND version = sqlc.arg(version);
+
+-- name: DeleteReconciliationRecordsByReconciliationID :exec
+DELETE FROM finance.reconciliation_records
+WHERE reconciliation_id = $1;
+
+-- name: BulkInsertReconciliationRecords :copyfrom
+INSERT INTO finance.reconciliation_records (
+    transaction_record_id,
+    reconciliation_id,
+    is_adjustment
+) VALUES (
+    $1,
+    $2,
+    $3
+);
+
+-- name: ListReconciliationsByFpID :many
+SELECT
+    r.id,
+    r.statement_date,
+    r.statement_balance,
+    r.status,
+    r.created_at,
+    r.finalised_at,
+    r.version,
+    (
+        SELECT count(*)
+        FROM finance.reconciliation_records rr
+        WHERE rr.reconciliation_id = r.id
+    ) AS cleared_count
+FROM finance.reconciliations r
+WHERE r.fp_id = $1
+ORDER BY r.statement_date DESC, r.id DESC;
+
+-- name: GetReconciliation :one
+SELECT
+    r.id,
+    r.fp_id,
+    r.statement_date,
+    r.statement_balance,
+    r.status,
+    r.created_at,
+    r.finalised_at,
+    r.version,
+    fp.balance AS fp_balance,
+    fp.currency AS fp_currency
+FROM finance.reconciliations r
+INNER JOIN finance.fund_providers fp
+    ON fp.id = r.fp_id
+WHERE r.id = sqlc.arg(id)
+    AND r.fp_id = sqlc.arg(fp_id);
+
+-- name: ListReconciliationCandidateRecords :many
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.description,
+    tr.occurred_at,
+    tr.wallet_id,
+    w.name AS wallet_name,
+    (rr.transaction_record_id IS NOT NULL)::boolean AS cleared,
+    COALESCE(rr.is_adjustment, false)::boolean AS is_adjustment
+FROM finance.transaction_records tr
+INNER JOIN finance.wallets w
+    ON w.id = tr.wallet_id
+LEFT JOIN finance.reconciliation_records rr
+    ON rr.transaction_record_id = tr.id
+    AND rr.reconciliation_id = sqlc.arg(reconciliation_id)
+WHERE tr.fp_id = sqlc.arg(fp_id)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.reconciliation_records locked
+        INNER JOIN finance.reconciliations r
+            ON r.id = locked.reconciliation_id
+        WHERE locked.transaction_record_id = tr.id
+            AND r.status = 'FINALISED'
+    )
+ORDER BY tr.occurred_at, tr.id;
+
+-- name: ListReconciledRecords :many
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.description,
+    tr.occurred_at,
+    tr.wallet_id,
+    w.name AS wallet_name,
+    rr.is_adjustment
+FROM finance.reconciliation_records rr
+INNER JOIN finance.transaction_records tr
+    ON tr.id = rr.transaction_record_id
+INNER JOIN finance.wallets w
+    ON w.id = tr.wallet_id
+WHERE rr.reconciliation_id = $1
+ORDER BY tr.occurred_at, tr.id;
@@ -0,0 +1,137 @@
+-- name: CreateRecurringTemplate :exec
+INSERT INTO finance.recurring_templates (
+    id,
+    user_id,
+    wallet_id,
+    fp_id,
+    amount,
+    transaction_type,
+    category_id,
+    description,
+    frequency,
+    interval,
+    day_of_month,
+    start_date,
+    status,
+    paused_at,
+    version
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15
+);
+
+-- name: GetRecurringTemplateForUpdate :one
+SELECT
+    id,
+    user_id,
+    wallet_id,
+    fp_id,
+    amount,
+    transaction_type,
+    category_id,
+    description,
+    frequency,
+    interval,
+    day_of_month,
+    start_date,
+    status,
+    paused_at,
+    version
+FROM finance.recurring_templates
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateRecurringTemplateStatus :execrows
+UPDATE finance.recurring_templates
+SET
+    status = sqlc.arg(status),
+    paused_at = sqlc.arg(paused_at),
+    version = version + 1
+WHERE id = sqlc.arg(id)
+    AND version = sqlc.arg(version);
+
+-- name: ListRecurringOccurrencesByTemplateID :many
+SELECT
+    occurrence_date,
+    status,
+    transaction_record_id
+FROM finance.recurring_occurrences
+WHERE template_id = $1
+ORDER BY occurrence_date;
+
+-- name: BulkInsertRecurringOccurrences :copyfrom
+INSERT INTO finance.recurring_occurrences (
+    template_id,
+    occurrence_date,
+    status,
+    transaction_record_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+);
+
+-- name: ListActiveRecurringTemplateIDs :many
+SELECT
+    id,
+    user_id
+FROM finance.recurring_templates
+WHERE status = 'ACTIVE'
+    AND start_date <= sqlc.arg(started_before)
+ORDER BY id;
+
+-- name: ListActiveRecurringTemplateIDsByWalletID :many
+SELECT id
+FROM finance.recurring_templates
+WHERE wallet_id = sqlc.arg(wallet_id)
+    AND status = 'ACTIVE'
+    AND start_date <= sqlc.arg(started_before)
+ORDER BY id;
+
+-- name: ListRecurringTemplatesByWalletID :many
+SELECT
+    rt.id,
+    rt.fp_id,
+    rt.amount,
+    rt.transaction_type,
+    rt.category_id,
+    c.name AS category_name,
+    rt.description,
+    rt.frequency,
+    rt.interval,
+    rt.day_of_month,
+    rt.start_date,
+    rt.status,
+    rt.paused_at,
+    rt.version
+FROM finance.recurring_templates rt
+LEFT JOIN finance.categories c
+    ON c.id = rt.category_id
+WHERE rt.wallet_id = $1
+ORDER BY rt.id;
+
+-- name: ListRecurringOccurrencesByWalletID :many
+SELECT
+    ro.template_id,
+    ro.occurrence_date,
+    ro.status
+FROM finance.recurring_occurrences ro
+INNER JOIN finance.recurring_templates rt
+    ON rt.id = ro.template_id
+WHERE rt.wallet_id = sqlc.arg(wallet_id)
+    AND ro.occurrence_date >= sqlc.arg(occurred_from)
+ORDER BY ro.template_id, ro.occurrence_date;
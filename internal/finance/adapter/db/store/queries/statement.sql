@@ -0,0 +1,51 @@
+-- name: CreateStatementArchive :execrows
+INSERT INTO finance.statement_archives (
+    id,
+    wallet_id,
+    accounting_period_id,
+    year_month,
+    file_name,
+    content,
+    sha256,
+    archived_at
+)
+SELECT
+    sqlc.arg(id),
+    ap.wallet_id,
+    ap.id,
+    ap.year_month,
+    sqlc.arg(file_name),
+    sqlc.arg(content),
+    sqlc.arg(sha256),
+    sqlc.arg(archived_at)
+FROM finance.accounting_periods ap
+WHERE ap.wallet_id = sqlc.arg(wallet_id)
+    AND ap.id = sqlc.arg(accounting_period_id)
+    AND ap.status = 'CLOSE';
+
+-- name: GetStatementArchiveByWalletIDAndYearMonth :one
+SELECT
+    id,
+    wallet_id,
+    accounting_period_id,
+    year_month,
+    file_name,
+    content,
+    sha256,
+    archived_at
+FROM finance.statement_archives
+WHERE wallet_id = $1
+    AND year_month = $2;
+
+-- name: ListClosedAccountingPeriodsWithoutStatementArchive :many
+SELECT
+    ap.wallet_id,
+    ap.year_month
+FROM finance.accounting_periods ap
+WHERE ap.status = 'CLOSE'
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.statement_archives sa
+        WHERE sa.accounting_period_id = ap.id
+    )
+ORDER BY ap.end_time, ap.wallet_id;
@@ -4,13 +4,19 @@ INSERT INTO finance.wallets (
     name,
     balance,
     currency,
-    version
+    version,
+    period_start_day,
+    period_interval,
+    user_id
 ) VALUES (
     $1, -- id
     $2, -- name
     $3, -- balance
     $4, -- currency
-    $5 -- version
+    $5, -- version
+    $6, -- period_start_day
+    $7, -- period_interval
+    $8 -- user_id
 );
 
 -- name: GetWalletByID :one
@@ -19,7 +25,10 @@ SELECT
     name,
     balance,
     currency,
-    version
+    version,
+    period_start_day,
+    period_interval,
+    user_id
 FROM finance.wallets
 WHERE id = $1;
 
@@ -31,6 +40,15 @@ SET
 WHERE id = sqlc.arg(id)
     AND version = sqlc.arg(version);
 
+-- name: UpdateWalletLedgerConfig :execrows
+UPDATE finance.wallets
+SET
+    period_start_day = sqlc.arg(period_start_day),
+    period_interval = sqlc.arg(period_interval),
+    version = version + 1
+WHERE id = sqlc.arg(id)
+    AND version = sqlc.arg(version);
+
 -- name: BulkInsertFundAllocations :copyfrom
 INSERT INTO finance.fund_provider_allocations (
     fp_id,
@@ -49,3 +67,48 @@ FROM (
 ) AS data
 WHERE finance.fund_provider_allocations.fp_id = data.fp_id
     AND finance.fund_provider_allocations.wallet_id = data.wallet_id;
+
+-- name: BatchUpsertFundAllocations :exec
+INSERT INTO finance.fund_provider_allocations (
+    fp_id,
+    wallet_id,
+    allocated_amount
+)
+SELECT
+    unnest(sqlc.arg(fp_ids)::uuid[]),
+    unnest(sqlc.arg(wallet_ids)::uuid[]),
+    unnest(sqlc.arg(allocated_amounts)::bigint[])
+ON CONFLICT (fp_id, wallet_id)
+DO UPDATE SET allocated_amount = EXCLUDED.allocated_amount;
+
+-- name: DeleteFundAllocations :execrows
+DELETE FROM finance.fund_provider_allocations
+WHERE wallet_id = sqlc.arg(wallet_id)
+    AND fp_id = ANY(sqlc.arg(fp_ids)::uuid[]);
+
+-- name: ListWallets :many
+SELECT
+    id,
+    name,
+    balance,
+    currency,
+    version,
+    period_start_day,
+    period_interval,
+    user_id
+FROM finance.wallets
+ORDER BY id;
+
+-- name: ListWalletAllocations :many
+SELECT
+    fpa.wallet_id,
+    fpa.fp_id,
+    fpa.allocated_amount,
+    fp.name    AS fp_name,
+    fp.fp_type AS fp_type,
+    fp.currency
+FROM finance.fund_provider_allocations fpa
+INNER JOIN finance.fund_providers fp
+    ON fp.id = fpa.fp_id
+WHERE fpa.wallet_id = ANY(sqlc.arg(wallet_ids)::uuid[])
+ORDER BY fpa.wallet_id, fp.name;
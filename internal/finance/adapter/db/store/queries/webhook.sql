@@ -0,0 +1,175 @@
+-- name: CreateWebhookSubscription :exec
+INSERT INTO finance.webhook_subscriptions (
+    id,
+    user_id,
+    url,
+    secret,
+    event_types,
+    created_at,
+    version
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+);
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT
+    id,
+    user_id,
+    url,
+    secret,
+    event_types,
+    created_at,
+    version
+FROM finance.webhook_subscriptions
+WHERE user_id = $1
+    AND id = $2;
+
+-- name: ListWebhookSubscriptions :many
+SELECT
+    id,
+    user_id,
+    url,
+    secret,
+    event_types,
+    created_at,
+    version
+FROM finance.webhook_subscriptions
+WHERE user_id = $1
+ORDER BY created_at, id;
+
+-- name: ListWebhookSubscriptionsByUserID :many
+SELECT
+    id,
+    url,
+    event_types,
+    created_at,
+    version
+FROM finance.webhook_subscriptions
+WHERE user_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM finance.webhook_subscriptions
+WHERE user_id = $1
+    AND id = $2;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO finance.webhook_deliveries (
+    id,
+    subscription_id,
+    event_id,
+    event_name,
+    payload,
+    status,
+    attempts,
+    next_attempt_at,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: GetWebhookDeliveryForUpdate :one
+SELECT
+    d.id,
+    d.subscription_id,
+    d.event_id,
+    d.event_name,
+    d.payload,
+    d.status,
+    d.attempts,
+    d.next_attempt_at,
+    d.last_status_code,
+    d.last_error,
+    d.created_at,
+    d.delivered_at,
+    s.user_id,
+    s.url,
+    s.secret,
+    s.event_types,
+    s.created_at AS subscription_created_at,
+    s.version AS subscription_version
+FROM finance.webhook_deliveries d
+JOIN finance.webhook_subscriptions s ON s.id = d.subscription_id
+WHERE d.id = $1
+FOR UPDATE OF d;
+
+-- name: UpdateWebhookDelivery :execrows
+UPDATE finance.webhook_deliveries
+SET
+    status = sqlc.arg(status),
+    attempts = sqlc.arg(attempts),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    last_status_code = sqlc.narg(last_status_code),
+    last_error = sqlc.narg(last_error),
+    delivered_at = sqlc.narg(delivered_at)
+WHERE id = sqlc.arg(id);
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO finance.webhook_delivery_attempts (
+    delivery_id,
+    attempt,
+    attempted_at,
+    status_code,
+    error,
+    duration_ms
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+);
+
+-- name: ListDueWebhookDeliveryIDs :many
+SELECT id
+FROM finance.webhook_deliveries
+WHERE status = 'PENDING'
+    AND next_attempt_at <= sqlc.arg(now)
+ORDER BY next_attempt_at, id
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListWebhookDeliveriesBySubscriptionID :many
+SELECT
+    id,
+    event_id,
+    event_name,
+    status,
+    attempts,
+    next_attempt_at,
+    last_status_code,
+    last_error,
+    created_at,
+    delivered_at
+FROM finance.webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id)
+    AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT
+    delivery_id,
+    attempt,
+    attempted_at,
+    status_code,
+    error,
+    duration_ms
+FROM finance.webhook_delivery_attempts
+WHERE delivery_id = ANY(sqlc.arg(delivery_ids)::uuid[])
+ORDER BY delivery_id, attempt;
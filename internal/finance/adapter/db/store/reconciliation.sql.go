@@ -0,0 +1,508 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: reconciliation.sql
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BulkInsertReconciliationRecordsParams struct {
+	TransactionRecordID uuid.UUID `db:"transaction_record_id"`
+	ReconciliationID    uuid.UUID `db:"reconciliation_id"`
+	IsAdjustment        bool      `db:"is_adjustment"`
+}
+
+const createReconciliation = `-- name: CreateReconciliation :exec
+INSERT INTO finance.reconciliations (
+    id,
+    fp_id,
+    statement_date,
+    statement_balance,
+    status,
+    created_at,
+    finalised_at,
+    version
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+`
+
+type CreateReconciliationParams struct {
+	ID               uuid.UUID        `db:"id"`
+	FpID             uuid.UUID        `db:"fp_id"`
+	StatementDate    time.Time        `db:"statement_date"`
+	StatementBalance int64            `db:"statement_balance"`
+	Status           string           `db:"status"`
+	CreatedAt        time.Time        `db:"created_at"`
+	FinalisedAt      pgtype.Timestamp `db:"finalised_at"`
+	Version          int32            `db:"version"`
+}
+
+func (q *Queries) CreateReconciliation(ctx context.Context, arg CreateReconciliationParams) error {
+	_, err := q.db.Exec(ctx, createReconciliation,
+		arg.ID,
+		arg.FpID,
+		arg.StatementDate,
+		arg.StatementBalance,
+		arg.Status,
+		arg.CreatedAt,
+		arg.FinalisedAt,
+		arg.Version,
+	)
+	return err
+}
+
+const deleteReconciliationRecordsByReconciliationID = `-- name: DeleteReconciliationRecordsByReconciliationID :exec
+DELETE FROM finance.reconciliation_records
+WHERE reconciliation_id = $1
+`
+
+func (q *Queries) DeleteReconciliationRecordsByReconciliationID(ctx context.Context, reconciliationID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteReconciliationRecordsByReconciliationID, reconciliationID)
+	return err
+}
+
+const getFundProviderBalanceForShare = `-- name: GetFundProviderBalanceForShare :one
+SELECT balance
+FROM finance.fund_providers
+WHERE id = $1
+FOR SHARE
+`
+
+// The share lock keeps the balance in line with the records listed in the same transaction
+func (q *Queries) GetFundProviderBalanceForShare(ctx context.Context, id uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getFundProviderBalanceForShare, id)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const getReconciliation = `-- name: GetReconciliation :one
+SELECT
+    r.id,
+    r.fp_id,
+    r.statement_date,
+    r.statement_balance,
+    r.status,
+    r.created_at,
+    r.finalised_at,
+    r.version,
+    fp.balance AS fp_balance,
+    fp.currency AS fp_currency
+FROM finance.reconciliations r
+INNER JOIN finance.fund_providers fp
+    ON fp.id = r.fp_id
+WHERE r.id = $1
+    AND r.fp_id = $2
+`
+
+type GetReconciliationParams struct {
+	ID   uuid.UUID `db:"id"`
+	FpID uuid.UUID `db:"fp_id"`
+}
+
+type GetReconciliationRow struct {
+	ID               uuid.UUID        `db:"id"`
+	FpID             uuid.UUID        `db:"fp_id"`
+	StatementDate    time.Time        `db:"statement_date"`
+	StatementBalance int64            `db:"statement_balance"`
+	Status           string           `db:"status"`
+	CreatedAt        time.Time        `db:"created_at"`
+	FinalisedAt      pgtype.Timestamp `db:"finalised_at"`
+	Version          int32            `db:"version"`
+	FpBalance        int64            `db:"fp_balance"`
+	FpCurrency       string           `db:"fp_currency"`
+}
+
+func (q *Queries) GetReconciliation(ctx context.Context, arg GetReconciliationParams) (GetReconciliationRow, error) {
+	row := q.db.QueryRow(ctx, getReconciliation, arg.ID, arg.FpID)
+	var i GetReconciliationRow
+	err := row.Scan(
+		&i.ID,
+		&i.FpID,
+		&i.StatementDate,
+		&i.StatementBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FinalisedAt,
+		&i.Version,
+		&i.FpBalance,
+		&i.FpCurrency,
+	)
+	return i, err
+}
+
+const getReconciliationForUpdate = `-- name: GetReconciliationForUpdate :one
+SELECT
+    id,
+    fp_id,
+    statement_date,
+    statement_balance,
+    status,
+    created_at,
+    finalised_at,
+    version
+FROM finance.reconciliations
+WHERE id = $1
+    AND fp_id = $2
+FOR UPDATE
+`
+
+type GetReconciliationForUpdateParams struct {
+	ID   uuid.UUID `db:"id"`
+	FpID uuid.UUID `db:"fp_id"`
+}
+
+func (q *Queries) GetReconciliationForUpdate(ctx context.Context, arg GetReconciliationForUpdateParams) (FinanceReconciliation, error) {
+	row := q.db.QueryRow(ctx, getReconciliationForUpdate, arg.ID, arg.FpID)
+	var i FinanceReconciliation
+	err := row.Scan(
+		&i.ID,
+		&i.FpID,
+		&i.StatementDate,
+		&i.StatementBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FinalisedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const listReconciledRecords = `-- name: ListReconciledRecords :many
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.description,
+    tr.occurred_at,
+    tr.wallet_id,
+    w.name AS wallet_name,
+    rr.is_adjustment
+FROM finance.reconciliation_records rr
+INNER JOIN finance.transaction_records tr
+    ON tr.id = rr.transaction_record_id
+INNER JOIN finance.wallets w
+    ON w.id = tr.wallet_id
+WHERE rr.reconciliation_id = $1
+ORDER BY tr.occurred_at, tr.id
+`
+
+type ListReconciledRecordsRow struct {
+	ID              uuid.UUID `db:"id"`
+	TransactionNo   *string   `db:"transaction_no"`
+	TransactionType string    `db:"transaction_type"`
+	Direction       string    `db:"direction"`
+	Amount          int64     `db:"amount"`
+	Description     string    `db:"description"`
+	OccurredAt      time.Time `db:"occurred_at"`
+	WalletID        uuid.UUID `db:"wallet_id"`
+	WalletName      string    `db:"wallet_name"`
+	IsAdjustment    bool      `db:"is_adjustment"`
+}
+
+func (q *Queries) ListReconciledRecords(ctx context.Context, reconciliationID uuid.UUID) ([]ListReconciledRecordsRow, error) {
+	rows, err := q.db.Query(ctx, listReconciledRecords, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReconciledRecordsRow
+	for rows.Next() {
+		var i ListReconciledRecordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransactionNo,
+			&i.TransactionType,
+			&i.Direction,
+			&i.Amount,
+			&i.Description,
+			&i.OccurredAt,
+			&i.WalletID,
+			&i.WalletName,
+			&i.IsAdjustment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReconciliationCandidateRecords = `-- name: ListReconciliationCandidateRecords :many
+SELECT
+    tr.id,
+    tr.transaction_no,
+    tr.transaction_type,
+    tr.direction,
+    tr.amount,
+    tr.description,
+    tr.occurred_at,
+    tr.wallet_id,
+    w.name AS wallet_name,
+    (rr.transaction_record_id IS NOT NULL)::boolean AS cleared,
+    COALESCE(rr.is_adjustment, false)::boolean AS is_adjustment
+FROM finance.transaction_records tr
+INNER JOIN finance.wallets w
+    ON w.id = tr.wallet_id
+LEFT JOIN finance.reconciliation_records rr
+    ON rr.transaction_record_id = tr.id
+    AND rr.reconciliation_id = $1
+WHERE tr.fp_id = $2
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.reconciliation_records locked
+        INNER JOIN finance.reconciliations r
+            ON r.id = locked.reconciliation_id
+        WHERE locked.transaction_record_id = tr.id
+            AND r.status = 'FINALISED'
+    )
+ORDER BY tr.occurred_at, tr.id
+`
+
+type ListReconciliationCandidateRecordsParams struct {
+	ReconciliationID uuid.UUID `db:"reconciliation_id"`
+	FpID             uuid.UUID `db:"fp_id"`
+}
+
+type ListReconciliationCandidateRecordsRow struct {
+	ID              uuid.UUID `db:"id"`
+	TransactionNo   *string   `db:"transaction_no"`
+	TransactionType string    `db:"transaction_type"`
+	Direction       string    `db:"direction"`
+	Amount          int64     `db:"amount"`
+	Description     string    `db:"description"`
+	OccurredAt      time.Time `db:"occurred_at"`
+	WalletID        uuid.UUID `db:"wallet_id"`
+	WalletName      string    `db:"wallet_name"`
+	Cleared         bool      `db:"cleared"`
+	IsAdjustment    bool      `db:"is_adjustment"`
+}
+
+func (q *Queries) ListReconciliationCandidateRecords(ctx context.Context, arg ListReconciliationCandidateRecordsParams) ([]ListReconciliationCandidateRecordsRow, error) {
+	rows, err := q.db.Query(ctx, listReconciliationCandidateRecords, arg.ReconciliationID, arg.FpID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReconciliationCandidateRecordsRow
+	for rows.Next() {
+		var i ListReconciliationCandidateRecordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransactionNo,
+			&i.TransactionType,
+			&i.Direction,
+			&i.Amount,
+			&i.Description,
+			&i.OccurredAt,
+			&i.WalletID,
+			&i.WalletName,
+			&i.Cleared,
+			&i.IsAdjustment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReconciliationRecordsByReconciliationID = `-- name: ListReconciliationRecordsByReconciliationID :many
+SELECT
+    transaction_record_id,
+    is_adjustment
+FROM finance.reconciliation_records
+WHERE reconciliation_id = $1
+ORDER BY transaction_record_id
+`
+
+type ListReconciliationRecordsByReconciliationIDRow struct {
+	TransactionRecordID uuid.UUID `db:"transaction_record_id"`
+	IsAdjustment        bool      `db:"is_adjustment"`
+}
+
+func (q *Queries) ListReconciliationRecordsByReconciliationID(ctx context.Context, reconciliationID uuid.UUID) ([]ListReconciliationRecordsByReconciliationIDRow, error) {
+	rows, err := q.db.Query(ctx, listReconciliationRecordsByReconciliationID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReconciliationRecordsByReconciliationIDRow
+	for rows.Next() {
+		var i ListReconciliationRecordsByReconciliationIDRow
+		if err := rows.Scan(&i.TransactionRecordID, &i.IsAdjustment); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReconciliationsByFpID = `-- name: ListReconciliationsByFpID :many
+SELECT
+    r.id,
+    r.statement_date,
+    r.statement_balance,
+    r.status,
+    r.created_at,
+    r.finalised_at,
+    r.version,
+    (
+        SELECT count(*)
+        FROM finance.reconciliation_records rr
+        WHERE rr.reconciliation_id = r.id
+    ) AS cleared_count
+FROM finance.reconciliations r
+WHERE r.fp_id = $1
+ORDER BY r.statement_date DESC, r.id DESC
+`
+
+type ListReconciliationsByFpIDRow struct {
+	ID               uuid.UUID        `db:"id"`
+	StatementDate    time.Time        `db:"statement_date"`
+	StatementBalance int64            `db:"statement_balance"`
+	Status           string           `db:"status"`
+	CreatedAt        time.Time        `db:"created_at"`
+	FinalisedAt      pgtype.Timestamp `db:"finalised_at"`
+	Version          int32            `db:"version"`
+	ClearedCount     int64            `db:"cleared_count"`
+}
+
+func (q *Queries) ListReconciliationsByFpID(ctx context.Context, fpID uuid.UUID) ([]ListReconciliationsByFpIDRow, error) {
+	rows, err := q.db.Query(ctx, listReconciliationsByFpID, fpID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReconciliationsByFpIDRow
+	for rows.Next() {
+		var i ListReconciliationsByFpIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StatementDate,
+			&i.StatementBalance,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FinalisedAt,
+			&i.Version,
+			&i.ClearedCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnreconciledTransactionRecordsByFpID = `-- name: ListUnreconciledTransactionRecordsByFpID :many
+SELECT
+    tr.id,
+    tr.wallet_id,
+    tr.direction,
+    tr.amount,
+    tr.occurred_at
+FROM finance.transaction_records tr
+WHERE tr.fp_id = $1
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.reconciliation_records rr
+        INNER JOIN finance.reconciliations r
+            ON r.id = rr.reconciliation_id
+        WHERE rr.transaction_record_id = tr.id
+            AND r.status = 'FINALISED'
+    )
+ORDER BY tr.occurred_at, tr.id
+`
+
+type ListUnreconciledTransactionRecordsByFpIDRow struct {
+	ID         uuid.UUID `db:"id"`
+	WalletID   uuid.UUID `db:"wallet_id"`
+	Direction  string    `db:"direction"`
+	Amount     int64     `db:"amount"`
+	OccurredAt time.Time `db:"occurred_at"`
+}
+
+func (q *Queries) ListUnreconciledTransactionRecordsByFpID(ctx context.Context, fpID uuid.UUID) ([]ListUnreconciledTransactionRecordsByFpIDRow, error) {
+	rows, err := q.db.Query(ctx, listUnreconciledTransactionRecordsByFpID, fpID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnreconciledTransactionRecordsByFpIDRow
+	for rows.Next() {
+		var i ListUnreconciledTransactionRecordsByFpIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.Direction,
+			&i.Amount,
+			&i.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateReconciliationStatus = `-- name: UpdateReconciliationStatus :execrows
+UPDATE finance.reconciliations
+SET
+    status = $1,
+    finalised_at = $2,
+    version = version + 1
+WHERE id = $3
+    AND version = $4
+`
+
+type UpdateReconciliationStatusParams struct {
+	Status      string           `db:"status"`
+	FinalisedAt pgtype.Timestamp `db:"finalised_at"`
+	ID          uuid.UUID        `db:"id"`
+	Version     int32            `db:"version"`
+}
+
+func (q *Queries) UpdateReconciliationStatus(ctx context.Context, arg UpdateReconciliationStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateReconciliationStatus,
+		arg.Status,
+		arg.FinalisedAt,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
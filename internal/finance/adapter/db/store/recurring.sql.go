@@ -0,0 +1,395 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: recurring.sql
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BulkInsertRecurringOccurrencesParams struct {
+	TemplateID          uuid.UUID  `db:"template_id"`
+	OccurrenceDate      time.Time  `db:"occurrence_date"`
+	Status              string     `db:"status"`
+	TransactionRecordID *uuid.UUID `db:"transaction_record_id"`
+}
+
+const createRecurringTemplate = `-- name: CreateRecurringTemplate :exec
+INSERT INTO finance.recurring_templates (
+    id,
+    user_id,
+    wallet_id,
+    fp_id,
+    amount,
+    transaction_type,
+    category_id,
+    description,
+    frequency,
+    interval,
+    day_of_month,
+    start_date,
+    status,
+    paused_at,
+    version
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15
+)
+`
+
+type CreateRecurringTemplateParams struct {
+	ID              uuid.UUID        `db:"id"`
+	UserID          string           `db:"user_id"`
+	WalletID        uuid.UUID        `db:"wallet_id"`
+	FpID            uuid.UUID        `db:"fp_id"`
+	Amount          int64            `db:"amount"`
+	TransactionType string           `db:"transaction_type"`
+	CategoryID      *uuid.UUID       `db:"category_id"`
+	Description     string           `db:"description"`
+	Frequency       string           `db:"frequency"`
+	Interval        int32            `db:"interval"`
+	DayOfMonth      int32            `db:"day_of_month"`
+	StartDate       time.Time        `db:"start_date"`
+	Status          string           `db:"status"`
+	PausedAt        pgtype.Timestamp `db:"paused_at"`
+	Version         int32            `db:"version"`
+}
+
+func (q *Queries) CreateRecurringTemplate(ctx context.Context, arg CreateRecurringTemplateParams) error {
+	_, err := q.db.Exec(ctx, createRecurringTemplate,
+		arg.ID,
+		arg.UserID,
+		arg.WalletID,
+		arg.FpID,
+		arg.Amount,
+		arg.TransactionType,
+		arg.CategoryID,
+		arg.Description,
+		arg.Frequency,
+		arg.Interval,
+		arg.DayOfMonth,
+		arg.StartDate,
+		arg.Status,
+		arg.PausedAt,
+		arg.Version,
+	)
+	return err
+}
+
+const getRecurringTemplateForUpdate = `-- name: GetRecurringTemplateForUpdate :one
+SELECT
+    id,
+    user_id,
+    wallet_id,
+    fp_id,
+    amount,
+    transaction_type,
+    category_id,
+    description,
+    frequency,
+    interval,
+    day_of_month,
+    start_date,
+    status,
+    paused_at,
+    version
+FROM finance.recurring_templates
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetRecurringTemplateForUpdate(ctx context.Context, id uuid.UUID) (FinanceRecurringTemplate, error) {
+	row := q.db.QueryRow(ctx, getRecurringTemplateForUpdate, id)
+	var i FinanceRecurringTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.FpID,
+		&i.Amount,
+		&i.TransactionType,
+		&i.CategoryID,
+		&i.Description,
+		&i.Frequency,
+		&i.Interval,
+		&i.DayOfMonth,
+		&i.StartDate,
+		&i.Status,
+		&i.PausedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const listActiveRecurringTemplateIDs = `-- name: ListActiveRecurringTemplateIDs :many
+SELECT
+    id,
+    user_id
+FROM finance.recurring_templates
+WHERE status = 'ACTIVE'
+    AND start_date <= $1
+ORDER BY id
+`
+
+type ListActiveRecurringTemplateIDsRow struct {
+	ID     uuid.UUID `db:"id"`
+	UserID string    `db:"user_id"`
+}
+
+func (q *Queries) ListActiveRecurringTemplateIDs(ctx context.Context, startedBefore time.Time) ([]ListActiveRecurringTemplateIDsRow, error) {
+	rows, err := q.db.Query(ctx, listActiveRecurringTemplateIDs, startedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveRecurringTemplateIDsRow
+	for rows.Next() {
+		var i ListActiveRecurringTemplateIDsRow
+		if err := rows.Scan(&i.ID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveRecurringTemplateIDsByWalletID = `-- name: ListActiveRecurringTemplateIDsByWalletID :many
+SELECT id
+FROM finance.recurring_templates
+WHERE wallet_id = $1
+    AND status = 'ACTIVE'
+    AND start_date <= $2
+ORDER BY id
+`
+
+type ListActiveRecurringTemplateIDsByWalletIDParams struct {
+	WalletID      uuid.UUID `db:"wallet_id"`
+	StartedBefore time.Time `db:"started_before"`
+}
+
+func (q *Queries) ListActiveRecurringTemplateIDsByWalletID(ctx context.Context, arg ListActiveRecurringTemplateIDsByWalletIDParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listActiveRecurringTemplateIDsByWalletID, arg.WalletID, arg.StartedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringOccurrencesByTemplateID = `-- name: ListRecurringOccurrencesByTemplateID :many
+SELECT
+    occurrence_date,
+    status,
+    transaction_record_id
+FROM finance.recurring_occurrences
+WHERE template_id = $1
+ORDER BY occurrence_date
+`
+
+type ListRecurringOccurrencesByTemplateIDRow struct {
+	OccurrenceDate      time.Time  `db:"occurrence_date"`
+	Status              string     `db:"status"`
+	TransactionRecordID *uuid.UUID `db:"transaction_record_id"`
+}
+
+func (q *Queries) ListRecurringOccurrencesByTemplateID(ctx context.Context, templateID uuid.UUID) ([]ListRecurringOccurrencesByTemplateIDRow, error) {
+	rows, err := q.db.Query(ctx, listRecurringOccurrencesByTemplateID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecurringOccurrencesByTemplateIDRow
+	for rows.Next() {
+		var i ListRecurringOccurrencesByTemplateIDRow
+		if err := rows.Scan(&i.OccurrenceDate, &i.Status, &i.TransactionRecordID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringOccurrencesByWalletID = `-- name: ListRecurringOccurrencesByWalletID :many
+SELECT
+    ro.template_id,
+    ro.occurrence_date,
+    ro.status
+FROM finance.recurring_occurrences ro
+INNER JOIN finance.recurring_templates rt
+    ON rt.id = ro.template_id
+WHERE rt.wallet_id = $1
+    AND ro.occurrence_date >= $2
+ORDER BY ro.template_id, ro.occurrence_date
+`
+
+type ListRecurringOccurrencesByWalletIDParams struct {
+	WalletID     uuid.UUID `db:"wallet_id"`
+	OccurredFrom time.Time `db:"occurred_from"`
+}
+
+type ListRecurringOccurrencesByWalletIDRow struct {
+	TemplateID     uuid.UUID `db:"template_id"`
+	OccurrenceDate time.Time `db:"occurrence_date"`
+	Status         string    `db:"status"`
+}
+
+func (q *Queries) ListRecurringOccurrencesByWalletID(ctx context.Context, arg ListRecurringOccurrencesByWalletIDParams) ([]ListRecurringOccurrencesByWalletIDRow, error) {
+	rows, err := q.db.Query(ctx, listRecurringOccurrencesByWalletID, arg.WalletID, arg.OccurredFrom)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecurringOccurrencesByWalletIDRow
+	for rows.Next() {
+		var i ListRecurringOccurrencesByWalletIDRow
+		if err := rows.Scan(&i.TemplateID, &i.OccurrenceDate, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringTemplatesByWalletID = `-- name: ListRecurringTemplatesByWalletID :many
+SELECT
+    rt.id,
+    rt.fp_id,
+    rt.amount,
+    rt.transaction_type,
+    rt.category_id,
+    c.name AS category_name,
+    rt.description,
+    rt.frequency,
+    rt.interval,
+    rt.day_of_month,
+    rt.start_date,
+    rt.status,
+    rt.paused_at,
+    rt.version
+FROM finance.recurring_templates rt
+LEFT JOIN finance.categories c
+    ON c.id = rt.category_id
+WHERE rt.wallet_id = $1
+ORDER BY rt.id
+`
+
+type ListRecurringTemplatesByWalletIDRow struct {
+	ID              uuid.UUID        `db:"id"`
+	FpID            uuid.UUID        `db:"fp_id"`
+	Amount          int64            `db:"amount"`
+	TransactionType string           `db:"transaction_type"`
+	CategoryID      *uuid.UUID       `db:"category_id"`
+	CategoryName    *string          `db:"category_name"`
+	Description     string           `db:"description"`
+	Frequency       string           `db:"frequency"`
+	Interval        int32            `db:"interval"`
+	DayOfMonth      int32            `db:"day_of_month"`
+	StartDate       time.Time        `db:"start_date"`
+	Status          string           `db:"status"`
+	PausedAt        pgtype.Timestamp `db:"paused_at"`
+	Version         int32            `db:"version"`
+}
+
+func (q *Queries) ListRecurringTemplatesByWalletID(ctx context.Context, walletID uuid.UUID) ([]ListRecurringTemplatesByWalletIDRow, error) {
+	rows, err := q.db.Query(ctx, listRecurringTemplatesByWalletID, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecurringTemplatesByWalletIDRow
+	for rows.Next() {
+		var i ListRecurringTemplatesByWalletIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FpID,
+			&i.Amount,
+			&i.TransactionType,
+			&i.CategoryID,
+			&i.CategoryName,
+			&i.Description,
+			&i.Frequency,
+			&i.Interval,
+			&i.DayOfMonth,
+			&i.StartDate,
+			&i.Status,
+			&i.PausedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRecurringTemplateStatus = `-- name: UpdateRecurringTemplateStatus :execrows
+UPDATE finance.recurring_templates
+SET
+    status = $1,
+    paused_at = $2,
+    version = version + 1
+WHERE id = $3
+    AND version = $4
+`
+
+type UpdateRecurringTemplateStatusParams struct {
+	Status   string           `db:"status"`
+	PausedAt pgtype.Timestamp `db:"paused_at"`
+	ID       uuid.UUID        `db:"id"`
+	Version  int32            `db:"version"`
+}
+
+func (q *Queries) UpdateRecurringTemplateStatus(ctx context.Context, arg UpdateRecurringTemplateStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRecurringTemplateStatus,
+		arg.Status,
+		arg.PausedAt,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
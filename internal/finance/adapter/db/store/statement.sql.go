@@ -0,0 +1,140 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: statement.sql
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createStatementArchive = `-- name: CreateStatementArchive :execrows
+INSERT INTO finance.statement_archives (
+    id,
+    wallet_id,
+    accounting_period_id,
+    year_month,
+    file_name,
+    content,
+    sha256,
+    archived_at
+)
+SELECT
+    $1,
+    ap.wallet_id,
+    ap.id,
+    ap.year_month,
+    $2,
+    $3,
+    $4,
+    $5
+FROM finance.accounting_periods ap
+WHERE ap.wallet_id = $6
+    AND ap.id = $7
+    AND ap.status = 'CLOSE'
+`
+
+type CreateStatementArchiveParams struct {
+	ID                 uuid.UUID `db:"id"`
+	FileName           string    `db:"file_name"`
+	Content            []byte    `db:"content"`
+	Sha256             string    `db:"sha256"`
+	ArchivedAt         time.Time `db:"archived_at"`
+	WalletID           uuid.UUID `db:"wallet_id"`
+	AccountingPeriodID uuid.UUID `db:"accounting_period_id"`
+}
+
+func (q *Queries) CreateStatementArchive(ctx context.Context, arg CreateStatementArchiveParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createStatementArchive,
+		arg.ID,
+		arg.FileName,
+		arg.Content,
+		arg.Sha256,
+		arg.ArchivedAt,
+		arg.WalletID,
+		arg.AccountingPeriodID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getStatementArchiveByWalletIDAndYearMonth = `-- name: GetStatementArchiveByWalletIDAndYearMonth :one
+SELECT
+    id,
+    wallet_id,
+    accounting_period_id,
+    year_month,
+    file_name,
+    content,
+    sha256,
+    archived_at
+FROM finance.statement_archives
+WHERE wallet_id = $1
+    AND year_month = $2
+`
+
+type GetStatementArchiveByWalletIDAndYearMonthParams struct {
+	WalletID  uuid.UUID `db:"wallet_id"`
+	YearMonth string    `db:"year_month"`
+}
+
+func (q *Queries) GetStatementArchiveByWalletIDAndYearMonth(ctx context.Context, arg GetStatementArchiveByWalletIDAndYearMonthParams) (FinanceStatementArchive, error) {
+	row := q.db.QueryRow(ctx, getStatementArchiveByWalletIDAndYearMonth, arg.WalletID, arg.YearMonth)
+	var i FinanceStatementArchive
+	err := row.Scan(
+		&i.ID,
+		&i.WalletID,
+		&i.AccountingPeriodID,
+		&i.YearMonth,
+		&i.FileName,
+		&i.Content,
+		&i.Sha256,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const listClosedAccountingPeriodsWithoutStatementArchive = `-- name: ListClosedAccountingPeriodsWithoutStatementArchive :many
+SELECT
+    ap.wallet_id,
+    ap.year_month
+FROM finance.accounting_periods ap
+WHERE ap.status = 'CLOSE'
+    AND NOT EXISTS (
+        SELECT 1
+        FROM finance.statement_archives sa
+        WHERE sa.accounting_period_id = ap.id
+    )
+ORDER BY ap.end_time, ap.wallet_id
+`
+
+type ListClosedAccountingPeriodsWithoutStatementArchiveRow struct {
+	WalletID  uuid.UUID `db:"wallet_id"`
+	YearMonth string    `db:"year_month"`
+}
+
+func (q *Queries) ListClosedAccountingPeriodsWithoutStatementArchive(ctx context.Context) ([]ListClosedAccountingPeriodsWithoutStatementArchiveRow, error) {
+	rows, err := q.db.Query(ctx, listClosedAccountingPeriodsWithoutStatementArchive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListClosedAccountingPeriodsWithoutStatementArchiveRow
+	for rows.Next() {
+		var i ListClosedAccountingPeriodsWithoutStatementArchiveRow
+		if err := rows.Scan(&i.WalletID, &i.YearMonth); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
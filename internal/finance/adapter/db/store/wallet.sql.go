@@ -35,6 +35,31 @@ func (q *Queries) BatchUpdateFundAllocations(ctx context.Context, arg BatchUpdat
 	return err
 }
 
+const batchUpsertFundAllocations = `-- name: BatchUpsertFundAllocations :exec
+INSERT INTO finance.fund_provider_allocations (
+    fp_id,
+    wallet_id,
+    allocated_amount
+)
+SELECT
+    unnest($1::uuid[]),
+    unnest($2::uuid[]),
+    unnest($3::bigint[])
+ON CONFLICT (fp_id, wallet_id)
+DO UPDATE SET allocated_amount = EXCLUDED.allocated_amount
+`
+
+type BatchUpsertFundAllocationsParams struct {
+	FpIds            []uuid.UUID `db:"fp_ids"`
+	WalletIds        []uuid.UUID `db:"wallet_ids"`
+	AllocatedAmounts []int64     `db:"allocated_amounts"`
+}
+
+func (q *Queries) BatchUpsertFundAllocations(ctx context.Context, arg BatchUpsertFundAllocationsParams) error {
+	_, err := q.db.Exec(ctx, batchUpsertFundAllocations, arg.FpIds, arg.WalletIds, arg.AllocatedAmounts)
+	return err
+}
+
 type BulkInsertFundAllocationsParams struct {
 	FpID            uuid.UUID `db:"fp_id"`
 	WalletID        uuid.UUID `db:"wallet_id"`
@@ -47,22 +72,31 @@ INSERT INTO finance.wallets (
     name,
     balance,
     currency,
-    version
+    version,
+    period_start_day,
+    period_interval,
+    user_id
 ) VALUES (
     $1, -- id
     $2, -- name
     $3, -- balance
     $4, -- currency
-    $5 -- version
+    $5, -- version
+    $6, -- period_start_day
+    $7, -- period_interval
+    $8 -- user_id
 )
 `
 
 type CreateWalletParams struct {
-	ID       uuid.UUID `db:"id"`
-	Name     string    `db:"name"`
-	Balance  int64     `db:"balance"`
-	Currency string    `db:"currency"`
-	Version  int32     `db:"version"`
+	ID             uuid.UUID `db:"id"`
+	Name           string    `db:"name"`
+	Balance        int64     `db:"balance"`
+	Currency       string    `db:"currency"`
+	Version        int32     `db:"version"`
+	PeriodStartDay int32     `db:"period_start_day"`
+	PeriodInterval int32     `db:"period_interval"`
+	UserID         *string   `db:"user_id"`
 }
 
 func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) error {
@@ -72,17 +106,42 @@ func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) erro
 		arg.Balance,
 		arg.Currency,
 		arg.Version,
+		arg.PeriodStartDay,
+		arg.PeriodInterval,
+		arg.UserID,
 	)
 	return err
 }
 
+const deleteFundAllocations = `-- name: DeleteFundAllocations :execrows
+DELETE FROM finance.fund_provider_allocations
+WHERE wallet_id = $1
+    AND fp_id = ANY($2::uuid[])
+`
+
+type DeleteFundAllocationsParams struct {
+	WalletID uuid.UUID   `db:"wallet_id"`
+	FpIds    []uuid.UUID `db:"fp_ids"`
+}
+
+func (q *Queries) DeleteFundAllocations(ctx context.Context, arg DeleteFundAllocationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFundAllocations, arg.WalletID, arg.FpIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getWalletByID = `-- name: GetWalletByID :one
 SELECT 
     id,
     name,
     balance,
     currency,
-    version
+    version,
+    period_start_day,
+    period_interval,
+    user_id
 FROM finance.wallets
 WHERE id = $1
 `
@@ -96,10 +155,107 @@ func (q *Queries) GetWalletByID(ctx context.Context, id uuid.UUID) (FinanceWalle
 		&i.Balance,
 		&i.Currency,
 		&i.Version,
+		&i.PeriodStartDay,
+		&i.PeriodInterval,
+		&i.UserID,
 	)
 	return i, err
 }
 
+const listWalletAllocations = `-- name: ListWalletAllocations :many
+SELECT
+    fpa.wallet_id,
+    fpa.fp_id,
+    fpa.allocated_amount,
+    fp.name    AS fp_name,
+    fp.fp_type AS fp_type,
+    fp.currency
+FROM finance.fund_provider_allocations fpa
+INNER JOIN finance.fund_providers fp
+    ON fp.id = fpa.fp_id
+WHERE fpa.wallet_id = ANY($1::uuid[])
+ORDER BY fpa.wallet_id, fp.name
+`
+
+type ListWalletAllocationsRow struct {
+	WalletID        uuid.UUID `db:"wallet_id"`
+	FpID            uuid.UUID `db:"fp_id"`
+	AllocatedAmount int64     `db:"allocated_amount"`
+	FpName          string    `db:"fp_name"`
+	FpType          string    `db:"fp_type"`
+	Currency        string    `db:"currency"`
+}
+
+func (q *Queries) ListWalletAllocations(ctx context.Context, walletIds []uuid.UUID) ([]ListWalletAllocationsRow, error) {
+	rows, err := q.db.Query(ctx, listWalletAllocations, walletIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWalletAllocationsRow
+	for rows.Next() {
+		var i ListWalletAllocationsRow
+		if err := rows.Scan(
+			&i.WalletID,
+			&i.FpID,
+			&i.AllocatedAmount,
+			&i.FpName,
+			&i.FpType,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWallets = `-- name: ListWallets :many
+SELECT
+    id,
+    name,
+    balance,
+    currency,
+    version,
+    period_start_day,
+    period_interval,
+    user_id
+FROM finance.wallets
+ORDER BY id
+`
+
+func (q *Queries) ListWallets(ctx context.Context) ([]FinanceWallet, error) {
+	rows, err := q.db.Query(ctx, listWallets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinanceWallet
+	for rows.Next() {
+		var i FinanceWallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Balance,
+			&i.Currency,
+			&i.Version,
+			&i.PeriodStartDay,
+			&i.PeriodInterval,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateWalletBalance = `-- name: UpdateWalletBalance :execrows
 UPDATE finance.wallets
 SET
@@ -122,3 +278,33 @@ func (q *Queries) UpdateWalletBalance(ctx context.Context, arg UpdateWalletBalan
 	}
 	return result.RowsAffected(), nil
 }
+
+const updateWalletLedgerConfig = `-- name: UpdateWalletLedgerConfig :execrows
+UPDATE finance.wallets
+SET
+    period_start_day = $1,
+    period_interval = $2,
+    version = version + 1
+WHERE id = $3
+    AND version = $4
+`
+
+type UpdateWalletLedgerConfigParams struct {
+	PeriodStartDay int32     `db:"period_start_day"`
+	PeriodInterval int32     `db:"period_interval"`
+	ID             uuid.UUID `db:"id"`
+	Version        int32     `db:"version"`
+}
+
+func (q *Queries) UpdateWalletLedgerConfig(ctx context.Context, arg UpdateWalletLedgerConfigParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateWalletLedgerConfig,
+		arg.PeriodStartDay,
+		arg.PeriodInterval,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,543 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: webhook.sql
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO finance.webhook_deliveries (
+    id,
+    subscription_id,
+    event_id,
+    event_name,
+    payload,
+    status,
+    attempts,
+    next_attempt_at,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+)
+ON CONFLICT (subscription_id, event_id) DO NOTHING
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID             uuid.UUID `db:"id"`
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	EventID        uuid.UUID `db:"event_id"`
+	EventName      string    `db:"event_name"`
+	Payload        []byte    `db:"payload"`
+	Status         string    `db:"status"`
+	Attempts       int32     `db:"attempts"`
+	NextAttemptAt  time.Time `db:"next_attempt_at"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventName,
+		arg.Payload,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWebhookDeliveryAttempt = `-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO finance.webhook_delivery_attempts (
+    delivery_id,
+    attempt,
+    attempted_at,
+    status_code,
+    error,
+    duration_ms
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type CreateWebhookDeliveryAttemptParams struct {
+	DeliveryID  uuid.UUID `db:"delivery_id"`
+	Attempt     int32     `db:"attempt"`
+	AttemptedAt time.Time `db:"attempted_at"`
+	StatusCode  *int32    `db:"status_code"`
+	Error       *string   `db:"error"`
+	DurationMs  int32     `db:"duration_ms"`
+}
+
+func (q *Queries) CreateWebhookDeliveryAttempt(ctx context.Context, arg CreateWebhookDeliveryAttemptParams) error {
+	_, err := q.db.Exec(ctx, createWebhookDeliveryAttempt,
+		arg.DeliveryID,
+		arg.Attempt,
+		arg.AttemptedAt,
+		arg.StatusCode,
+		arg.Error,
+		arg.DurationMs,
+	)
+	return err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :exec
+INSERT INTO finance.webhook_subscriptions (
+    id,
+    user_id,
+    url,
+    secret,
+    event_types,
+    created_at,
+    version
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+)
+`
+
+type CreateWebhookSubscriptionParams struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     string    `db:"user_id"`
+	Url        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+	Version    int32     `db:"version"`
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) error {
+	_, err := q.db.Exec(ctx, createWebhookSubscription,
+		arg.ID,
+		arg.UserID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedAt,
+		arg.Version,
+	)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM finance.webhook_subscriptions
+WHERE user_id = $1
+    AND id = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, arg.UserID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhookDeliveryForUpdate = `-- name: GetWebhookDeliveryForUpdate :one
+SELECT
+    d.id,
+    d.subscription_id,
+    d.event_id,
+    d.event_name,
+    d.payload,
+    d.status,
+    d.attempts,
+    d.next_attempt_at,
+    d.last_status_code,
+    d.last_error,
+    d.created_at,
+    d.delivered_at,
+    s.user_id,
+    s.url,
+    s.secret,
+    s.event_types,
+    s.created_at AS subscription_created_at,
+    s.version AS subscription_version
+FROM finance.webhook_deliveries d
+JOIN finance.webhook_subscriptions s ON s.id = d.subscription_id
+WHERE d.id = $1
+FOR UPDATE OF d
+`
+
+type GetWebhookDeliveryForUpdateRow struct {
+	ID                    uuid.UUID        `db:"id"`
+	SubscriptionID        uuid.UUID        `db:"subscription_id"`
+	EventID               uuid.UUID        `db:"event_id"`
+	EventName             string           `db:"event_name"`
+	Payload               []byte           `db:"payload"`
+	Status                string           `db:"status"`
+	Attempts              int32            `db:"attempts"`
+	NextAttemptAt         time.Time        `db:"next_attempt_at"`
+	LastStatusCode        *int32           `db:"last_status_code"`
+	LastError             *string          `db:"last_error"`
+	CreatedAt             time.Time        `db:"created_at"`
+	DeliveredAt           pgtype.Timestamp `db:"delivered_at"`
+	UserID                string           `db:"user_id"`
+	Url                   string           `db:"url"`
+	Secret                string           `db:"secret"`
+	EventTypes            []string         `db:"event_types"`
+	SubscriptionCreatedAt time.Time        `db:"subscription_created_at"`
+	SubscriptionVersion   int32            `db:"subscription_version"`
+}
+
+func (q *Queries) GetWebhookDeliveryForUpdate(ctx context.Context, id uuid.UUID) (GetWebhookDeliveryForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getWebhookDeliveryForUpdate, id)
+	var i GetWebhookDeliveryForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventName,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.SubscriptionCreatedAt,
+		&i.SubscriptionVersion,
+	)
+	return i, err
+}
+
+const getWebhookSubscriptionByID = `-- name: GetWebhookSubscriptionByID :one
+SELECT
+    id,
+    user_id,
+    url,
+    secret,
+    event_types,
+    created_at,
+    version
+FROM finance.webhook_subscriptions
+WHERE user_id = $1
+    AND id = $2
+`
+
+type GetWebhookSubscriptionByIDParams struct {
+	UserID string    `db:"user_id"`
+	ID     uuid.UUID `db:"id"`
+}
+
+func (q *Queries) GetWebhookSubscriptionByID(ctx context.Context, arg GetWebhookSubscriptionByIDParams) (FinanceWebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscriptionByID, arg.UserID, arg.ID)
+	var i FinanceWebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const listDueWebhookDeliveryIDs = `-- name: ListDueWebhookDeliveryIDs :many
+SELECT id
+FROM finance.webhook_deliveries
+WHERE status = 'PENDING'
+    AND next_attempt_at <= $1
+ORDER BY next_attempt_at, id
+LIMIT $2
+`
+
+type ListDueWebhookDeliveryIDsParams struct {
+	Now      time.Time `db:"now"`
+	RowLimit int32     `db:"row_limit"`
+}
+
+func (q *Queries) ListDueWebhookDeliveryIDs(ctx context.Context, arg ListDueWebhookDeliveryIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listDueWebhookDeliveryIDs, arg.Now, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveriesBySubscriptionID = `-- name: ListWebhookDeliveriesBySubscriptionID :many
+SELECT
+    id,
+    event_id,
+    event_name,
+    status,
+    attempts,
+    next_attempt_at,
+    last_status_code,
+    last_error,
+    created_at,
+    delivered_at
+FROM finance.webhook_deliveries
+WHERE subscription_id = $1
+    AND ($2::text IS NULL OR status = $2::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+`
+
+type ListWebhookDeliveriesBySubscriptionIDParams struct {
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	Status         *string   `db:"status"`
+	RowLimit       int32     `db:"row_limit"`
+}
+
+type ListWebhookDeliveriesBySubscriptionIDRow struct {
+	ID             uuid.UUID        `db:"id"`
+	EventID        uuid.UUID        `db:"event_id"`
+	EventName      string           `db:"event_name"`
+	Status         string           `db:"status"`
+	Attempts       int32            `db:"attempts"`
+	NextAttemptAt  time.Time        `db:"next_attempt_at"`
+	LastStatusCode *int32           `db:"last_status_code"`
+	LastError      *string          `db:"last_error"`
+	CreatedAt      time.Time        `db:"created_at"`
+	DeliveredAt    pgtype.Timestamp `db:"delivered_at"`
+}
+
+func (q *Queries) ListWebhookDeliveriesBySubscriptionID(ctx context.Context, arg ListWebhookDeliveriesBySubscriptionIDParams) ([]ListWebhookDeliveriesBySubscriptionIDRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveriesBySubscriptionID, arg.SubscriptionID, arg.Status, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesBySubscriptionIDRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesBySubscriptionIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.EventName,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveryAttempts = `-- name: ListWebhookDeliveryAttempts :many
+SELECT
+    delivery_id,
+    attempt,
+    attempted_at,
+    status_code,
+    error,
+    duration_ms
+FROM finance.webhook_delivery_attempts
+WHERE delivery_id = ANY($1::uuid[])
+ORDER BY delivery_id, attempt
+`
+
+func (q *Queries) ListWebhookDeliveryAttempts(ctx context.Context, deliveryIds []uuid.UUID) ([]FinanceWebhookDeliveryAttempt, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveryAttempts, deliveryIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinanceWebhookDeliveryAttempt
+	for rows.Next() {
+		var i FinanceWebhookDeliveryAttempt
+		if err := rows.Scan(
+			&i.DeliveryID,
+			&i.Attempt,
+			&i.AttemptedAt,
+			&i.StatusCode,
+			&i.Error,
+			&i.DurationMs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT
+    id,
+    user_id,
+    url,
+    secret,
+    event_types,
+    created_at,
+    version
+FROM finance.webhook_subscriptions
+WHERE user_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context, userID string) ([]FinanceWebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinanceWebhookSubscription
+	for rows.Next() {
+		var i FinanceWebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptionsByUserID = `-- name: ListWebhookSubscriptionsByUserID :many
+SELECT
+    id,
+    url,
+    event_types,
+    created_at,
+    version
+FROM finance.webhook_subscriptions
+WHERE user_id = $1
+ORDER BY created_at, id
+`
+
+type ListWebhookSubscriptionsByUserIDRow struct {
+	ID         uuid.UUID `db:"id"`
+	Url        string    `db:"url"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+	Version    int32     `db:"version"`
+}
+
+func (q *Queries) ListWebhookSubscriptionsByUserID(ctx context.Context, userID string) ([]ListWebhookSubscriptionsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookSubscriptionsByUserIDRow
+	for rows.Next() {
+		var i ListWebhookSubscriptionsByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.EventTypes,
+			&i.CreatedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWebhookDelivery = `-- name: UpdateWebhookDelivery :execrows
+UPDATE finance.webhook_deliveries
+SET
+    status = $1,
+    attempts = $2,
+    next_attempt_at = $3,
+    last_status_code = $4,
+    last_error = $5,
+    delivered_at = $6
+WHERE id = $7
+`
+
+type UpdateWebhookDeliveryParams struct {
+	Status         string           `db:"status"`
+	Attempts       int32            `db:"attempts"`
+	NextAttemptAt  time.Time        `db:"next_attempt_at"`
+	LastStatusCode *int32           `db:"last_status_code"`
+	LastError      *string          `db:"last_error"`
+	DeliveredAt    pgtype.Timestamp `db:"delivered_at"`
+	ID             uuid.UUID        `db:"id"`
+}
+
+func (q *Queries) UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateWebhookDelivery,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.DeliveredAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type transactionReadModel struct {
+	queries *store.Queries
+}
+
+func NewTransactionReadModel(queries *store.Queries) *transactionReadModel {
+	return &transactionReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *transactionReadModel) ListTransactions(
+	ctx context.Context,
+	filter query.TransactionFilter,
+) ([]query.Transaction, error) {
+	trModels, err := rm.queries.ListTransactionRecords(ctx, store.ListTransactionRecordsParams{
+		WalletID:        filter.WalletID,
+		Cursor:          filter.Cursor,
+		FromPeriod:      filter.FromPeriod,
+		ToPeriod:        filter.ToPeriod,
+		FpID:            filter.FundProviderID,
+		TransactionType: filter.TransactionType,
+		MinAmount:       filter.MinAmount,
+		MaxAmount:       filter.MaxAmount,
+		TransactionNo:   filter.TransactionNo,
+		CategoryID:      filter.CategoryID,
+		PageSize:        int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transaction records of wallet '%s': %w", filter.WalletID.String(), err)
+	}
+
+	transactions := make([]query.Transaction, 0, len(trModels))
+	for _, trModel := range trModels {
+		var transactionNo string
+		if trModel.TransactionNo != nil {
+			transactionNo = *trModel.TransactionNo
+		}
+
+		var reversedAt *time.Time
+		if trModel.ReversedAt.Valid {
+			reversedAt = &trModel.ReversedAt.Time
+		}
+
+		transactions = append(transactions, query.Transaction{
+			ID:               trModel.ID,
+			TransactionNo:    transactionNo,
+			TransactionType:  trModel.TransactionType,
+			Direction:        trModel.Direction,
+			Amount:           trModel.Amount,
+			WalletBalance:    trModel.WalletBalance,
+			FundProviderID:   trModel.FpID,
+			FundProviderName: trModel.FpName,
+			FpBalance:        trModel.FpBalance,
+			Description:      trModel.Description,
+			OccurredAt:       trModel.OccurredAt,
+			RecordedAt:       trModel.RecordedAt,
+			LinkedID:         trModel.LinkedID,
+			ReversalOfID:     trModel.ReversalOfID,
+			ReversedByID:     trModel.ReversedByID,
+			ReversedAt:       reversedAt,
+			CategoryID:       trModel.CategoryID,
+			CategoryName:     trModel.CategoryName,
+			YearMonth:        trModel.YearMonth,
+		})
+	}
+
+	return transactions, nil
+}
+
+func (rm *transactionReadModel) ListDuplicateCandidates(
+	ctx context.Context,
+	lookups []query.DuplicateLookup,
+	limit int,
+) ([][]query.DuplicateCandidate, error) {
+	params := store.ListDuplicateCandidatesParams{
+		FpIds:         make([]uuid.UUID, 0, len(lookups)),
+		Amounts:       make([]int64, 0, len(lookups)),
+		OccurredFroms: make([]time.Time, 0, len(lookups)),
+		OccurredTos:   make([]time.Time, 0, len(lookups)),
+		MaxCandidates: int32(limit),
+	}
+	for _, lookup := range lookups {
+		params.FpIds = append(params.FpIds, lookup.FundProviderID)
+		params.Amounts = append(params.Amounts, lookup.Amount)
+		params.OccurredFroms = append(params.OccurredFroms, lookup.OccurredFrom)
+		params.OccurredTos = append(params.OccurredTos, lookup.OccurredTo)
+	}
+
+	trModels, err := rm.queries.ListDuplicateCandidates(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duplicate candidates: %w", err)
+	}
+
+	candidates := make([][]query.DuplicateCandidate, len(lookups))
+	for _, trModel := range trModels {
+		// lookup_index is 1-based
+		i := int(trModel.LookupIndex) - 1
+		candidates[i] = append(candidates[i], query.DuplicateCandidate{
+			ID:              trModel.ID,
+			WalletID:        trModel.WalletID,
+			WalletName:      trModel.WalletName,
+			TransactionNo:   convert.SafeDeref(trModel.TransactionNo, ""),
+			TransactionType: trModel.TransactionType,
+			Direction:       trModel.Direction,
+			Amount:          trModel.Amount,
+			Description:     trModel.Description,
+			OccurredAt:      trModel.OccurredAt,
+			YearMonth:       trModel.YearMonth,
+		})
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type walletReadModel struct {
+	queries *store.Queries
+}
+
+func NewWalletReadModel(queries *store.Queries) *walletReadModel {
+	return &walletReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *walletReadModel) GetWallet(ctx context.Context, wID uuid.UUID) (query.Wallet, error) {
+	wModel, err := rm.queries.GetWalletByID(ctx, wID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return query.Wallet{}, fmt.Errorf("wallet '%s': %w", wID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return query.Wallet{}, fmt.Errorf("failed to retrieve wallet '%s': %w", wID.String(), err)
+	}
+
+	wallets, err := rm.withAllocations(ctx, wModel)
+	if err != nil {
+		return query.Wallet{}, err
+	}
+
+	return wallets[0], nil
+}
+
+func (rm *walletReadModel) ListWallets(ctx context.Context) ([]query.Wallet, error) {
+	wModels, err := rm.queries.ListWallets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	return rm.withAllocations(ctx, wModels...)
+}
+
+func (rm *walletReadModel) withAllocations(
+	ctx context.Context,
+	wModels ...store.FinanceWallet,
+) ([]query.Wallet, error) {
+	if len(wModels) == 0 {
+		return []query.Wallet{}, nil
+	}
+
+	wIDs := make([]uuid.UUID, 0, len(wModels))
+	for _, wModel := range wModels {
+		wIDs = append(wIDs, wModel.ID)
+	}
+
+	allocationModels, err := rm.queries.ListWalletAllocations(ctx, wIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallet allocations: %w", err)
+	}
+
+	allocationsByWallet := make(map[uuid.UUID][]query.WalletAllocation, len(wModels))
+	for _, allocationModel := range allocationModels {
+		allocationsByWallet[allocationModel.WalletID] = append(
+			allocationsByWallet[allocationModel.WalletID],
+			query.WalletAllocation{
+				FundProviderID:   allocationModel.FpID,
+				FundProviderName: allocationModel.FpName,
+				FundProviderType: allocationModel.FpType,
+				Allocated:        allocationModel.AllocatedAmount,
+			},
+		)
+	}
+
+	wallets := make([]query.Wallet, 0, len(wModels))
+	for _, wModel := range wModels {
+		allocations := allocationsByWallet[wModel.ID]
+		if allocations == nil {
+			allocations = []query.WalletAllocation{}
+		}
+
+		wallets = append(wallets, query.Wallet{
+			ID:             wModel.ID,
+			Name:           wModel.Name,
+			Balance:        wModel.Balance,
+			Currency:       wModel.Currency,
+			Version:        wModel.Version,
+			PeriodStartDay: wModel.PeriodStartDay,
+			PeriodInterval: wModel.PeriodInterval,
+			Allocations:    allocations,
+		})
+	}
+
+	return wallets, nil
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sumni-finance-backend/internal/common/convert"
 	common_db "sumni-finance-backend/internal/common/db"
 	"sumni-finance-backend/internal/finance/adapter/db/store"
 	"sumni-finance-backend/internal/finance/domain/fundprovider"
 	"sumni-finance-backend/internal/finance/domain/ledger"
 	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type walletRepo struct {
@@ -57,6 +61,8 @@ func (r *walletRepo) getByID(
 		wModel.Balance,
 		wModel.Currency,
 		wModel.Version,
+		wModel.PeriodStartDay,
+		wModel.PeriodInterval,
 	)
 	if err != nil {
 		return nil, err
@@ -129,6 +135,8 @@ func (r *walletRepo) getByIDWithProviders(
 		wModel.Balance,
 		wModel.Currency,
 		wModel.Version,
+		wModel.PeriodStartDay,
+		wModel.PeriodInterval,
 		filteredAllocations...,
 	)
 	if err != nil {
@@ -138,13 +146,16 @@ func (r *walletRepo) getByIDWithProviders(
 	return w, nil
 }
 
-func (r *walletRepo) Create(ctx context.Context, wallet *wallet.Wallet) error {
+func (r *walletRepo) Create(ctx context.Context, userID string, wallet *wallet.Wallet) error {
 	return r.queries.CreateWallet(ctx, store.CreateWalletParams{
-		ID:       wallet.ID(),
-		Name:     wallet.Name(),
-		Balance:  wallet.Balance().Amount(),
-		Currency: wallet.Currency().Code(),
-		Version:  0,
+		ID:             wallet.ID(),
+		Name:           wallet.Name(),
+		Balance:        wallet.Balance().Amount(),
+		Currency:       wallet.Currency().Code(),
+		Version:        0,
+		PeriodStartDay: wallet.LedgerManager().Config().StartDate().Value(),
+		PeriodInterval: wallet.LedgerManager().Config().Interval(),
+		UserID:         convert.SafePtr(userID),
 	})
 }
 
@@ -175,7 +186,116 @@ func (r *walletRepo) CreateAllocations(
 			return err
 		}
 
-		return r.insertFundAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations())
+		if err = r.insertFundAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+			return err
+		}
+
+		return saveEvents(ctx, txQueries, w.PullEvents())
+	})
+}
+
+func (r *walletRepo) UpdateAllocations(
+	ctx context.Context,
+	wID uuid.UUID,
+	allocationSpec wallet.ProviderAllocationSpec,
+	updateFunc func(w *wallet.Wallet) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		w, err := r.getByIDWithProviders(ctx, wID, allocationSpec, txQueries)
+		if err != nil {
+			return err
+		}
+
+		if err = updateFunc(w); err != nil {
+			return err
+		}
+
+		if err = r.updateWalletBalance(ctx, w, txQueries); err != nil {
+			return err
+		}
+
+		if err = r.updateFundProviderAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+			return err
+		}
+
+		if err = r.deleteFundAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().RemovedFpAllocations()); err != nil {
+			return err
+		}
+
+		return saveEvents(ctx, txQueries, w.PullEvents())
+	})
+}
+
+func (r *walletRepo) UpdateLedgerConfig(
+	ctx context.Context,
+	wID uuid.UUID,
+	updateFunc func(w *wallet.Wallet) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		w, err := r.getByID(ctx, wID, txQueries)
+		if err != nil {
+			return err
+		}
+
+		apModels, err := txQueries.ListOpenAccountingPeriodsByWalletID(ctx, wID)
+		if err != nil {
+			return fmt.Errorf("failed to list open accounting periods: %w", err)
+		}
+
+		openPeriods := make([]*ledger.AccountingPeriod, 0, len(apModels))
+		for _, apModel := range apModels {
+			ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+				apModel.ID,
+				apModel.YearMonth,
+				apModel.StartDate,
+				apModel.Interval,
+				apModel.Status,
+				apModel.WalletOpeningBalance,
+				apModel.TotalDebit,
+				apModel.TotalCredit,
+				apModel.TotalIncome,
+				apModel.TotalExpense,
+				apModel.WalletClosingBalance,
+				w.Currency().Code(),
+				apModel.StartTime,
+				apModel.EndTime,
+				apModel.Version,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal accounting period %s: %w", apModel.ID, err)
+			}
+
+			openPeriods = append(openPeriods, ap)
+		}
+
+		if err = w.SetAccountingPeriods(openPeriods...); err != nil {
+			return err
+		}
+
+		if err = updateFunc(w); err != nil {
+			return err
+		}
+
+		ledgerConfig := w.LedgerManager().Config()
+		rows, err := txQueries.UpdateWalletLedgerConfig(ctx, store.UpdateWalletLedgerConfigParams{
+			ID:             w.ID(),
+			PeriodStartDay: ledgerConfig.StartDate().Value(),
+			PeriodInterval: ledgerConfig.Interval(),
+			Version:        w.Version(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return fmt.Errorf("failed to update wallet ledger config: %w", common_db.ErrConcurrentModification)
+		}
+
+		return nil
 	})
 }
 
@@ -267,63 +387,203 @@ func (r *walletRepo) CreateTransactionRecords(
 	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
 		txQueries := r.queries.WithTx(tx)
 
-		w, err := r.getByIDWithProviders(ctx, wID, allocationSpec, txQueries)
+		w, err := r.getByIDWithProvidersAndAccountingPeriod(ctx, txQueries, wID, allocationSpec, yearMonth)
 		if err != nil {
 			return err
 		}
 
-		apModels, err := txQueries.GetAccountingPeriodsByYearMonthAndWalletID(
-			ctx,
-			store.GetAccountingPeriodsByYearMonthAndWalletIDParams{
-				WalletID:  wID,
-				YearMonth: yearMonth.String(),
-			},
-		)
-		if err != nil {
+		if err = updateFunc(w); err != nil {
 			return err
 		}
 
-		ap, err := r.toAccountingPeriodsDomain(apModels, w.Currency().Code())
-		if err != nil {
+		if err := r.updateWalletBalance(ctx, w, txQueries); err != nil {
 			return err
 		}
 
-		if err = w.SetAccountingPeriods(ap); err != nil {
+		if err := r.updateFundProviderAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
 			return err
 		}
 
-		if err = updateFunc(w); err != nil {
+		if err := r.saveAccountingPeriod(ctx, txQueries, w, yearMonth); err != nil {
 			return err
 		}
 
-		if err := r.updateWalletBalance(ctx, w, txQueries); err != nil {
-			return err
-		}
+		return saveEvents(ctx, txQueries, w.PullEvents())
+	})
+}
 
-		if err := r.updateFundProviderAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+func (r *walletRepo) CreateTransfer(
+	ctx context.Context,
+	srcWID uuid.UUID,
+	dstWID uuid.UUID,
+	fpID uuid.UUID,
+	occurredAt time.Time,
+	transferFunc func(src *wallet.Wallet, dst *wallet.Wallet) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+		allocationSpec := wallet.NewProviderMatchesAnySpec([]uuid.UUID{fpID})
+
+		src, err := r.getByIDWithProvidersAndAccountingPeriodCovering(ctx, txQueries, srcWID, allocationSpec, occurredAt)
+		if err != nil {
 			return err
 		}
 
-		// Get the accounting period from the wallet's ledger manager
-		acPeriod, exists := w.LedgerManager().FindAccountingPeriod(ap.YearMonth())
-		if !exists {
-			return fmt.Errorf("accounting period not found in wallet after recording transactions")
+		dst, err := r.getByIDWithProvidersAndAccountingPeriodCovering(ctx, txQueries, dstWID, allocationSpec, occurredAt)
+		if err != nil {
+			return err
 		}
 
-		// Update accounting period
-		if err := r.updateAccountingPeriod(ctx, txQueries, acPeriod); err != nil {
+		if err = transferFunc(src, dst); err != nil {
 			return err
 		}
 
-		// Insert transaction records
-		if err := r.insertTransactionRecords(ctx, txQueries, w.ID(), acPeriod); err != nil {
-			return err
+		// Always write in the same order to avoid deadlocks between opposite transfers
+		wallets := []*wallet.Wallet{src, dst}
+		slices.SortFunc(wallets, func(a, b *wallet.Wallet) int {
+			return strings.Compare(a.ID().String(), b.ID().String())
+		})
+
+		for _, w := range wallets {
+			if err := r.updateWalletBalance(ctx, w, txQueries); err != nil {
+				return err
+			}
+
+			// The fund provider balance does not change, only the split between wallets
+			if err := r.upsertFundAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+				return err
+			}
+
+			// Each wallet has its own ledger config, the transfer may fall in a different period of each
+			ap, exist := w.LedgerManager().FindAccountingPeriodCovering(occurredAt)
+			if !exist {
+				return fmt.Errorf("accounting period not found in wallet %s after the transfer", w.ID())
+			}
+
+			if err := r.saveAccountingPeriod(ctx, txQueries, w, ap.YearMonth()); err != nil {
+				return err
+			}
+
+			if err := saveEvents(ctx, txQueries, w.PullEvents()); err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
 }
 
+// getByIDWithProvidersAndAccountingPeriodCovering loads the wallet with the allocations matching allocationSpec
+// and the period containing t, the wallet has no period when none contains t.
+func (r *walletRepo) getByIDWithProvidersAndAccountingPeriodCovering(
+	ctx context.Context,
+	queries *store.Queries,
+	wID uuid.UUID,
+	allocationSpec wallet.ProviderAllocationSpec,
+	t time.Time,
+) (*wallet.Wallet, error) {
+	w, err := r.getByIDWithProviders(ctx, wID, allocationSpec, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	apModel, err := queries.GetAccountingPeriodCoveringByWalletID(ctx, store.GetAccountingPeriodCoveringByWalletIDParams{
+		WalletID:   wID,
+		OccurredAt: t,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return w, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounting period: %w", err)
+	}
+
+	ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+		apModel.ID,
+		apModel.YearMonth,
+		apModel.StartDate,
+		apModel.Interval,
+		apModel.Status,
+		apModel.WalletOpeningBalance,
+		apModel.TotalDebit,
+		apModel.TotalCredit,
+		apModel.TotalIncome,
+		apModel.TotalExpense,
+		apModel.WalletClosingBalance,
+		w.Currency().Code(),
+		apModel.StartTime,
+		apModel.EndTime,
+		apModel.Version,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal accounting period %s: %w", apModel.ID, err)
+	}
+
+	if err = w.SetAccountingPeriods(ap); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (r *walletRepo) getByIDWithProvidersAndAccountingPeriod(
+	ctx context.Context,
+	queries *store.Queries,
+	wID uuid.UUID,
+	allocationSpec wallet.ProviderAllocationSpec,
+	yearMonth ledger.YearMonth,
+) (*wallet.Wallet, error) {
+	w, err := r.getByIDWithProviders(ctx, wID, allocationSpec, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	apModels, err := queries.GetAccountingPeriodsByYearMonthAndWalletID(
+		ctx,
+		store.GetAccountingPeriodsByYearMonthAndWalletIDParams{
+			WalletID:  wID,
+			YearMonth: yearMonth.String(),
+		},
+	)
+	// The wallet is loaded without the period, recording into it is then rejected
+	if errors.Is(err, pgx.ErrNoRows) {
+		return w, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ap, err := r.toAccountingPeriodsDomain(apModels, w.Currency().Code())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = w.SetAccountingPeriods(ap); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// saveAccountingPeriod persists the totals of the yearMonth period and the transaction records recorded in it.
+func (r *walletRepo) saveAccountingPeriod(
+	ctx context.Context,
+	queries *store.Queries,
+	w *wallet.Wallet,
+	yearMonth ledger.YearMonth,
+) error {
+	acPeriod, exists := w.LedgerManager().FindAccountingPeriod(yearMonth)
+	if !exists {
+		return fmt.Errorf("accounting period not found in wallet after recording transactions")
+	}
+
+	if err := r.updateAccountingPeriod(ctx, queries, acPeriod); err != nil {
+		return err
+	}
+
+	return r.insertTransactionRecords(ctx, queries, w.ID(), acPeriod)
+}
+
 func (r *walletRepo) toAccountingPeriodsDomain(
 	apModel store.GetAccountingPeriodsByYearMonthAndWalletIDRow,
 	currencyCode string,
@@ -337,8 +597,11 @@ func (r *walletRepo) toAccountingPeriodsDomain(
 		apModel.WalletOpeningBalance,
 		apModel.TotalDebit,
 		apModel.TotalCredit,
+		apModel.TotalIncome,
+		apModel.TotalExpense,
 		apModel.WalletClosingBalance,
 		currencyCode,
+		apModel.StartTime,
 		apModel.EndTime,
 		apModel.Version,
 	)
@@ -406,6 +669,96 @@ func (r *walletRepo) updateFundProviderAllocations(
 	return nil
 }
 
+// deleteFundAllocations saves the fund providers that got the removed allocations released and deletes the allocations.
+func (r *walletRepo) deleteFundAllocations(
+	ctx context.Context,
+	queries *store.Queries,
+	wID uuid.UUID,
+	allocations []wallet.FpAllocation,
+) error {
+	allocationsLen := len(allocations)
+	if allocationsLen == 0 {
+		return nil
+	}
+
+	fpParams := store.BatchUpdateFundProvidersBalanceParams{
+		Ids:                make([]uuid.UUID, 0, allocationsLen),
+		Balances:           make([]int64, 0, allocationsLen),
+		UnallocatedAmounts: make([]int64, 0, allocationsLen),
+		Versions:           make([]int32, 0, allocationsLen),
+	}
+
+	for _, allocation := range allocations {
+		fp := allocation.FundProvider()
+		if fp == nil {
+			return errors.New("fund provider is missing in allocation")
+		}
+
+		fpParams.Ids = append(fpParams.Ids, fp.ID())
+		fpParams.Balances = append(fpParams.Balances, fp.Balance().Amount())
+		fpParams.UnallocatedAmounts = append(fpParams.UnallocatedAmounts, fp.UnallocatedBalance().Amount())
+		fpParams.Versions = append(fpParams.Versions, fp.Version())
+	}
+
+	rows, err := queries.BatchUpdateFundProvidersBalance(ctx, fpParams)
+	if err != nil {
+		return fmt.Errorf("failed to batch update fund providers: %w", err)
+	}
+
+	if rows != int64(allocationsLen) {
+		return fmt.Errorf("failed to update all fund providers: expected %d, updated %d: %w",
+			allocationsLen, rows, common_db.ErrConcurrentModification)
+	}
+
+	rows, err = queries.DeleteFundAllocations(ctx, store.DeleteFundAllocationsParams{
+		WalletID: wID,
+		FpIds:    fpParams.Ids,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete fund allocations: %w", err)
+	}
+
+	if rows != int64(allocationsLen) {
+		return fmt.Errorf("failed to delete all fund allocations: expected %d, deleted %d", allocationsLen, rows)
+	}
+
+	return nil
+}
+
+func (r *walletRepo) upsertFundAllocations(
+	ctx context.Context,
+	queries *store.Queries,
+	wID uuid.UUID,
+	allocations []wallet.FpAllocation,
+) error {
+	if len(allocations) == 0 {
+		return nil
+	}
+
+	allocationParams := store.BatchUpsertFundAllocationsParams{
+		FpIds:            make([]uuid.UUID, 0, len(allocations)),
+		WalletIds:        make([]uuid.UUID, 0, len(allocations)),
+		AllocatedAmounts: make([]int64, 0, len(allocations)),
+	}
+
+	for _, allocation := range allocations {
+		fp := allocation.FundProvider()
+		if fp == nil {
+			return errors.New("fund provider is missing in allocation")
+		}
+
+		allocationParams.FpIds = append(allocationParams.FpIds, fp.ID())
+		allocationParams.WalletIds = append(allocationParams.WalletIds, wID)
+		allocationParams.AllocatedAmounts = append(allocationParams.AllocatedAmounts, allocation.Allocated().Amount())
+	}
+
+	if err := queries.BatchUpsertFundAllocations(ctx, allocationParams); err != nil {
+		return fmt.Errorf("failed to batch upsert fund allocations: %w", err)
+	}
+
+	return nil
+}
+
 func (r *walletRepo) updateAccountingPeriod(
 	ctx context.Context,
 	queries *store.Queries,
@@ -414,6 +767,8 @@ func (r *walletRepo) updateAccountingPeriod(
 	rows, err := queries.UpdateAccountingPeriod(ctx, store.UpdateAccountingPeriodParams{
 		TotalDebit:     ap.TotalDebit().Amount(),
 		TotalCredit:    ap.TotalCredit().Amount(),
+		TotalIncome:    ap.TotalIncome().Amount(),
+		TotalExpense:   ap.TotalExpense().Amount(),
 		ClosingBalance: ap.ClosingBalance().Amount(),
 		Status:         ap.Status().String(),
 		ID:             ap.ID(),
@@ -449,20 +804,45 @@ func (r *walletRepo) insertTransactionRecords(
 			txNoPtr = &txNo
 		}
 
+		var linkedIDPtr *uuid.UUID
+		if linkedID := txRecord.LinkedID(); linkedID != uuid.Nil {
+			linkedIDPtr = &linkedID
+		}
+
+		var reversalOfIDPtr *uuid.UUID
+		if reversalOfID := txRecord.ReversalOfID(); reversalOfID != uuid.Nil {
+			reversalOfIDPtr = &reversalOfID
+		}
+
+		var categoryIDPtr *uuid.UUID
+		if categoryID := txRecord.CategoryID(); categoryID != uuid.Nil {
+			categoryIDPtr = &categoryID
+		}
+
 		txParams = append(txParams, store.BulkInsertTransactionRecordsParams{
 			ID:                  txRecord.ID(),
 			TransactionNo:       txNoPtr,
 			TransactionType:     txRecord.TransactionType().String(),
+			Direction:           txRecord.Direction().String(),
 			Amount:              txRecord.Amount().Amount(),
 			WalletBalance:       txRecord.WalletBalance().Amount(),
 			WalletID:            wID,
 			FpID:                txRecord.FpID(),
 			FpBalance:           txRecord.FpBalance().Amount(),
 			AccountingPeriodsID: ap.ID(),
+			LinkedID:            linkedIDPtr,
+			ReversalOfID:        reversalOfIDPtr,
+			CategoryID:          categoryIDPtr,
+			Description:         txRecord.Description(),
+			OccurredAt:          txRecord.OccurredAt(),
+			RecordedAt:          txRecord.RecordedAt(),
 		})
 	}
 
 	rowsInserted, err := queries.BulkInsertTransactionRecords(ctx, txParams)
+	if common_db.IsUniqueViolationOn(err, "uq_transaction_records_fp_id_transaction_no") {
+		return fmt.Errorf("failed to bulk insert transaction records: %w", wallet.ErrDuplicateTransactionNo)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to bulk insert transaction records: %w", err)
 	}
@@ -484,6 +864,9 @@ func (r *walletRepo) GetByIDWithAccountingPeriod(
 		ID:        wID,
 		YearMonth: yearMonth.String(),
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("wallet '%s': %w", wID.String(), common_db.ErrNotFound)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get wallet with accounting period: %w", err)
 	}
@@ -499,8 +882,11 @@ func (r *walletRepo) GetByIDWithAccountingPeriod(
 			convert.SafeDeref(model.WalletOpeningBalance, 0),
 			convert.SafeDeref(model.TotalDebit, 0),
 			convert.SafeDeref(model.TotalCredit, 0),
+			convert.SafeDeref(model.TotalIncome, 0),
+			convert.SafeDeref(model.TotalExpense, 0),
 			convert.SafeDeref(model.WalletClosingBalance, 0),
 			model.WalletCurrency,
+			model.PeriodStartTime.Time,
 			model.PeriodEndTime.Time,
 			convert.SafeDeref(model.PeriodVersion, 0),
 		)
@@ -517,6 +903,8 @@ func (r *walletRepo) GetByIDWithAccountingPeriod(
 		model.WalletBalance,
 		model.WalletCurrency,
 		model.WalletVersion,
+		model.WalletPeriodStartDay,
+		model.WalletPeriodInterval,
 		accountingPeriods,
 	)
 	if err != nil {
@@ -525,3 +913,267 @@ func (r *walletRepo) GetByIDWithAccountingPeriod(
 
 	return w, nil
 }
+
+func (r *walletRepo) GetByIDWithLatestAccountingPeriod(
+	ctx context.Context,
+	wID uuid.UUID,
+) (*wallet.Wallet, error) {
+	return r.getByIDWithLatestAccountingPeriod(ctx, r.queries, wID)
+}
+
+func (r *walletRepo) UpdateWithLatestAccountingPeriod(
+	ctx context.Context,
+	wID uuid.UUID,
+	updateFunc func(w *wallet.Wallet) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		w, err := r.getByIDWithLatestAccountingPeriod(ctx, txQueries, wID)
+		if err != nil {
+			return err
+		}
+
+		loadedIDs := make(map[uuid.UUID]struct{}, 1)
+		for _, ap := range w.LedgerManager().AccountingPeriods() {
+			loadedIDs[ap.ID()] = struct{}{}
+		}
+
+		if err = updateFunc(w); err != nil {
+			return err
+		}
+
+		for _, ap := range w.LedgerManager().AccountingPeriods() {
+			if _, loaded := loadedIDs[ap.ID()]; loaded {
+				if err := r.updateAccountingPeriod(ctx, txQueries, ap); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err := createAccountingPeriod(ctx, txQueries, w.ID(), ap); err != nil {
+				return err
+			}
+		}
+
+		return saveEvents(ctx, txQueries, w.PullEvents())
+	})
+}
+
+func (r *walletRepo) getByIDWithLatestAccountingPeriod(
+	ctx context.Context,
+	queries *store.Queries,
+	wID uuid.UUID,
+) (*wallet.Wallet, error) {
+	w, err := r.getByID(ctx, wID, queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve wallet '%s': %w", wID.String(), err)
+	}
+
+	if err = r.loadLatestAccountingPeriod(ctx, queries, w); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// loadLatestAccountingPeriod sets the period of w that ends last, if any, together with its budgets.
+func (r *walletRepo) loadLatestAccountingPeriod(
+	ctx context.Context,
+	queries *store.Queries,
+	w *wallet.Wallet,
+) error {
+	apModel, err := queries.GetLatestAccountingPeriodByWalletID(ctx, w.ID())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get latest accounting period: %w", err)
+	}
+
+	ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+		apModel.ID,
+		apModel.YearMonth,
+		apModel.StartDate,
+		apModel.Interval,
+		apModel.Status,
+		apModel.WalletOpeningBalance,
+		apModel.TotalDebit,
+		apModel.TotalCredit,
+		apModel.TotalIncome,
+		apModel.TotalExpense,
+		apModel.WalletClosingBalance,
+		w.Currency().Code(),
+		apModel.StartTime,
+		apModel.EndTime,
+		apModel.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal accounting period %s: %w", apModel.ID, err)
+	}
+
+	// The budgets are carried over when the next period opens
+	if err = loadCategoryBudgets(ctx, queries, ap); err != nil {
+		return err
+	}
+
+	return w.SetAccountingPeriods(ap)
+}
+
+func (r *walletRepo) ReverseTransactionRecord(
+	ctx context.Context,
+	wID uuid.UUID,
+	txID uuid.UUID,
+	reverseFunc func(w *wallet.Wallet, original *ledger.TransactionRecord) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		trModel, err := txQueries.GetTransactionRecordForUpdate(ctx, store.GetTransactionRecordForUpdateParams{
+			WalletID: wID,
+			ID:       txID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("transaction record '%s': %w", txID.String(), common_db.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get transaction record: %w", err)
+		}
+
+		w, err := r.getByIDWithProviders(ctx, wID, wallet.NewProviderMatchesAnySpec([]uuid.UUID{trModel.FpID}), txQueries)
+		if err != nil {
+			return err
+		}
+
+		original, err := ledger.UnmarshalTransactionRecordFromDatabase(
+			trModel.ID,
+			convert.SafeDeref(trModel.TransactionNo, ""),
+			trModel.TransactionType,
+			trModel.Direction,
+			trModel.Amount,
+			w.Currency().Code(),
+			trModel.Description,
+			trModel.OccurredAt,
+			trModel.RecordedAt,
+			trModel.WalletBalance,
+			trModel.FpID,
+			trModel.FpBalance,
+			convert.SafeDeref(trModel.LinkedID, uuid.Nil),
+			convert.SafeDeref(trModel.ReversalOfID, uuid.Nil),
+			convert.SafeDeref(trModel.ReversedByID, uuid.Nil),
+			trModel.ReversedAt.Time,
+			convert.SafeDeref(trModel.CategoryID, uuid.Nil),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal transaction record %s: %w", trModel.ID, err)
+		}
+
+		if trModel.IsReconciled {
+			original.MarkReconciled()
+		}
+
+		apModel, err := txQueries.GetAccountingPeriodByID(ctx, store.GetAccountingPeriodByIDParams{
+			WalletID: wID,
+			ID:       trModel.AccountingPeriodsID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get accounting period of transaction record: %w", err)
+		}
+
+		originalPeriod, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			apModel.ID,
+			apModel.YearMonth,
+			apModel.StartDate,
+			apModel.Interval,
+			apModel.Status,
+			apModel.WalletOpeningBalance,
+			apModel.TotalDebit,
+			apModel.TotalCredit,
+			apModel.TotalIncome,
+			apModel.TotalExpense,
+			apModel.WalletClosingBalance,
+			w.Currency().Code(),
+			apModel.StartTime,
+			apModel.EndTime,
+			apModel.Version,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal accounting period %s: %w", apModel.ID, err)
+		}
+
+		// The reversal of a record in a closed period is booked in the latest period
+		periods := []*ledger.AccountingPeriod{originalPeriod}
+
+		latestModel, err := txQueries.GetLatestAccountingPeriodByWalletID(ctx, wID)
+		if err != nil {
+			return fmt.Errorf("failed to get latest accounting period: %w", err)
+		}
+
+		if latestModel.ID != originalPeriod.ID() {
+			latestPeriod, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+				latestModel.ID,
+				latestModel.YearMonth,
+				latestModel.StartDate,
+				latestModel.Interval,
+				latestModel.Status,
+				latestModel.WalletOpeningBalance,
+				latestModel.TotalDebit,
+				latestModel.TotalCredit,
+				latestModel.TotalIncome,
+				latestModel.TotalExpense,
+				latestModel.WalletClosingBalance,
+				w.Currency().Code(),
+				latestModel.StartTime,
+				latestModel.EndTime,
+				latestModel.Version,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal accounting period %s: %w", latestModel.ID, err)
+			}
+
+			periods = append(periods, latestPeriod)
+		}
+
+		if err = w.SetAccountingPeriods(periods...); err != nil {
+			return err
+		}
+
+		if err = reverseFunc(w, original); err != nil {
+			return err
+		}
+
+		if err := r.updateWalletBalance(ctx, w, txQueries); err != nil {
+			return err
+		}
+
+		if err := r.updateFundProviderAllocations(ctx, txQueries, w.ID(), w.FundProviderManager().FpAllocations()); err != nil {
+			return err
+		}
+
+		for _, ap := range w.LedgerManager().AccountingPeriods() {
+			if len(ap.Transactions()) == 0 {
+				continue
+			}
+
+			if err := r.saveAccountingPeriod(ctx, txQueries, w, ap.YearMonth()); err != nil {
+				return err
+			}
+		}
+
+		rows, err := txQueries.MarkTransactionRecordReversed(ctx, store.MarkTransactionRecordReversedParams{
+			ID:           original.ID(),
+			ReversedByID: convert.SafePtr(original.ReversedByID()),
+			ReversedAt:   pgtype.Timestamp{Time: original.ReversedAt(), Valid: original.IsReversed()},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark transaction record reversed: %w", err)
+		}
+
+		if rows == 0 {
+			return fmt.Errorf("failed to mark transaction record reversed: %w", common_db.ErrConcurrentModification)
+		}
+
+		return saveEvents(ctx, txQueries, w.PullEvents())
+	})
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type webhookReadModel struct {
+	queries *store.Queries
+}
+
+func NewWebhookReadModel(queries *store.Queries) *webhookReadModel {
+	return &webhookReadModel{
+		queries: queries,
+	}
+}
+
+func (rm *webhookReadModel) ListWebhookSubscriptions(ctx context.Context, userID string) ([]query.WebhookSubscription, error) {
+	sModels, err := rm.queries.ListWebhookSubscriptionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	subscriptions := make([]query.WebhookSubscription, 0, len(sModels))
+	for _, sModel := range sModels {
+		subscriptions = append(subscriptions, query.WebhookSubscription{
+			ID:         sModel.ID,
+			URL:        sModel.Url,
+			EventTypes: sModel.EventTypes,
+			CreatedAt:  sModel.CreatedAt,
+			Version:    sModel.Version,
+		})
+	}
+
+	return subscriptions, nil
+}
+
+func (rm *webhookReadModel) ListWebhookDeliveries(
+	ctx context.Context,
+	userID string,
+	subscriptionID uuid.UUID,
+	status string,
+	limit int,
+) ([]query.WebhookDelivery, error) {
+	_, err := rm.queries.GetWebhookSubscriptionByID(ctx, store.GetWebhookSubscriptionByIDParams{
+		UserID: userID,
+		ID:     subscriptionID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("webhook subscription '%s': %w", subscriptionID.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var statusFilter *string
+	if status != "" {
+		statusFilter = convert.SafePtr(status)
+	}
+
+	dModels, err := rm.queries.ListWebhookDeliveriesBySubscriptionID(ctx, store.ListWebhookDeliveriesBySubscriptionIDParams{
+		SubscriptionID: subscriptionID,
+		Status:         statusFilter,
+		RowLimit:       int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	deliveryIDs := make([]uuid.UUID, 0, len(dModels))
+	for _, dModel := range dModels {
+		deliveryIDs = append(deliveryIDs, dModel.ID)
+	}
+
+	aModels, err := rm.queries.ListWebhookDeliveryAttempts(ctx, deliveryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook delivery attempts: %w", err)
+	}
+
+	attempts := make(map[uuid.UUID][]query.WebhookDeliveryAttempt, len(dModels))
+	for _, aModel := range aModels {
+		attempts[aModel.DeliveryID] = append(attempts[aModel.DeliveryID], query.WebhookDeliveryAttempt{
+			Attempt:     aModel.Attempt,
+			AttemptedAt: aModel.AttemptedAt,
+			StatusCode:  aModel.StatusCode,
+			Error:       convert.SafeDeref(aModel.Error, ""),
+			DurationMs:  aModel.DurationMs,
+		})
+	}
+
+	deliveries := make([]query.WebhookDelivery, 0, len(dModels))
+	for _, dModel := range dModels {
+		var nextAttemptAt *time.Time
+		if dModel.Status == webhook.StatusPending.String() {
+			nextAttemptAt = &dModel.NextAttemptAt
+		}
+
+		var deliveredAt *time.Time
+		if dModel.DeliveredAt.Valid {
+			deliveredAt = &dModel.DeliveredAt.Time
+		}
+
+		deliveries = append(deliveries, query.WebhookDelivery{
+			ID:             dModel.ID,
+			EventID:        dModel.EventID,
+			EventName:      dModel.EventName,
+			Status:         dModel.Status,
+			Attempts:       dModel.Attempts,
+			NextAttemptAt:  nextAttemptAt,
+			LastStatusCode: dModel.LastStatusCode,
+			LastError:      convert.SafeDeref(dModel.LastError, ""),
+			CreatedAt:      dModel.CreatedAt,
+			DeliveredAt:    deliveredAt,
+			AttemptLog:     attempts[dModel.ID],
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (rm *webhookReadModel) ListDueWebhookDeliveryIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	ids, err := rm.queries.ListDueWebhookDeliveryIDs(ctx, store.ListDueWebhookDeliveryIDsParams{
+		Now:      now,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/adapter/db/store"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type webhookRepo struct {
+	queries            *store.Queries
+	transactionManager *common_db.PgxTransactionManager
+}
+
+func NewWebhookRepo(
+	queries *store.Queries,
+	transactionManager *common_db.PgxTransactionManager,
+) (*webhookRepo, error) {
+	if queries == nil || transactionManager == nil {
+		return nil, errors.New("missing dependencies")
+	}
+
+	return &webhookRepo{
+		queries:            queries,
+		transactionManager: transactionManager,
+	}, nil
+}
+
+func (r *webhookRepo) CreateSubscription(ctx context.Context, s *webhook.Subscription) error {
+	return r.queries.CreateWebhookSubscription(ctx, store.CreateWebhookSubscriptionParams{
+		ID:         s.ID(),
+		UserID:     s.UserID(),
+		Url:        s.URL(),
+		Secret:     s.Secret(),
+		EventTypes: s.EventTypes(),
+		CreatedAt:  s.CreatedAt(),
+		Version:    s.Version(),
+	})
+}
+
+func (r *webhookRepo) GetSubscription(ctx context.Context, userID string, id uuid.UUID) (*webhook.Subscription, error) {
+	sModel, err := r.queries.GetWebhookSubscriptionByID(ctx, store.GetWebhookSubscriptionByIDParams{
+		UserID: userID,
+		ID:     id,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("webhook subscription '%s': %w", id.String(), common_db.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscription(sModel)
+}
+
+func (r *webhookRepo) DeleteSubscription(ctx context.Context, userID string, id uuid.UUID) error {
+	rows, err := r.queries.DeleteWebhookSubscription(ctx, store.DeleteWebhookSubscriptionParams{
+		UserID: userID,
+		ID:     id,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("webhook subscription '%s': %w", id.String(), common_db.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) ListSubscriptions(ctx context.Context, userID string) ([]*webhook.Subscription, error) {
+	sModels, err := r.queries.ListWebhookSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	subscriptions := make([]*webhook.Subscription, 0, len(sModels))
+	for _, sModel := range sModels {
+		s, err := toWebhookSubscription(sModel)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, s)
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookRepo) CreateDeliveries(ctx context.Context, deliveries []*webhook.Delivery) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		for _, d := range deliveries {
+			if err := txQueries.CreateWebhookDelivery(ctx, store.CreateWebhookDeliveryParams{
+				ID:             d.ID(),
+				SubscriptionID: d.SubscriptionID(),
+				EventID:        d.EventID(),
+				EventName:      d.EventName(),
+				Payload:        d.Payload(),
+				Status:         d.Status().String(),
+				Attempts:       d.Attempts(),
+				NextAttemptAt:  d.NextAttemptAt(),
+				CreatedAt:      d.CreatedAt(),
+			}); err != nil {
+				return fmt.Errorf("failed to create webhook delivery: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *webhookRepo) UpdateDelivery(
+	ctx context.Context,
+	id uuid.UUID,
+	updateFn func(s *webhook.Subscription, d *webhook.Delivery) error,
+) error {
+	return r.transactionManager.WithTx(ctx, func(tx pgx.Tx) error {
+		txQueries := r.queries.WithTx(tx)
+
+		dModel, err := txQueries.GetWebhookDeliveryForUpdate(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("webhook delivery '%s': %w", id.String(), common_db.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get webhook delivery: %w", err)
+		}
+
+		s, err := webhook.UnmarshalSubscriptionFromDatabase(
+			dModel.SubscriptionID,
+			dModel.UserID,
+			dModel.Url,
+			dModel.Secret,
+			dModel.EventTypes,
+			dModel.SubscriptionCreatedAt,
+			dModel.SubscriptionVersion,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal webhook subscription %s: %w", dModel.SubscriptionID, err)
+		}
+
+		d, err := webhook.UnmarshalDeliveryFromDatabase(
+			dModel.ID,
+			dModel.SubscriptionID,
+			dModel.EventID,
+			dModel.EventName,
+			dModel.Payload,
+			dModel.Status,
+			dModel.Attempts,
+			dModel.NextAttemptAt,
+			convert.SafeDeref(dModel.LastStatusCode, 0),
+			convert.SafeDeref(dModel.LastError, ""),
+			dModel.CreatedAt,
+			dModel.DeliveredAt.Time,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal webhook delivery %s: %w", dModel.ID, err)
+		}
+
+		if err = updateFn(s, d); err != nil {
+			return err
+		}
+
+		for i, a := range d.PullAttempts() {
+			var statusCode *int32
+			if a.StatusCode != 0 {
+				statusCode = convert.SafePtr(a.StatusCode)
+			}
+
+			var attemptErr *string
+			if a.Error != "" {
+				attemptErr = convert.SafePtr(a.Error)
+			}
+
+			if err = txQueries.CreateWebhookDeliveryAttempt(ctx, store.CreateWebhookDeliveryAttemptParams{
+				DeliveryID:  d.ID(),
+				Attempt:     dModel.Attempts + int32(i) + 1,
+				AttemptedAt: a.AttemptedAt,
+				StatusCode:  statusCode,
+				Error:       attemptErr,
+				DurationMs:  int32(a.Duration.Milliseconds()),
+			}); err != nil {
+				return fmt.Errorf("failed to log webhook delivery attempt: %w", err)
+			}
+		}
+
+		var lastStatusCode *int32
+		if d.LastStatusCode() != 0 {
+			lastStatusCode = convert.SafePtr(d.LastStatusCode())
+		}
+
+		var lastError *string
+		if d.LastError() != "" {
+			lastError = convert.SafePtr(d.LastError())
+		}
+
+		rows, err := txQueries.UpdateWebhookDelivery(ctx, store.UpdateWebhookDeliveryParams{
+			ID:             d.ID(),
+			Status:         d.Status().String(),
+			Attempts:       d.Attempts(),
+			NextAttemptAt:  d.NextAttemptAt(),
+			LastStatusCode: lastStatusCode,
+			LastError:      lastError,
+			DeliveredAt:    common_db.ToPgTimestamp(d.DeliveredAt()),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update webhook delivery: %w", err)
+		}
+		if rows != 1 {
+			return fmt.Errorf("webhook delivery '%s': %w", d.ID().String(), common_db.ErrConcurrentModification)
+		}
+
+		return nil
+	})
+}
+
+func toWebhookSubscription(sModel store.FinanceWebhookSubscription) (*webhook.Subscription, error) {
+	return webhook.UnmarshalSubscriptionFromDatabase(
+		sModel.ID,
+		sModel.UserID,
+		sModel.Url,
+		sModel.Secret,
+		sModel.EventTypes,
+		sModel.CreatedAt,
+		sModel.Version,
+	)
+}
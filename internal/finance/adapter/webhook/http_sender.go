@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	domain_webhook "sumni-finance-backend/internal/finance/domain/webhook"
+	"time"
+)
+
+// maxResponseBody bounds the bytes read from a response, the body is only drained so the connection is reused
+const maxResponseBody = 64 << 10
+
+type httpSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender posts the webhooks, an endpoint answering after timeout counts as unreachable.
+// Redirects are not followed, they are reported as the 3xx status they are.
+func NewHTTPSender(timeout time.Duration) *httpSender {
+	return &httpSender{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *httpSender) Send(ctx context.Context, req domain_webhook.Request) (int32, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+
+	httpReq.Header.Set("User-Agent", "sumni-finance-webhooks")
+	for name, value := range req.Headers {
+		httpReq.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	return int32(resp.StatusCode), nil
+}
@@ -4,30 +4,98 @@ import (
 	"sumni-finance-backend/internal/common/cqrs"
 	"sumni-finance-backend/internal/finance/adapter/db"
 	"sumni-finance-backend/internal/finance/adapter/db/store"
+	webhook_adapter "sumni-finance-backend/internal/finance/adapter/webhook"
 	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"time"
 
 	common_db "sumni-finance-backend/internal/common/db"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// webhookTimeout bounds a webhook POST, a slower endpoint is retried like an unreachable one
+const webhookTimeout = 10 * time.Second
+
 type Application struct {
 	Commands Commands
 	Queries  Queries
 }
 
 type Commands struct {
-	AllocateFund             command.AllocateFundHandler
-	CreateFundProvider       command.CreateFundProviderHandler
-	CreateWallet             command.CreateWalletHandler
-	OpenAccountingPeriod     command.OpenAccountingPeriodHandler
-	RecordTransactionRecords command.RecordTransactionRecordsHandler
+	AdjustReconciliation         command.AdjustReconciliationHandler
+	AllocateFund                 command.AllocateFundHandler
+	ArchiveStatement             command.ArchiveStatementHandler
+	CancelReconciliation         command.CancelReconciliationHandler
+	ClearReconciliationRecords   command.ClearReconciliationRecordsHandler
+	CloseAccountingPeriod        command.CloseAccountingPeriodHandler
+	CreateCategory               command.CreateCategoryHandler
+	CreateFundProvider           command.CreateFundProviderHandler
+	CreateImportProfile          command.CreateImportProfileHandler
+	CreateRecurringTemplate      command.CreateRecurringTemplateHandler
+	CreateWallet                 command.CreateWalletHandler
+	CreateWebhookSubscription    command.CreateWebhookSubscriptionHandler
+	DecreaseAllocation           command.DecreaseAllocationHandler
+	DeleteCategory               command.DeleteCategoryHandler
+	DeleteImportProfile          command.DeleteImportProfileHandler
+	DeleteWebhookSubscription    command.DeleteWebhookSubscriptionHandler
+	DeliverWebhook               command.DeliverWebhookHandler
+	DispatchOutboxEvent          command.DispatchOutboxEventHandler
+	FinaliseReconciliation       command.FinaliseReconciliationHandler
+	ImportTransactions           command.ImportTransactionsHandler
+	IncreaseAllocation           command.IncreaseAllocationHandler
+	MaterializeRecurringTemplate command.MaterializeRecurringTemplateHandler
+	OpenAccountingPeriod         command.OpenAccountingPeriodHandler
+	PauseRecurringTemplate       command.PauseRecurringTemplateHandler
+	PlanCategoryBudgets          command.PlanCategoryBudgetsHandler
+	RecordTransactionRecords     command.RecordTransactionRecordsHandler
+	RemoveAllocation             command.RemoveAllocationHandler
+	ResumeRecurringTemplate      command.ResumeRecurringTemplateHandler
+	ReverseTransaction           command.ReverseTransactionHandler
+	RolloverAccountingPeriods    command.RolloverAccountingPeriodsHandler
+	SeedDefaultCategories        command.SeedDefaultCategoriesHandler
+	SendWebhookTestEvent         command.SendWebhookTestEventHandler
+	SkipRecurringOccurrence      command.SkipRecurringOccurrenceHandler
+	StartReconciliation          command.StartReconciliationHandler
+	TransferBetweenFundProviders command.TransferBetweenFundProvidersHandler
+	TransferBetweenWallets       command.TransferBetweenWalletsHandler
+	UpdateCategory               command.UpdateCategoryHandler
+	UpdateLedgerConfig           command.UpdateLedgerConfigHandler
 }
 
 type Queries struct {
+	AccountingPeriodClosingReport query.GetAccountingPeriodClosingReportHandler
+	AccountingPeriods             query.ListAccountingPeriodsHandler
+	BudgetReport                  query.GetBudgetReportHandler
+	Categories                    query.ListCategoriesHandler
+	FundProvider                  query.GetFundProviderHandler
+	FundProviders                 query.ListFundProvidersHandler
+	ImportPreview                 query.PreviewImportHandler
+	ImportProfiles                query.ListImportProfilesHandler
+	LedgerExport                  query.ExportLedgerHandler
+	OutboxEventsDue               query.ListOutboxEventsDueHandler
+	PeriodContinuity              query.VerifyPeriodContinuityHandler
+	Reconciliation                query.GetReconciliationHandler
+	Reconciliations               query.ListReconciliationsHandler
+	RecurringTemplates            query.ListRecurringTemplatesHandler
+	RecurringTemplatesDue         query.ListRecurringTemplatesDueHandler
+	StatementArchive              query.GetStatementArchiveHandler
+	StatementsDueForArchive       query.ListStatementsDueForArchiveHandler
+	Transactions                  query.ListTransactionsHandler
+	UpcomingOccurrences           query.ListUpcomingOccurrencesHandler
+	Wallet                        query.GetWalletHandler
+	WalletStatement               query.GetWalletStatementHandler
+	Wallets                       query.ListWalletsHandler
+	WalletsDueForRollover         query.ListWalletsDueForRolloverHandler
+	WebhookDeliveries             query.ListWebhookDeliveriesHandler
+	WebhookDeliveriesDue          query.ListWebhookDeliveriesDueHandler
+	WebhookSubscriptions          query.ListWebhookSubscriptionsHandler
 }
 
-func NewApplication(pgPool *pgxpool.Pool) (Application, error) {
+// NewApplication wires the finance application, the subscribers receive the domain events relayed from the outbox
+// along with the webhook deliveries.
+func NewApplication(pgPool *pgxpool.Pool, subscribers ...event.Subscriber) (Application, error) {
 	queries := store.New(pgPool)
 	transactionManager := common_db.NewPgxTransactionManager(pgPool)
 
@@ -36,21 +104,134 @@ func NewApplication(pgPool *pgxpool.Pool) (Application, error) {
 		return Application{}, err
 	}
 
-	fundProviderRepo, err := db.NewFundProviderRepo(queries)
+	fundProviderRepo, err := db.NewFundProviderRepo(queries, transactionManager)
+	if err != nil {
+		return Application{}, err
+	}
+
+	categoryRepo, err := db.NewCategoryRepo(queries, transactionManager)
+	if err != nil {
+		return Application{}, err
+	}
+
+	recurringTemplateRepo, err := db.NewRecurringTemplateRepo(queries, transactionManager, walletRepo)
+	if err != nil {
+		return Application{}, err
+	}
+
+	importProfileRepo, err := db.NewImportProfileRepo(queries)
+	if err != nil {
+		return Application{}, err
+	}
+
+	statementRepo, err := db.NewStatementRepo(queries)
+	if err != nil {
+		return Application{}, err
+	}
+
+	reconciliationRepo, err := db.NewReconciliationRepo(queries, transactionManager, walletRepo)
+	if err != nil {
+		return Application{}, err
+	}
+
+	outboxRepo, err := db.NewOutboxRepo(queries, transactionManager)
+	if err != nil {
+		return Application{}, err
+	}
+
+	webhookRepo, err := db.NewWebhookRepo(queries, transactionManager)
 	if err != nil {
 		return Application{}, err
 	}
 
-	ledgerRepo := db.NewLedgerRepository(queries)
+	ledgerRepo := db.NewLedgerRepository(queries, transactionManager)
+	accountingPeriodReadModel := db.NewAccountingPeriodReadModel(queries)
+	walletReadModel := db.NewWalletReadModel(queries)
+	fundProviderReadModel := db.NewFundProviderReadModel(queries)
+	transactionReadModel := db.NewTransactionReadModel(queries)
+	categoryReadModel := db.NewCategoryReadModel(queries)
+	recurringTemplateReadModel := db.NewRecurringTemplateReadModel(queries)
+	importReadModel := db.NewImportReadModel(queries)
+	ledgerExportReadModel := db.NewLedgerExportReadModel(queries, pgPool)
+	statementReadModel := db.NewStatementReadModel(queries, pgPool)
+	reconciliationReadModel := db.NewReconciliationReadModel(queries)
+	outboxReadModel := db.NewOutboxReadModel(queries)
+	webhookReadModel := db.NewWebhookReadModel(queries)
+	webhookSender := webhook_adapter.NewHTTPSender(webhookTimeout)
+
+	findDuplicateTransactions := cqrs.ApplyQueryDecorator(query.NewFindDuplicateTransactionsHandler(transactionReadModel, time.Now))
+
+	scheduleWebhookDeliveries := cqrs.ApplyCommandDecorators(command.NewScheduleWebhookDeliveriesHandler(webhookRepo, time.Now))
+	subscribers = append(subscribers, command.NewWebhookSubscriber(scheduleWebhookDeliveries))
 
 	return Application{
 		Commands: Commands{
-			AllocateFund:             cqrs.ApplyCommandDecorators(command.NewAllocateFundHandler(walletRepo, fundProviderRepo)),
-			CreateFundProvider:       cqrs.ApplyCommandDecorators(command.NewCreateFundProviderHandler(fundProviderRepo)),
-			CreateWallet:             cqrs.ApplyCommandDecorators(command.NewCreateWalletHandler(walletRepo)),
-			OpenAccountingPeriod:     cqrs.ApplyCommandDecorators(command.NewOpenAccountingPeriodHandler(walletRepo, ledgerRepo)),
-			RecordTransactionRecords: cqrs.ApplyCommandDecorators(command.NewRecordTransactionRecordsHandler(walletRepo)),
+			AdjustReconciliation:         cqrs.ApplyCommandDecorators(command.NewAdjustReconciliationHandler(reconciliationRepo, time.Now)),
+			AllocateFund:                 cqrs.ApplyCommandDecorators(command.NewAllocateFundHandler(walletRepo, fundProviderRepo)),
+			ArchiveStatement:             cqrs.ApplyCommandDecorators(command.NewArchiveStatementHandler(statementRepo, time.Now)),
+			CancelReconciliation:         cqrs.ApplyCommandDecorators(command.NewCancelReconciliationHandler(reconciliationRepo)),
+			ClearReconciliationRecords:   cqrs.ApplyCommandDecorators(command.NewClearReconciliationRecordsHandler(reconciliationRepo)),
+			CloseAccountingPeriod:        cqrs.ApplyCommandDecorators(command.NewCloseAccountingPeriodHandler(walletRepo, ledgerRepo, time.Now)),
+			CreateCategory:               cqrs.ApplyCommandDecorators(command.NewCreateCategoryHandler(categoryRepo)),
+			CreateFundProvider:           cqrs.ApplyCommandDecorators(command.NewCreateFundProviderHandler(fundProviderRepo)),
+			CreateImportProfile:          cqrs.ApplyCommandDecorators(command.NewCreateImportProfileHandler(importProfileRepo)),
+			CreateRecurringTemplate:      cqrs.ApplyCommandDecorators(command.NewCreateRecurringTemplateHandler(recurringTemplateRepo, walletRepo, categoryRepo)),
+			CreateWallet:                 cqrs.ApplyCommandDecorators(command.NewCreateWalletHandler(walletRepo)),
+			CreateWebhookSubscription:    cqrs.ApplyCommandDecorators(command.NewCreateWebhookSubscriptionHandler(webhookRepo, time.Now)),
+			DecreaseAllocation:           cqrs.ApplyCommandDecorators(command.NewDecreaseAllocationHandler(walletRepo)),
+			DeleteCategory:               cqrs.ApplyCommandDecorators(command.NewDeleteCategoryHandler(categoryRepo)),
+			DeleteImportProfile:          cqrs.ApplyCommandDecorators(command.NewDeleteImportProfileHandler(importProfileRepo)),
+			DeleteWebhookSubscription:    cqrs.ApplyCommandDecorators(command.NewDeleteWebhookSubscriptionHandler(webhookRepo)),
+			DeliverWebhook:               cqrs.ApplyCommandDecorators(command.NewDeliverWebhookHandler(webhookRepo, webhookSender, time.Now)),
+			DispatchOutboxEvent:          cqrs.ApplyCommandDecorators(command.NewDispatchOutboxEventHandler(outboxRepo, subscribers, time.Now)),
+			FinaliseReconciliation:       cqrs.ApplyCommandDecorators(command.NewFinaliseReconciliationHandler(reconciliationRepo, time.Now)),
+			ImportTransactions:           cqrs.ApplyCommandDecorators(command.NewImportTransactionsHandler(importProfileRepo, walletRepo, time.Now)),
+			IncreaseAllocation:           cqrs.ApplyCommandDecorators(command.NewIncreaseAllocationHandler(walletRepo)),
+			MaterializeRecurringTemplate: cqrs.ApplyCommandDecorators(command.NewMaterializeRecurringTemplateHandler(recurringTemplateRepo, time.Now)),
+			OpenAccountingPeriod:         cqrs.ApplyCommandDecorators(command.NewOpenAccountingPeriodHandler(walletRepo, ledgerRepo)),
+			PauseRecurringTemplate:       cqrs.ApplyCommandDecorators(command.NewPauseRecurringTemplateHandler(recurringTemplateRepo, time.Now)),
+			PlanCategoryBudgets:          cqrs.ApplyCommandDecorators(command.NewPlanCategoryBudgetsHandler(walletRepo, ledgerRepo, categoryRepo)),
+			RecordTransactionRecords:     cqrs.ApplyCommandDecorators(command.NewRecordTransactionRecordsHandler(walletRepo, categoryRepo, findDuplicateTransactions, time.Now)),
+			RemoveAllocation:             cqrs.ApplyCommandDecorators(command.NewRemoveAllocationHandler(walletRepo)),
+			ResumeRecurringTemplate:      cqrs.ApplyCommandDecorators(command.NewResumeRecurringTemplateHandler(recurringTemplateRepo, time.Now)),
+			ReverseTransaction:           cqrs.ApplyCommandDecorators(command.NewReverseTransactionHandler(walletRepo, time.Now)),
+			RolloverAccountingPeriods:    cqrs.ApplyCommandDecorators(command.NewRolloverAccountingPeriodsHandler(walletRepo, recurringTemplateRepo, time.Now)),
+			SeedDefaultCategories:        cqrs.ApplyCommandDecorators(command.NewSeedDefaultCategoriesHandler(categoryRepo)),
+			SendWebhookTestEvent:         cqrs.ApplyCommandDecorators(command.NewSendWebhookTestEventHandler(webhookRepo, webhookSender, time.Now)),
+			SkipRecurringOccurrence:      cqrs.ApplyCommandDecorators(command.NewSkipRecurringOccurrenceHandler(recurringTemplateRepo)),
+			StartReconciliation:          cqrs.ApplyCommandDecorators(command.NewStartReconciliationHandler(reconciliationRepo, fundProviderRepo, time.Now)),
+			TransferBetweenFundProviders: cqrs.ApplyCommandDecorators(command.NewTransferBetweenFundProvidersHandler(walletRepo, time.Now)),
+			TransferBetweenWallets:       cqrs.ApplyCommandDecorators(command.NewTransferBetweenWalletsHandler(walletRepo, time.Now)),
+			UpdateCategory:               cqrs.ApplyCommandDecorators(command.NewUpdateCategoryHandler(categoryRepo)),
+			UpdateLedgerConfig:           cqrs.ApplyCommandDecorators(command.NewUpdateLedgerConfigHandler(walletRepo)),
+		},
+		Queries: Queries{
+			AccountingPeriodClosingReport: cqrs.ApplyQueryDecorator(query.NewGetAccountingPeriodClosingReportHandler(accountingPeriodReadModel)),
+			AccountingPeriods:             cqrs.ApplyQueryDecorator(query.NewListAccountingPeriodsHandler(accountingPeriodReadModel)),
+			BudgetReport:                  cqrs.ApplyQueryDecorator(query.NewGetBudgetReportHandler(accountingPeriodReadModel)),
+			Categories:                    cqrs.ApplyQueryDecorator(query.NewListCategoriesHandler(categoryReadModel)),
+			FundProvider:                  cqrs.ApplyQueryDecorator(query.NewGetFundProviderHandler(fundProviderReadModel)),
+			FundProviders:                 cqrs.ApplyQueryDecorator(query.NewListFundProvidersHandler(fundProviderReadModel)),
+			ImportPreview:                 cqrs.ApplyQueryDecorator(query.NewPreviewImportHandler(importReadModel)),
+			ImportProfiles:                cqrs.ApplyQueryDecorator(query.NewListImportProfilesHandler(importReadModel)),
+			LedgerExport:                  cqrs.ApplyQueryDecorator(query.NewExportLedgerHandler(ledgerExportReadModel)),
+			OutboxEventsDue:               cqrs.ApplyQueryDecorator(query.NewListOutboxEventsDueHandler(outboxReadModel, time.Now)),
+			PeriodContinuity:              cqrs.ApplyQueryDecorator(query.NewVerifyPeriodContinuityHandler(accountingPeriodReadModel)),
+			Reconciliation:                cqrs.ApplyQueryDecorator(query.NewGetReconciliationHandler(reconciliationReadModel)),
+			Reconciliations:               cqrs.ApplyQueryDecorator(query.NewListReconciliationsHandler(reconciliationReadModel)),
+			RecurringTemplates:            cqrs.ApplyQueryDecorator(query.NewListRecurringTemplatesHandler(recurringTemplateReadModel)),
+			RecurringTemplatesDue:         cqrs.ApplyQueryDecorator(query.NewListRecurringTemplatesDueHandler(recurringTemplateReadModel, time.Now)),
+			StatementArchive:              cqrs.ApplyQueryDecorator(query.NewGetStatementArchiveHandler(statementReadModel)),
+			StatementsDueForArchive:       cqrs.ApplyQueryDecorator(query.NewListStatementsDueForArchiveHandler(statementReadModel)),
+			Transactions:                  cqrs.ApplyQueryDecorator(query.NewListTransactionsHandler(transactionReadModel)),
+			UpcomingOccurrences:           cqrs.ApplyQueryDecorator(query.NewListUpcomingOccurrencesHandler(recurringTemplateReadModel, time.Now)),
+			Wallet:                        cqrs.ApplyQueryDecorator(query.NewGetWalletHandler(walletReadModel)),
+			WalletStatement:               cqrs.ApplyQueryDecorator(query.NewGetWalletStatementHandler(statementReadModel)),
+			Wallets:                       cqrs.ApplyQueryDecorator(query.NewListWalletsHandler(walletReadModel)),
+			WalletsDueForRollover:         cqrs.ApplyQueryDecorator(query.NewListWalletsDueForRolloverHandler(accountingPeriodReadModel, time.Now)),
+			WebhookDeliveries:             cqrs.ApplyQueryDecorator(query.NewListWebhookDeliveriesHandler(webhookReadModel)),
+			WebhookDeliveriesDue:          cqrs.ApplyQueryDecorator(query.NewListWebhookDeliveriesDueHandler(webhookReadModel, time.Now)),
+			WebhookSubscriptions:          cqrs.ApplyQueryDecorator(query.NewListWebhookSubscriptionsHandler(webhookReadModel)),
 		},
-		Queries: Queries{},
 	}, nil
 }
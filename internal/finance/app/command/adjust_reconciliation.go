@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AdjustReconciliationCmd struct {
+	FundProviderID   uuid.UUID
+	ReconciliationID uuid.UUID
+	// WalletID holds an allocation of the fund provider, the adjustment is booked into its open accounting period
+	WalletID uuid.UUID
+	// Description of the adjustment, a default one referencing the statement is used when empty
+	Description string
+}
+
+type AdjustReconciliationHandler cqrs.CommandHandler[AdjustReconciliationCmd]
+
+type adjustReconciliationHandler struct {
+	reconciliationRepo reconciliation.Repository
+	now                func() time.Time
+}
+
+// NewAdjustReconciliationHandler creates the handler posting the ADJUSTMENT record that closes the difference
+// of a reconciliation. now dates the adjustment, production code passes time.Now.
+func NewAdjustReconciliationHandler(
+	reconciliationRepo reconciliation.Repository,
+	now func() time.Time,
+) AdjustReconciliationHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &adjustReconciliationHandler{
+		reconciliationRepo: reconciliationRepo,
+		now:                now,
+	}
+}
+
+func (h *adjustReconciliationHandler) Handle(ctx context.Context, cmd AdjustReconciliationCmd) error {
+	if err := h.reconciliationRepo.Adjust(
+		ctx,
+		cmd.FundProviderID,
+		cmd.ReconciliationID,
+		cmd.WalletID,
+		func(r *reconciliation.Reconciliation, w *wallet.Wallet) error {
+			return r.Adjust(w, cmd.Description, h.now())
+		},
+	); err != nil {
+		if errors.Is(err, reconciliation.ErrNothingToAdjust) {
+			return httperr.NewIncorrectInputError(err, "reconciliation-nothing-to-adjust")
+		}
+
+		if errors.As(err, &wallet.ErrFundAllocatedNotFound{}) {
+			return httperr.NewIncorrectInputError(err, "fund-provider-not-allocated")
+		}
+
+		if errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "wallet-has-no-open-accounting-period")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionOutsidePeriod) {
+			return httperr.NewIncorrectInputError(err, "transaction-outside-accounting-period")
+		}
+
+		if errors.Is(err, wallet.ErrInsufficientAllocated) {
+			return httperr.NewIncorrectInputError(err, "insufficient-allocated-amount")
+		}
+
+		return reconciliationError(err, "failed-to-adjust-reconciliation")
+	}
+
+	return nil
+}
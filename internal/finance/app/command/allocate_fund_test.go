@@ -128,7 +128,7 @@ func TestAllocateFundHandler_Handle(t *testing.T) {
 				spec wallet.ProviderAllocationSpec,
 				updateFunc func(*wallet.Wallet) error,
 			) error {
-				w, err := wallet.NewWallet("USD", "Tai chinh tong")
+				w, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 				require.NoError(t, err)
 
 				return updateFunc(w)
@@ -186,6 +186,8 @@ func TestAllocateFundHandler_Handle(t *testing.T) {
 					0,
 					"USD",
 					0,
+					1,
+					1,
 					pa,
 				)
 				require.NoError(t, err)
@@ -269,7 +271,7 @@ func TestAllocateFundHandler_Handle(t *testing.T) {
 				spec wallet.ProviderAllocationSpec,
 				updateFunc func(*wallet.Wallet) error,
 			) error {
-				w, err := wallet.NewWallet("USD", "Tai chinh tong")
+				w, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 				require.NoError(t, err)
 
 				return updateFunc(w)
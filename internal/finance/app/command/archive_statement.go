@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/statement"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArchiveStatementCmd stores the rendered PDF statement of a closed accounting period.
+type ArchiveStatementCmd struct {
+	WalletID           uuid.UUID
+	AccountingPeriodID uuid.UUID
+	YearMonth          string
+	Content            []byte
+}
+
+type ArchiveStatementHandler cqrs.CommandHandler[ArchiveStatementCmd]
+
+type archiveStatementHandler struct {
+	statementRepo statement.Repository
+	now           func() time.Time
+}
+
+func NewArchiveStatementHandler(statementRepo statement.Repository, now func() time.Time) ArchiveStatementHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &archiveStatementHandler{
+		statementRepo: statementRepo,
+		now:           now,
+	}
+}
+
+func (h *archiveStatementHandler) Handle(ctx context.Context, cmd ArchiveStatementCmd) error {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(cmd.YearMonth)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	a, err := statement.NewArchive(cmd.WalletID, cmd.AccountingPeriodID, yearMonth, cmd.Content, h.now())
+	if err != nil {
+		if errors.Is(err, statement.ErrNotPDF) {
+			return httperr.NewIncorrectInputError(err, "statement-not-pdf")
+		}
+
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.statementRepo.CreateArchive(ctx, a); err != nil {
+		if errors.Is(err, statement.ErrAlreadyArchived) {
+			return httperr.NewIncorrectInputError(err, "statement-already-archived")
+		}
+
+		if errors.Is(err, statement.ErrPeriodNotArchivable) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-not-closed")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-archive-statement")
+	}
+
+	return nil
+}
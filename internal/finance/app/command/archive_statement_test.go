@@ -0,0 +1,81 @@
+package command_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/statement"
+	statement_mocks "sumni-finance-backend/internal/finance/domain/statement/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveStatementHandler_Handle(t *testing.T) {
+	archivedAt := time.Date(2026, time.May, 1, 0, 15, 0, 0, time.UTC)
+	now := func() time.Time { return archivedAt }
+
+	t.Run("returns error when the content is not a PDF", func(t *testing.T) {
+		statementRepoMock := statement_mocks.NewMockRepository(t)
+
+		err := command.NewArchiveStatementHandler(statementRepoMock, now).Handle(context.Background(), command.ArchiveStatementCmd{
+			WalletID:           uuid.New(),
+			AccountingPeriodID: uuid.New(),
+			YearMonth:          "2026,4",
+			Content:            []byte("not a pdf"),
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "statement-not-pdf", slugErr.Slug())
+	})
+
+	t.Run("returns error when the period is not closed", func(t *testing.T) {
+		statementRepoMock := statement_mocks.NewMockRepository(t)
+		statementRepoMock.
+			EXPECT().
+			CreateArchive(mock.Anything, mock.Anything).
+			Return(statement.ErrPeriodNotArchivable).
+			Once()
+
+		err := command.NewArchiveStatementHandler(statementRepoMock, now).Handle(context.Background(), command.ArchiveStatementCmd{
+			WalletID:           uuid.New(),
+			AccountingPeriodID: uuid.New(),
+			YearMonth:          "2026,4",
+			Content:            []byte("%PDF-1.7"),
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "accounting-period-not-closed", slugErr.Slug())
+	})
+
+	t.Run("archives the statement", func(t *testing.T) {
+		wID, apID := uuid.New(), uuid.New()
+
+		statementRepoMock := statement_mocks.NewMockRepository(t)
+		statementRepoMock.
+			EXPECT().
+			CreateArchive(mock.Anything, mock.MatchedBy(func(a *statement.Archive) bool {
+				return a.WalletID() == wID &&
+					a.AccountingPeriodID() == apID &&
+					a.FileName() == "statement-2026-4.pdf" &&
+					a.ArchivedAt().Equal(archivedAt)
+			})).
+			Return(nil).
+			Once()
+
+		err := command.NewArchiveStatementHandler(statementRepoMock, now).Handle(context.Background(), command.ArchiveStatementCmd{
+			WalletID:           wID,
+			AccountingPeriodID: apID,
+			YearMonth:          "2026,4",
+			Content:            []byte("%PDF-1.7"),
+		})
+
+		require.NoError(t, err)
+	})
+}
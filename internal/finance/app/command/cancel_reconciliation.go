@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+
+	"github.com/google/uuid"
+)
+
+type CancelReconciliationCmd struct {
+	FundProviderID   uuid.UUID
+	ReconciliationID uuid.UUID
+}
+
+type CancelReconciliationHandler cqrs.CommandHandler[CancelReconciliationCmd]
+
+type cancelReconciliationHandler struct {
+	reconciliationRepo reconciliation.Repository
+}
+
+func NewCancelReconciliationHandler(reconciliationRepo reconciliation.Repository) CancelReconciliationHandler {
+	return &cancelReconciliationHandler{reconciliationRepo: reconciliationRepo}
+}
+
+func (h *cancelReconciliationHandler) Handle(ctx context.Context, cmd CancelReconciliationCmd) error {
+	if err := h.reconciliationRepo.Update(ctx, cmd.FundProviderID, cmd.ReconciliationID, func(r *reconciliation.Reconciliation) error {
+		return r.Cancel()
+	}); err != nil {
+		return reconciliationError(err, "failed-to-cancel-reconciliation")
+	}
+
+	return nil
+}
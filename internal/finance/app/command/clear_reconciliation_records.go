@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+
+	"github.com/google/uuid"
+)
+
+type ClearReconciliationRecordsCmd struct {
+	FundProviderID   uuid.UUID
+	ReconciliationID uuid.UUID
+	TransactionIDs   []uuid.UUID
+	// Cleared marks the records as found on the statement, false marks them as missing again
+	Cleared bool
+}
+
+type ClearReconciliationRecordsHandler cqrs.CommandHandler[ClearReconciliationRecordsCmd]
+
+type clearReconciliationRecordsHandler struct {
+	reconciliationRepo reconciliation.Repository
+}
+
+func NewClearReconciliationRecordsHandler(reconciliationRepo reconciliation.Repository) ClearReconciliationRecordsHandler {
+	return &clearReconciliationRecordsHandler{reconciliationRepo: reconciliationRepo}
+}
+
+func (h *clearReconciliationRecordsHandler) Handle(ctx context.Context, cmd ClearReconciliationRecordsCmd) error {
+	if len(cmd.TransactionIDs) == 0 {
+		return httperr.NewIncorrectInputError(errors.New("transactionIds is required"), "invalid-cmd-input")
+	}
+
+	if err := h.reconciliationRepo.Update(ctx, cmd.FundProviderID, cmd.ReconciliationID, func(r *reconciliation.Reconciliation) error {
+		if cmd.Cleared {
+			return r.Clear(cmd.TransactionIDs...)
+		}
+
+		return r.Unclear(cmd.TransactionIDs...)
+	}); err != nil {
+		return reconciliationError(err, "failed-to-clear-reconciliation-records")
+	}
+
+	return nil
+}
+
+// reconciliationError maps the errors of a reconciliation update, slug is used for the unknown ones.
+func reconciliationError(err error, slug string) error {
+	if errors.Is(err, common_db.ErrNotFound) {
+		return httperr.NewNotFoundError(err, "reconciliation-not-found")
+	}
+
+	if errors.Is(err, reconciliation.ErrReconciliationNotOpen) {
+		return httperr.NewIncorrectInputError(err, "reconciliation-not-in-progress")
+	}
+
+	if errors.Is(err, reconciliation.ErrRecordNotReconcilable) {
+		return httperr.NewIncorrectInputError(err, "transaction-not-reconcilable")
+	}
+
+	if errors.Is(err, reconciliation.ErrRecordAfterStatement) {
+		return httperr.NewIncorrectInputError(err, "transaction-after-statement-date")
+	}
+
+	return httperr.NewUnknowError(err, slug)
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CloseAccountingPeriodCmd struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+type CloseAccountingPeriodHandler cqrs.CommandHandler[CloseAccountingPeriodCmd]
+
+type closeAccountingPeriodHandler struct {
+	walletRepo wallet.Repository
+	ledgerRepo ledger.Repository
+	now        func() time.Time
+}
+
+// NewCloseAccountingPeriodHandler creates the handler closing an accounting period.
+// now is the clock used to decide whether the period has ended, production code passes time.Now.
+func NewCloseAccountingPeriodHandler(
+	walletRepo wallet.Repository,
+	ledgerRepo ledger.Repository,
+	now func() time.Time,
+) CloseAccountingPeriodHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &closeAccountingPeriodHandler{
+		walletRepo: walletRepo,
+		ledgerRepo: ledgerRepo,
+		now:        now,
+	}
+}
+
+func (h *closeAccountingPeriodHandler) Handle(ctx context.Context, cmd CloseAccountingPeriodCmd) error {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(cmd.YearMonth)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	w, err := h.walletRepo.GetByIDWithAccountingPeriod(ctx, cmd.WalletID, yearMonth)
+	if err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "wallet-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-retrieve-wallet")
+	}
+
+	if err = w.CloseAccountingPeriod(yearMonth, h.now()); err != nil {
+		return httperr.NewIncorrectInputError(err, "failed-to-close-accounting-period")
+	}
+
+	ap, exist := w.LedgerManager().FindAccountingPeriod(yearMonth)
+	if !exist {
+		return httperr.NewUnknowError(
+			errors.New("accounting period is successful closed in domain but not found in wallet domain"),
+			"failed-to-close-accounting-period",
+		)
+	}
+
+	if err = h.ledgerRepo.UpdateAccountingPeriod(ctx, ap); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-update-accounting-period")
+	}
+
+	return nil
+}
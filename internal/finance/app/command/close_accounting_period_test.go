@@ -0,0 +1,214 @@
+package command_test
+
+import (
+	"context"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	ledger_mocks "sumni-finance-backend/internal/finance/domain/ledger/mocks"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type CloseAccountingPeriodDependenciesManager struct {
+	walletRepoMock *wallet_mocks.MockRepository
+	ledgerRepoMock *ledger_mocks.MockRepository
+	now            time.Time
+}
+
+func NewCloseAccountingPeriodDM(t *testing.T, now time.Time) *CloseAccountingPeriodDependenciesManager {
+	t.Helper()
+
+	return &CloseAccountingPeriodDependenciesManager{
+		walletRepoMock: wallet_mocks.NewMockRepository(t),
+		ledgerRepoMock: ledger_mocks.NewMockRepository(t),
+		now:            now,
+	}
+}
+
+func (dm *CloseAccountingPeriodDependenciesManager) NewHandler() command.CloseAccountingPeriodHandler {
+	return command.NewCloseAccountingPeriodHandler(
+		dm.walletRepoMock,
+		dm.ledgerRepoMock,
+		func() time.Time { return dm.now },
+	)
+}
+
+func TestCloseAccountingPeriodHandler_Handle(t *testing.T) {
+	endDate := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local)
+
+	newWalletWithPeriod := func(t *testing.T, wID uuid.UUID, status string) *wallet.Wallet {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			"2026,4",
+			1,
+			1,
+			status,
+			1_000_000,
+			200_000,
+			700_000,
+			0,
+			0,
+			0,
+			"VND",
+			endDate.AddDate(0, -1, 0),
+			endDate,
+			0,
+		)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+			wID,
+			"Tai chinh tong",
+			1_500_000,
+			"VND",
+			3,
+			1,
+			1,
+			[]*ledger.AccountingPeriod{ap},
+		)
+		require.NoError(t, err)
+
+		return w
+	}
+
+	t.Run("returns error when year month is invalid", func(t *testing.T) {
+		dm := NewCloseAccountingPeriodDM(t, endDate)
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  uuid.New(),
+			YearMonth: "2026-04",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when wallet repo fails", func(t *testing.T) {
+		dm := NewCloseAccountingPeriodDM(t, endDate)
+		dm.walletRepoMock.
+			EXPECT().
+			GetByIDWithAccountingPeriod(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, assert.AnError).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("returns not found when wallet does not exist", func(t *testing.T) {
+		dm := NewCloseAccountingPeriodDM(t, endDate)
+		dm.walletRepoMock.
+			EXPECT().
+			GetByIDWithAccountingPeriod(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, common_db.ErrNotFound).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "wallet-not-found", slugErr.Slug())
+		assert.Equal(t, httperr.ErrorTypeNotFound, slugErr.ErrorType())
+	})
+
+	t.Run("returns error when it is too early to close", func(t *testing.T) {
+		wID := uuid.New()
+		dm := NewCloseAccountingPeriodDM(t, endDate.Add(-time.Hour))
+		dm.walletRepoMock.
+			EXPECT().
+			GetByIDWithAccountingPeriod(mock.Anything, wID, mock.Anything).
+			Return(newWalletWithPeriod(t, wID, "OPEN"), nil).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  wID,
+			YearMonth: "2026,4",
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ledger.ErrAccountingPeriodNotEnded)
+	})
+
+	t.Run("returns error when period is already closed", func(t *testing.T) {
+		wID := uuid.New()
+		dm := NewCloseAccountingPeriodDM(t, endDate)
+		dm.walletRepoMock.
+			EXPECT().
+			GetByIDWithAccountingPeriod(mock.Anything, wID, mock.Anything).
+			Return(newWalletWithPeriod(t, wID, "CLOSE"), nil).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  wID,
+			YearMonth: "2026,4",
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ledger.ErrAccountingPeriodAlreadyClosed)
+	})
+
+	t.Run("returns error when update accounting period fails", func(t *testing.T) {
+		wID := uuid.New()
+		dm := NewCloseAccountingPeriodDM(t, endDate)
+		dm.walletRepoMock.
+			EXPECT().
+			GetByIDWithAccountingPeriod(mock.Anything, wID, mock.Anything).
+			Return(newWalletWithPeriod(t, wID, "OPEN"), nil).
+			Once()
+		dm.ledgerRepoMock.
+			EXPECT().
+			UpdateAccountingPeriod(mock.Anything, mock.Anything).
+			Return(assert.AnError).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  wID,
+			YearMonth: "2026,4",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("closes accounting period successfully", func(t *testing.T) {
+		wID := uuid.New()
+		dm := NewCloseAccountingPeriodDM(t, endDate)
+		dm.walletRepoMock.
+			EXPECT().
+			GetByIDWithAccountingPeriod(mock.Anything, wID, mock.Anything).
+			Return(newWalletWithPeriod(t, wID, "OPEN"), nil).
+			Once()
+		dm.ledgerRepoMock.
+			EXPECT().
+			UpdateAccountingPeriod(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, ap *ledger.AccountingPeriod) error {
+				assert.True(t, ap.IsClose())
+				assert.Equal(t, int64(1_500_000), ap.ClosingBalance().Amount())
+				return nil
+			}).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CloseAccountingPeriodCmd{
+			WalletID:  wID,
+			YearMonth: "2026,4",
+		})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/category"
+
+	"github.com/google/uuid"
+)
+
+type CreateCategoryCmd struct {
+	UserID string
+	Name   string
+	// Kind is INCOME or EXPENSE, a sub category defaults to the kind of its parent
+	Kind     string
+	ParentID *uuid.UUID
+}
+
+type CreateCategoryHandler cqrs.CommandHandler[CreateCategoryCmd]
+
+type createCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+func NewCreateCategoryHandler(categoryRepo category.Repository) CreateCategoryHandler {
+	return &createCategoryHandler{categoryRepo: categoryRepo}
+}
+
+func (h *createCategoryHandler) Handle(ctx context.Context, cmd CreateCategoryCmd) error {
+	var parent *category.Category
+	if cmd.ParentID != nil {
+		p, err := h.categoryRepo.GetByID(ctx, cmd.UserID, *cmd.ParentID)
+		if err != nil {
+			if errors.Is(err, common_db.ErrNotFound) {
+				return httperr.NewIncorrectInputError(err, "parent-category-not-found")
+			}
+
+			return httperr.NewUnknowError(err, "failed-to-get-parent-category")
+		}
+		parent = p
+	}
+
+	c, err := category.NewCategory(cmd.UserID, cmd.Name, cmd.Kind, parent)
+	if err != nil {
+		if errors.Is(err, category.ErrCategoryTooDeep) {
+			return httperr.NewIncorrectInputError(err, "category-too-deep")
+		}
+
+		if errors.Is(err, category.ErrParentKindMismatch) {
+			return httperr.NewIncorrectInputError(err, "category-kind-mismatch")
+		}
+
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.categoryRepo.Create(ctx, c); err != nil {
+		if errors.Is(err, category.ErrCategoryNameTaken) {
+			return httperr.NewIncorrectInputError(err, "category-name-taken")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-create-category")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package command_test
+
+import (
+	"context"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/category"
+	category_mocks "sumni-finance-backend/internal/finance/domain/category/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type CreateCategoryDependenciesManager struct {
+	categoryRepoMock *category_mocks.MockRepository
+}
+
+func NewCreateCategoryDM(t *testing.T) *CreateCategoryDependenciesManager {
+	t.Helper()
+
+	return &CreateCategoryDependenciesManager{
+		categoryRepoMock: category_mocks.NewMockRepository(t),
+	}
+}
+
+func (dm *CreateCategoryDependenciesManager) NewHandler() command.CreateCategoryHandler {
+	return command.NewCreateCategoryHandler(dm.categoryRepoMock)
+}
+
+func TestCreateCategoryHandler_Handle(t *testing.T) {
+	const userID = "user-1"
+
+	t.Run("returns error when parent category is not found", func(t *testing.T) {
+		parentID := uuid.New()
+		dm := NewCreateCategoryDM(t)
+		dm.categoryRepoMock.
+			EXPECT().
+			GetByID(mock.Anything, userID, parentID).
+			Return(nil, common_db.ErrNotFound).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CreateCategoryCmd{
+			UserID:   userID,
+			Name:     "Đi chợ",
+			ParentID: &parentID,
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, common_db.ErrNotFound)
+	})
+
+	t.Run("returns error when name is taken", func(t *testing.T) {
+		dm := NewCreateCategoryDM(t)
+		dm.categoryRepoMock.
+			EXPECT().
+			Create(mock.Anything, mock.Anything).
+			Return(category.ErrCategoryNameTaken).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.CreateCategoryCmd{
+			UserID: userID,
+			Name:   "Ăn uống",
+			Kind:   "EXPENSE",
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, category.ErrCategoryNameTaken)
+	})
+
+	t.Run("creates a sub category under its parent", func(t *testing.T) {
+		parent, err := category.UnmarshalCategoryFromDatabase(uuid.New(), userID, uuid.Nil, "Ăn uống", "EXPENSE", 0)
+		require.NoError(t, err)
+
+		parentID := parent.ID()
+		dm := NewCreateCategoryDM(t)
+		dm.categoryRepoMock.
+			EXPECT().
+			GetByID(mock.Anything, userID, parentID).
+			Return(parent, nil).
+			Once()
+		dm.categoryRepoMock.
+			EXPECT().
+			Create(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, c *category.Category) error {
+				assert.Equal(t, parentID, c.ParentID())
+				assert.Equal(t, category.KindExpense, c.Kind())
+				assert.Equal(t, userID, c.UserID())
+				return nil
+			}).
+			Once()
+
+		err = dm.NewHandler().Handle(context.Background(), command.CreateCategoryCmd{
+			UserID:   userID,
+			Name:     "Đi chợ",
+			ParentID: &parentID,
+		})
+
+		require.NoError(t, err)
+	})
+}
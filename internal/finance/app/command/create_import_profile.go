@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/importing"
+)
+
+type CreateImportProfileCmd struct {
+	UserID string
+	Spec   importing.ProfileSpec
+}
+
+type CreateImportProfileHandler cqrs.CommandHandler[CreateImportProfileCmd]
+
+type createImportProfileHandler struct {
+	importRepo importing.Repository
+}
+
+func NewCreateImportProfileHandler(importRepo importing.Repository) CreateImportProfileHandler {
+	return &createImportProfileHandler{importRepo: importRepo}
+}
+
+func (h *createImportProfileHandler) Handle(ctx context.Context, cmd CreateImportProfileCmd) error {
+	p, err := importing.NewMappingProfile(cmd.UserID, cmd.Spec)
+	if err != nil {
+		if errors.Is(err, importing.ErrInvalidAmountSign) {
+			return httperr.NewIncorrectInputError(err, "invalid-amount-sign")
+		}
+
+		if errors.Is(err, importing.ErrInvalidDateFormat) {
+			return httperr.NewIncorrectInputError(err, "invalid-date-format")
+		}
+
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.importRepo.CreateProfile(ctx, p); err != nil {
+		if errors.Is(err, importing.ErrProfileNameTaken) {
+			return httperr.NewIncorrectInputError(err, "import-profile-name-taken")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-create-import-profile")
+	}
+
+	return nil
+}
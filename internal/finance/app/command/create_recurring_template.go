@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateRecurringTemplateCmd struct {
+	// UserID owns the category of the template
+	UserID          string
+	WalletID        uuid.UUID
+	FundProviderID  uuid.UUID
+	Amount          int64
+	TransactionType string
+	CategoryID      *uuid.UUID
+	Description     string
+	// Frequency is MONTHLY or WEEKLY, every Interval months or weeks
+	Frequency string
+	Interval  int32
+	// DayOfMonth is only used by a MONTHLY schedule
+	DayOfMonth int32
+	StartDate  time.Time
+}
+
+type CreateRecurringTemplateHandler cqrs.CommandHandler[CreateRecurringTemplateCmd]
+
+type createRecurringTemplateHandler struct {
+	recurringRepo recurring.Repository
+	walletRepo    wallet.Repository
+	categoryRepo  category.Repository
+}
+
+func NewCreateRecurringTemplateHandler(
+	recurringRepo recurring.Repository,
+	walletRepo wallet.Repository,
+	categoryRepo category.Repository,
+) CreateRecurringTemplateHandler {
+	return &createRecurringTemplateHandler{
+		recurringRepo: recurringRepo,
+		walletRepo:    walletRepo,
+		categoryRepo:  categoryRepo,
+	}
+}
+
+func (h *createRecurringTemplateHandler) Handle(ctx context.Context, cmd CreateRecurringTemplateCmd) error {
+	w, err := h.walletRepo.GetByIDWithProviders(
+		ctx,
+		cmd.WalletID,
+		wallet.NewProviderMatchesAnySpec([]uuid.UUID{cmd.FundProviderID}),
+	)
+	if err != nil {
+		return httperr.NewUnknowError(err, "failed-to-get-wallet")
+	}
+
+	if _, exist := w.FundProviderManager().FindFundProviderAllocation(cmd.FundProviderID); !exist {
+		return httperr.NewIncorrectInputError(
+			wallet.ErrFundAllocatedNotFound{FpID: cmd.FundProviderID.String()},
+			"fund-provider-not-allocated",
+		)
+	}
+
+	var c *category.Category
+	if cmd.CategoryID != nil {
+		c, err = h.categoryRepo.GetByID(ctx, cmd.UserID, *cmd.CategoryID)
+		if err != nil {
+			if errors.Is(err, common_db.ErrNotFound) {
+				return httperr.NewIncorrectInputError(err, "category-not-found")
+			}
+
+			return httperr.NewUnknowError(err, "failed-to-get-category")
+		}
+	}
+
+	schedule, err := recurring.NewSchedule(cmd.Frequency, cmd.Interval, cmd.DayOfMonth, cmd.StartDate)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-recurring-schedule")
+	}
+
+	t, err := recurring.NewTemplate(
+		cmd.UserID,
+		cmd.WalletID,
+		cmd.FundProviderID,
+		cmd.Amount,
+		cmd.TransactionType,
+		c,
+		cmd.Description,
+		schedule,
+	)
+	if err != nil {
+		if errors.Is(err, recurring.ErrTransactionTypeNotRecurring) {
+			return httperr.NewIncorrectInputError(err, "transaction-type-not-recurring")
+		}
+
+		if errors.Is(err, category.ErrCategoryKindMismatch) {
+			return httperr.NewIncorrectInputError(err, "category-kind-mismatch")
+		}
+
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.recurringRepo.Create(ctx, t); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-create-recurring-template")
+	}
+
+	return nil
+}
@@ -8,8 +8,12 @@ import (
 )
 
 type CreateWalletCmd struct {
-	CurrencyCode string
-	Name         string
+	// UserID owns the wallet
+	UserID         string
+	CurrencyCode   string
+	Name           string
+	PeriodStartDay int32
+	PeriodInterval int32
 }
 
 type CreateWalletHandler cqrs.CommandHandler[CreateWalletCmd]
@@ -25,12 +29,17 @@ func NewCreateWalletHandler(walletRepo wallet.Repository) CreateWalletHandler {
 }
 
 func (h *createWalletHandler) Handle(ctx context.Context, cmd CreateWalletCmd) error {
-	walletDomain, err := wallet.NewWallet(cmd.CurrencyCode, cmd.Name)
+	ledgerConfig, err := wallet.NewLedgerConfig(cmd.PeriodStartDay, cmd.PeriodInterval)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-ledger-config")
+	}
+
+	walletDomain, err := wallet.NewWallet(cmd.CurrencyCode, cmd.Name, ledgerConfig)
 	if err != nil {
 		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
 	}
 
-	err = h.walletRepo.Create(ctx, walletDomain)
+	err = h.walletRepo.Create(ctx, cmd.UserID, walletDomain)
 	if err != nil {
 		return httperr.NewUnknowError(err, "failed-to-create-wallet")
 	}
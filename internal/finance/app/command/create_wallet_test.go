@@ -68,31 +68,50 @@ func TestCreateWallet_Handle(t *testing.T) {
 			},
 			hasErr: true,
 		},
+		{
+			name: "returns error when period interval is invalid",
+			cmd: command.CreateWalletCmd{
+				Name:           "My Wallet",
+				CurrencyCode:   "VND",
+				PeriodStartDay: 25,
+				PeriodInterval: 6,
+			},
+			setupMock: func(dm *CreateWalletDependenciesManager) {
+				// No mock setup needed - validation fails before repo call
+			},
+			hasErr:        true,
+			errorContains: "period interval",
+		},
 		{
 			name: "returns error when repository save fails",
 			cmd: command.CreateWalletCmd{
-				Name:         "My Wallet",
-				CurrencyCode: "VND",
+				Name:           "My Wallet",
+				CurrencyCode:   "VND",
+				PeriodStartDay: 1,
+				PeriodInterval: 1,
 			},
 			setupMock: func(dm *CreateWalletDependenciesManager) {
 				dm.walletRepoMock.
 					EXPECT().
-					Create(mock.Anything, mock.Anything).
+					Create(mock.Anything, mock.Anything, mock.Anything).
 					Return(assert.AnError).
 					Once()
 			},
 			hasErr: true,
 		},
 		{
-			name: "creates wallet successfully",
+			name: "creates wallet owned by the user successfully",
 			cmd: command.CreateWalletCmd{
-				Name:         "My Wallet",
-				CurrencyCode: "VND",
+				UserID:         "user-1",
+				Name:           "My Wallet",
+				CurrencyCode:   "VND",
+				PeriodStartDay: 25,
+				PeriodInterval: 1,
 			},
 			setupMock: func(dm *CreateWalletDependenciesManager) {
 				dm.walletRepoMock.
 					EXPECT().
-					Create(mock.Anything, mock.Anything).
+					Create(mock.Anything, "user-1", mock.Anything).
 					Return(nil).
 					Once()
 			},
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"time"
+)
+
+type CreateWebhookSubscriptionCmd struct {
+	UserID string
+	URL    string
+	Secret string
+	// EventTypes filters the events posted to URL, every event is posted when empty
+	EventTypes []string
+}
+
+type CreateWebhookSubscriptionHandler cqrs.CommandHandler[CreateWebhookSubscriptionCmd]
+
+type createWebhookSubscriptionHandler struct {
+	webhookRepo webhook.Repository
+	now         func() time.Time
+}
+
+func NewCreateWebhookSubscriptionHandler(webhookRepo webhook.Repository, now func() time.Time) CreateWebhookSubscriptionHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &createWebhookSubscriptionHandler{
+		webhookRepo: webhookRepo,
+		now:         now,
+	}
+}
+
+func (h *createWebhookSubscriptionHandler) Handle(ctx context.Context, cmd CreateWebhookSubscriptionCmd) error {
+	s, err := webhook.NewSubscription(cmd.UserID, cmd.URL, cmd.Secret, cmd.EventTypes, h.now())
+	if err != nil {
+		if errors.Is(err, webhook.ErrInvalidURL) {
+			return httperr.NewIncorrectInputError(err, "invalid-webhook-url")
+		}
+
+		if errors.Is(err, webhook.ErrUnknownEventType) {
+			return httperr.NewIncorrectInputError(err, "unknown-event-type")
+		}
+
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.webhookRepo.CreateSubscription(ctx, s); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-create-webhook-subscription")
+	}
+
+	return nil
+}
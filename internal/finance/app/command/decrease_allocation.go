@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+type DecreaseAllocationCmd struct {
+	WalletID       uuid.UUID
+	FundProviderID uuid.UUID
+	Amount         int64
+}
+
+type DecreaseAllocationHandler cqrs.CommandHandler[DecreaseAllocationCmd]
+
+type decreaseAllocationHandler struct {
+	walletRepo wallet.Repository
+}
+
+func NewDecreaseAllocationHandler(walletRepo wallet.Repository) DecreaseAllocationHandler {
+	return &decreaseAllocationHandler{walletRepo: walletRepo}
+}
+
+func (h *decreaseAllocationHandler) Handle(ctx context.Context, cmd DecreaseAllocationCmd) error {
+	if cmd.Amount <= 0 {
+		return httperr.NewIncorrectInputError(wallet.ErrAllocationAmountNotPositive, "invalid-allocation-amount")
+	}
+
+	if err := h.walletRepo.UpdateAllocations(
+		ctx,
+		cmd.WalletID,
+		wallet.NewProviderMatchesAnySpec([]uuid.UUID{cmd.FundProviderID}),
+		func(w *wallet.Wallet) error {
+			return w.DecreaseAllocation(cmd.FundProviderID, cmd.Amount)
+		},
+	); err != nil {
+		if errors.As(err, &wallet.ErrFundAllocatedNotFound{}) {
+			return httperr.NewIncorrectInputError(err, "fund-provider-not-allocated")
+		}
+
+		if errors.Is(err, wallet.ErrInsufficientAllocated) {
+			return httperr.NewIncorrectInputError(err, "insufficient-allocated-amount")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-decrease-allocation")
+	}
+
+	return nil
+}
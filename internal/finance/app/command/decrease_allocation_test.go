@@ -0,0 +1,95 @@
+package command_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecreaseAllocationHandler_Handle(t *testing.T) {
+	newWallet := func(t *testing.T, fp *fundprovider.FundProvider, allocated int64) *wallet.Wallet {
+		t.Helper()
+
+		allocation, err := wallet.NewFpAllocation(fp, allocated)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletFromDatabase(uuid.New(), "Tai chinh tong", allocated, "VND", 0, 1, 1, allocation)
+		require.NoError(t, err)
+
+		return w
+	}
+
+	t.Run("returns error when amount is not positive", func(t *testing.T) {
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+
+		err := command.NewDecreaseAllocationHandler(walletRepoMock).Handle(context.Background(), command.DecreaseAllocationCmd{
+			WalletID:       uuid.New(),
+			FundProviderID: uuid.New(),
+			Amount:         0,
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wallet.ErrAllocationAmountNotPositive)
+	})
+
+	t.Run("returns incorrect input when amount exceeds the allocation", func(t *testing.T) {
+		fp, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1_000_000, 0, "VND", 1)
+		require.NoError(t, err)
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			UpdateAllocations(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, wID uuid.UUID, spec wallet.ProviderAllocationSpec, updateFunc func(*wallet.Wallet) error) error {
+				return updateFunc(newWallet(t, fp, 1_000_000))
+			}).
+			Once()
+
+		err = command.NewDecreaseAllocationHandler(walletRepoMock).Handle(context.Background(), command.DecreaseAllocationCmd{
+			WalletID:       uuid.New(),
+			FundProviderID: fp.ID(),
+			Amount:         2_000_000,
+		})
+
+		require.Error(t, err)
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "insufficient-allocated-amount", slugErr.Slug())
+	})
+
+	t.Run("releases allocation successfully", func(t *testing.T) {
+		fp, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1_000_000, 0, "VND", 1)
+		require.NoError(t, err)
+
+		w := newWallet(t, fp, 1_000_000)
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			UpdateAllocations(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, wID uuid.UUID, spec wallet.ProviderAllocationSpec, updateFunc func(*wallet.Wallet) error) error {
+				return updateFunc(w)
+			}).
+			Once()
+
+		err = command.NewDecreaseAllocationHandler(walletRepoMock).Handle(context.Background(), command.DecreaseAllocationCmd{
+			WalletID:       w.ID(),
+			FundProviderID: fp.ID(),
+			Amount:         400_000,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(600_000), w.Balance().Amount())
+		assert.Equal(t, int64(400_000), fp.UnallocatedBalance().Amount())
+	})
+}
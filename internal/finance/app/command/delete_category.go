@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/category"
+
+	"github.com/google/uuid"
+)
+
+type DeleteCategoryCmd struct {
+	UserID     string
+	CategoryID uuid.UUID
+}
+
+type DeleteCategoryHandler cqrs.CommandHandler[DeleteCategoryCmd]
+
+type deleteCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+func NewDeleteCategoryHandler(categoryRepo category.Repository) DeleteCategoryHandler {
+	return &deleteCategoryHandler{categoryRepo: categoryRepo}
+}
+
+func (h *deleteCategoryHandler) Handle(ctx context.Context, cmd DeleteCategoryCmd) error {
+	if err := h.categoryRepo.Delete(ctx, cmd.UserID, cmd.CategoryID); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "category-not-found")
+		}
+
+		if errors.Is(err, category.ErrCategoryHasChildren) {
+			return httperr.NewIncorrectInputError(err, "category-has-children")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-delete-category")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/importing"
+
+	"github.com/google/uuid"
+)
+
+type DeleteImportProfileCmd struct {
+	UserID    string
+	ProfileID uuid.UUID
+}
+
+type DeleteImportProfileHandler cqrs.CommandHandler[DeleteImportProfileCmd]
+
+type deleteImportProfileHandler struct {
+	importRepo importing.Repository
+}
+
+func NewDeleteImportProfileHandler(importRepo importing.Repository) DeleteImportProfileHandler {
+	return &deleteImportProfileHandler{importRepo: importRepo}
+}
+
+func (h *deleteImportProfileHandler) Handle(ctx context.Context, cmd DeleteImportProfileCmd) error {
+	if err := h.importRepo.DeleteProfile(ctx, cmd.UserID, cmd.ProfileID); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "import-profile-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-delete-import-profile")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+// DeleteWebhookSubscriptionCmd deletes the subscription with its delivery logs, pending deliveries are dropped.
+type DeleteWebhookSubscriptionCmd struct {
+	UserID         string
+	SubscriptionID uuid.UUID
+}
+
+type DeleteWebhookSubscriptionHandler cqrs.CommandHandler[DeleteWebhookSubscriptionCmd]
+
+type deleteWebhookSubscriptionHandler struct {
+	webhookRepo webhook.Repository
+}
+
+func NewDeleteWebhookSubscriptionHandler(webhookRepo webhook.Repository) DeleteWebhookSubscriptionHandler {
+	return &deleteWebhookSubscriptionHandler{webhookRepo: webhookRepo}
+}
+
+func (h *deleteWebhookSubscriptionHandler) Handle(ctx context.Context, cmd DeleteWebhookSubscriptionCmd) error {
+	if err := h.webhookRepo.DeleteSubscription(ctx, cmd.UserID, cmd.SubscriptionID); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "webhook-subscription-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-delete-webhook-subscription")
+	}
+
+	return nil
+}
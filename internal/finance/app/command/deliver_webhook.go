@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliverWebhookCmd posts a pending webhook delivery to the endpoint of its subscription.
+type DeliverWebhookCmd struct {
+	DeliveryID uuid.UUID
+}
+
+type DeliverWebhookHandler cqrs.CommandHandler[DeliverWebhookCmd]
+
+type deliverWebhookHandler struct {
+	webhookRepo webhook.Repository
+	sender      webhook.Sender
+	now         func() time.Time
+}
+
+// NewDeliverWebhookHandler creates the handler attempting a webhook delivery.
+// now stamps and times the attempt, production code passes time.Now.
+func NewDeliverWebhookHandler(
+	webhookRepo webhook.Repository,
+	sender webhook.Sender,
+	now func() time.Time,
+) DeliverWebhookHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &deliverWebhookHandler{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		now:         now,
+	}
+}
+
+func (h *deliverWebhookHandler) Handle(ctx context.Context, cmd DeliverWebhookCmd) error {
+	var deliveryErr error
+
+	if err := h.webhookRepo.UpdateDelivery(ctx, cmd.DeliveryID, func(s *webhook.Subscription, d *webhook.Delivery) error {
+		// Delivered or dead in the meantime
+		if !d.IsPending() {
+			return nil
+		}
+
+		if err := attemptWebhookDelivery(ctx, h.sender, h.now, s, d); err != nil {
+			return err
+		}
+
+		if d.Status() != webhook.StatusSucceeded {
+			deliveryErr = errors.New(d.LastError())
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "webhook-delivery-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-deliver-webhook")
+	}
+
+	// The failure is stored, it is still reported so the worker counts it
+	if deliveryErr != nil {
+		return httperr.NewUnknowError(deliveryErr, "webhook-delivery-failed")
+	}
+
+	return nil
+}
+
+// attemptWebhookDelivery posts d, signed with the secret of s, and records the outcome on d.
+func attemptWebhookDelivery(
+	ctx context.Context,
+	sender webhook.Sender,
+	now func() time.Time,
+	s *webhook.Subscription,
+	d *webhook.Delivery,
+) error {
+	sentAt := now()
+	statusCode, err := sender.Send(ctx, d.Request(s, sentAt))
+
+	a := webhook.Attempt{
+		AttemptedAt: sentAt,
+		StatusCode:  statusCode,
+		Duration:    now().Sub(sentAt),
+	}
+	if err != nil {
+		a.Error = err.Error()
+	}
+
+	return d.RecordAttempt(a)
+}
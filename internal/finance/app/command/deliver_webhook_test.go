@@ -0,0 +1,103 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	webhook_mocks "sumni-finance-backend/internal/finance/domain/webhook/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverWebhookHandler_Handle(t *testing.T) {
+	attemptedAt := time.Date(2026, time.May, 4, 10, 0, 0, 0, time.Local)
+	now := func() time.Time { return attemptedAt }
+
+	newDelivery := func(t *testing.T) (*webhook.Subscription, *webhook.Delivery) {
+		t.Helper()
+
+		s, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", "0123456789abcdef", nil, attemptedAt)
+		require.NoError(t, err)
+
+		d, err := webhook.NewDelivery(s.ID(), event.Envelope{
+			ID:         uuid.New(),
+			OccurredAt: attemptedAt,
+			Event:      event.PeriodClosed{AccountingPeriodID: uuid.New()},
+		}, attemptedAt)
+		require.NoError(t, err)
+
+		return s, d
+	}
+
+	// updateWith runs the update function against s and d the way the repository would
+	updateWith := func(
+		s *webhook.Subscription,
+		d *webhook.Delivery,
+	) func(context.Context, uuid.UUID, func(*webhook.Subscription, *webhook.Delivery) error) error {
+		return func(_ context.Context, _ uuid.UUID, updateFn func(*webhook.Subscription, *webhook.Delivery) error) error {
+			return updateFn(s, d)
+		}
+	}
+
+	t.Run("posts the signed delivery", func(t *testing.T) {
+		s, d := newDelivery(t)
+
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+		webhookRepoMock.EXPECT().UpdateDelivery(mock.Anything, d.ID(), mock.Anything).RunAndReturn(updateWith(s, d)).Once()
+
+		senderMock := webhook_mocks.NewMockSender(t)
+		senderMock.EXPECT().Send(mock.Anything, d.Request(s, attemptedAt)).Return(200, nil).Once()
+
+		err := command.NewDeliverWebhookHandler(webhookRepoMock, senderMock, now).
+			Handle(context.Background(), command.DeliverWebhookCmd{DeliveryID: d.ID()})
+
+		require.NoError(t, err)
+		assert.Equal(t, webhook.StatusSucceeded, d.Status())
+		assert.Equal(t, attemptedAt, d.DeliveredAt())
+	})
+
+	t.Run("stores the failure and reports it", func(t *testing.T) {
+		s, d := newDelivery(t)
+
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+		webhookRepoMock.EXPECT().UpdateDelivery(mock.Anything, d.ID(), mock.Anything).RunAndReturn(updateWith(s, d)).Once()
+
+		senderMock := webhook_mocks.NewMockSender(t)
+		senderMock.EXPECT().Send(mock.Anything, mock.Anything).Return(0, errors.New("connection refused")).Once()
+
+		err := command.NewDeliverWebhookHandler(webhookRepoMock, senderMock, now).
+			Handle(context.Background(), command.DeliverWebhookCmd{DeliveryID: d.ID()})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "webhook-delivery-failed", slugErr.Slug())
+
+		assert.True(t, d.IsPending())
+		assert.Equal(t, "connection refused", d.LastError())
+		assert.Equal(t, attemptedAt.Add(webhook.RetryDelay(1)), d.NextAttemptAt())
+	})
+
+	t.Run("skips the delivery that is dead", func(t *testing.T) {
+		s, d := newDelivery(t)
+		for range webhook.MaxAttempts {
+			require.NoError(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, StatusCode: 500}))
+		}
+
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+		webhookRepoMock.EXPECT().UpdateDelivery(mock.Anything, d.ID(), mock.Anything).RunAndReturn(updateWith(s, d)).Once()
+
+		err := command.NewDeliverWebhookHandler(webhookRepoMock, webhook_mocks.NewMockSender(t), now).
+			Handle(context.Background(), command.DeliverWebhookCmd{DeliveryID: d.ID()})
+
+		require.NoError(t, err)
+		assert.Equal(t, webhook.StatusDead, d.Status())
+	})
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/outbox"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DispatchOutboxEventCmd relays a pending outbox event to the subscribers.
+type DispatchOutboxEventCmd struct {
+	EventID uuid.UUID
+}
+
+type DispatchOutboxEventHandler cqrs.CommandHandler[DispatchOutboxEventCmd]
+
+type dispatchOutboxEventHandler struct {
+	outboxRepo  outbox.Repository
+	subscribers []event.Subscriber
+	now         func() time.Time
+}
+
+// NewDispatchOutboxEventHandler creates the handler handing an outbox event to every subscriber.
+// When one of them fails the failure is stored and the event is handed to all of them again on the next attempt,
+// now stamps the attempt, production code passes time.Now.
+func NewDispatchOutboxEventHandler(
+	outboxRepo outbox.Repository,
+	subscribers []event.Subscriber,
+	now func() time.Time,
+) DispatchOutboxEventHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &dispatchOutboxEventHandler{
+		outboxRepo:  outboxRepo,
+		subscribers: subscribers,
+		now:         now,
+	}
+}
+
+func (h *dispatchOutboxEventHandler) Handle(ctx context.Context, cmd DispatchOutboxEventCmd) error {
+	var deliveryErr error
+
+	if err := h.outboxRepo.Update(ctx, cmd.EventID, func(m *outbox.Message) error {
+		// Relayed or given up in the meantime
+		if !m.IsPending() {
+			return nil
+		}
+
+		deliveryErr = h.deliver(ctx, event.Envelope{
+			ID:         m.ID(),
+			UserID:     m.UserID(),
+			OccurredAt: m.OccurredAt(),
+			Event:      m.Event(),
+		})
+		if deliveryErr != nil {
+			return m.MarkFailed(deliveryErr, h.now())
+		}
+
+		return m.MarkDispatched(h.now())
+	}); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "outbox-event-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-dispatch-outbox-event")
+	}
+
+	// The failure is stored, it is still reported so the relay counts it
+	if deliveryErr != nil {
+		return httperr.NewUnknowError(deliveryErr, "outbox-event-delivery-failed")
+	}
+
+	return nil
+}
+
+func (h *dispatchOutboxEventHandler) deliver(ctx context.Context, env event.Envelope) error {
+	var errs []error
+	for _, subscriber := range h.subscribers {
+		if err := subscriber.Handle(ctx, env); err != nil {
+			errs = append(errs, fmt.Errorf("subscriber %s: %w", subscriber.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
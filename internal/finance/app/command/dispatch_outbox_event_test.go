@@ -0,0 +1,106 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/outbox"
+	outbox_mocks "sumni-finance-backend/internal/finance/domain/outbox/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// subscriberStub records the envelopes it handled and fails with err.
+type subscriberStub struct {
+	handled []event.Envelope
+	err     error
+}
+
+func (s *subscriberStub) Name() string { return "stub" }
+
+func (s *subscriberStub) Handle(_ context.Context, env event.Envelope) error {
+	s.handled = append(s.handled, env)
+	return s.err
+}
+
+func TestDispatchOutboxEventHandler_Handle(t *testing.T) {
+	dispatchedAt := time.Date(2026, time.May, 4, 10, 0, 0, 0, time.Local)
+	now := func() time.Time { return dispatchedAt }
+
+	newMessage := func(t *testing.T) *outbox.Message {
+		t.Helper()
+
+		m, err := outbox.NewMessage(event.FundProviderCreated{FundProviderID: uuid.New()}, "user-1", dispatchedAt.Add(-time.Minute))
+		require.NoError(t, err)
+
+		return m
+	}
+
+	// updateWith runs the update function against m the way the repository would
+	updateWith := func(m *outbox.Message) func(context.Context, uuid.UUID, func(*outbox.Message) error) error {
+		return func(_ context.Context, _ uuid.UUID, updateFn func(*outbox.Message) error) error {
+			return updateFn(m)
+		}
+	}
+
+	t.Run("hands the event with its user to every subscriber", func(t *testing.T) {
+		m := newMessage(t)
+		first, second := &subscriberStub{}, &subscriberStub{}
+
+		outboxRepoMock := outbox_mocks.NewMockRepository(t)
+		outboxRepoMock.EXPECT().Update(mock.Anything, m.ID(), mock.Anything).RunAndReturn(updateWith(m)).Once()
+
+		err := command.NewDispatchOutboxEventHandler(outboxRepoMock, []event.Subscriber{first, second}, now).
+			Handle(context.Background(), command.DispatchOutboxEventCmd{EventID: m.ID()})
+
+		require.NoError(t, err)
+		want := []event.Envelope{{ID: m.ID(), UserID: "user-1", OccurredAt: m.OccurredAt(), Event: m.Event()}}
+		assert.Equal(t, want, first.handled)
+		assert.Equal(t, want, second.handled)
+		assert.Equal(t, outbox.StatusDispatched, m.Status())
+		assert.Equal(t, dispatchedAt, m.DispatchedAt())
+	})
+
+	t.Run("stores the failure and reports it", func(t *testing.T) {
+		m := newMessage(t)
+		healthy, failing := &subscriberStub{}, &subscriberStub{err: errors.New("connection refused")}
+
+		outboxRepoMock := outbox_mocks.NewMockRepository(t)
+		outboxRepoMock.EXPECT().Update(mock.Anything, m.ID(), mock.Anything).RunAndReturn(updateWith(m)).Once()
+
+		err := command.NewDispatchOutboxEventHandler(outboxRepoMock, []event.Subscriber{healthy, failing}, now).
+			Handle(context.Background(), command.DispatchOutboxEventCmd{EventID: m.ID()})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "outbox-event-delivery-failed", slugErr.Slug())
+
+		assert.Len(t, healthy.handled, 1)
+		assert.True(t, m.IsPending())
+		assert.Equal(t, int32(1), m.Attempts())
+		assert.Contains(t, m.LastError(), "connection refused")
+		assert.Equal(t, dispatchedAt.Add(outbox.RetryDelay(1)), m.NextAttemptAt())
+	})
+
+	t.Run("skips the event relayed in the meantime", func(t *testing.T) {
+		m := newMessage(t)
+		require.NoError(t, m.MarkDispatched(dispatchedAt))
+		subscriber := &subscriberStub{}
+
+		outboxRepoMock := outbox_mocks.NewMockRepository(t)
+		outboxRepoMock.EXPECT().Update(mock.Anything, m.ID(), mock.Anything).RunAndReturn(updateWith(m)).Once()
+
+		err := command.NewDispatchOutboxEventHandler(outboxRepoMock, []event.Subscriber{subscriber}, now).
+			Handle(context.Background(), command.DispatchOutboxEventCmd{EventID: m.ID()})
+
+		require.NoError(t, err)
+		assert.Empty(t, subscriber.handled)
+	})
+}
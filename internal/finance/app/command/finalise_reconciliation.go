@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FinaliseReconciliationCmd struct {
+	FundProviderID   uuid.UUID
+	ReconciliationID uuid.UUID
+}
+
+type FinaliseReconciliationHandler cqrs.CommandHandler[FinaliseReconciliationCmd]
+
+type finaliseReconciliationHandler struct {
+	reconciliationRepo reconciliation.Repository
+	now                func() time.Time
+}
+
+// NewFinaliseReconciliationHandler creates the handler finalising a reconciliation without difference,
+// which locks its cleared records. now stamps the finalisation, production code passes time.Now.
+func NewFinaliseReconciliationHandler(
+	reconciliationRepo reconciliation.Repository,
+	now func() time.Time,
+) FinaliseReconciliationHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &finaliseReconciliationHandler{
+		reconciliationRepo: reconciliationRepo,
+		now:                now,
+	}
+}
+
+func (h *finaliseReconciliationHandler) Handle(ctx context.Context, cmd FinaliseReconciliationCmd) error {
+	if err := h.reconciliationRepo.Update(ctx, cmd.FundProviderID, cmd.ReconciliationID, func(r *reconciliation.Reconciliation) error {
+		return r.Finalise(h.now())
+	}); err != nil {
+		if errors.Is(err, reconciliation.ErrDifferenceNotZero) {
+			return httperr.NewIncorrectInputError(err, "reconciliation-difference-not-zero")
+		}
+
+		return reconciliationError(err, "failed-to-finalise-reconciliation")
+	}
+
+	return nil
+}
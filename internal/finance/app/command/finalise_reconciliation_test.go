@@ -0,0 +1,101 @@
+package command_test
+
+import (
+	"context"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	reconciliation_mocks "sumni-finance-backend/internal/finance/domain/reconciliation/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinaliseReconciliationHandler_Handle(t *testing.T) {
+	finalisedAt := time.Date(2026, time.May, 4, 10, 0, 0, 0, time.Local)
+	now := func() time.Time { return finalisedAt }
+
+	newReconciliation := func(t *testing.T, statementBalance int64) *reconciliation.Reconciliation {
+		t.Helper()
+
+		r, err := reconciliation.UnmarshalReconciliationFromDatabase(
+			uuid.New(), uuid.New(), time.Date(2026, time.April, 30, 0, 0, 0, 0, time.Local), statementBalance, "IN_PROGRESS",
+			time.Date(2026, time.May, 2, 0, 0, 0, 0, time.Local), time.Time{}, 0, 1000, nil, nil, nil,
+		)
+		require.NoError(t, err)
+
+		return r
+	}
+
+	// updateWith runs the update function against r the way the repository would
+	updateWith := func(r *reconciliation.Reconciliation) func(context.Context, uuid.UUID, uuid.UUID, func(*reconciliation.Reconciliation) error) error {
+		return func(_ context.Context, _ uuid.UUID, _ uuid.UUID, updateFn func(*reconciliation.Reconciliation) error) error {
+			return updateFn(r)
+		}
+	}
+
+	t.Run("returns error when the reconciliation does not exist", func(t *testing.T) {
+		reconciliationRepoMock := reconciliation_mocks.NewMockRepository(t)
+		reconciliationRepoMock.
+			EXPECT().
+			Update(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("reconciliation: %w", common_db.ErrNotFound)).
+			Once()
+
+		err := command.NewFinaliseReconciliationHandler(reconciliationRepoMock, now).Handle(context.Background(), command.FinaliseReconciliationCmd{
+			FundProviderID:   uuid.New(),
+			ReconciliationID: uuid.New(),
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "reconciliation-not-found", slugErr.Slug())
+	})
+
+	t.Run("returns error while there is a difference", func(t *testing.T) {
+		r := newReconciliation(t, 900)
+
+		reconciliationRepoMock := reconciliation_mocks.NewMockRepository(t)
+		reconciliationRepoMock.
+			EXPECT().
+			Update(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(updateWith(r)).
+			Once()
+
+		err := command.NewFinaliseReconciliationHandler(reconciliationRepoMock, now).Handle(context.Background(), command.FinaliseReconciliationCmd{
+			FundProviderID:   r.FpID(),
+			ReconciliationID: r.ID(),
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "reconciliation-difference-not-zero", slugErr.Slug())
+		assert.True(t, r.IsInProgress())
+	})
+
+	t.Run("finalises the reconciliation", func(t *testing.T) {
+		r := newReconciliation(t, 1000)
+
+		reconciliationRepoMock := reconciliation_mocks.NewMockRepository(t)
+		reconciliationRepoMock.
+			EXPECT().
+			Update(mock.Anything, r.FpID(), r.ID(), mock.Anything).
+			RunAndReturn(updateWith(r)).
+			Once()
+
+		err := command.NewFinaliseReconciliationHandler(reconciliationRepoMock, now).Handle(context.Background(), command.FinaliseReconciliationCmd{
+			FundProviderID:   r.FpID(),
+			ReconciliationID: r.ID(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, reconciliation.StatusFinalised, r.Status())
+		assert.Equal(t, finalisedAt, r.FinalisedAt())
+	})
+}
@@ -0,0 +1,162 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportTransactionsCmd records the rows of a bank statement against a fund provider of the wallet.
+// Only the NEW rows of the preview are recorded, into the open accounting period. When the statement reports
+// balances, the import is refused unless they reconcile with the balance of the fund provider.
+type ImportTransactionsCmd struct {
+	UserID         string
+	WalletID       uuid.UUID
+	FundProviderID uuid.UUID
+	// Format is one of CSV, OFX, QIF, CAMT053 and MT940
+	Format string
+	// ProfileID is required for CSV only
+	ProfileID uuid.UUID
+	Content   []byte
+}
+
+type ImportTransactionsHandler cqrs.CommandHandler[ImportTransactionsCmd]
+
+type importTransactionsHandler struct {
+	importRepo importing.Repository
+	walletRepo wallet.Repository
+	now        func() time.Time
+}
+
+// NewImportTransactionsHandler creates the handler recording imported bank transactions.
+// now is the clock stamping the recorded time, production code passes time.Now.
+func NewImportTransactionsHandler(
+	importRepo importing.Repository,
+	walletRepo wallet.Repository,
+	now func() time.Time,
+) ImportTransactionsHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &importTransactionsHandler{
+		importRepo: importRepo,
+		walletRepo: walletRepo,
+		now:        now,
+	}
+}
+
+func (h *importTransactionsHandler) Handle(ctx context.Context, cmd ImportTransactionsCmd) error {
+	format, err := importing.NewFormat(cmd.Format)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-statement-format")
+	}
+
+	var profile *importing.MappingProfile
+	if format == importing.FormatCSV {
+		if cmd.ProfileID == uuid.Nil {
+			return httperr.NewIncorrectInputError(importing.ErrProfileRequired, "import-profile-required")
+		}
+
+		profile, err = h.importRepo.GetProfile(ctx, cmd.UserID, cmd.ProfileID)
+		if err != nil {
+			if errors.Is(err, common_db.ErrNotFound) {
+				return httperr.NewIncorrectInputError(err, "import-profile-not-found")
+			}
+
+			return httperr.NewUnknowError(err, "failed-to-get-import-profile")
+		}
+	}
+
+	batch, err := importing.ParseStatement(format, profile, bytes.NewReader(cmd.Content), time.Local)
+	if err != nil {
+		return statementError(err)
+	}
+
+	recorded, err := h.importRepo.ListRecordedTransactionNos(ctx, cmd.FundProviderID, batch.References())
+	if err != nil {
+		return httperr.NewUnknowError(err, "failed-to-list-recorded-transactions")
+	}
+	batch.MarkRecorded(recorded)
+
+	w, err := h.walletRepo.GetByIDWithLatestAccountingPeriod(ctx, cmd.WalletID)
+	if err != nil {
+		return httperr.NewUnknowError(err, "failed-to-get-wallet")
+	}
+
+	ap, exist := w.LedgerManager().LatestAccountingPeriod()
+	if !exist || ap.IsClose() {
+		return httperr.NewIncorrectInputError(wallet.ErrNoOpenAccountingPeriod, "wallet-has-no-open-accounting-period")
+	}
+	batch.MarkOutsidePeriod(ap.StartTime(), ap.EndDate())
+
+	txSpecs := batch.TransactionSpecs(cmd.FundProviderID, h.now())
+	if len(txSpecs) == 0 {
+		return httperr.NewIncorrectInputError(errors.New("statement has no new rows to import"), "nothing-to-import")
+	}
+
+	if err := h.walletRepo.CreateTransactionRecords(
+		ctx,
+		cmd.WalletID,
+		wallet.NewProviderMatchesAnySpec([]uuid.UUID{cmd.FundProviderID}),
+		ap.YearMonth(),
+		func(w *wallet.Wallet) error {
+			allocation, exist := w.FundProviderManager().FindFundProviderAllocation(cmd.FundProviderID)
+			if !exist {
+				return wallet.ErrFundAllocatedNotFound{FpID: cmd.FundProviderID.String()}
+			}
+
+			if err := batch.CheckBalances(allocation.FundProvider().Balance().Amount()); err != nil {
+				return err
+			}
+
+			return w.RecordTransactions(ap.YearMonth(), txSpecs...)
+		},
+	); err != nil {
+		if errors.As(err, &wallet.ErrFundAllocatedNotFound{}) {
+			return httperr.NewIncorrectInputError(err, "fund-provider-not-allocated")
+		}
+
+		if errors.Is(err, wallet.ErrInsufficientAllocated) {
+			return httperr.NewIncorrectInputError(err, "insufficient-allocated-amount")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionOutsidePeriod) {
+			return httperr.NewIncorrectInputError(err, "transaction-outside-accounting-period")
+		}
+
+		if errors.Is(err, importing.ErrLedgerBalanceMismatch) {
+			return httperr.NewIncorrectInputError(err, "ledger-balance-mismatch")
+		}
+
+		// Another import recorded one of the references since the preview
+		if errors.Is(err, wallet.ErrDuplicateTransactionNo) {
+			return httperr.NewIncorrectInputError(err, "duplicate-transaction-no")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-import-transactions")
+	}
+
+	return nil
+}
+
+func statementError(err error) error {
+	if errors.Is(err, importing.ErrMissingColumn) {
+		return httperr.NewIncorrectInputError(err, "statement-column-missing")
+	}
+
+	if errors.Is(err, importing.ErrUnreadableStatement) {
+		return httperr.NewIncorrectInputError(err, "unreadable-statement")
+	}
+
+	return httperr.NewUnknowError(err, "failed-to-parse-statement")
+}
@@ -0,0 +1,325 @@
+package command_test
+
+import (
+	"context"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	importing_mocks "sumni-finance-backend/internal/finance/domain/importing/mocks"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportTransactionsHandler_Handle(t *testing.T) {
+	now := time.Date(2026, time.April, 20, 0, 0, 0, 0, time.Local)
+	statement := []byte("Ngày,Số tiền,Mã GD\n" +
+		"05/04/2026,-150000,FT001\n" +
+		"06/04/2026,2000000,FT002\n" +
+		"28/03/2026,-50000,FT000\n")
+
+	profile, err := importing.NewMappingProfile("user-1", importing.ProfileSpec{
+		Name:            "Techcombank",
+		DateColumn:      "Ngày",
+		DateFormat:      "DD/MM/YYYY",
+		AmountSign:      "SIGNED",
+		AmountColumn:    "Số tiền",
+		ReferenceColumn: "Mã GD",
+	})
+	require.NoError(t, err)
+
+	newPeriod := func(t *testing.T, status string) *ledger.AccountingPeriod {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			"2026,4",
+			1,
+			1,
+			status,
+			1_000_000,
+			0,
+			0,
+			0,
+			0,
+			0,
+			"VND",
+			time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local),
+			0,
+		)
+		require.NoError(t, err)
+
+		return ap
+	}
+
+	newWalletWithPeriod := func(t *testing.T, status string) *wallet.Wallet {
+		t.Helper()
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+			uuid.New(),
+			"Tai chinh tong",
+			1_000_000,
+			"VND",
+			0,
+			1,
+			1,
+			[]*ledger.AccountingPeriod{newPeriod(t, status)},
+		)
+		require.NoError(t, err)
+
+		return w
+	}
+
+	t.Run("returns not found when the profile does not exist", func(t *testing.T) {
+		importRepoMock := importing_mocks.NewMockRepository(t)
+		importRepoMock.
+			EXPECT().
+			GetProfile(mock.Anything, "user-1", mock.Anything).
+			Return(nil, fmt.Errorf("import profile: %w", common_db.ErrNotFound)).
+			Once()
+
+		err := command.NewImportTransactionsHandler(importRepoMock, wallet_mocks.NewMockRepository(t), func() time.Time { return now }).
+			Handle(context.Background(), command.ImportTransactionsCmd{
+				UserID:    "user-1",
+				Format:    "CSV",
+				ProfileID: uuid.New(),
+				Content:   statement,
+			})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "import-profile-not-found", slugErr.Slug())
+	})
+
+	t.Run("returns error when the statement lacks a column of the profile", func(t *testing.T) {
+		importRepoMock := importing_mocks.NewMockRepository(t)
+		importRepoMock.
+			EXPECT().
+			GetProfile(mock.Anything, mock.Anything, mock.Anything).
+			Return(profile, nil).
+			Once()
+
+		err := command.NewImportTransactionsHandler(importRepoMock, wallet_mocks.NewMockRepository(t), func() time.Time { return now }).
+			Handle(context.Background(), command.ImportTransactionsCmd{
+				UserID:    "user-1",
+				Format:    "CSV",
+				ProfileID: uuid.New(),
+				Content:   []byte("Ngày,Số tiền\n05/04/2026,-150000\n"),
+			})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "statement-column-missing", slugErr.Slug())
+	})
+
+	t.Run("returns error when the latest accounting period is closed", func(t *testing.T) {
+		importRepoMock := importing_mocks.NewMockRepository(t)
+		importRepoMock.EXPECT().GetProfile(mock.Anything, mock.Anything, mock.Anything).Return(profile, nil).Once()
+		importRepoMock.EXPECT().ListRecordedTransactionNos(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			GetByIDWithLatestAccountingPeriod(mock.Anything, mock.Anything).
+			Return(newWalletWithPeriod(t, "CLOSE"), nil).
+			Once()
+
+		err := command.NewImportTransactionsHandler(importRepoMock, walletRepoMock, func() time.Time { return now }).
+			Handle(context.Background(), command.ImportTransactionsCmd{
+				UserID:    "user-1",
+				Format:    "CSV",
+				ProfileID: uuid.New(),
+				Content:   statement,
+			})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "wallet-has-no-open-accounting-period", slugErr.Slug())
+	})
+
+	t.Run("returns error when every row is already recorded", func(t *testing.T) {
+		importRepoMock := importing_mocks.NewMockRepository(t)
+		importRepoMock.EXPECT().GetProfile(mock.Anything, mock.Anything, mock.Anything).Return(profile, nil).Once()
+		importRepoMock.
+			EXPECT().
+			ListRecordedTransactionNos(mock.Anything, mock.Anything, mock.Anything).
+			Return([]string{"FT001", "FT002"}, nil).
+			Once()
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			GetByIDWithLatestAccountingPeriod(mock.Anything, mock.Anything).
+			Return(newWalletWithPeriod(t, "OPEN"), nil).
+			Once()
+
+		err := command.NewImportTransactionsHandler(importRepoMock, walletRepoMock, func() time.Time { return now }).
+			Handle(context.Background(), command.ImportTransactionsCmd{
+				UserID:    "user-1",
+				Format:    "CSV",
+				ProfileID: uuid.New(),
+				Content:   statement,
+			})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "nothing-to-import", slugErr.Slug())
+	})
+
+	t.Run("records the new rows within the open accounting period", func(t *testing.T) {
+		fpID := uuid.New()
+		w := newWalletWithPeriod(t, "OPEN")
+
+		importRepoMock := importing_mocks.NewMockRepository(t)
+		importRepoMock.EXPECT().GetProfile(mock.Anything, mock.Anything, mock.Anything).Return(profile, nil).Once()
+		importRepoMock.
+			EXPECT().
+			ListRecordedTransactionNos(mock.Anything, fpID, []string{"FT001", "FT002", "FT000"}).
+			Return([]string{"FT001"}, nil).
+			Once()
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			GetByIDWithLatestAccountingPeriod(mock.Anything, w.ID()).
+			Return(w, nil).
+			Once()
+		walletRepoMock.
+			EXPECT().
+			CreateTransactionRecords(mock.Anything, w.ID(), mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+
+		err := command.NewImportTransactionsHandler(importRepoMock, walletRepoMock, func() time.Time { return now }).
+			Handle(context.Background(), command.ImportTransactionsCmd{
+				UserID:         "user-1",
+				WalletID:       w.ID(),
+				FundProviderID: fpID,
+				Format:         "CSV",
+				ProfileID:      uuid.New(),
+				Content:        statement,
+			})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("checks the ledger balance of an OFX statement", func(t *testing.T) {
+		ofx := func(ledgerBalance string) []byte {
+			return []byte(`OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>VND
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260405
+<TRNAMT>-150000
+<FITID>FT26095001
+<NAME>EVN HCMC
+</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL>
+<BALAMT>` + ledgerBalance + `
+<DTASOF>20260406
+</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`)
+		}
+
+		testCases := []struct {
+			name          string
+			ledgerBalance string
+			wantSlug      string
+		}{
+			{
+				name:          "refuses the import when the balances differ",
+				ledgerBalance: "900000",
+				wantSlug:      "ledger-balance-mismatch",
+			},
+			{
+				name:          "records the rows when the balances match",
+				ledgerBalance: "850000",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				fp, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1_000_000, 0, "VND", 1)
+				require.NoError(t, err)
+
+				allocation, err := wallet.NewFpAllocation(fp, 1_000_000)
+				require.NoError(t, err)
+
+				w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+					uuid.New(),
+					"Tai chinh tong",
+					1_000_000,
+					"VND",
+					0,
+					1,
+					1,
+					[]*ledger.AccountingPeriod{newPeriod(t, "OPEN")},
+					allocation,
+				)
+				require.NoError(t, err)
+
+				importRepoMock := importing_mocks.NewMockRepository(t)
+				importRepoMock.
+					EXPECT().
+					ListRecordedTransactionNos(mock.Anything, fp.ID(), []string{"FT26095001"}).
+					Return(nil, nil).
+					Once()
+
+				walletRepoMock := wallet_mocks.NewMockRepository(t)
+				walletRepoMock.EXPECT().GetByIDWithLatestAccountingPeriod(mock.Anything, w.ID()).Return(w, nil).Once()
+				walletRepoMock.
+					EXPECT().
+					CreateTransactionRecords(mock.Anything, w.ID(), mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(
+						_ context.Context,
+						_ uuid.UUID,
+						_ wallet.ProviderAllocationSpec,
+						_ ledger.YearMonth,
+						updateFunc func(w *wallet.Wallet) error,
+					) error {
+						return updateFunc(w)
+					}).
+					Once()
+
+				err = command.NewImportTransactionsHandler(importRepoMock, walletRepoMock, func() time.Time { return now }).
+					Handle(context.Background(), command.ImportTransactionsCmd{
+						UserID:         "user-1",
+						WalletID:       w.ID(),
+						FundProviderID: fp.ID(),
+						Format:         "OFX",
+						Content:        ofx(tc.ledgerBalance),
+					})
+
+				if tc.wantSlug == "" {
+					require.NoError(t, err)
+					assert.Equal(t, int64(850_000), fp.Balance().Amount())
+					return
+				}
+
+				var slugErr httperr.SlugError
+				require.ErrorAs(t, err, &slugErr)
+				assert.Equal(t, tc.wantSlug, slugErr.Slug())
+			})
+		}
+	})
+}
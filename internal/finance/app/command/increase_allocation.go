@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+type IncreaseAllocationCmd struct {
+	WalletID       uuid.UUID
+	FundProviderID uuid.UUID
+	Amount         int64
+}
+
+type IncreaseAllocationHandler cqrs.CommandHandler[IncreaseAllocationCmd]
+
+type increaseAllocationHandler struct {
+	walletRepo wallet.Repository
+}
+
+func NewIncreaseAllocationHandler(walletRepo wallet.Repository) IncreaseAllocationHandler {
+	return &increaseAllocationHandler{walletRepo: walletRepo}
+}
+
+func (h *increaseAllocationHandler) Handle(ctx context.Context, cmd IncreaseAllocationCmd) error {
+	if cmd.Amount <= 0 {
+		return httperr.NewIncorrectInputError(wallet.ErrAllocationAmountNotPositive, "invalid-allocation-amount")
+	}
+
+	if err := h.walletRepo.UpdateAllocations(
+		ctx,
+		cmd.WalletID,
+		wallet.NewProviderMatchesAnySpec([]uuid.UUID{cmd.FundProviderID}),
+		func(w *wallet.Wallet) error {
+			return w.IncreaseAllocation(cmd.FundProviderID, cmd.Amount)
+		},
+	); err != nil {
+		if errors.As(err, &wallet.ErrFundAllocatedNotFound{}) {
+			return httperr.NewIncorrectInputError(err, "fund-provider-not-allocated")
+		}
+
+		if errors.As(err, &fundprovider.ErrInsufficientAllocatedAmount{}) {
+			return httperr.NewIncorrectInputError(err, "insufficient-unallocated-amount")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-increase-allocation")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MaterializeRecurringTemplateCmd struct {
+	TemplateID uuid.UUID
+}
+
+type MaterializeRecurringTemplateHandler cqrs.CommandHandler[MaterializeRecurringTemplateCmd]
+
+type materializeRecurringTemplateHandler struct {
+	recurringRepo recurring.Repository
+	now           func() time.Time
+}
+
+// NewMaterializeRecurringTemplateHandler creates the handler posting the due occurrences of a template
+// into the open accounting period of its wallet. now decides which occurrences are due, production code passes time.Now.
+func NewMaterializeRecurringTemplateHandler(
+	recurringRepo recurring.Repository,
+	now func() time.Time,
+) MaterializeRecurringTemplateHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &materializeRecurringTemplateHandler{
+		recurringRepo: recurringRepo,
+		now:           now,
+	}
+}
+
+func (h *materializeRecurringTemplateHandler) Handle(ctx context.Context, cmd MaterializeRecurringTemplateCmd) error {
+	if err := h.recurringRepo.Materialize(ctx, cmd.TemplateID, func(t *recurring.Template, w *wallet.Wallet) error {
+		_, err := t.Materialize(w, h.now())
+		return err
+	}); err != nil {
+		if errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "wallet-has-no-open-accounting-period")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-materialize-recurring-template")
+	}
+
+	return nil
+}
@@ -37,12 +37,20 @@ func (h *openAccountingPeriodHandler) Handle(ctx context.Context, cmd OpenAccoun
 		return httperr.NewIncorrectInputError(err, "failed-to-create-year-month")
 	}
 
-	w, err := h.walletRepo.GetByIDWithAccountingPeriod(ctx, cmd.WalletID, newYearMonth)
+	w, err := h.walletRepo.GetByIDWithLatestAccountingPeriod(ctx, cmd.WalletID)
 	if err != nil {
 		return httperr.NewUnknowError(err, "failed-to-retrieve-wallet")
 	}
 
 	if err := w.OpenAccountingPeriod(newYearMonth); err != nil {
+		if errors.Is(err, ledger.ErrPreviousPeriodNotClosed) {
+			return httperr.NewIncorrectInputError(err, "previous-accounting-period-not-closed")
+		}
+
+		if errors.Is(err, ledger.ErrAccountingPeriodNotContiguous) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-not-contiguous")
+		}
+
 		return httperr.NewIncorrectInputError(err, "failed-to-open-accounting-period")
 	}
 
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PauseRecurringTemplateCmd struct {
+	WalletID   uuid.UUID
+	TemplateID uuid.UUID
+}
+
+type PauseRecurringTemplateHandler cqrs.CommandHandler[PauseRecurringTemplateCmd]
+
+type pauseRecurringTemplateHandler struct {
+	recurringRepo recurring.Repository
+	now           func() time.Time
+}
+
+// NewPauseRecurringTemplateHandler creates the handler pausing a recurring template.
+// now is the clock stamping the pause, production code passes time.Now.
+func NewPauseRecurringTemplateHandler(
+	recurringRepo recurring.Repository,
+	now func() time.Time,
+) PauseRecurringTemplateHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &pauseRecurringTemplateHandler{
+		recurringRepo: recurringRepo,
+		now:           now,
+	}
+}
+
+func (h *pauseRecurringTemplateHandler) Handle(ctx context.Context, cmd PauseRecurringTemplateCmd) error {
+	if err := h.recurringRepo.Update(ctx, cmd.WalletID, cmd.TemplateID, func(t *recurring.Template) error {
+		return t.Pause(h.now())
+	}); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "recurring-template-not-found")
+		}
+
+		if errors.Is(err, recurring.ErrTemplateAlreadyPaused) {
+			return httperr.NewIncorrectInputError(err, "recurring-template-already-paused")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-pause-recurring-template")
+	}
+
+	return nil
+}
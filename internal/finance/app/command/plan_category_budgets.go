@@ -0,0 +1,147 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+// PlanCategoryBudgetsCmd replaces the category budgets of a wallet period, an empty list clears them.
+type PlanCategoryBudgetsCmd struct {
+	UserID    string
+	WalletID  uuid.UUID
+	YearMonth string
+	Budgets   []CategoryBudgetCmd
+}
+
+type CategoryBudgetCmd struct {
+	CategoryID uuid.UUID
+	Amount     int64
+}
+
+type PlanCategoryBudgetsHandler cqrs.CommandHandler[PlanCategoryBudgetsCmd]
+
+type planCategoryBudgetsHandler struct {
+	walletRepo   wallet.Repository
+	ledgerRepo   ledger.Repository
+	categoryRepo category.Repository
+}
+
+func NewPlanCategoryBudgetsHandler(
+	walletRepo wallet.Repository,
+	ledgerRepo ledger.Repository,
+	categoryRepo category.Repository,
+) PlanCategoryBudgetsHandler {
+	return &planCategoryBudgetsHandler{
+		walletRepo:   walletRepo,
+		ledgerRepo:   ledgerRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (h *planCategoryBudgetsHandler) Handle(ctx context.Context, cmd PlanCategoryBudgetsCmd) error {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(cmd.YearMonth)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	if err = h.ensureCategoriesBudgetable(ctx, cmd.UserID, cmd.Budgets); err != nil {
+		return err
+	}
+
+	w, err := h.walletRepo.GetByIDWithAccountingPeriod(ctx, cmd.WalletID, yearMonth)
+	if err != nil {
+		return httperr.NewUnknowError(err, "failed-to-retrieve-wallet")
+	}
+
+	budgets := make([]ledger.CategoryBudget, 0, len(cmd.Budgets))
+	for _, b := range cmd.Budgets {
+		amount, err := valueobject.NewMoney(b.Amount, w.Currency())
+		if err != nil {
+			return httperr.NewIncorrectInputError(err, "invalid-budget-amount")
+		}
+
+		budget, err := ledger.NewCategoryBudget(b.CategoryID, amount)
+		if err != nil {
+			return httperr.NewIncorrectInputError(err, "invalid-budget-amount")
+		}
+		budgets = append(budgets, budget)
+	}
+
+	if err = w.PlanBudgets(yearMonth, budgets...); err != nil {
+		if errors.Is(err, ledger.ErrAccountingPeriodAlreadyClosed) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-already-closed")
+		}
+
+		if errors.Is(err, ledger.ErrDuplicateCategoryBudget) {
+			return httperr.NewIncorrectInputError(err, "duplicate-category-budget")
+		}
+
+		return httperr.NewIncorrectInputError(err, "failed-to-plan-budgets")
+	}
+
+	ap, exist := w.LedgerManager().FindAccountingPeriod(yearMonth)
+	if !exist {
+		return httperr.NewUnknowError(
+			errors.New("budgets are planned in domain but the accounting period is not found in wallet domain"),
+			"failed-to-plan-budgets",
+		)
+	}
+
+	if err = h.ledgerRepo.UpdateCategoryBudgets(ctx, ap); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-update-category-budgets")
+	}
+
+	return nil
+}
+
+// ensureCategoriesBudgetable checks that every budgeted category is an expense category of userID.
+func (h *planCategoryBudgetsHandler) ensureCategoriesBudgetable(
+	ctx context.Context,
+	userID string,
+	budgets []CategoryBudgetCmd,
+) error {
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	categoryIDs := make([]uuid.UUID, 0, len(budgets))
+	for _, b := range budgets {
+		categoryIDs = append(categoryIDs, b.CategoryID)
+	}
+
+	categories, err := h.categoryRepo.GetByIDs(ctx, userID, categoryIDs)
+	if err != nil {
+		return httperr.NewUnknowError(err, "failed-to-get-categories")
+	}
+
+	categoryByID := make(map[uuid.UUID]*category.Category, len(categories))
+	for _, c := range categories {
+		categoryByID[c.ID()] = c
+	}
+
+	for _, cID := range categoryIDs {
+		c, exist := categoryByID[cID]
+		if !exist {
+			return httperr.NewIncorrectInputError(
+				fmt.Errorf("category '%s': %w", cID.String(), common_db.ErrNotFound),
+				"category-not-found",
+			)
+		}
+
+		if err = c.CanBeBudgeted(); err != nil {
+			return httperr.NewIncorrectInputError(err, "category-not-budgetable")
+		}
+	}
+
+	return nil
+}
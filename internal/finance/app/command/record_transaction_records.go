@@ -3,18 +3,43 @@ package command
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"sumni-finance-backend/internal/common/convert"
 	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
 	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/category"
 	"sumni-finance-backend/internal/finance/domain/ledger"
 	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type RecordTransactionRecordsCmd struct {
+	// UserID owns the categories of the records
+	UserID             string
 	WalletID           uuid.UUID
 	YearMonth          string
 	TransactionRecords []TransactionRecordCmd
+
+	// ConfirmDuplicates records the transactions even if they look like recorded ones. Without it nothing is recorded
+	// when a transaction looks like a recorded one, same fund provider and amount within DuplicateWindowDays,
+	// and a DuplicateTransactionsError listing the candidates is returned instead
+	ConfirmDuplicates bool
+	// DuplicateWindowDays defaults to query.DefaultDuplicateWindowDays
+	DuplicateWindowDays int
+}
+
+// DuplicateTransactionsError lists the transactions looking like recorded ones, none of the transactions was recorded.
+type DuplicateTransactionsError struct {
+	Warnings []query.DuplicateWarning
+}
+
+func (e DuplicateTransactionsError) Error() string {
+	return fmt.Sprintf("%d transactions look like recorded ones", len(e.Warnings))
 }
 
 type TransactionRecordCmd struct {
@@ -22,17 +47,42 @@ type TransactionRecordCmd struct {
 	Amount          int64
 	TransactionNo   string
 	TransactionType string
-	Description     string
+	// Direction is IN or OUT, only required for an ADJUSTMENT
+	Direction   string
+	Description string
+	// OccurredAt is the business date of the transaction, defaults to the moment of recording
+	OccurredAt *time.Time
+	CategoryID *uuid.UUID
 }
 
 type RecordTransactionRecordsHandler cqrs.CommandHandler[RecordTransactionRecordsCmd]
 
 type recordTransactionRecordsHandler struct {
-	walletRepo wallet.Repository
+	walletRepo   wallet.Repository
+	categoryRepo category.Repository
+	duplicates   query.FindDuplicateTransactionsHandler
+	now          func() time.Time
 }
 
-func NewRecordTransactionRecordsHandler(walletRepo wallet.Repository) RecordTransactionRecordsHandler {
-	return &recordTransactionRecordsHandler{walletRepo: walletRepo}
+// NewRecordTransactionRecordsHandler creates the handler recording transactions into an accounting period.
+// duplicates looks up the recorded transactions in warning mode.
+// now is the clock stamping the recorded time, production code passes time.Now.
+func NewRecordTransactionRecordsHandler(
+	walletRepo wallet.Repository,
+	categoryRepo category.Repository,
+	duplicates query.FindDuplicateTransactionsHandler,
+	now func() time.Time,
+) RecordTransactionRecordsHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &recordTransactionRecordsHandler{
+		walletRepo:   walletRepo,
+		categoryRepo: categoryRepo,
+		duplicates:   duplicates,
+		now:          now,
+	}
 }
 
 func (h *recordTransactionRecordsHandler) Handle(ctx context.Context, cmd RecordTransactionRecordsCmd) error {
@@ -43,7 +93,18 @@ func (h *recordTransactionRecordsHandler) Handle(ctx context.Context, cmd Record
 		)
 	}
 
-	fpIDs, txSpecs := h.extractFpIDsAndBuildTxSpec(cmd.TransactionRecords)
+	if !cmd.ConfirmDuplicates {
+		if err := h.checkDuplicates(ctx, cmd); err != nil {
+			return err
+		}
+	}
+
+	categories, err := h.getCategories(ctx, cmd.UserID, cmd.TransactionRecords)
+	if err != nil {
+		return err
+	}
+
+	fpIDs, txSpecs := h.extractFpIDsAndBuildTxSpec(cmd.TransactionRecords, categories, h.now())
 	yearMonth, err := ledger.UnmarshalYearMonthFromString(cmd.YearMonth)
 	if err != nil {
 		return httperr.NewIncorrectInputError(err, "invalid-year-month-format")
@@ -62,13 +123,110 @@ func (h *recordTransactionRecordsHandler) Handle(ctx context.Context, cmd Record
 			return nil
 		},
 	); err != nil {
+		if errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "wallet-has-no-open-accounting-period")
+		}
+
+		if errors.Is(err, ledger.ErrAccountingPeriodAlreadyClosed) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-already-closed")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionOutsidePeriod) {
+			return httperr.NewIncorrectInputError(err, "transaction-outside-accounting-period")
+		}
+
+		if errors.Is(err, ledger.ErrDirectionRequired) {
+			return httperr.NewIncorrectInputError(err, "transaction-direction-required")
+		}
+
+		if errors.Is(err, wallet.ErrTransferRecordNotAllowed) {
+			return httperr.NewIncorrectInputError(err, "transfer-record-not-allowed")
+		}
+
+		if errors.Is(err, category.ErrCategoryKindMismatch) {
+			return httperr.NewIncorrectInputError(err, "category-kind-mismatch")
+		}
+
+		if errors.Is(err, wallet.ErrDuplicateTransactionNo) {
+			return httperr.NewIncorrectInputError(err, "duplicate-transaction-no")
+		}
+
 		return httperr.NewUnknowError(err, "failed-to-create-ledger-records")
 	}
 
 	return nil
 }
 
-func (h *recordTransactionRecordsHandler) extractFpIDsAndBuildTxSpec(transactionRecords []TransactionRecordCmd) (
+// checkDuplicates returns a DuplicateTransactionsError when a transaction looks like a recorded one.
+func (h *recordTransactionRecordsHandler) checkDuplicates(ctx context.Context, cmd RecordTransactionRecordsCmd) error {
+	checks := make([]query.DuplicateCheck, 0, len(cmd.TransactionRecords))
+	for _, tr := range cmd.TransactionRecords {
+		checks = append(checks, query.DuplicateCheck{
+			FundProviderID: tr.FundProviderID,
+			Amount:         tr.Amount,
+			OccurredAt:     tr.OccurredAt,
+		})
+	}
+
+	warnings, err := h.duplicates.Handle(ctx, query.FindDuplicateTransactions{
+		Transactions: checks,
+		WindowDays:   cmd.DuplicateWindowDays,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		return DuplicateTransactionsError{Warnings: warnings}
+	}
+
+	return nil
+}
+
+// getCategories loads the categories referenced by the records, indexed by id.
+func (h *recordTransactionRecordsHandler) getCategories(
+	ctx context.Context,
+	userID string,
+	transactionRecords []TransactionRecordCmd,
+) (map[uuid.UUID]*category.Category, error) {
+	categoryIDs := make([]uuid.UUID, 0)
+	for _, tr := range transactionRecords {
+		if tr.CategoryID != nil && !slices.Contains(categoryIDs, *tr.CategoryID) {
+			categoryIDs = append(categoryIDs, *tr.CategoryID)
+		}
+	}
+
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+
+	categories, err := h.categoryRepo.GetByIDs(ctx, userID, categoryIDs)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-get-categories")
+	}
+
+	categoryByID := make(map[uuid.UUID]*category.Category, len(categories))
+	for _, c := range categories {
+		categoryByID[c.ID()] = c
+	}
+
+	for _, cID := range categoryIDs {
+		if _, exist := categoryByID[cID]; !exist {
+			return nil, httperr.NewIncorrectInputError(
+				fmt.Errorf("category '%s': %w", cID.String(), common_db.ErrNotFound),
+				"category-not-found",
+			)
+		}
+	}
+
+	return categoryByID, nil
+}
+
+func (h *recordTransactionRecordsHandler) extractFpIDsAndBuildTxSpec(
+	transactionRecords []TransactionRecordCmd,
+	categories map[uuid.UUID]*category.Category,
+	recordedAt time.Time,
+) (
 	[]uuid.UUID,
 	[]wallet.TransactionSpec,
 ) {
@@ -82,12 +240,21 @@ func (h *recordTransactionRecordsHandler) extractFpIDsAndBuildTxSpec(transaction
 			fpIDs = append(fpIDs, tr.FundProviderID)
 		}
 
+		var c *category.Category
+		if tr.CategoryID != nil {
+			c = categories[*tr.CategoryID]
+		}
+
 		txSpecs = append(txSpecs, wallet.TransactionSpec{
 			TransactionNo:   tr.TransactionNo,
 			TransactionType: tr.TransactionType,
+			Direction:       tr.Direction,
 			Amount:          tr.Amount,
 			Description:     tr.Description,
 			FpID:            tr.FundProviderID,
+			OccurredAt:      convert.SafeDeref(tr.OccurredAt, recordedAt),
+			RecordedAt:      recordedAt,
+			Category:        c,
 		})
 	}
 
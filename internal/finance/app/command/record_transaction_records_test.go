@@ -0,0 +1,95 @@
+package command_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	category_mocks "sumni-finance-backend/internal/finance/domain/category/mocks"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type duplicatesStub struct {
+	warnings []query.DuplicateWarning
+	queries  []query.FindDuplicateTransactions
+}
+
+func (s *duplicatesStub) Handle(ctx context.Context, q query.FindDuplicateTransactions) ([]query.DuplicateWarning, error) {
+	s.queries = append(s.queries, q)
+	return s.warnings, nil
+}
+
+func TestRecordTransactionRecordsHandler_Handle_Duplicates(t *testing.T) {
+	now := func() time.Time { return time.Date(2026, time.April, 20, 9, 0, 0, 0, time.Local) }
+	fpID := uuid.New()
+
+	newCmd := func(confirmDuplicates bool) command.RecordTransactionRecordsCmd {
+		return command.RecordTransactionRecordsCmd{
+			UserID:    "user-1",
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+			TransactionRecords: []command.TransactionRecordCmd{
+				{FundProviderID: fpID, Amount: 100, TransactionType: "WITHDRAWAL"},
+				{FundProviderID: fpID, Amount: 200, TransactionType: "WITHDRAWAL"},
+			},
+			ConfirmDuplicates:   confirmDuplicates,
+			DuplicateWindowDays: 7,
+		}
+	}
+
+	t.Run("records without looking for duplicates once they are confirmed", func(t *testing.T) {
+		duplicates := &duplicatesStub{
+			warnings: []query.DuplicateWarning{{Index: 0, Candidates: []query.DuplicateCandidate{{ID: uuid.New()}}}},
+		}
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			CreateTransactionRecords(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+
+		err := command.NewRecordTransactionRecordsHandler(walletRepoMock, category_mocks.NewMockRepository(t), duplicates, now).
+			Handle(context.Background(), newCmd(true))
+
+		require.NoError(t, err)
+		assert.Empty(t, duplicates.queries)
+	})
+
+	t.Run("records nothing and returns the candidates by default", func(t *testing.T) {
+		warnings := []query.DuplicateWarning{{Index: 1, Candidates: []query.DuplicateCandidate{{ID: uuid.New(), Amount: 200}}}}
+		duplicates := &duplicatesStub{warnings: warnings}
+
+		err := command.NewRecordTransactionRecordsHandler(wallet_mocks.NewMockRepository(t), category_mocks.NewMockRepository(t), duplicates, now).
+			Handle(context.Background(), newCmd(false))
+
+		var duplicateErr command.DuplicateTransactionsError
+		require.ErrorAs(t, err, &duplicateErr)
+		assert.Equal(t, warnings, duplicateErr.Warnings)
+
+		// Every transaction is checked in a single lookup
+		require.Len(t, duplicates.queries, 1)
+		assert.Len(t, duplicates.queries[0].Transactions, 2)
+		assert.Equal(t, 7, duplicates.queries[0].WindowDays)
+	})
+
+	t.Run("records when nothing looks like a recorded transaction", func(t *testing.T) {
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			CreateTransactionRecords(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+
+		err := command.NewRecordTransactionRecordsHandler(walletRepoMock, category_mocks.NewMockRepository(t), &duplicatesStub{}, now).
+			Handle(context.Background(), newCmd(false))
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+type RemoveAllocationCmd struct {
+	WalletID       uuid.UUID
+	FundProviderID uuid.UUID
+}
+
+type RemoveAllocationHandler cqrs.CommandHandler[RemoveAllocationCmd]
+
+type removeAllocationHandler struct {
+	walletRepo wallet.Repository
+}
+
+func NewRemoveAllocationHandler(walletRepo wallet.Repository) RemoveAllocationHandler {
+	return &removeAllocationHandler{walletRepo: walletRepo}
+}
+
+func (h *removeAllocationHandler) Handle(ctx context.Context, cmd RemoveAllocationCmd) error {
+	if err := h.walletRepo.UpdateAllocations(
+		ctx,
+		cmd.WalletID,
+		wallet.NewProviderMatchesAnySpec([]uuid.UUID{cmd.FundProviderID}),
+		func(w *wallet.Wallet) error {
+			return w.RemoveFundProvider(cmd.FundProviderID)
+		},
+	); err != nil {
+		if errors.As(err, &wallet.ErrFundAllocatedNotFound{}) {
+			return httperr.NewIncorrectInputError(err, "fund-provider-not-allocated")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-remove-allocation")
+	}
+
+	return nil
+}
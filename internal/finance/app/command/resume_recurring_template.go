@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ResumeRecurringTemplateCmd struct {
+	WalletID   uuid.UUID
+	TemplateID uuid.UUID
+}
+
+type ResumeRecurringTemplateHandler cqrs.CommandHandler[ResumeRecurringTemplateCmd]
+
+type resumeRecurringTemplateHandler struct {
+	recurringRepo recurring.Repository
+	now           func() time.Time
+}
+
+// NewResumeRecurringTemplateHandler creates the handler resuming a paused recurring template.
+// now is the clock deciding which dates came due during the pause, production code passes time.Now.
+func NewResumeRecurringTemplateHandler(
+	recurringRepo recurring.Repository,
+	now func() time.Time,
+) ResumeRecurringTemplateHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &resumeRecurringTemplateHandler{
+		recurringRepo: recurringRepo,
+		now:           now,
+	}
+}
+
+func (h *resumeRecurringTemplateHandler) Handle(ctx context.Context, cmd ResumeRecurringTemplateCmd) error {
+	if err := h.recurringRepo.Update(ctx, cmd.WalletID, cmd.TemplateID, func(t *recurring.Template) error {
+		return t.Resume(h.now())
+	}); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "recurring-template-not-found")
+		}
+
+		if errors.Is(err, recurring.ErrTemplateNotPaused) {
+			return httperr.NewIncorrectInputError(err, "recurring-template-not-paused")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-resume-recurring-template")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReverseTransactionCmd struct {
+	WalletID      uuid.UUID
+	TransactionID uuid.UUID
+	// Description of the reversal record, a default one referencing the original is used when empty
+	Description string
+}
+
+type ReverseTransactionHandler cqrs.CommandHandler[ReverseTransactionCmd]
+
+type reverseTransactionHandler struct {
+	walletRepo wallet.Repository
+	now        func() time.Time
+}
+
+// NewReverseTransactionHandler creates the handler booking the compensating record of a transaction.
+// now is the clock stamping the reversal, production code passes time.Now.
+func NewReverseTransactionHandler(
+	walletRepo wallet.Repository,
+	now func() time.Time,
+) ReverseTransactionHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &reverseTransactionHandler{
+		walletRepo: walletRepo,
+		now:        now,
+	}
+}
+
+func (h *reverseTransactionHandler) Handle(ctx context.Context, cmd ReverseTransactionCmd) error {
+	reversedAt := h.now()
+
+	if err := h.walletRepo.ReverseTransactionRecord(
+		ctx,
+		cmd.WalletID,
+		cmd.TransactionID,
+		func(w *wallet.Wallet, original *ledger.TransactionRecord) error {
+			return w.ReverseTransaction(original, cmd.Description, reversedAt)
+		},
+	); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "transaction-not-found")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionAlreadyReversed) {
+			return httperr.NewIncorrectInputError(err, "transaction-already-reversed")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionNotReversible) {
+			return httperr.NewIncorrectInputError(err, "transaction-not-reversible")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionReconciled) {
+			return httperr.NewIncorrectInputError(err, "transaction-reconciled")
+		}
+
+		if errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "no-open-accounting-period")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionOutsidePeriod) {
+			return httperr.NewIncorrectInputError(err, "transaction-outside-accounting-period")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-reverse-transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package command_test
+
+import (
+	"context"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseTransactionHandler_Handle(t *testing.T) {
+	now := time.Date(2026, time.May, 10, 0, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name     string
+		repoErr  error
+		wantSlug string
+	}{
+		{
+			name:     "returns not found when the transaction does not exist",
+			repoErr:  fmt.Errorf("transaction record: %w", common_db.ErrNotFound),
+			wantSlug: "transaction-not-found",
+		},
+		{
+			name:     "returns error when the transaction is already reversed",
+			repoErr:  ledger.ErrTransactionAlreadyReversed,
+			wantSlug: "transaction-already-reversed",
+		},
+		{
+			name:     "returns error when the transaction can not be reversed",
+			repoErr:  ledger.ErrTransactionNotReversible,
+			wantSlug: "transaction-not-reversible",
+		},
+		{
+			name:     "returns error when the transaction is cleared by a finalised reconciliation",
+			repoErr:  ledger.ErrTransactionReconciled,
+			wantSlug: "transaction-reconciled",
+		},
+		{
+			name:     "returns unknown error when wallet repo fails",
+			repoErr:  assert.AnError,
+			wantSlug: "failed-to-reverse-transaction",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			walletRepoMock := wallet_mocks.NewMockRepository(t)
+			walletRepoMock.
+				EXPECT().
+				ReverseTransactionRecord(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(tc.repoErr).
+				Once()
+
+			err := command.NewReverseTransactionHandler(walletRepoMock, func() time.Time { return now }).
+				Handle(context.Background(), command.ReverseTransactionCmd{
+					WalletID:      uuid.New(),
+					TransactionID: uuid.New(),
+				})
+
+			var slugErr httperr.SlugError
+			require.ErrorAs(t, err, &slugErr)
+			assert.Equal(t, tc.wantSlug, slugErr.Slug())
+		})
+	}
+
+	t.Run("reverses the requested transaction", func(t *testing.T) {
+		wID, txID := uuid.New(), uuid.New()
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			ReverseTransactionRecord(mock.Anything, wID, txID, mock.Anything).
+			Return(nil).
+			Once()
+
+		err := command.NewReverseTransactionHandler(walletRepoMock, func() time.Time { return now }).
+			Handle(context.Background(), command.ReverseTransactionCmd{
+				WalletID:      wID,
+				TransactionID: txID,
+			})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RolloverAccountingPeriodsCmd struct {
+	WalletID uuid.UUID
+}
+
+type RolloverAccountingPeriodsHandler cqrs.CommandHandler[RolloverAccountingPeriodsCmd]
+
+type rolloverAccountingPeriodsHandler struct {
+	walletRepo    wallet.Repository
+	recurringRepo recurring.Repository
+	now           func() time.Time
+}
+
+// NewRolloverAccountingPeriodsHandler creates the handler closing the ended period of a wallet and opening the next one.
+// now is the clock used to decide whether the period has ended, production code passes time.Now.
+func NewRolloverAccountingPeriodsHandler(
+	walletRepo wallet.Repository,
+	recurringRepo recurring.Repository,
+	now func() time.Time,
+) RolloverAccountingPeriodsHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &rolloverAccountingPeriodsHandler{
+		walletRepo:    walletRepo,
+		recurringRepo: recurringRepo,
+		now:           now,
+	}
+}
+
+func (h *rolloverAccountingPeriodsHandler) Handle(ctx context.Context, cmd RolloverAccountingPeriodsCmd) error {
+	now := h.now()
+
+	// The occurrences due before the period ends are posted before it is closed, they could not be posted afterwards
+	if err := h.materializeRecurringTemplates(ctx, cmd.WalletID, now); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-materialize-recurring-templates")
+	}
+
+	if err := h.walletRepo.UpdateWithLatestAccountingPeriod(ctx, cmd.WalletID, func(w *wallet.Wallet) error {
+		_, err := w.RolloverAccountingPeriods(now)
+		return err
+	}); err != nil {
+		if errors.Is(err, wallet.ErrNoAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "wallet-has-no-accounting-period")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-rollover-accounting-periods")
+	}
+
+	return nil
+}
+
+func (h *rolloverAccountingPeriodsHandler) materializeRecurringTemplates(ctx context.Context, wID uuid.UUID, now time.Time) error {
+	tIDs, err := h.recurringRepo.ListActiveTemplateIDs(ctx, wID, now)
+	if err != nil {
+		return err
+	}
+
+	for _, tID := range tIDs {
+		if err := h.recurringRepo.Materialize(ctx, tID, func(t *recurring.Template, w *wallet.Wallet) error {
+			_, err := t.Materialize(w, now)
+			return err
+		}); err != nil && !errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return fmt.Errorf("failed to materialize recurring template %s: %w", tID, err)
+		}
+	}
+
+	return nil
+}
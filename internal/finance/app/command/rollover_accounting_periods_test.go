@@ -0,0 +1,194 @@
+package command_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	recurring_mocks "sumni-finance-backend/internal/finance/domain/recurring/mocks"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloverAccountingPeriodsHandler_Handle(t *testing.T) {
+	endDate := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local)
+
+	runUpdateFunc := func(t *testing.T, w *wallet.Wallet) func(context.Context, uuid.UUID, func(*wallet.Wallet) error) error {
+		t.Helper()
+
+		return func(ctx context.Context, wID uuid.UUID, updateFunc func(*wallet.Wallet) error) error {
+			return updateFunc(w)
+		}
+	}
+
+	withoutTemplates := func(t *testing.T) *recurring_mocks.MockRepository {
+		t.Helper()
+
+		recurringRepoMock := recurring_mocks.NewMockRepository(t)
+		recurringRepoMock.
+			EXPECT().
+			ListActiveTemplateIDs(mock.Anything, mock.Anything, endDate).
+			Return(nil, nil).
+			Once()
+
+		return recurringRepoMock
+	}
+
+	t.Run("returns error when wallet has no accounting period", func(t *testing.T) {
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 1_000_000, "VND", 1, 1, 1, nil)
+		require.NoError(t, err)
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			UpdateWithLatestAccountingPeriod(mock.Anything, w.ID(), mock.Anything).
+			RunAndReturn(runUpdateFunc(t, w)).
+			Once()
+
+		err = command.NewRolloverAccountingPeriodsHandler(walletRepoMock, withoutTemplates(t), func() time.Time { return endDate }).
+			Handle(context.Background(), command.RolloverAccountingPeriodsCmd{WalletID: w.ID()})
+
+		require.ErrorIs(t, err, wallet.ErrNoAccountingPeriod)
+	})
+
+	t.Run("returns error when wallet repo fails", func(t *testing.T) {
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			UpdateWithLatestAccountingPeriod(mock.Anything, mock.Anything, mock.Anything).
+			Return(assert.AnError).
+			Once()
+
+		err := command.NewRolloverAccountingPeriodsHandler(walletRepoMock, withoutTemplates(t), func() time.Time { return endDate }).
+			Handle(context.Background(), command.RolloverAccountingPeriodsCmd{WalletID: uuid.New()})
+
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("rolls the ended period over", func(t *testing.T) {
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			"2026,4",
+			1,
+			1,
+			"OPEN",
+			1_000_000,
+			0,
+			0,
+			0,
+			0,
+			0,
+			"VND",
+			endDate.AddDate(0, -1, 0),
+			endDate,
+			0,
+		)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 1_000_000, "VND", 1, 1, 1, []*ledger.AccountingPeriod{ap})
+		require.NoError(t, err)
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			UpdateWithLatestAccountingPeriod(mock.Anything, w.ID(), mock.Anything).
+			RunAndReturn(runUpdateFunc(t, w)).
+			Once()
+
+		err = command.NewRolloverAccountingPeriodsHandler(walletRepoMock, withoutTemplates(t), func() time.Time { return endDate }).
+			Handle(context.Background(), command.RolloverAccountingPeriodsCmd{WalletID: w.ID()})
+
+		require.NoError(t, err)
+		assert.True(t, ap.IsClose())
+
+		latest, exists := w.LedgerManager().LatestAccountingPeriod()
+		require.True(t, exists)
+		assert.Equal(t, ap.NextYearMonth(), latest.YearMonth())
+	})
+
+	t.Run("posts the occurrences due before the end of the period before closing it", func(t *testing.T) {
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), "2026,4", 1, 1, "OPEN", 1_000_000, 0, 0, 0, 0, 1_000_000, "VND",
+			endDate.AddDate(0, -1, 0), endDate, 0,
+		)
+		require.NoError(t, err)
+
+		provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1_000_000, 0, "VND", 1)
+		require.NoError(t, err)
+
+		allocation, err := wallet.NewFpAllocation(provider, 1_000_000)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 1_000_000, "VND", 0, 1, 1, []*ledger.AccountingPeriod{ap}, allocation)
+		require.NoError(t, err)
+
+		schedule, err := recurring.NewSchedule("WEEKLY", 1, 0, time.Date(2026, time.April, 6, 0, 0, 0, 0, time.Local))
+		require.NoError(t, err)
+
+		tmpl, err := recurring.NewTemplate("user-1", w.ID(), provider.ID(), 100_000, "WITHDRAWAL", nil, "Đi chợ", schedule)
+		require.NoError(t, err)
+
+		// The materializer last ran mid-month, April 20 and 27 came due since then
+		_, err = tmpl.Materialize(w, time.Date(2026, time.April, 14, 0, 0, 0, 0, time.Local))
+		require.NoError(t, err)
+		require.Len(t, ap.Transactions(), 2)
+
+		recurringRepoMock := recurring_mocks.NewMockRepository(t)
+		recurringRepoMock.
+			EXPECT().
+			ListActiveTemplateIDs(mock.Anything, w.ID(), endDate).
+			Return([]uuid.UUID{tmpl.ID()}, nil).
+			Once()
+		recurringRepoMock.
+			EXPECT().
+			Materialize(mock.Anything, tmpl.ID(), mock.Anything).
+			RunAndReturn(func(ctx context.Context, tID uuid.UUID, materializeFn func(*recurring.Template, *wallet.Wallet) error) error {
+				return materializeFn(tmpl, w)
+			}).
+			Once()
+
+		walletRepoMock := wallet_mocks.NewMockRepository(t)
+		walletRepoMock.
+			EXPECT().
+			UpdateWithLatestAccountingPeriod(mock.Anything, w.ID(), mock.Anything).
+			RunAndReturn(runUpdateFunc(t, w)).
+			Once()
+
+		err = command.NewRolloverAccountingPeriodsHandler(walletRepoMock, recurringRepoMock, func() time.Time { return endDate }).
+			Handle(context.Background(), command.RolloverAccountingPeriodsCmd{WalletID: w.ID()})
+
+		require.NoError(t, err)
+		assert.True(t, ap.IsClose())
+
+		require.Len(t, ap.Transactions(), 4)
+		assert.Equal(t, time.Date(2026, time.April, 27, 0, 0, 0, 0, time.Local), ap.Transactions()[3].OccurredAt())
+		assert.Equal(t, int64(600_000), w.Balance().Amount())
+	})
+
+	t.Run("returns error when recurring templates cannot be materialized", func(t *testing.T) {
+		recurringRepoMock := recurring_mocks.NewMockRepository(t)
+		recurringRepoMock.
+			EXPECT().
+			ListActiveTemplateIDs(mock.Anything, mock.Anything, mock.Anything).
+			Return([]uuid.UUID{uuid.New()}, nil).
+			Once()
+		recurringRepoMock.
+			EXPECT().
+			Materialize(mock.Anything, mock.Anything, mock.Anything).
+			Return(assert.AnError).
+			Once()
+
+		err := command.NewRolloverAccountingPeriodsHandler(wallet_mocks.NewMockRepository(t), recurringRepoMock, func() time.Time { return endDate }).
+			Handle(context.Background(), command.RolloverAccountingPeriodsCmd{WalletID: uuid.New()})
+
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
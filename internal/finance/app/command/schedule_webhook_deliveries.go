@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"time"
+)
+
+// ScheduleWebhookDeliveriesCmd schedules a delivery of a relayed event to every subscription accepting it
+// among the subscriptions of the user who raised it, an event raised without a user is posted to nobody.
+// An event relayed again is not scheduled twice for a subscription.
+type ScheduleWebhookDeliveriesCmd struct {
+	Event event.Envelope
+}
+
+type ScheduleWebhookDeliveriesHandler cqrs.CommandHandler[ScheduleWebhookDeliveriesCmd]
+
+type scheduleWebhookDeliveriesHandler struct {
+	webhookRepo webhook.Repository
+	now         func() time.Time
+}
+
+func NewScheduleWebhookDeliveriesHandler(
+	webhookRepo webhook.Repository,
+	now func() time.Time,
+) ScheduleWebhookDeliveriesHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &scheduleWebhookDeliveriesHandler{
+		webhookRepo: webhookRepo,
+		now:         now,
+	}
+}
+
+func (h *scheduleWebhookDeliveriesHandler) Handle(ctx context.Context, cmd ScheduleWebhookDeliveriesCmd) error {
+	if cmd.Event.UserID == "" {
+		return nil
+	}
+
+	subscriptions, err := h.webhookRepo.ListSubscriptions(ctx, cmd.Event.UserID)
+	if err != nil {
+		return httperr.NewUnknowError(err, "failed-to-list-webhook-subscriptions")
+	}
+
+	var deliveries []*webhook.Delivery
+	for _, s := range subscriptions {
+		if s.UserID() != cmd.Event.UserID || !s.Accepts(cmd.Event.Event.EventName()) {
+			continue
+		}
+
+		d, err := webhook.NewDelivery(s.ID(), cmd.Event, h.now())
+		if err != nil {
+			return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err = h.webhookRepo.CreateDeliveries(ctx, deliveries); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-schedule-webhook-deliveries")
+	}
+
+	return nil
+}
+
+// webhookSubscriber hands the events relayed from the outbox to the webhook deliveries.
+type webhookSubscriber struct {
+	schedule ScheduleWebhookDeliveriesHandler
+}
+
+func NewWebhookSubscriber(schedule ScheduleWebhookDeliveriesHandler) event.Subscriber {
+	return &webhookSubscriber{schedule: schedule}
+}
+
+func (s *webhookSubscriber) Name() string { return "webhooks" }
+
+func (s *webhookSubscriber) Handle(ctx context.Context, env event.Envelope) error {
+	return s.schedule.Handle(ctx, ScheduleWebhookDeliveriesCmd{Event: env})
+}
@@ -0,0 +1,103 @@
+package command_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	webhook_mocks "sumni-finance-backend/internal/finance/domain/webhook/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleWebhookDeliveriesHandler_Handle(t *testing.T) {
+	scheduledAt := time.Date(2026, time.May, 4, 10, 0, 0, 0, time.Local)
+	now := func() time.Time { return scheduledAt }
+
+	newSubscription := func(t *testing.T, eventTypes ...string) *webhook.Subscription {
+		t.Helper()
+
+		s, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", "0123456789abcdef", eventTypes, scheduledAt)
+		require.NoError(t, err)
+
+		return s
+	}
+
+	env := event.Envelope{
+		ID:         uuid.New(),
+		UserID:     "user-1",
+		OccurredAt: scheduledAt,
+		Event:      event.PeriodClosed{AccountingPeriodID: uuid.New()},
+	}
+
+	t.Run("schedules a delivery for every subscription of the user accepting the event", func(t *testing.T) {
+		all := newSubscription(t)
+		closings := newSubscription(t, event.NamePeriodClosed)
+		transactions := newSubscription(t, event.NameTransactionRecorded)
+
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+		webhookRepoMock.EXPECT().ListSubscriptions(mock.Anything, "user-1").
+			Return([]*webhook.Subscription{all, closings, transactions}, nil).Once()
+
+		var scheduled []*webhook.Delivery
+		webhookRepoMock.EXPECT().CreateDeliveries(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, deliveries []*webhook.Delivery) error {
+				scheduled = deliveries
+				return nil
+			}).Once()
+
+		err := command.NewWebhookSubscriber(command.NewScheduleWebhookDeliveriesHandler(webhookRepoMock, now)).
+			Handle(context.Background(), env)
+
+		require.NoError(t, err)
+		require.Len(t, scheduled, 2)
+		assert.Equal(t, all.ID(), scheduled[0].SubscriptionID())
+		assert.Equal(t, closings.ID(), scheduled[1].SubscriptionID())
+		for _, d := range scheduled {
+			assert.Equal(t, env.ID, d.EventID())
+			assert.Equal(t, scheduledAt, d.NextAttemptAt())
+		}
+	})
+
+	t.Run("stores nothing when no subscription accepts the event", func(t *testing.T) {
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+		webhookRepoMock.EXPECT().ListSubscriptions(mock.Anything, "user-1").
+			Return([]*webhook.Subscription{newSubscription(t, event.NameTransactionRecorded)}, nil).Once()
+
+		err := command.NewScheduleWebhookDeliveriesHandler(webhookRepoMock, now).
+			Handle(context.Background(), command.ScheduleWebhookDeliveriesCmd{Event: env})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("skips the subscriptions of other users", func(t *testing.T) {
+		other, err := webhook.NewSubscription("user-2", "https://bot.example.com/hook", "0123456789abcdef", nil, scheduledAt)
+		require.NoError(t, err)
+
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+		webhookRepoMock.EXPECT().ListSubscriptions(mock.Anything, "user-1").
+			Return([]*webhook.Subscription{other}, nil).Once()
+
+		err = command.NewScheduleWebhookDeliveriesHandler(webhookRepoMock, now).
+			Handle(context.Background(), command.ScheduleWebhookDeliveriesCmd{Event: env})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("posts an event raised without a user to nobody", func(t *testing.T) {
+		webhookRepoMock := webhook_mocks.NewMockRepository(t)
+
+		anonymous := env
+		anonymous.UserID = ""
+
+		err := command.NewScheduleWebhookDeliveriesHandler(webhookRepoMock, now).
+			Handle(context.Background(), command.ScheduleWebhookDeliveriesCmd{Event: anonymous})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/category"
+)
+
+// SeedDefaultCategoriesCmd gives a user the default household categories.
+// It does nothing once the user has categories, so it is safe to repeat.
+type SeedDefaultCategoriesCmd struct {
+	UserID string
+}
+
+type SeedDefaultCategoriesHandler cqrs.CommandHandler[SeedDefaultCategoriesCmd]
+
+type seedDefaultCategoriesHandler struct {
+	categoryRepo category.Repository
+}
+
+func NewSeedDefaultCategoriesHandler(categoryRepo category.Repository) SeedDefaultCategoriesHandler {
+	return &seedDefaultCategoriesHandler{categoryRepo: categoryRepo}
+}
+
+func (h *seedDefaultCategoriesHandler) Handle(ctx context.Context, cmd SeedDefaultCategoriesCmd) error {
+	categories, err := category.NewDefaultCategories(cmd.UserID)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if _, err = h.categoryRepo.CreateDefaults(ctx, cmd.UserID, categories); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-seed-default-categories")
+	}
+
+	return nil
+}
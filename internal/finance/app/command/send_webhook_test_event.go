@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SendWebhookTestEventCmd posts a WebhookTest event to the endpoint of a subscription right away.
+// The outcome is logged with the deliveries of the subscription, a failed test is retried like any delivery.
+type SendWebhookTestEventCmd struct {
+	UserID         string
+	SubscriptionID uuid.UUID
+}
+
+type SendWebhookTestEventHandler cqrs.CommandHandler[SendWebhookTestEventCmd]
+
+type sendWebhookTestEventHandler struct {
+	webhookRepo webhook.Repository
+	sender      webhook.Sender
+	now         func() time.Time
+}
+
+func NewSendWebhookTestEventHandler(
+	webhookRepo webhook.Repository,
+	sender webhook.Sender,
+	now func() time.Time,
+) SendWebhookTestEventHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &sendWebhookTestEventHandler{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		now:         now,
+	}
+}
+
+func (h *sendWebhookTestEventHandler) Handle(ctx context.Context, cmd SendWebhookTestEventCmd) error {
+	s, err := h.webhookRepo.GetSubscription(ctx, cmd.UserID, cmd.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "webhook-subscription-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-get-webhook-subscription")
+	}
+
+	eventID, err := uuid.NewV7()
+	if err != nil {
+		return httperr.NewUnknowError(fmt.Errorf("failed to create test eventID: %w", err), "failed-to-send-webhook-test-event")
+	}
+
+	sentAt := h.now()
+	d, err := webhook.NewDelivery(s.ID(), event.Envelope{
+		ID:         eventID,
+		OccurredAt: sentAt,
+		Event: webhook.TestEvent{
+			SubscriptionID: s.ID(),
+			Message:        "Test event sent from the webhook settings",
+		},
+	}, sentAt)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.webhookRepo.CreateDeliveries(ctx, []*webhook.Delivery{d}); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-send-webhook-test-event")
+	}
+
+	if err = h.webhookRepo.UpdateDelivery(ctx, d.ID(), func(s *webhook.Subscription, d *webhook.Delivery) error {
+		// Picked up by the delivery worker in the meantime
+		if !d.IsPending() {
+			return nil
+		}
+
+		return attemptWebhookDelivery(ctx, h.sender, h.now, s, d)
+	}); err != nil {
+		return httperr.NewUnknowError(err, "failed-to-send-webhook-test-event")
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SkipRecurringOccurrenceCmd struct {
+	WalletID   uuid.UUID
+	TemplateID uuid.UUID
+	Date       time.Time
+}
+
+type SkipRecurringOccurrenceHandler cqrs.CommandHandler[SkipRecurringOccurrenceCmd]
+
+type skipRecurringOccurrenceHandler struct {
+	recurringRepo recurring.Repository
+}
+
+func NewSkipRecurringOccurrenceHandler(recurringRepo recurring.Repository) SkipRecurringOccurrenceHandler {
+	return &skipRecurringOccurrenceHandler{recurringRepo: recurringRepo}
+}
+
+func (h *skipRecurringOccurrenceHandler) Handle(ctx context.Context, cmd SkipRecurringOccurrenceCmd) error {
+	if err := h.recurringRepo.Update(ctx, cmd.WalletID, cmd.TemplateID, func(t *recurring.Template) error {
+		return t.Skip(cmd.Date)
+	}); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "recurring-template-not-found")
+		}
+
+		if errors.Is(err, recurring.ErrNotAnOccurrence) {
+			return httperr.NewIncorrectInputError(err, "date-not-an-occurrence")
+		}
+
+		if errors.Is(err, recurring.ErrOccurrenceAlreadyHandled) {
+			return httperr.NewIncorrectInputError(err, "occurrence-already-handled")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-skip-recurring-occurrence")
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StartReconciliationCmd struct {
+	FundProviderID uuid.UUID
+	// StatementDate is the day the statement closes on, StatementBalance its closing balance
+	StatementDate    time.Time
+	StatementBalance int64
+}
+
+type StartReconciliationHandler cqrs.CommandHandler[StartReconciliationCmd]
+
+type startReconciliationHandler struct {
+	reconciliationRepo reconciliation.Repository
+	fundProviderRepo   fundprovider.Repository
+	now                func() time.Time
+}
+
+// NewStartReconciliationHandler creates the handler starting the reconciliation of a fund provider with a statement.
+// now stamps the reconciliation, production code passes time.Now.
+func NewStartReconciliationHandler(
+	reconciliationRepo reconciliation.Repository,
+	fundProviderRepo fundprovider.Repository,
+	now func() time.Time,
+) StartReconciliationHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &startReconciliationHandler{
+		reconciliationRepo: reconciliationRepo,
+		fundProviderRepo:   fundProviderRepo,
+		now:                now,
+	}
+}
+
+func (h *startReconciliationHandler) Handle(ctx context.Context, cmd StartReconciliationCmd) error {
+	fp, err := h.fundProviderRepo.GetByID(ctx, cmd.FundProviderID)
+	if err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "fund-provider-not-found")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-get-fund-provider")
+	}
+
+	r, err := reconciliation.NewReconciliation(fp, cmd.StatementDate, cmd.StatementBalance, h.now())
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+	}
+
+	if err = h.reconciliationRepo.Create(ctx, r); err != nil {
+		if errors.Is(err, reconciliation.ErrReconciliationInProgress) {
+			return httperr.NewIncorrectInputError(err, "reconciliation-in-progress")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-start-reconciliation")
+	}
+
+	return nil
+}
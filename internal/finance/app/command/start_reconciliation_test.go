@@ -0,0 +1,95 @@
+package command_test
+
+import (
+	"context"
+	"fmt"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	fp_mocks "sumni-finance-backend/internal/finance/domain/fundprovider/mocks"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	reconciliation_mocks "sumni-finance-backend/internal/finance/domain/reconciliation/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartReconciliationHandler_Handle(t *testing.T) {
+	startedAt := time.Date(2026, time.May, 2, 9, 0, 0, 0, time.Local)
+	now := func() time.Time { return startedAt }
+	statementDate := time.Date(2026, time.April, 30, 0, 0, 0, 0, time.Local)
+
+	provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1000, 0, "VND", 1)
+	require.NoError(t, err)
+
+	t.Run("returns error when the fund provider does not exist", func(t *testing.T) {
+		reconciliationRepoMock := reconciliation_mocks.NewMockRepository(t)
+		fundProviderRepoMock := fp_mocks.NewMockRepository(t)
+		fundProviderRepoMock.
+			EXPECT().
+			GetByID(mock.Anything, provider.ID()).
+			Return(nil, fmt.Errorf("fund provider: %w", common_db.ErrNotFound)).
+			Once()
+
+		err := command.NewStartReconciliationHandler(reconciliationRepoMock, fundProviderRepoMock, now).Handle(context.Background(), command.StartReconciliationCmd{
+			FundProviderID:   provider.ID(),
+			StatementDate:    statementDate,
+			StatementBalance: 900,
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "fund-provider-not-found", slugErr.Slug())
+	})
+
+	t.Run("returns error when another reconciliation is in progress", func(t *testing.T) {
+		reconciliationRepoMock := reconciliation_mocks.NewMockRepository(t)
+		reconciliationRepoMock.
+			EXPECT().
+			Create(mock.Anything, mock.Anything).
+			Return(reconciliation.ErrReconciliationInProgress).
+			Once()
+		fundProviderRepoMock := fp_mocks.NewMockRepository(t)
+		fundProviderRepoMock.EXPECT().GetByID(mock.Anything, provider.ID()).Return(provider, nil).Once()
+
+		err := command.NewStartReconciliationHandler(reconciliationRepoMock, fundProviderRepoMock, now).Handle(context.Background(), command.StartReconciliationCmd{
+			FundProviderID:   provider.ID(),
+			StatementDate:    statementDate,
+			StatementBalance: 900,
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "reconciliation-in-progress", slugErr.Slug())
+	})
+
+	t.Run("starts the reconciliation", func(t *testing.T) {
+		reconciliationRepoMock := reconciliation_mocks.NewMockRepository(t)
+		reconciliationRepoMock.
+			EXPECT().
+			Create(mock.Anything, mock.MatchedBy(func(r *reconciliation.Reconciliation) bool {
+				return r.FpID() == provider.ID() &&
+					r.StatementDate().Equal(statementDate) &&
+					r.StatementBalance() == 900 &&
+					r.Difference() == -100 &&
+					r.CreatedAt().Equal(startedAt)
+			})).
+			Return(nil).
+			Once()
+		fundProviderRepoMock := fp_mocks.NewMockRepository(t)
+		fundProviderRepoMock.EXPECT().GetByID(mock.Anything, provider.ID()).Return(provider, nil).Once()
+
+		err := command.NewStartReconciliationHandler(reconciliationRepoMock, fundProviderRepoMock, now).Handle(context.Background(), command.StartReconciliationCmd{
+			FundProviderID:   provider.ID(),
+			StatementDate:    statementDate,
+			StatementBalance: 900,
+		})
+
+		require.NoError(t, err)
+	})
+}
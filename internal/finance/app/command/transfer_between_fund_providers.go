@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransferBetweenFundProvidersCmd struct {
+	WalletID           uuid.UUID
+	YearMonth          string
+	FromFundProviderID uuid.UUID
+	ToFundProviderID   uuid.UUID
+	Amount             int64
+	Fee                int64
+	TransactionNo      string
+	Description        string
+	// OccurredAt is the business date of the transfer, defaults to the moment of recording
+	OccurredAt *time.Time
+}
+
+type TransferBetweenFundProvidersHandler cqrs.CommandHandler[TransferBetweenFundProvidersCmd]
+
+type transferBetweenFundProvidersHandler struct {
+	walletRepo wallet.Repository
+	now        func() time.Time
+}
+
+// NewTransferBetweenFundProvidersHandler creates the handler moving money between fund providers of a wallet.
+// now is the clock stamping the recorded time, production code passes time.Now.
+func NewTransferBetweenFundProvidersHandler(
+	walletRepo wallet.Repository,
+	now func() time.Time,
+) TransferBetweenFundProvidersHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &transferBetweenFundProvidersHandler{
+		walletRepo: walletRepo,
+		now:        now,
+	}
+}
+
+func (h *transferBetweenFundProvidersHandler) Handle(ctx context.Context, cmd TransferBetweenFundProvidersCmd) error {
+	if cmd.FromFundProviderID == cmd.ToFundProviderID {
+		return httperr.NewIncorrectInputError(wallet.ErrTransferToSameFundProvider, "transfer-to-same-fund-provider")
+	}
+
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(cmd.YearMonth)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	recordedAt := h.now()
+	spec := wallet.FundProviderTransferSpec{
+		FromFpID:      cmd.FromFundProviderID,
+		ToFpID:        cmd.ToFundProviderID,
+		Amount:        cmd.Amount,
+		Fee:           cmd.Fee,
+		TransactionNo: cmd.TransactionNo,
+		Description:   cmd.Description,
+		OccurredAt:    convert.SafeDeref(cmd.OccurredAt, recordedAt),
+		RecordedAt:    recordedAt,
+	}
+
+	if err = h.walletRepo.CreateTransactionRecords(
+		ctx,
+		cmd.WalletID,
+		wallet.NewProviderMatchesAnySpec([]uuid.UUID{cmd.FromFundProviderID, cmd.ToFundProviderID}),
+		yearMonth,
+		func(w *wallet.Wallet) error {
+			return w.TransferBetweenFundProviders(yearMonth, spec)
+		},
+	); err != nil {
+		if errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "wallet-has-no-open-accounting-period")
+		}
+
+		if errors.Is(err, ledger.ErrAccountingPeriodAlreadyClosed) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-already-closed")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionOutsidePeriod) {
+			return httperr.NewIncorrectInputError(err, "transaction-outside-accounting-period")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-transfer-between-fund-providers")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransferBetweenWalletsCmd struct {
+	FromWalletID   uuid.UUID
+	ToWalletID     uuid.UUID
+	FundProviderID uuid.UUID
+	Amount         int64
+	TransactionNo  string
+	Description    string
+	// OccurredAt is the business date of the transfer, defaults to the moment of recording
+	OccurredAt *time.Time
+}
+
+type TransferBetweenWalletsHandler cqrs.CommandHandler[TransferBetweenWalletsCmd]
+
+type transferBetweenWalletsHandler struct {
+	walletRepo wallet.Repository
+	now        func() time.Time
+}
+
+// NewTransferBetweenWalletsHandler creates the handler moving allocation between two wallets.
+// now is the clock stamping the recorded time, production code passes time.Now.
+func NewTransferBetweenWalletsHandler(
+	walletRepo wallet.Repository,
+	now func() time.Time,
+) TransferBetweenWalletsHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &transferBetweenWalletsHandler{
+		walletRepo: walletRepo,
+		now:        now,
+	}
+}
+
+func (h *transferBetweenWalletsHandler) Handle(ctx context.Context, cmd TransferBetweenWalletsCmd) error {
+	if cmd.FromWalletID == cmd.ToWalletID {
+		return httperr.NewIncorrectInputError(wallet.ErrTransferToSameWallet, "transfer-to-same-wallet")
+	}
+
+	recordedAt := h.now()
+	spec := wallet.TransferSpec{
+		FpID:          cmd.FundProviderID,
+		Amount:        cmd.Amount,
+		TransactionNo: cmd.TransactionNo,
+		Description:   cmd.Description,
+		OccurredAt:    convert.SafeDeref(cmd.OccurredAt, recordedAt),
+		RecordedAt:    recordedAt,
+	}
+
+	if err := h.walletRepo.CreateTransfer(
+		ctx,
+		cmd.FromWalletID,
+		cmd.ToWalletID,
+		cmd.FundProviderID,
+		spec.OccurredAt,
+		func(src *wallet.Wallet, dst *wallet.Wallet) error {
+			return wallet.TransferBetweenWallets(src, dst, spec)
+		},
+	); err != nil {
+		if errors.Is(err, wallet.ErrNoOpenAccountingPeriod) {
+			return httperr.NewIncorrectInputError(err, "wallet-has-no-open-accounting-period")
+		}
+
+		if errors.Is(err, ledger.ErrAccountingPeriodAlreadyClosed) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-already-closed")
+		}
+
+		if errors.Is(err, ledger.ErrTransactionOutsidePeriod) {
+			return httperr.NewIncorrectInputError(err, "transaction-outside-accounting-period")
+		}
+
+		if errors.Is(err, wallet.ErrInsufficientAllocated) {
+			return httperr.NewIncorrectInputError(err, "insufficient-allocated-amount")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-transfer-between-wallets")
+	}
+
+	return nil
+}
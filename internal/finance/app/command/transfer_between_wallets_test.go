@@ -0,0 +1,135 @@
+package command_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	wallet_mocks "sumni-finance-backend/internal/finance/domain/wallet/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type TransferBetweenWalletsDependenciesManager struct {
+	walletRepoMock *wallet_mocks.MockRepository
+	now            time.Time
+}
+
+func NewTransferBetweenWalletsDM(t *testing.T, now time.Time) *TransferBetweenWalletsDependenciesManager {
+	t.Helper()
+
+	return &TransferBetweenWalletsDependenciesManager{
+		walletRepoMock: wallet_mocks.NewMockRepository(t),
+		now:            now,
+	}
+}
+
+func (dm *TransferBetweenWalletsDependenciesManager) NewHandler() command.TransferBetweenWalletsHandler {
+	return command.NewTransferBetweenWalletsHandler(
+		dm.walletRepoMock,
+		func() time.Time { return dm.now },
+	)
+}
+
+func TestTransferBetweenWalletsHandler_Handle(t *testing.T) {
+	now := time.Date(2026, time.April, 20, 10, 0, 0, 0, time.Local)
+
+	t.Run("returns error when transferring to the same wallet", func(t *testing.T) {
+		dm := NewTransferBetweenWalletsDM(t, now)
+		wID := uuid.New()
+
+		err := dm.NewHandler().Handle(context.Background(), command.TransferBetweenWalletsCmd{
+			FromWalletID: wID,
+			ToWalletID:   wID,
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wallet.ErrTransferToSameWallet)
+	})
+
+	t.Run("returns incorrect input when a wallet has no open period containing the transfer", func(t *testing.T) {
+		dm := NewTransferBetweenWalletsDM(t, now)
+		dm.walletRepoMock.
+			EXPECT().
+			CreateTransfer(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(wallet.ErrNoOpenAccountingPeriod).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.TransferBetweenWalletsCmd{
+			FromWalletID:   uuid.New(),
+			ToWalletID:     uuid.New(),
+			FundProviderID: uuid.New(),
+			Amount:         2_000_000,
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "wallet-has-no-open-accounting-period", slugErr.Slug())
+		assert.Equal(t, httperr.ErrorTypeIncorrectInput, slugErr.ErrorType())
+	})
+
+	t.Run("returns error when wallet repo fails", func(t *testing.T) {
+		dm := NewTransferBetweenWalletsDM(t, now)
+		dm.walletRepoMock.
+			EXPECT().
+			CreateTransfer(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(assert.AnError).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.TransferBetweenWalletsCmd{
+			FromWalletID:   uuid.New(),
+			ToWalletID:     uuid.New(),
+			FundProviderID: uuid.New(),
+			Amount:         2_000_000,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("transfers successfully at the moment of recording by default", func(t *testing.T) {
+		dm := NewTransferBetweenWalletsDM(t, now)
+
+		fromID := uuid.New()
+		toID := uuid.New()
+		fpID := uuid.New()
+
+		dm.walletRepoMock.
+			EXPECT().
+			CreateTransfer(mock.Anything, fromID, toID, fpID, now, mock.Anything).
+			Return(nil).
+			Once()
+
+		err := dm.NewHandler().Handle(context.Background(), command.TransferBetweenWalletsCmd{
+			FromWalletID:   fromID,
+			ToWalletID:     toID,
+			FundProviderID: fpID,
+			Amount:         2_000_000,
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func NewValidYearMonth(t *testing.T, month, year int) ledger.YearMonth {
+	t.Helper()
+
+	yearMonth, err := ledger.NewYearMonth(month, year)
+	require.NoError(t, err)
+
+	return yearMonth
+}
+
+func NewDefaultLedgerConfig(t *testing.T) wallet.LedgerConfig {
+	t.Helper()
+
+	ledgerConfig, err := wallet.NewDefaultLedgerConfig()
+	require.NoError(t, err)
+
+	return ledgerConfig
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/category"
+
+	"github.com/google/uuid"
+)
+
+type UpdateCategoryCmd struct {
+	UserID     string
+	CategoryID uuid.UUID
+	Name       string
+}
+
+type UpdateCategoryHandler cqrs.CommandHandler[UpdateCategoryCmd]
+
+type updateCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+func NewUpdateCategoryHandler(categoryRepo category.Repository) UpdateCategoryHandler {
+	return &updateCategoryHandler{categoryRepo: categoryRepo}
+}
+
+func (h *updateCategoryHandler) Handle(ctx context.Context, cmd UpdateCategoryCmd) error {
+	if err := h.categoryRepo.Update(
+		ctx,
+		cmd.UserID,
+		cmd.CategoryID,
+		func(c *category.Category) error {
+			return c.Rename(cmd.Name)
+		},
+	); err != nil {
+		if errors.Is(err, common_db.ErrNotFound) {
+			return httperr.NewNotFoundError(err, "category-not-found")
+		}
+
+		if errors.Is(err, category.ErrCategoryNameTaken) {
+			return httperr.NewIncorrectInputError(err, "category-name-taken")
+		}
+
+		var validErrs *validator.ErrorList
+		if errors.As(err, &validErrs) {
+			return httperr.NewIncorrectInputError(err, "invalid-cmd-input")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-update-category")
+	}
+
+	return nil
+}
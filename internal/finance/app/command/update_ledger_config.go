@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+type UpdateLedgerConfigCmd struct {
+	WalletID       uuid.UUID
+	PeriodStartDay int32
+	PeriodInterval int32
+}
+
+type UpdateLedgerConfigHandler cqrs.CommandHandler[UpdateLedgerConfigCmd]
+
+type updateLedgerConfigHandler struct {
+	walletRepo wallet.Repository
+}
+
+func NewUpdateLedgerConfigHandler(walletRepo wallet.Repository) UpdateLedgerConfigHandler {
+	return &updateLedgerConfigHandler{walletRepo: walletRepo}
+}
+
+func (h *updateLedgerConfigHandler) Handle(ctx context.Context, cmd UpdateLedgerConfigCmd) error {
+	ledgerConfig, err := wallet.NewLedgerConfig(cmd.PeriodStartDay, cmd.PeriodInterval)
+	if err != nil {
+		return httperr.NewIncorrectInputError(err, "invalid-ledger-config")
+	}
+
+	if err = h.walletRepo.UpdateLedgerConfig(ctx, cmd.WalletID, func(w *wallet.Wallet) error {
+		return w.ChangeLedgerConfig(ledgerConfig)
+	}); err != nil {
+		if errors.Is(err, wallet.ErrLedgerConfigLocked) {
+			return httperr.NewIncorrectInputError(err, "accounting-period-still-open")
+		}
+
+		return httperr.NewUnknowError(err, "failed-to-update-ledger-config")
+	}
+
+	return nil
+}
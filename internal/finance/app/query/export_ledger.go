@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+)
+
+// ExportLedger exports an accounting period of a wallet, its balances followed by its transaction records.
+type ExportLedger struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+// LedgerExport is the header of the period and its transaction records in the order they were recorded.
+// Records reads the records from the database while it is ranged over, it is meant to be ranged over once.
+type LedgerExport struct {
+	Period  AccountingPeriodClosingReport
+	Records iter.Seq2[Transaction, error]
+}
+
+type ExportLedgerHandler cqrs.QueryHandler[ExportLedger, LedgerExport]
+
+type LedgerExportReadModel interface {
+	GetAccountingPeriodClosingReport(
+		ctx context.Context,
+		wID uuid.UUID,
+		yearMonth ledger.YearMonth,
+	) (AccountingPeriodClosingReport, error)
+	StreamTransactionsByAccountingPeriodID(ctx context.Context, apID uuid.UUID) iter.Seq2[Transaction, error]
+}
+
+type exportLedgerHandler struct {
+	readModel LedgerExportReadModel
+}
+
+func NewExportLedgerHandler(readModel LedgerExportReadModel) ExportLedgerHandler {
+	return &exportLedgerHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *exportLedgerHandler) Handle(ctx context.Context, q ExportLedger) (LedgerExport, error) {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(q.YearMonth)
+	if err != nil {
+		return LedgerExport{}, httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	period, err := h.readModel.GetAccountingPeriodClosingReport(ctx, q.WalletID, yearMonth)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return LedgerExport{}, httperr.NewNotFoundError(err, "accounting-period-not-found")
+	}
+	if err != nil {
+		return LedgerExport{}, httperr.NewUnknowError(err, "failed-to-retrieve-accounting-period")
+	}
+
+	return LedgerExport{
+		Period:  withBalanceSoFar(period),
+		Records: h.readModel.StreamTransactionsByAccountingPeriodID(ctx, period.ID),
+	}, nil
+}
+
+// withBalanceSoFar fills in the closing balance of an open period, it is only stored when the period closes.
+func withBalanceSoFar(period AccountingPeriodClosingReport) AccountingPeriodClosingReport {
+	if period.Status != ledger.AccountingPeriodClose.String() {
+		period.ClosingBalance = period.OpeningBalance + period.TotalCredit - period.TotalDebit
+	}
+
+	return period
+}
@@ -0,0 +1,147 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ledgerExportReadModelStub struct {
+	period     query.AccountingPeriodClosingReport
+	err        error
+	records    []query.Transaction
+	streamedID uuid.UUID
+}
+
+func (s *ledgerExportReadModelStub) GetAccountingPeriodClosingReport(
+	ctx context.Context,
+	wID uuid.UUID,
+	yearMonth ledger.YearMonth,
+) (query.AccountingPeriodClosingReport, error) {
+	return s.period, s.err
+}
+
+func (s *ledgerExportReadModelStub) StreamTransactionsByAccountingPeriodID(
+	ctx context.Context,
+	apID uuid.UUID,
+) iter.Seq2[query.Transaction, error] {
+	return func(yield func(query.Transaction, error) bool) {
+		s.streamedID = apID
+		for _, tr := range s.records {
+			if !yield(tr, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestExportLedgerHandler_Handle(t *testing.T) {
+	t.Run("returns error when year month is invalid", func(t *testing.T) {
+		_, err := query.NewExportLedgerHandler(&ledgerExportReadModelStub{}).Handle(context.Background(), query.ExportLedger{
+			WalletID:  uuid.New(),
+			YearMonth: "2026-04",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "invalid-year-month-format", slugErr.Slug())
+	})
+
+	t.Run("returns not found when accounting period does not exist", func(t *testing.T) {
+		stub := &ledgerExportReadModelStub{err: common_db.ErrNotFound}
+
+		_, err := query.NewExportLedgerHandler(stub).Handle(context.Background(), query.ExportLedger{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "accounting-period-not-found", slugErr.Slug())
+	})
+
+	t.Run("returns unknown error when the period cannot be read", func(t *testing.T) {
+		stub := &ledgerExportReadModelStub{err: errors.New("connection reset")}
+
+		_, err := query.NewExportLedgerHandler(stub).Handle(context.Background(), query.ExportLedger{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "failed-to-retrieve-accounting-period", slugErr.Slug())
+	})
+
+	t.Run("computes the closing balance of an open period", func(t *testing.T) {
+		stub := &ledgerExportReadModelStub{period: query.AccountingPeriodClosingReport{
+			ID:             uuid.New(),
+			Status:         ledger.AccountingPeriodOpen.String(),
+			OpeningBalance: 1_000_000,
+			TotalDebit:     300_000,
+			TotalCredit:    500_000,
+		}}
+
+		export, err := query.NewExportLedgerHandler(stub).Handle(context.Background(), query.ExportLedger{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1_200_000), export.Period.ClosingBalance)
+	})
+
+	t.Run("keeps the stored closing balance of a closed period", func(t *testing.T) {
+		stub := &ledgerExportReadModelStub{period: query.AccountingPeriodClosingReport{
+			ID:             uuid.New(),
+			Status:         ledger.AccountingPeriodClose.String(),
+			OpeningBalance: 1_000_000,
+			TotalDebit:     300_000,
+			TotalCredit:    500_000,
+			ClosingBalance: 1_200_000,
+		}}
+
+		export, err := query.NewExportLedgerHandler(stub).Handle(context.Background(), query.ExportLedger{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1_200_000), export.Period.ClosingBalance)
+	})
+
+	t.Run("streams the records of the period", func(t *testing.T) {
+		periodID := uuid.New()
+		stub := &ledgerExportReadModelStub{
+			period: query.AccountingPeriodClosingReport{ID: periodID, Status: ledger.AccountingPeriodOpen.String()},
+			records: []query.Transaction{
+				{ID: uuid.New(), Amount: 100_000, FundProviderName: "Techcombank"},
+				{ID: uuid.New(), Amount: 50_000, FundProviderName: "Tiền mặt"},
+			},
+		}
+
+		export, err := query.NewExportLedgerHandler(stub).Handle(context.Background(), query.ExportLedger{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+		require.NoError(t, err)
+
+		var names []string
+		for tr, err := range export.Records {
+			require.NoError(t, err)
+			names = append(names, tr.FundProviderName)
+		}
+
+		assert.Equal(t, []string{"Techcombank", "Tiền mặt"}, names)
+		assert.Equal(t, periodID, stub.streamedID)
+	})
+}
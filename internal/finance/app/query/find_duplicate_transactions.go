@@ -0,0 +1,121 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultDuplicateWindowDays = 3
+	MaxDuplicateWindowDays     = 31
+
+	// maxDuplicateCandidates bounds the candidates listed for a single transaction
+	maxDuplicateCandidates = 5
+)
+
+// FindDuplicateTransactions looks for the recorded transactions that look like the ones about to be recorded:
+// same fund provider and amount, occurred at most WindowDays apart. Reversed records and reversals are left out.
+type FindDuplicateTransactions struct {
+	Transactions []DuplicateCheck
+	// WindowDays defaults to DefaultDuplicateWindowDays
+	WindowDays int
+}
+
+type DuplicateCheck struct {
+	FundProviderID uuid.UUID
+	Amount         int64
+	// OccurredAt defaults to now, like the recorded transaction
+	OccurredAt *time.Time
+}
+
+type FindDuplicateTransactionsHandler cqrs.QueryHandler[FindDuplicateTransactions, []DuplicateWarning]
+
+type FindDuplicateTransactionsReadModel interface {
+	// ListDuplicateCandidates looks up the candidates of all lookups in a single round trip,
+	// at most limit per lookup. The result holds the candidates of every lookup at its position.
+	ListDuplicateCandidates(ctx context.Context, lookups []DuplicateLookup, limit int) ([][]DuplicateCandidate, error)
+}
+
+// DuplicateLookup is the range of the recorded transactions that look like a DuplicateCheck.
+type DuplicateLookup struct {
+	FundProviderID uuid.UUID
+	Amount         int64
+	OccurredFrom   time.Time
+	OccurredTo     time.Time
+}
+
+type findDuplicateTransactionsHandler struct {
+	readModel FindDuplicateTransactionsReadModel
+	now       func() time.Time
+}
+
+func NewFindDuplicateTransactionsHandler(
+	readModel FindDuplicateTransactionsReadModel,
+	now func() time.Time,
+) FindDuplicateTransactionsHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &findDuplicateTransactionsHandler{
+		readModel: readModel,
+		now:       now,
+	}
+}
+
+func (h *findDuplicateTransactionsHandler) Handle(ctx context.Context, q FindDuplicateTransactions) ([]DuplicateWarning, error) {
+	windowDays := q.WindowDays
+	if windowDays == 0 {
+		windowDays = DefaultDuplicateWindowDays
+	}
+
+	if windowDays < 0 || windowDays > MaxDuplicateWindowDays {
+		return nil, httperr.NewIncorrectInputError(
+			fmt.Errorf("duplicate window must be between 1 and %d days", MaxDuplicateWindowDays),
+			"invalid-duplicate-window",
+		)
+	}
+
+	now := h.now()
+
+	if len(q.Transactions) == 0 {
+		return []DuplicateWarning{}, nil
+	}
+
+	lookups := make([]DuplicateLookup, 0, len(q.Transactions))
+	for _, check := range q.Transactions {
+		occurredAt := convert.SafeDeref(check.OccurredAt, now)
+
+		lookups = append(lookups, DuplicateLookup{
+			FundProviderID: check.FundProviderID,
+			Amount:         check.Amount,
+			OccurredFrom:   occurredAt.AddDate(0, 0, -windowDays),
+			OccurredTo:     occurredAt.AddDate(0, 0, windowDays),
+		})
+	}
+
+	candidates, err := h.readModel.ListDuplicateCandidates(ctx, lookups, maxDuplicateCandidates)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-find-duplicate-transactions")
+	}
+
+	warnings := make([]DuplicateWarning, 0)
+	for i := range lookups {
+		if i >= len(candidates) || len(candidates[i]) == 0 {
+			continue
+		}
+
+		warnings = append(warnings, DuplicateWarning{
+			Index:      i,
+			Candidates: candidates[i],
+		})
+	}
+
+	return warnings, nil
+}
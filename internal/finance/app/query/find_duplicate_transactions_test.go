@@ -0,0 +1,99 @@
+package query_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/query"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type duplicateReadModelStub struct {
+	// candidates are returned by amount
+	candidates map[int64][]query.DuplicateCandidate
+	calls      [][]query.DuplicateLookup
+}
+
+func (s *duplicateReadModelStub) ListDuplicateCandidates(
+	ctx context.Context,
+	lookups []query.DuplicateLookup,
+	limit int,
+) ([][]query.DuplicateCandidate, error) {
+	s.calls = append(s.calls, lookups)
+
+	candidates := make([][]query.DuplicateCandidate, 0, len(lookups))
+	for _, lookup := range lookups {
+		candidates = append(candidates, s.candidates[lookup.Amount])
+	}
+
+	return candidates, nil
+}
+
+func TestFindDuplicateTransactionsHandler_Handle(t *testing.T) {
+	recordedAt := time.Date(2026, time.April, 20, 9, 0, 0, 0, time.Local)
+	now := func() time.Time { return recordedAt }
+	fpID := uuid.New()
+
+	t.Run("returns error when the window is out of range", func(t *testing.T) {
+		for _, windowDays := range []int{-1, query.MaxDuplicateWindowDays + 1} {
+			_, err := query.NewFindDuplicateTransactionsHandler(&duplicateReadModelStub{}, now).Handle(context.Background(), query.FindDuplicateTransactions{
+				Transactions: []query.DuplicateCheck{{FundProviderID: fpID, Amount: 100}},
+				WindowDays:   windowDays,
+			})
+
+			var slugErr httperr.SlugError
+			require.ErrorAs(t, err, &slugErr)
+			assert.Equal(t, "invalid-duplicate-window", slugErr.Slug())
+		}
+	})
+
+	t.Run("looks around the date of each transaction", func(t *testing.T) {
+		occurredAt := time.Date(2026, time.April, 10, 0, 0, 0, 0, time.Local)
+		readModel := &duplicateReadModelStub{}
+
+		_, err := query.NewFindDuplicateTransactionsHandler(readModel, now).Handle(context.Background(), query.FindDuplicateTransactions{
+			Transactions: []query.DuplicateCheck{
+				{FundProviderID: fpID, Amount: 100, OccurredAt: &occurredAt},
+				{FundProviderID: fpID, Amount: 200},
+			},
+		})
+		require.NoError(t, err)
+
+		// Every transaction is looked up in a single call
+		require.Len(t, readModel.calls, 1)
+		lookups := readModel.calls[0]
+		require.Len(t, lookups, 2)
+		assert.Equal(t, query.DuplicateLookup{
+			FundProviderID: fpID,
+			Amount:         100,
+			OccurredFrom:   occurredAt.AddDate(0, 0, -query.DefaultDuplicateWindowDays),
+			OccurredTo:     occurredAt.AddDate(0, 0, query.DefaultDuplicateWindowDays),
+		}, lookups[0])
+
+		// Without a date the transaction is recorded now
+		assert.Equal(t, recordedAt.AddDate(0, 0, -query.DefaultDuplicateWindowDays), lookups[1].OccurredFrom)
+		assert.Equal(t, recordedAt.AddDate(0, 0, query.DefaultDuplicateWindowDays), lookups[1].OccurredTo)
+	})
+
+	t.Run("warns only about the transactions with candidates", func(t *testing.T) {
+		candidate := query.DuplicateCandidate{ID: uuid.New(), Amount: 200}
+		readModel := &duplicateReadModelStub{
+			candidates: map[int64][]query.DuplicateCandidate{200: {candidate}},
+		}
+
+		warnings, err := query.NewFindDuplicateTransactionsHandler(readModel, now).Handle(context.Background(), query.FindDuplicateTransactions{
+			Transactions: []query.DuplicateCheck{
+				{FundProviderID: fpID, Amount: 100},
+				{FundProviderID: fpID, Amount: 200},
+			},
+			WindowDays: 7,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []query.DuplicateWarning{{Index: 1, Candidates: []query.DuplicateCandidate{candidate}}}, warnings)
+	})
+}
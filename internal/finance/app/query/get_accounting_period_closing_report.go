@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+)
+
+type GetAccountingPeriodClosingReport struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+type GetAccountingPeriodClosingReportHandler cqrs.QueryHandler[GetAccountingPeriodClosingReport, AccountingPeriodClosingReport]
+
+type AccountingPeriodClosingReportReadModel interface {
+	GetAccountingPeriodClosingReport(
+		ctx context.Context,
+		wID uuid.UUID,
+		yearMonth ledger.YearMonth,
+	) (AccountingPeriodClosingReport, error)
+}
+
+type getAccountingPeriodClosingReportHandler struct {
+	readModel AccountingPeriodClosingReportReadModel
+}
+
+func NewGetAccountingPeriodClosingReportHandler(
+	readModel AccountingPeriodClosingReportReadModel,
+) GetAccountingPeriodClosingReportHandler {
+	return &getAccountingPeriodClosingReportHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getAccountingPeriodClosingReportHandler) Handle(
+	ctx context.Context,
+	q GetAccountingPeriodClosingReport,
+) (AccountingPeriodClosingReport, error) {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(q.YearMonth)
+	if err != nil {
+		return AccountingPeriodClosingReport{}, httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	report, err := h.readModel.GetAccountingPeriodClosingReport(ctx, q.WalletID, yearMonth)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return AccountingPeriodClosingReport{}, httperr.NewNotFoundError(err, "accounting-period-not-found")
+	}
+	if err != nil {
+		return AccountingPeriodClosingReport{}, httperr.NewUnknowError(err, "failed-to-retrieve-closing-report")
+	}
+
+	return report, nil
+}
@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+)
+
+type GetBudgetReport struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+type GetBudgetReportHandler cqrs.QueryHandler[GetBudgetReport, BudgetReport]
+
+// BudgetReportReadModel returns the budgeted categories of the period with Budgeted and Actual filled in.
+type BudgetReportReadModel interface {
+	GetBudgetReport(
+		ctx context.Context,
+		wID uuid.UUID,
+		yearMonth ledger.YearMonth,
+	) (BudgetReport, error)
+}
+
+type getBudgetReportHandler struct {
+	readModel BudgetReportReadModel
+}
+
+func NewGetBudgetReportHandler(readModel BudgetReportReadModel) GetBudgetReportHandler {
+	return &getBudgetReportHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getBudgetReportHandler) Handle(ctx context.Context, q GetBudgetReport) (BudgetReport, error) {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(q.YearMonth)
+	if err != nil {
+		return BudgetReport{}, httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	report, err := h.readModel.GetBudgetReport(ctx, q.WalletID, yearMonth)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return BudgetReport{}, httperr.NewNotFoundError(err, "accounting-period-not-found")
+	}
+	if err != nil {
+		return BudgetReport{}, httperr.NewUnknowError(err, "failed-to-retrieve-budget-report")
+	}
+
+	report.TotalBudgeted, report.TotalActual = 0, 0
+	for i := range report.Categories {
+		c := &report.Categories[i]
+		c.Remaining = c.Budgeted - c.Actual
+		c.OverBudget = c.Actual > c.Budgeted
+
+		report.TotalBudgeted += c.Budgeted
+		report.TotalActual += c.Actual
+	}
+	report.TotalRemaining = report.TotalBudgeted - report.TotalActual
+
+	return report, nil
+}
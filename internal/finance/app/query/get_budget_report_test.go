@@ -0,0 +1,77 @@
+package query_test
+
+import (
+	"context"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type budgetReportReadModelStub struct {
+	report query.BudgetReport
+	err    error
+}
+
+func (s *budgetReportReadModelStub) GetBudgetReport(
+	ctx context.Context,
+	wID uuid.UUID,
+	yearMonth ledger.YearMonth,
+) (query.BudgetReport, error) {
+	return s.report, s.err
+}
+
+func TestGetBudgetReportHandler_Handle(t *testing.T) {
+	t.Run("returns error when year month is invalid", func(t *testing.T) {
+		stub := &budgetReportReadModelStub{}
+
+		_, err := query.NewGetBudgetReportHandler(stub).Handle(context.Background(), query.GetBudgetReport{
+			WalletID:  uuid.New(),
+			YearMonth: "2026-04",
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when accounting period is not found", func(t *testing.T) {
+		stub := &budgetReportReadModelStub{err: common_db.ErrNotFound}
+
+		_, err := query.NewGetBudgetReportHandler(stub).Handle(context.Background(), query.GetBudgetReport{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		require.ErrorIs(t, err, common_db.ErrNotFound)
+	})
+
+	t.Run("computes remaining and flags over budget categories", func(t *testing.T) {
+		stub := &budgetReportReadModelStub{report: query.BudgetReport{
+			YearMonth: "2026,4",
+			Currency:  "VND",
+			Categories: []query.CategoryBudgetStatus{
+				{CategoryID: uuid.New(), Name: "Ăn uống", Budgeted: 3_000_000, Actual: 3_500_000},
+				{CategoryID: uuid.New(), Name: "Đi lại", Budgeted: 1_000_000, Actual: 400_000},
+			},
+		}}
+
+		report, err := query.NewGetBudgetReportHandler(stub).Handle(context.Background(), query.GetBudgetReport{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, report.Categories, 2)
+		assert.Equal(t, int64(-500_000), report.Categories[0].Remaining)
+		assert.True(t, report.Categories[0].OverBudget)
+		assert.Equal(t, int64(600_000), report.Categories[1].Remaining)
+		assert.False(t, report.Categories[1].OverBudget)
+
+		assert.Equal(t, int64(4_000_000), report.TotalBudgeted)
+		assert.Equal(t, int64(3_900_000), report.TotalActual)
+		assert.Equal(t, int64(100_000), report.TotalRemaining)
+	})
+}
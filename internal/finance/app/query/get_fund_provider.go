@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+
+	"github.com/google/uuid"
+)
+
+type GetFundProvider struct {
+	FundProviderID uuid.UUID
+}
+
+type GetFundProviderHandler cqrs.QueryHandler[GetFundProvider, FundProvider]
+
+type GetFundProviderReadModel interface {
+	GetFundProvider(ctx context.Context, fpID uuid.UUID) (FundProvider, error)
+}
+
+type getFundProviderHandler struct {
+	readModel GetFundProviderReadModel
+}
+
+func NewGetFundProviderHandler(readModel GetFundProviderReadModel) GetFundProviderHandler {
+	return &getFundProviderHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getFundProviderHandler) Handle(ctx context.Context, q GetFundProvider) (FundProvider, error) {
+	fp, err := h.readModel.GetFundProvider(ctx, q.FundProviderID)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return FundProvider{}, httperr.NewNotFoundError(err, "fund-provider-not-found")
+	}
+	if err != nil {
+		return FundProvider{}, httperr.NewUnknowError(err, "failed-to-retrieve-fund-provider")
+	}
+
+	return fp, nil
+}
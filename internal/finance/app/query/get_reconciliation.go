@@ -0,0 +1,74 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+
+	"github.com/google/uuid"
+)
+
+type GetReconciliation struct {
+	FundProviderID   uuid.UUID
+	ReconciliationID uuid.UUID
+}
+
+type GetReconciliationHandler cqrs.QueryHandler[GetReconciliation, Reconciliation]
+
+type GetReconciliationReadModel interface {
+	// GetReconciliation returns the reconciliation with the balance of its fund provider and its records,
+	// the unlocked records of the fund provider while in progress and the cleared ones afterwards.
+	GetReconciliation(ctx context.Context, fpID uuid.UUID, rID uuid.UUID) (Reconciliation, error)
+}
+
+type getReconciliationHandler struct {
+	readModel GetReconciliationReadModel
+}
+
+func NewGetReconciliationHandler(readModel GetReconciliationReadModel) GetReconciliationHandler {
+	return &getReconciliationHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getReconciliationHandler) Handle(ctx context.Context, q GetReconciliation) (Reconciliation, error) {
+	r, err := h.readModel.GetReconciliation(ctx, q.FundProviderID, q.ReconciliationID)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return Reconciliation{}, httperr.NewNotFoundError(err, "reconciliation-not-found")
+	}
+	if err != nil {
+		return Reconciliation{}, httperr.NewUnknowError(err, "failed-to-retrieve-reconciliation")
+	}
+
+	for _, record := range r.Records {
+		if record.Cleared {
+			r.ClearedCount++
+		}
+	}
+
+	if r.Status != reconciliation.StatusInProgress.String() {
+		return r, nil
+	}
+
+	r.ClearedBalance = r.BookBalance
+	for _, record := range r.Records {
+		if !record.Cleared {
+			r.ClearedBalance -= reconciliationSignedAmount(record)
+		}
+	}
+	r.Difference = r.StatementBalance - r.ClearedBalance
+
+	return r, nil
+}
+
+func reconciliationSignedAmount(record ReconciliationRecord) int64 {
+	if record.Direction == ledger.DirectionIn.String() {
+		return record.Amount
+	}
+
+	return -record.Amount
+}
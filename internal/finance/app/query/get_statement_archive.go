@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+)
+
+type GetStatementArchive struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+type GetStatementArchiveHandler cqrs.QueryHandler[GetStatementArchive, StatementArchive]
+
+type StatementArchiveReadModel interface {
+	GetStatementArchive(ctx context.Context, wID uuid.UUID, yearMonth ledger.YearMonth) (StatementArchive, error)
+}
+
+type getStatementArchiveHandler struct {
+	readModel StatementArchiveReadModel
+}
+
+func NewGetStatementArchiveHandler(readModel StatementArchiveReadModel) GetStatementArchiveHandler {
+	return &getStatementArchiveHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getStatementArchiveHandler) Handle(ctx context.Context, q GetStatementArchive) (StatementArchive, error) {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(q.YearMonth)
+	if err != nil {
+		return StatementArchive{}, httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	archive, err := h.readModel.GetStatementArchive(ctx, q.WalletID, yearMonth)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return StatementArchive{}, httperr.NewNotFoundError(err, "statement-archive-not-found")
+	}
+	if err != nil {
+		return StatementArchive{}, httperr.NewUnknowError(err, "failed-to-retrieve-statement-archive")
+	}
+
+	return archive, nil
+}
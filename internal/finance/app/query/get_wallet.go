@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+
+	"github.com/google/uuid"
+)
+
+type GetWallet struct {
+	WalletID uuid.UUID
+}
+
+type GetWalletHandler cqrs.QueryHandler[GetWallet, Wallet]
+
+type GetWalletReadModel interface {
+	GetWallet(ctx context.Context, wID uuid.UUID) (Wallet, error)
+}
+
+type getWalletHandler struct {
+	readModel GetWalletReadModel
+}
+
+func NewGetWalletHandler(readModel GetWalletReadModel) GetWalletHandler {
+	return &getWalletHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getWalletHandler) Handle(ctx context.Context, q GetWallet) (Wallet, error) {
+	w, err := h.readModel.GetWallet(ctx, q.WalletID)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return Wallet{}, httperr.NewNotFoundError(err, "wallet-not-found")
+	}
+	if err != nil {
+		return Wallet{}, httperr.NewUnknowError(err, "failed-to-retrieve-wallet")
+	}
+
+	return w, nil
+}
@@ -0,0 +1,186 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// GetWalletStatement builds the statement of an accounting period of a wallet.
+type GetWalletStatement struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+type GetWalletStatementHandler cqrs.QueryHandler[GetWalletStatement, WalletStatement]
+
+type WalletStatementReadModel interface {
+	GetWallet(ctx context.Context, wID uuid.UUID) (Wallet, error)
+	GetAccountingPeriodClosingReport(
+		ctx context.Context,
+		wID uuid.UUID,
+		yearMonth ledger.YearMonth,
+	) (AccountingPeriodClosingReport, error)
+	StreamTransactionsByAccountingPeriodID(ctx context.Context, apID uuid.UUID) iter.Seq2[Transaction, error]
+}
+
+type getWalletStatementHandler struct {
+	readModel WalletStatementReadModel
+}
+
+func NewGetWalletStatementHandler(readModel WalletStatementReadModel) GetWalletStatementHandler {
+	return &getWalletStatementHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *getWalletStatementHandler) Handle(ctx context.Context, q GetWalletStatement) (WalletStatement, error) {
+	yearMonth, err := ledger.UnmarshalYearMonthFromString(q.YearMonth)
+	if err != nil {
+		return WalletStatement{}, httperr.NewIncorrectInputError(err, "invalid-year-month-format")
+	}
+
+	wallet, err := h.readModel.GetWallet(ctx, q.WalletID)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return WalletStatement{}, httperr.NewNotFoundError(err, "wallet-not-found")
+	}
+	if err != nil {
+		return WalletStatement{}, httperr.NewUnknowError(err, "failed-to-retrieve-wallet")
+	}
+
+	period, err := h.readModel.GetAccountingPeriodClosingReport(ctx, q.WalletID, yearMonth)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return WalletStatement{}, httperr.NewNotFoundError(err, "accounting-period-not-found")
+	}
+	if err != nil {
+		return WalletStatement{}, httperr.NewUnknowError(err, "failed-to-retrieve-accounting-period")
+	}
+	period = withBalanceSoFar(period)
+
+	var records []Transaction
+	for tr, err := range h.readModel.StreamTransactionsByAccountingPeriodID(ctx, period.ID) {
+		if err != nil {
+			return WalletStatement{}, httperr.NewUnknowError(err, "failed-to-retrieve-transactions")
+		}
+		records = append(records, tr)
+	}
+
+	// Records are streamed in the order they were recorded, the statement lists them in the order they occurred
+	slices.SortStableFunc(records, func(a, b Transaction) int {
+		return a.OccurredAt.Compare(b.OccurredAt)
+	})
+
+	return WalletStatement{
+		WalletID:      wallet.ID,
+		WalletName:    wallet.Name,
+		Period:        period,
+		FundProviders: fundProviderTotals(wallet.Allocations, records),
+		Lines:         statementLines(period.OpeningBalance, records),
+		Categories:    categoryTotals(records),
+	}, nil
+}
+
+func statementLines(openingBalance int64, records []Transaction) []StatementLine {
+	lines := make([]StatementLine, 0, len(records))
+	balance := openingBalance
+	for _, tr := range records {
+		balance += signedAmount(tr)
+		lines = append(lines, StatementLine{Transaction: tr, RunningBalance: balance})
+	}
+
+	return lines
+}
+
+// fundProviderTotals lists the fund providers allocated to the wallet, then the ones it no longer
+// holds but moved money through during the period.
+func fundProviderTotals(allocations []WalletAllocation, records []Transaction) []StatementFundProvider {
+	var totals []StatementFundProvider
+	index := make(map[uuid.UUID]int)
+	for _, a := range allocations {
+		index[a.FundProviderID] = len(totals)
+		totals = append(totals, StatementFundProvider{FundProviderID: a.FundProviderID, FundProviderName: a.FundProviderName})
+	}
+
+	for _, tr := range records {
+		i, ok := index[tr.FundProviderID]
+		if !ok {
+			i = len(totals)
+			index[tr.FundProviderID] = i
+			totals = append(totals, StatementFundProvider{FundProviderID: tr.FundProviderID, FundProviderName: tr.FundProviderName})
+		}
+
+		fp := &totals[i]
+		if tr.Direction == ledger.DirectionIn.String() {
+			fp.Inflow += tr.Amount
+		} else {
+			fp.Outflow += tr.Amount
+		}
+		fp.Net = fp.Inflow - fp.Outflow
+	}
+
+	return totals
+}
+
+// categoryTotals totals the records by category sorted by name, the uncategorised records come last.
+func categoryTotals(records []Transaction) []StatementCategory {
+	var totals []StatementCategory
+	index := make(map[uuid.UUID]int)
+	var uncategorised *StatementCategory
+	for _, tr := range records {
+		var c *StatementCategory
+		switch {
+		case tr.CategoryID == nil:
+			if uncategorised == nil {
+				uncategorised = &StatementCategory{}
+			}
+			c = uncategorised
+		default:
+			i, ok := index[*tr.CategoryID]
+			if !ok {
+				i = len(totals)
+				index[*tr.CategoryID] = i
+
+				var name string
+				if tr.CategoryName != nil {
+					name = *tr.CategoryName
+				}
+				totals = append(totals, StatementCategory{CategoryID: tr.CategoryID, Name: name})
+			}
+			c = &totals[i]
+		}
+
+		if tr.Direction == ledger.DirectionIn.String() {
+			c.Inflow += tr.Amount
+		} else {
+			c.Outflow += tr.Amount
+		}
+	}
+
+	// Category names are Vietnamese, Ă sorts after A rather than after Z as its code point would
+	collator := collate.New(language.Vietnamese)
+	slices.SortFunc(totals, func(a, b StatementCategory) int {
+		return collator.CompareString(a.Name, b.Name)
+	})
+	if uncategorised != nil {
+		totals = append(totals, *uncategorised)
+	}
+
+	return totals
+}
+
+func signedAmount(tr Transaction) int64 {
+	if tr.Direction == ledger.DirectionIn.String() {
+		return tr.Amount
+	}
+
+	return -tr.Amount
+}
@@ -0,0 +1,155 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type walletStatementReadModelStub struct {
+	ledgerExportReadModelStub
+	wallet    query.Wallet
+	walletErr error
+	streamErr error
+}
+
+func (s *walletStatementReadModelStub) GetWallet(ctx context.Context, wID uuid.UUID) (query.Wallet, error) {
+	return s.wallet, s.walletErr
+}
+
+func (s *walletStatementReadModelStub) StreamTransactionsByAccountingPeriodID(
+	ctx context.Context,
+	apID uuid.UUID,
+) iter.Seq2[query.Transaction, error] {
+	if s.streamErr != nil {
+		return func(yield func(query.Transaction, error) bool) {
+			yield(query.Transaction{}, s.streamErr)
+		}
+	}
+
+	return s.ledgerExportReadModelStub.StreamTransactionsByAccountingPeriodID(ctx, apID)
+}
+
+func TestGetWalletStatementHandler_Handle(t *testing.T) {
+	t.Run("returns error when year month is invalid", func(t *testing.T) {
+		_, err := query.NewGetWalletStatementHandler(&walletStatementReadModelStub{}).Handle(context.Background(), query.GetWalletStatement{
+			WalletID:  uuid.New(),
+			YearMonth: "04/2026",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "invalid-year-month-format", slugErr.Slug())
+	})
+
+	t.Run("returns not found when wallet does not exist", func(t *testing.T) {
+		stub := &walletStatementReadModelStub{walletErr: common_db.ErrNotFound}
+
+		_, err := query.NewGetWalletStatementHandler(stub).Handle(context.Background(), query.GetWalletStatement{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "wallet-not-found", slugErr.Slug())
+	})
+
+	t.Run("returns not found when accounting period does not exist", func(t *testing.T) {
+		stub := &walletStatementReadModelStub{ledgerExportReadModelStub: ledgerExportReadModelStub{err: common_db.ErrNotFound}}
+
+		_, err := query.NewGetWalletStatementHandler(stub).Handle(context.Background(), query.GetWalletStatement{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "accounting-period-not-found", slugErr.Slug())
+	})
+
+	t.Run("returns unknown error when the records cannot be read", func(t *testing.T) {
+		stub := &walletStatementReadModelStub{streamErr: errors.New("connection reset")}
+
+		_, err := query.NewGetWalletStatementHandler(stub).Handle(context.Background(), query.GetWalletStatement{
+			WalletID:  uuid.New(),
+			YearMonth: "2026,4",
+		})
+
+		var slugErr httperr.SlugError
+		require.ErrorAs(t, err, &slugErr)
+		assert.Equal(t, "failed-to-retrieve-transactions", slugErr.Slug())
+	})
+
+	t.Run("builds the statement of the period", func(t *testing.T) {
+		bank, cash, closed := uuid.New(), uuid.New(), uuid.New()
+		food, salary := uuid.New(), uuid.New()
+		foodName, salaryName := "Ăn uống", "Lương"
+		day := func(d int) time.Time { return time.Date(2026, time.April, d, 9, 0, 0, 0, time.UTC) }
+
+		stub := &walletStatementReadModelStub{
+			wallet: query.Wallet{
+				ID:   uuid.New(),
+				Name: "Gia đình",
+				Allocations: []query.WalletAllocation{
+					{FundProviderID: bank, FundProviderName: "Techcombank"},
+					{FundProviderID: cash, FundProviderName: "Tiền mặt"},
+				},
+			},
+			ledgerExportReadModelStub: ledgerExportReadModelStub{
+				period: query.AccountingPeriodClosingReport{
+					ID:             uuid.New(),
+					Status:         ledger.AccountingPeriodOpen.String(),
+					OpeningBalance: 1_000_000,
+					TotalDebit:     350_000,
+					TotalCredit:    5_000_000,
+				},
+				// Recorded order, the salary was recorded after the groceries it came before
+				records: []query.Transaction{
+					{Direction: "OUT", Amount: 300_000, FundProviderID: cash, FundProviderName: "Tiền mặt", OccurredAt: day(10), CategoryID: &food, CategoryName: &foodName},
+					{Direction: "IN", Amount: 5_000_000, FundProviderID: bank, FundProviderName: "Techcombank", OccurredAt: day(5), CategoryID: &salary, CategoryName: &salaryName},
+					{Direction: "OUT", Amount: 50_000, FundProviderID: closed, FundProviderName: "Ví MoMo", OccurredAt: day(10)},
+				},
+			},
+		}
+
+		statement, err := query.NewGetWalletStatementHandler(stub).Handle(context.Background(), query.GetWalletStatement{
+			WalletID:  stub.wallet.ID,
+			YearMonth: "2026,4",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Gia đình", statement.WalletName)
+		assert.Equal(t, int64(5_650_000), statement.Period.ClosingBalance)
+
+		require.Len(t, statement.Lines, 3)
+		assert.Equal(t, int64(5_000_000), statement.Lines[0].Amount)
+		assert.Equal(t, []int64{6_000_000, 5_700_000, 5_650_000}, []int64{
+			statement.Lines[0].RunningBalance,
+			statement.Lines[1].RunningBalance,
+			statement.Lines[2].RunningBalance,
+		})
+
+		assert.Equal(t, []query.StatementFundProvider{
+			{FundProviderID: bank, FundProviderName: "Techcombank", Inflow: 5_000_000, Net: 5_000_000},
+			{FundProviderID: cash, FundProviderName: "Tiền mặt", Outflow: 300_000, Net: -300_000},
+			{FundProviderID: closed, FundProviderName: "Ví MoMo", Outflow: 50_000, Net: -50_000},
+		}, statement.FundProviders)
+
+		assert.Equal(t, []query.StatementCategory{
+			{CategoryID: &food, Name: "Ăn uống", Outflow: 300_000},
+			{CategoryID: &salary, Name: "Lương", Inflow: 5_000_000},
+			{Outflow: 50_000},
+		}, statement.Categories)
+	})
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+
+	"github.com/google/uuid"
+)
+
+type ListAccountingPeriods struct {
+	WalletID uuid.UUID
+}
+
+type ListAccountingPeriodsHandler cqrs.QueryHandler[ListAccountingPeriods, []AccountingPeriod]
+
+type ListAccountingPeriodsReadModel interface {
+	ListAccountingPeriods(ctx context.Context, wID uuid.UUID) ([]AccountingPeriod, error)
+}
+
+type listAccountingPeriodsHandler struct {
+	readModel ListAccountingPeriodsReadModel
+}
+
+func NewListAccountingPeriodsHandler(readModel ListAccountingPeriodsReadModel) ListAccountingPeriodsHandler {
+	return &listAccountingPeriodsHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listAccountingPeriodsHandler) Handle(ctx context.Context, q ListAccountingPeriods) ([]AccountingPeriod, error) {
+	periods, err := h.readModel.ListAccountingPeriods(ctx, q.WalletID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-accounting-periods")
+	}
+
+	return periods, nil
+}
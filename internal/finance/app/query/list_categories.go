@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+type ListCategories struct {
+	UserID string
+}
+
+type ListCategoriesHandler cqrs.QueryHandler[ListCategories, []Category]
+
+type ListCategoriesReadModel interface {
+	ListCategories(ctx context.Context, userID string) ([]Category, error)
+}
+
+type listCategoriesHandler struct {
+	readModel ListCategoriesReadModel
+}
+
+func NewListCategoriesHandler(readModel ListCategoriesReadModel) ListCategoriesHandler {
+	return &listCategoriesHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listCategoriesHandler) Handle(ctx context.Context, q ListCategories) ([]Category, error) {
+	categories, err := h.readModel.ListCategories(ctx, q.UserID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-categories")
+	}
+
+	return categories, nil
+}
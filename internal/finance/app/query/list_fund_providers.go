@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+type ListFundProviders struct{}
+
+type ListFundProvidersHandler cqrs.QueryHandler[ListFundProviders, []FundProvider]
+
+type ListFundProvidersReadModel interface {
+	ListFundProviders(ctx context.Context) ([]FundProvider, error)
+}
+
+type listFundProvidersHandler struct {
+	readModel ListFundProvidersReadModel
+}
+
+func NewListFundProvidersHandler(readModel ListFundProvidersReadModel) ListFundProvidersHandler {
+	return &listFundProvidersHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listFundProvidersHandler) Handle(ctx context.Context, _ ListFundProviders) ([]FundProvider, error) {
+	fps, err := h.readModel.ListFundProviders(ctx)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-fund-providers")
+	}
+
+	return fps, nil
+}
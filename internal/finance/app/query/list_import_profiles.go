@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+type ListImportProfiles struct {
+	UserID string
+}
+
+type ListImportProfilesHandler cqrs.QueryHandler[ListImportProfiles, []ImportProfile]
+
+type ListImportProfilesReadModel interface {
+	ListImportProfiles(ctx context.Context, userID string) ([]ImportProfile, error)
+}
+
+type listImportProfilesHandler struct {
+	readModel ListImportProfilesReadModel
+}
+
+func NewListImportProfilesHandler(readModel ListImportProfilesReadModel) ListImportProfilesHandler {
+	return &listImportProfilesHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listImportProfilesHandler) Handle(ctx context.Context, q ListImportProfiles) ([]ImportProfile, error) {
+	profiles, err := h.readModel.ListImportProfiles(ctx, q.UserID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-import-profiles")
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultOutboxBatchSize bounds the events relayed by a single run of the relay
+const DefaultOutboxBatchSize = 100
+
+// ListOutboxEventsDue lists the pending outbox events whose next delivery is due, the oldest first.
+type ListOutboxEventsDue struct {
+	// Limit defaults to DefaultOutboxBatchSize
+	Limit int
+}
+
+type ListOutboxEventsDueHandler cqrs.QueryHandler[ListOutboxEventsDue, []uuid.UUID]
+
+type ListOutboxEventsDueReadModel interface {
+	ListDueOutboxEventIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+}
+
+type listOutboxEventsDueHandler struct {
+	readModel ListOutboxEventsDueReadModel
+	now       func() time.Time
+}
+
+func NewListOutboxEventsDueHandler(
+	readModel ListOutboxEventsDueReadModel,
+	now func() time.Time,
+) ListOutboxEventsDueHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &listOutboxEventsDueHandler{
+		readModel: readModel,
+		now:       now,
+	}
+}
+
+func (h *listOutboxEventsDueHandler) Handle(ctx context.Context, q ListOutboxEventsDue) ([]uuid.UUID, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultOutboxBatchSize
+	}
+
+	ids, err := h.readModel.ListDueOutboxEventIDs(ctx, h.now(), limit)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-outbox-events-due")
+	}
+
+	return ids, nil
+}
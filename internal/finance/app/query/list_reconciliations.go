@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+
+	"github.com/google/uuid"
+)
+
+type ListReconciliations struct {
+	FundProviderID uuid.UUID
+}
+
+type ListReconciliationsHandler cqrs.QueryHandler[ListReconciliations, []Reconciliation]
+
+type ListReconciliationsReadModel interface {
+	// ListReconciliations returns the reconciliations of the fund provider, the latest statement first
+	ListReconciliations(ctx context.Context, fpID uuid.UUID) ([]Reconciliation, error)
+}
+
+type listReconciliationsHandler struct {
+	readModel ListReconciliationsReadModel
+}
+
+func NewListReconciliationsHandler(readModel ListReconciliationsReadModel) ListReconciliationsHandler {
+	return &listReconciliationsHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listReconciliationsHandler) Handle(ctx context.Context, q ListReconciliations) ([]Reconciliation, error) {
+	reconciliations, err := h.readModel.ListReconciliations(ctx, q.FundProviderID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-reconciliations")
+	}
+
+	return reconciliations, nil
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+
+	"github.com/google/uuid"
+)
+
+type ListRecurringTemplates struct {
+	WalletID uuid.UUID
+}
+
+type ListRecurringTemplatesHandler cqrs.QueryHandler[ListRecurringTemplates, []RecurringTemplate]
+
+type ListRecurringTemplatesReadModel interface {
+	ListRecurringTemplates(ctx context.Context, wID uuid.UUID) ([]RecurringTemplate, error)
+}
+
+type listRecurringTemplatesHandler struct {
+	readModel ListRecurringTemplatesReadModel
+}
+
+func NewListRecurringTemplatesHandler(readModel ListRecurringTemplatesReadModel) ListRecurringTemplatesHandler {
+	return &listRecurringTemplatesHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listRecurringTemplatesHandler) Handle(ctx context.Context, q ListRecurringTemplates) ([]RecurringTemplate, error) {
+	templates, err := h.readModel.ListRecurringTemplates(ctx, q.WalletID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-recurring-templates")
+	}
+
+	return templates, nil
+}
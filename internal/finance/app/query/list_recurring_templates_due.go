@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListRecurringTemplatesDue lists the active templates whose schedule has started.
+type ListRecurringTemplatesDue struct{}
+
+// RecurringTemplateDue is an active template whose schedule has started, owned by the user UserID.
+type RecurringTemplateDue struct {
+	TemplateID uuid.UUID
+	UserID     string
+}
+
+type ListRecurringTemplatesDueHandler cqrs.QueryHandler[ListRecurringTemplatesDue, []RecurringTemplateDue]
+
+type ListRecurringTemplatesDueReadModel interface {
+	ListActiveRecurringTemplates(ctx context.Context, startedBefore time.Time) ([]RecurringTemplateDue, error)
+}
+
+type listRecurringTemplatesDueHandler struct {
+	readModel ListRecurringTemplatesDueReadModel
+	now       func() time.Time
+}
+
+func NewListRecurringTemplatesDueHandler(
+	readModel ListRecurringTemplatesDueReadModel,
+	now func() time.Time,
+) ListRecurringTemplatesDueHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &listRecurringTemplatesDueHandler{
+		readModel: readModel,
+		now:       now,
+	}
+}
+
+func (h *listRecurringTemplatesDueHandler) Handle(ctx context.Context, q ListRecurringTemplatesDue) ([]RecurringTemplateDue, error) {
+	templates, err := h.readModel.ListActiveRecurringTemplates(ctx, h.now())
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-recurring-templates-due")
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+// ListStatementsDueForArchive lists the closed accounting periods whose statement is not archived yet,
+// the oldest first.
+type ListStatementsDueForArchive struct{}
+
+type ListStatementsDueForArchiveHandler cqrs.QueryHandler[ListStatementsDueForArchive, []StatementPeriod]
+
+type ListStatementsDueForArchiveReadModel interface {
+	ListClosedPeriodsWithoutStatementArchive(ctx context.Context) ([]StatementPeriod, error)
+}
+
+type listStatementsDueForArchiveHandler struct {
+	readModel ListStatementsDueForArchiveReadModel
+}
+
+func NewListStatementsDueForArchiveHandler(
+	readModel ListStatementsDueForArchiveReadModel,
+) ListStatementsDueForArchiveHandler {
+	return &listStatementsDueForArchiveHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listStatementsDueForArchiveHandler) Handle(
+	ctx context.Context,
+	q ListStatementsDueForArchive,
+) ([]StatementPeriod, error) {
+	periods, err := h.readModel.ListClosedPeriodsWithoutStatementArchive(ctx)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-statements-due-for-archive")
+	}
+
+	return periods, nil
+}
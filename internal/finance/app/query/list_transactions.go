@@ -0,0 +1,162 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultTransactionPageSize = 20
+	MaxTransactionPageSize     = 100
+)
+
+// ListTransactions lists the transaction records of a wallet, newest first.
+// Every filter is optional, year months use the "2024,4" format.
+type ListTransactions struct {
+	WalletID uuid.UUID
+
+	// Cursor is the id of the last transaction of the previous page
+	Cursor *uuid.UUID
+	Limit  int
+
+	FromYearMonth   string
+	ToYearMonth     string
+	FundProviderID  *uuid.UUID
+	TransactionType string
+	MinAmount       *int64
+	MaxAmount       *int64
+	TransactionNo   string
+	// CategoryID also matches the sub categories of a top level category
+	CategoryID *uuid.UUID
+}
+
+// TransactionFilter is ListTransactions validated and normalised for the read model.
+// Periods are expressed as year*12 + month so that ranges can be compared.
+type TransactionFilter struct {
+	WalletID        uuid.UUID
+	Cursor          *uuid.UUID
+	Limit           int
+	FromPeriod      *int32
+	ToPeriod        *int32
+	FundProviderID  *uuid.UUID
+	TransactionType *string
+	MinAmount       *int64
+	MaxAmount       *int64
+	TransactionNo   *string
+	CategoryID      *uuid.UUID
+}
+
+type ListTransactionsHandler cqrs.QueryHandler[ListTransactions, TransactionPage]
+
+type ListTransactionsReadModel interface {
+	ListTransactions(ctx context.Context, filter TransactionFilter) ([]Transaction, error)
+}
+
+type listTransactionsHandler struct {
+	readModel ListTransactionsReadModel
+}
+
+func NewListTransactionsHandler(readModel ListTransactionsReadModel) ListTransactionsHandler {
+	return &listTransactionsHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listTransactionsHandler) Handle(ctx context.Context, q ListTransactions) (TransactionPage, error) {
+	filter, err := toTransactionFilter(q)
+	if err != nil {
+		return TransactionPage{}, httperr.NewIncorrectInputError(err, "invalid-transaction-filter")
+	}
+
+	// Ask one more record than requested to know whether a next page exists
+	pageSize := filter.Limit
+	filter.Limit++
+
+	transactions, err := h.readModel.ListTransactions(ctx, filter)
+	if err != nil {
+		return TransactionPage{}, httperr.NewUnknowError(err, "failed-to-list-transactions")
+	}
+
+	page := TransactionPage{Transactions: transactions}
+	if len(transactions) > pageSize {
+		page.Transactions = transactions[:pageSize]
+
+		nextCursor := page.Transactions[pageSize-1].ID
+		page.NextCursor = &nextCursor
+	}
+
+	return page, nil
+}
+
+func toTransactionFilter(q ListTransactions) (TransactionFilter, error) {
+	filter := TransactionFilter{
+		WalletID:       q.WalletID,
+		Cursor:         q.Cursor,
+		Limit:          q.Limit,
+		FundProviderID: q.FundProviderID,
+		MinAmount:      q.MinAmount,
+		MaxAmount:      q.MaxAmount,
+		CategoryID:     q.CategoryID,
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultTransactionPageSize
+	}
+	if filter.Limit > MaxTransactionPageSize {
+		filter.Limit = MaxTransactionPageSize
+	}
+
+	if q.FromYearMonth != "" {
+		period, err := toPeriod(q.FromYearMonth)
+		if err != nil {
+			return TransactionFilter{}, err
+		}
+		filter.FromPeriod = &period
+	}
+
+	if q.ToYearMonth != "" {
+		period, err := toPeriod(q.ToYearMonth)
+		if err != nil {
+			return TransactionFilter{}, err
+		}
+		filter.ToPeriod = &period
+	}
+
+	if filter.FromPeriod != nil && filter.ToPeriod != nil && *filter.FromPeriod > *filter.ToPeriod {
+		return TransactionFilter{}, errors.New("fromYearMonth must not be after toYearMonth")
+	}
+
+	if filter.MinAmount != nil && filter.MaxAmount != nil && *filter.MinAmount > *filter.MaxAmount {
+		return TransactionFilter{}, errors.New("minAmount must not be greater than maxAmount")
+	}
+
+	if q.TransactionType != "" {
+		txType, err := ledger.NewTransactionType(q.TransactionType)
+		if err != nil {
+			return TransactionFilter{}, err
+		}
+
+		txTypeStr := txType.String()
+		filter.TransactionType = &txTypeStr
+	}
+
+	if q.TransactionNo != "" {
+		filter.TransactionNo = &q.TransactionNo
+	}
+
+	return filter, nil
+}
+
+func toPeriod(ymStr string) (int32, error) {
+	ym, err := ledger.UnmarshalYearMonthFromString(ymStr)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(ym.Year()*12 + ym.Month()), nil
+}
@@ -0,0 +1,136 @@
+package query_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/finance/app/query"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transactionReadModelStub struct {
+	transactions []query.Transaction
+	err          error
+	filter       query.TransactionFilter
+}
+
+func (s *transactionReadModelStub) ListTransactions(
+	ctx context.Context,
+	filter query.TransactionFilter,
+) ([]query.Transaction, error) {
+	s.filter = filter
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if len(s.transactions) > filter.Limit {
+		return s.transactions[:filter.Limit], nil
+	}
+
+	return s.transactions, nil
+}
+
+func newTransactions(t *testing.T, n int) []query.Transaction {
+	t.Helper()
+
+	transactions := make([]query.Transaction, 0, n)
+	for range n {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+
+		transactions = append(transactions, query.Transaction{ID: id})
+	}
+
+	return transactions
+}
+
+func TestListTransactionsHandler_Handle(t *testing.T) {
+	t.Run("returns error when filter is invalid", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			q    query.ListTransactions
+		}{
+			{
+				name: "invalid fromYearMonth",
+				q:    query.ListTransactions{FromYearMonth: "2026-04"},
+			},
+			{
+				name: "fromYearMonth after toYearMonth",
+				q:    query.ListTransactions{FromYearMonth: "2026,5", ToYearMonth: "2026,4"},
+			},
+			{
+				name: "minAmount greater than maxAmount",
+				q:    query.ListTransactions{MinAmount: convert.SafePtr[int64](10), MaxAmount: convert.SafePtr[int64](5)},
+			},
+			{
+				name: "unknown transaction type",
+				q:    query.ListTransactions{TransactionType: "UNKNOWN"},
+			},
+		}
+
+		for _, tt := range testCases {
+			t.Run(tt.name, func(t *testing.T) {
+				stub := &transactionReadModelStub{}
+
+				_, err := query.NewListTransactionsHandler(stub).Handle(context.Background(), tt.q)
+
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("returns error when read model fails", func(t *testing.T) {
+		stub := &transactionReadModelStub{err: assert.AnError}
+
+		_, err := query.NewListTransactionsHandler(stub).Handle(context.Background(), query.ListTransactions{})
+
+		require.Error(t, err)
+	})
+
+	t.Run("normalises the filter", func(t *testing.T) {
+		stub := &transactionReadModelStub{}
+
+		_, err := query.NewListTransactionsHandler(stub).Handle(context.Background(), query.ListTransactions{
+			Limit:           1_000,
+			FromYearMonth:   "2025,12",
+			ToYearMonth:     "2026,1",
+			TransactionType: " deposit ",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, query.MaxTransactionPageSize+1, stub.filter.Limit)
+		assert.Equal(t, int32(2025*12+12), *stub.filter.FromPeriod)
+		assert.Equal(t, int32(2026*12+1), *stub.filter.ToPeriod)
+		assert.Equal(t, "DEPOSIT", *stub.filter.TransactionType)
+		assert.Nil(t, stub.filter.TransactionNo)
+	})
+
+	t.Run("returns next cursor when there are more records", func(t *testing.T) {
+		transactions := newTransactions(t, 3)
+		stub := &transactionReadModelStub{transactions: transactions}
+
+		page, err := query.NewListTransactionsHandler(stub).Handle(context.Background(), query.ListTransactions{
+			Limit: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Transactions, 2)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, transactions[1].ID, *page.NextCursor)
+	})
+
+	t.Run("returns no cursor on the last page", func(t *testing.T) {
+		stub := &transactionReadModelStub{transactions: newTransactions(t, 2)}
+
+		page, err := query.NewListTransactionsHandler(stub).Handle(context.Background(), query.ListTransactions{
+			Limit: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Transactions, 2)
+		assert.Nil(t, page.NextCursor)
+	})
+}
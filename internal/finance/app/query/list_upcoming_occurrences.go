@@ -0,0 +1,125 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultUpcomingDays = 30
+	maxUpcomingDays     = 366
+)
+
+// ListUpcomingOccurrences lists the dates of the recurring templates of a wallet from today on
+// that are not posted yet, Days defaults to 30.
+type ListUpcomingOccurrences struct {
+	WalletID uuid.UUID
+	Days     int
+}
+
+type ListUpcomingOccurrencesHandler cqrs.QueryHandler[ListUpcomingOccurrences, []UpcomingOccurrence]
+
+type ListUpcomingOccurrencesReadModel interface {
+	ListRecurringTemplates(ctx context.Context, wID uuid.UUID) ([]RecurringTemplate, error)
+	ListRecurringOccurrences(ctx context.Context, wID uuid.UUID, from time.Time) ([]RecurringOccurrence, error)
+}
+
+type listUpcomingOccurrencesHandler struct {
+	readModel ListUpcomingOccurrencesReadModel
+	now       func() time.Time
+}
+
+func NewListUpcomingOccurrencesHandler(
+	readModel ListUpcomingOccurrencesReadModel,
+	now func() time.Time,
+) ListUpcomingOccurrencesHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &listUpcomingOccurrencesHandler{
+		readModel: readModel,
+		now:       now,
+	}
+}
+
+func (h *listUpcomingOccurrencesHandler) Handle(ctx context.Context, q ListUpcomingOccurrences) ([]UpcomingOccurrence, error) {
+	days := q.Days
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+
+	if days < 0 || days > maxUpcomingDays {
+		return nil, httperr.NewIncorrectInputError(errors.New("days must be between 1 and 366"), "invalid-upcoming-days")
+	}
+
+	templates, err := h.readModel.ListRecurringTemplates(ctx, q.WalletID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-recurring-templates")
+	}
+
+	now := h.now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	handled, err := h.readModel.ListRecurringOccurrences(ctx, q.WalletID, from)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-recurring-occurrences")
+	}
+
+	statusByDate := make(map[uuid.UUID]map[string]string, len(templates))
+	for _, o := range handled {
+		if statusByDate[o.TemplateID] == nil {
+			statusByDate[o.TemplateID] = make(map[string]string)
+		}
+		statusByDate[o.TemplateID][o.Date.Format(time.DateOnly)] = o.Status
+	}
+
+	upcoming := make([]UpcomingOccurrence, 0)
+	for _, t := range templates {
+		schedule, err := recurring.NewSchedule(t.Frequency, t.Interval, t.DayOfMonth, t.StartDate)
+		if err != nil {
+			return nil, httperr.NewUnknowError(err, "failed-to-list-upcoming-occurrences")
+		}
+
+		// Dates are compared on the calendar of the schedule
+		tFrom := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, t.StartDate.Location())
+		for _, date := range schedule.Occurrences(tFrom, tFrom.AddDate(0, 0, days)) {
+			status := "SCHEDULED"
+			switch statusByDate[t.ID][date.Format(time.DateOnly)] {
+			case recurring.OccurrencePosted.String():
+				continue
+			case recurring.OccurrenceSkipped.String():
+				status = recurring.OccurrenceSkipped.String()
+			default:
+				if t.Status == recurring.StatusPaused.String() {
+					status = recurring.StatusPaused.String()
+				}
+			}
+
+			upcoming = append(upcoming, UpcomingOccurrence{
+				TemplateID:      t.ID,
+				Date:            date,
+				FundProviderID:  t.FundProviderID,
+				Amount:          t.Amount,
+				TransactionType: t.TransactionType,
+				CategoryID:      t.CategoryID,
+				CategoryName:    t.CategoryName,
+				Description:     t.Description,
+				Status:          status,
+			})
+		}
+	}
+
+	slices.SortStableFunc(upcoming, func(a, b UpcomingOccurrence) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return upcoming, nil
+}
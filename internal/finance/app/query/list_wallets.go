@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+type ListWallets struct{}
+
+type ListWalletsHandler cqrs.QueryHandler[ListWallets, []Wallet]
+
+type ListWalletsReadModel interface {
+	ListWallets(ctx context.Context) ([]Wallet, error)
+}
+
+type listWalletsHandler struct {
+	readModel ListWalletsReadModel
+}
+
+func NewListWalletsHandler(readModel ListWalletsReadModel) ListWalletsHandler {
+	return &listWalletsHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listWalletsHandler) Handle(ctx context.Context, _ ListWallets) ([]Wallet, error) {
+	wallets, err := h.readModel.ListWallets(ctx)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-wallets")
+	}
+
+	return wallets, nil
+}
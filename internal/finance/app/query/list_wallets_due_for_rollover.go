@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListWalletsDueForRollover lists the wallets whose latest accounting period has ended.
+type ListWalletsDueForRollover struct{}
+
+// WalletDueForRollover is a wallet whose latest accounting period has ended, UserID is empty when it has no owner.
+type WalletDueForRollover struct {
+	WalletID uuid.UUID
+	UserID   string
+}
+
+type ListWalletsDueForRolloverHandler cqrs.QueryHandler[ListWalletsDueForRollover, []WalletDueForRollover]
+
+type ListWalletsDueForRolloverReadModel interface {
+	ListWalletsWithEndedPeriod(ctx context.Context, endedBefore time.Time) ([]WalletDueForRollover, error)
+}
+
+type listWalletsDueForRolloverHandler struct {
+	readModel ListWalletsDueForRolloverReadModel
+	now       func() time.Time
+}
+
+func NewListWalletsDueForRolloverHandler(
+	readModel ListWalletsDueForRolloverReadModel,
+	now func() time.Time,
+) ListWalletsDueForRolloverHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &listWalletsDueForRolloverHandler{
+		readModel: readModel,
+		now:       now,
+	}
+}
+
+func (h *listWalletsDueForRolloverHandler) Handle(ctx context.Context, q ListWalletsDueForRollover) ([]WalletDueForRollover, error) {
+	wallets, err := h.readModel.ListWalletsWithEndedPeriod(ctx, h.now())
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-wallets-due-for-rollover")
+	}
+
+	return wallets, nil
+}
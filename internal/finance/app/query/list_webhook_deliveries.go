@@ -0,0 +1,81 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultWebhookDeliveryPageSize = 50
+	MaxWebhookDeliveryPageSize     = 200
+)
+
+// ListWebhookDeliveries lists the deliveries of a subscription of the user, the latest first.
+type ListWebhookDeliveries struct {
+	UserID         string
+	SubscriptionID uuid.UUID
+	// Status is optional, one of PENDING, SUCCEEDED or DEAD
+	Status string
+	// Limit defaults to DefaultWebhookDeliveryPageSize and is capped at MaxWebhookDeliveryPageSize
+	Limit int
+}
+
+type ListWebhookDeliveriesHandler cqrs.QueryHandler[ListWebhookDeliveries, []WebhookDelivery]
+
+type ListWebhookDeliveriesReadModel interface {
+	// ListWebhookDeliveries returns the deliveries of the subscription with their attempts,
+	// common_db.ErrNotFound when the subscription is not one of userID.
+	ListWebhookDeliveries(
+		ctx context.Context,
+		userID string,
+		subscriptionID uuid.UUID,
+		status string,
+		limit int,
+	) ([]WebhookDelivery, error)
+}
+
+type listWebhookDeliveriesHandler struct {
+	readModel ListWebhookDeliveriesReadModel
+}
+
+func NewListWebhookDeliveriesHandler(readModel ListWebhookDeliveriesReadModel) ListWebhookDeliveriesHandler {
+	return &listWebhookDeliveriesHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listWebhookDeliveriesHandler) Handle(ctx context.Context, q ListWebhookDeliveries) ([]WebhookDelivery, error) {
+	status := ""
+	if q.Status != "" {
+		s, err := webhook.NewStatus(q.Status)
+		if err != nil {
+			return nil, httperr.NewIncorrectInputError(err, "invalid-webhook-delivery-status")
+		}
+
+		status = s.String()
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultWebhookDeliveryPageSize
+	}
+	if limit > MaxWebhookDeliveryPageSize {
+		limit = MaxWebhookDeliveryPageSize
+	}
+
+	deliveries, err := h.readModel.ListWebhookDeliveries(ctx, q.UserID, q.SubscriptionID, status, limit)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return nil, httperr.NewNotFoundError(err, "webhook-subscription-not-found")
+	}
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-webhook-deliveries")
+	}
+
+	return deliveries, nil
+}
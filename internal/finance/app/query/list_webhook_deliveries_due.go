@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultWebhookBatchSize bounds the deliveries attempted by a single run of the delivery worker
+const DefaultWebhookBatchSize = 100
+
+// ListWebhookDeliveriesDue lists the pending webhook deliveries whose next attempt is due, the oldest first.
+type ListWebhookDeliveriesDue struct {
+	// Limit defaults to DefaultWebhookBatchSize
+	Limit int
+}
+
+type ListWebhookDeliveriesDueHandler cqrs.QueryHandler[ListWebhookDeliveriesDue, []uuid.UUID]
+
+type ListWebhookDeliveriesDueReadModel interface {
+	ListDueWebhookDeliveryIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+}
+
+type listWebhookDeliveriesDueHandler struct {
+	readModel ListWebhookDeliveriesDueReadModel
+	now       func() time.Time
+}
+
+func NewListWebhookDeliveriesDueHandler(
+	readModel ListWebhookDeliveriesDueReadModel,
+	now func() time.Time,
+) ListWebhookDeliveriesDueHandler {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &listWebhookDeliveriesDueHandler{
+		readModel: readModel,
+		now:       now,
+	}
+}
+
+func (h *listWebhookDeliveriesDueHandler) Handle(ctx context.Context, q ListWebhookDeliveriesDue) ([]uuid.UUID, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultWebhookBatchSize
+	}
+
+	ids, err := h.readModel.ListDueWebhookDeliveryIDs(ctx, h.now(), limit)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-webhook-deliveries-due")
+	}
+
+	return ids, nil
+}
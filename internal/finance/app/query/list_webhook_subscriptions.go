@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+)
+
+type ListWebhookSubscriptions struct {
+	UserID string
+}
+
+type ListWebhookSubscriptionsHandler cqrs.QueryHandler[ListWebhookSubscriptions, []WebhookSubscription]
+
+type ListWebhookSubscriptionsReadModel interface {
+	ListWebhookSubscriptions(ctx context.Context, userID string) ([]WebhookSubscription, error)
+}
+
+type listWebhookSubscriptionsHandler struct {
+	readModel ListWebhookSubscriptionsReadModel
+}
+
+func NewListWebhookSubscriptionsHandler(readModel ListWebhookSubscriptionsReadModel) ListWebhookSubscriptionsHandler {
+	return &listWebhookSubscriptionsHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *listWebhookSubscriptionsHandler) Handle(ctx context.Context, q ListWebhookSubscriptions) ([]WebhookSubscription, error) {
+	subscriptions, err := h.readModel.ListWebhookSubscriptions(ctx, q.UserID)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-list-webhook-subscriptions")
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,194 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sumni-finance-backend/internal/common/cqrs"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PreviewImport parses a bank statement and tells which rows would be recorded against the fund provider,
+// nothing is recorded.
+type PreviewImport struct {
+	UserID         string
+	WalletID       uuid.UUID
+	FundProviderID uuid.UUID
+	// Format is one of CSV, OFX, QIF, CAMT053 and MT940
+	Format string
+	// ProfileID is required for CSV only
+	ProfileID uuid.UUID
+	Content   []byte
+}
+
+type PreviewImportHandler cqrs.QueryHandler[PreviewImport, ImportPreview]
+
+type PreviewImportReadModel interface {
+	GetImportProfile(ctx context.Context, userID string, pID uuid.UUID) (ImportProfile, error)
+	// GetLatestAccountingPeriod returns the period of the wallet that ends last
+	GetLatestAccountingPeriod(ctx context.Context, wID uuid.UUID) (AccountingPeriod, error)
+	ListRecordedTransactionNos(ctx context.Context, fpID uuid.UUID, transactionNos []string) ([]string, error)
+	GetFundProviderBalance(ctx context.Context, fpID uuid.UUID) (int64, error)
+}
+
+type previewImportHandler struct {
+	readModel PreviewImportReadModel
+}
+
+func NewPreviewImportHandler(readModel PreviewImportReadModel) PreviewImportHandler {
+	return &previewImportHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *previewImportHandler) Handle(ctx context.Context, q PreviewImport) (ImportPreview, error) {
+	format, err := importing.NewFormat(q.Format)
+	if err != nil {
+		return ImportPreview{}, httperr.NewIncorrectInputError(err, "invalid-statement-format")
+	}
+
+	var profile *importing.MappingProfile
+	if format == importing.FormatCSV {
+		profile, err = h.getProfile(ctx, q.UserID, q.ProfileID)
+		if err != nil {
+			return ImportPreview{}, err
+		}
+	}
+
+	batch, err := importing.ParseStatement(format, profile, bytes.NewReader(q.Content), time.Local)
+	if errors.Is(err, importing.ErrMissingColumn) {
+		return ImportPreview{}, httperr.NewIncorrectInputError(err, "statement-column-missing")
+	}
+	if errors.Is(err, importing.ErrUnreadableStatement) {
+		return ImportPreview{}, httperr.NewIncorrectInputError(err, "unreadable-statement")
+	}
+	if err != nil {
+		return ImportPreview{}, httperr.NewUnknowError(err, "failed-to-parse-statement")
+	}
+
+	recorded, err := h.readModel.ListRecordedTransactionNos(ctx, q.FundProviderID, batch.References())
+	if err != nil {
+		return ImportPreview{}, httperr.NewUnknowError(err, "failed-to-list-recorded-transactions")
+	}
+	batch.MarkRecorded(recorded)
+
+	ap, err := h.readModel.GetLatestAccountingPeriod(ctx, q.WalletID)
+	if err != nil && !errors.Is(err, common_db.ErrNotFound) {
+		return ImportPreview{}, httperr.NewUnknowError(err, "failed-to-get-accounting-period")
+	}
+	if errors.Is(err, common_db.ErrNotFound) || ap.Status != ledger.AccountingPeriodOpen.String() {
+		return ImportPreview{}, httperr.NewIncorrectInputError(wallet.ErrNoOpenAccountingPeriod, "wallet-has-no-open-accounting-period")
+	}
+	batch.MarkOutsidePeriod(ap.StartTime, ap.EndDate)
+
+	preview := toImportPreview(ap.YearMonth, batch)
+
+	if _, exist := batch.LedgerBalance(); exist {
+		fpBalance, err := h.readModel.GetFundProviderBalance(ctx, q.FundProviderID)
+		if errors.Is(err, common_db.ErrNotFound) {
+			return ImportPreview{}, httperr.NewIncorrectInputError(err, "fund-provider-not-found")
+		}
+		if err != nil {
+			return ImportPreview{}, httperr.NewUnknowError(err, "failed-to-get-fund-provider")
+		}
+
+		report, _ := batch.Reconcile(fpBalance)
+		preview.Reconciliation = toImportReconciliation(report)
+	}
+
+	return preview, nil
+}
+
+// getProfile loads the mapping profile of userID reading a CSV statement.
+func (h *previewImportHandler) getProfile(ctx context.Context, userID string, pID uuid.UUID) (*importing.MappingProfile, error) {
+	if pID == uuid.Nil {
+		return nil, httperr.NewIncorrectInputError(importing.ErrProfileRequired, "import-profile-required")
+	}
+
+	p, err := h.readModel.GetImportProfile(ctx, userID, pID)
+	if errors.Is(err, common_db.ErrNotFound) {
+		return nil, httperr.NewIncorrectInputError(err, "import-profile-not-found")
+	}
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-get-import-profile")
+	}
+
+	profile, err := importing.UnmarshalMappingProfileFromDatabase(p.ID, userID, importing.ProfileSpec{
+		Name:               p.Name,
+		Delimiter:          p.Delimiter,
+		SkipRows:           p.SkipRows,
+		DateColumn:         p.DateColumn,
+		DateFormat:         p.DateFormat,
+		AmountSign:         p.AmountSign,
+		AmountColumn:       p.AmountColumn,
+		DebitColumn:        p.DebitColumn,
+		CreditColumn:       p.CreditColumn,
+		DescriptionColumn:  p.DescriptionColumn,
+		ReferenceColumn:    p.ReferenceColumn,
+		ThousandsSeparator: p.ThousandsSeparator,
+	}, p.Version)
+	if err != nil {
+		return nil, httperr.NewUnknowError(err, "failed-to-get-import-profile")
+	}
+
+	return profile, nil
+}
+
+func toImportPreview(yearMonth string, batch *importing.Batch) ImportPreview {
+	rows := make([]ImportRow, 0, len(batch.Rows()))
+	for _, r := range batch.Rows() {
+		row := ImportRow{
+			Line:    r.Line().Number,
+			Status:  r.Status().String(),
+			Problem: r.Problem(),
+		}
+
+		if r.Status() != importing.RowInvalid {
+			line := r.Line()
+			row.OccurredAt = &line.OccurredAt
+			row.Amount = line.Amount
+			row.Direction = line.Direction.String()
+			row.TransactionType = line.TransactionType().String()
+			row.Description = line.Description
+			row.TransactionNo = line.Reference
+		}
+
+		rows = append(rows, row)
+	}
+
+	return ImportPreview{
+		YearMonth:          yearMonth,
+		Rows:               rows,
+		NewCount:           batch.Count(importing.RowNew),
+		DuplicateCount:     batch.Count(importing.RowDuplicate),
+		InvalidCount:       batch.Count(importing.RowInvalid),
+		OutsidePeriodCount: batch.Count(importing.RowOutsidePeriod),
+	}
+}
+
+func toImportReconciliation(report importing.Reconciliation) *ImportReconciliation {
+	reconciliation := &ImportReconciliation{
+		ClosingBalance:      report.Closing.Amount,
+		ClosingAsOf:         report.Closing.AsOf,
+		FundProviderBalance: report.FundProviderBalance,
+		ProjectedBalance:    report.ProjectedBalance,
+		OpeningDifference:   report.OpeningDifference,
+		ClosingDifference:   report.ClosingDifference,
+		UnrecordedLines:     report.UnrecordedLines,
+		Balanced:            report.Balanced(),
+	}
+
+	if report.Opening != nil {
+		reconciliation.OpeningBalance = &report.Opening.Amount
+		reconciliation.OpeningAsOf = &report.Opening.AsOf
+	}
+
+	return reconciliation
+}
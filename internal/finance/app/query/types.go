@@ -0,0 +1,392 @@
+package query
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccountingPeriodClosingReport struct {
+	ID               uuid.UUID
+	YearMonth        string
+	Status           string
+	EndDate          time.Time
+	Currency         string
+	OpeningBalance   int64
+	TotalDebit       int64
+	TotalCredit      int64
+	TotalIncome      int64
+	TotalExpense     int64
+	ClosingBalance   int64
+	TransactionCount int64
+}
+
+type Wallet struct {
+	ID             uuid.UUID
+	Name           string
+	Balance        int64
+	Currency       string
+	Version        int32
+	PeriodStartDay int32
+	PeriodInterval int32
+	Allocations    []WalletAllocation
+}
+
+type WalletAllocation struct {
+	FundProviderID   uuid.UUID
+	FundProviderName string
+	FundProviderType string
+	Allocated        int64
+}
+
+type FundProvider struct {
+	ID                 uuid.UUID
+	Name               string
+	Type               string
+	Currency           string
+	Balance            int64
+	AllocatedBalance   int64
+	UnallocatedBalance int64
+	Version            int32
+	Allocations        []FundProviderAllocation
+}
+
+type FundProviderAllocation struct {
+	WalletID   uuid.UUID
+	WalletName string
+	Allocated  int64
+}
+
+type AccountingPeriod struct {
+	ID             uuid.UUID
+	YearMonth      string
+	Status         string
+	StartDay       int32
+	Interval       int32
+	StartTime      time.Time
+	EndDate        time.Time
+	OpeningBalance int64
+	TotalDebit     int64
+	TotalCredit    int64
+	TotalIncome    int64
+	TotalExpense   int64
+	ClosingBalance int64
+	Version        int32
+}
+
+type PeriodContinuityReport struct {
+	WalletID       uuid.UUID
+	CheckedPeriods int
+	Continuous     bool
+	Issues         []PeriodContinuityIssue
+}
+
+type PeriodContinuityIssue struct {
+	Kind                   string
+	YearMonth              string
+	PreviousYearMonth      string
+	StartTime              time.Time
+	PreviousEndDate        time.Time
+	OpeningBalance         int64
+	PreviousClosingBalance int64
+}
+
+type Transaction struct {
+	ID               uuid.UUID
+	TransactionNo    string
+	TransactionType  string
+	Direction        string
+	Amount           int64
+	WalletBalance    int64
+	FundProviderID   uuid.UUID
+	FundProviderName string
+	FpBalance        int64
+	Description      string
+	OccurredAt       time.Time
+	RecordedAt       time.Time
+	LinkedID         *uuid.UUID
+	ReversalOfID     *uuid.UUID
+	ReversedByID     *uuid.UUID
+	ReversedAt       *time.Time
+	CategoryID       *uuid.UUID
+	CategoryName     *string
+	YearMonth        string
+}
+
+type TransactionPage struct {
+	Transactions []Transaction
+	// NextCursor is the id to pass as cursor for the next page, nil on the last page
+	NextCursor *uuid.UUID
+}
+
+// DuplicateWarning lists the recorded transactions looking like the Index-th transaction to record.
+type DuplicateWarning struct {
+	Index      int
+	Candidates []DuplicateCandidate
+}
+
+type DuplicateCandidate struct {
+	ID              uuid.UUID
+	WalletID        uuid.UUID
+	WalletName      string
+	TransactionNo   string
+	TransactionType string
+	Direction       string
+	Amount          int64
+	Description     string
+	OccurredAt      time.Time
+	YearMonth       string
+}
+
+// Category is a top level category with its sub categories in Children.
+type Category struct {
+	ID       uuid.UUID
+	ParentID *uuid.UUID
+	Name     string
+	Kind     string
+	Version  int32
+	Children []Category
+}
+
+type BudgetReport struct {
+	AccountingPeriodID uuid.UUID
+	YearMonth          string
+	Currency           string
+	TotalBudgeted      int64
+	TotalActual        int64
+	TotalRemaining     int64
+	Categories         []CategoryBudgetStatus
+}
+
+// CategoryBudgetStatus compares the budget of a category with what was actually spent on it,
+// the spending of a top level category includes its sub categories.
+type CategoryBudgetStatus struct {
+	CategoryID uuid.UUID
+	ParentID   *uuid.UUID
+	Name       string
+	Budgeted   int64
+	Actual     int64
+	Remaining  int64
+	OverBudget bool
+}
+
+type RecurringTemplate struct {
+	ID              uuid.UUID
+	FundProviderID  uuid.UUID
+	Amount          int64
+	TransactionType string
+	CategoryID      *uuid.UUID
+	CategoryName    *string
+	Description     string
+	Frequency       string
+	Interval        int32
+	DayOfMonth      int32
+	StartDate       time.Time
+	Status          string
+	PausedAt        *time.Time
+	Version         int32
+}
+
+// RecurringOccurrence is a date of a template that is already posted or skipped.
+type RecurringOccurrence struct {
+	TemplateID uuid.UUID
+	Date       time.Time
+	Status     string
+}
+
+type UpcomingOccurrence struct {
+	TemplateID      uuid.UUID
+	Date            time.Time
+	FundProviderID  uuid.UUID
+	Amount          int64
+	TransactionType string
+	CategoryID      *uuid.UUID
+	CategoryName    *string
+	Description     string
+	// Status is SCHEDULED, SKIPPED, or PAUSED while the template is paused
+	Status string
+}
+
+type ImportProfile struct {
+	ID                 uuid.UUID
+	Name               string
+	Delimiter          string
+	SkipRows           int32
+	DateColumn         string
+	DateFormat         string
+	AmountSign         string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	DescriptionColumn  string
+	ReferenceColumn    string
+	ThousandsSeparator string
+	Version            int32
+}
+
+type ImportPreview struct {
+	// YearMonth is the open accounting period the NEW rows are recorded into
+	YearMonth          string
+	Rows               []ImportRow
+	NewCount           int
+	DuplicateCount     int
+	InvalidCount       int
+	OutsidePeriodCount int
+	// Reconciliation is nil when the statement reports no balance
+	Reconciliation *ImportReconciliation
+}
+
+// ImportReconciliation compares the balances of a statement with the balance of the fund provider,
+// differences are the statement minus the fund provider. The import is refused unless it is balanced.
+type ImportReconciliation struct {
+	// OpeningBalance is nil when the statement reports its closing balance only, as OFX does
+	OpeningBalance *int64
+	OpeningAsOf    *time.Time
+	ClosingBalance int64
+	ClosingAsOf    time.Time
+	// FundProviderBalance is the balance before the import, ProjectedBalance the one once the NEW rows are recorded
+	FundProviderBalance int64
+	ProjectedBalance    int64
+	OpeningDifference   int64
+	ClosingDifference   int64
+	// UnrecordedLines are the INVALID and OUTSIDE_PERIOD rows
+	UnrecordedLines []int
+	Balanced        bool
+}
+
+type ImportRow struct {
+	Line int
+	// OccurredAt is nil for an INVALID row, as are the other fields of the transaction
+	OccurredAt      *time.Time
+	Amount          int64
+	Direction       string
+	TransactionType string
+	Description     string
+	TransactionNo   string
+	// Status is NEW, DUPLICATE, INVALID or OUTSIDE_PERIOD
+	Status  string
+	Problem string
+}
+
+// WalletStatement is the monthly statement of a wallet, the closing balance of an open period is its balance so far.
+type WalletStatement struct {
+	WalletID      uuid.UUID
+	WalletName    string
+	Period        AccountingPeriodClosingReport
+	FundProviders []StatementFundProvider
+	Lines         []StatementLine
+	Categories    []StatementCategory
+}
+
+// StatementFundProvider is the money the wallet moved through a fund provider during the period.
+type StatementFundProvider struct {
+	FundProviderID   uuid.UUID
+	FundProviderName string
+	Inflow           int64
+	Outflow          int64
+	Net              int64
+}
+
+// StatementLine is a transaction record with the balance of the wallet once it occurred.
+type StatementLine struct {
+	Transaction
+	RunningBalance int64
+}
+
+// StatementCategory totals the records of a category, CategoryID is nil for the uncategorised records.
+type StatementCategory struct {
+	CategoryID *uuid.UUID
+	Name       string
+	Inflow     int64
+	Outflow    int64
+}
+
+type StatementArchive struct {
+	ID                 uuid.UUID
+	AccountingPeriodID uuid.UUID
+	YearMonth          string
+	FileName           string
+	Content            []byte
+	SHA256             string
+	ArchivedAt         time.Time
+}
+
+// StatementPeriod is a closed accounting period of a wallet.
+type StatementPeriod struct {
+	WalletID  uuid.UUID
+	YearMonth string
+}
+
+// Reconciliation matches the records of a fund provider with a bank statement. The cleared balance is
+// the balance of the fund provider less the uncleared records, Difference the statement balance minus it.
+// Both are only computed while the reconciliation is in progress.
+type Reconciliation struct {
+	ID               uuid.UUID
+	FundProviderID   uuid.UUID
+	StatementDate    time.Time
+	StatementBalance int64
+	// Status is IN_PROGRESS, FINALISED or CANCELLED
+	Status       string
+	CreatedAt    time.Time
+	FinalisedAt  *time.Time
+	ClearedCount int
+	Version      int32
+	// Currency, BookBalance, ClearedBalance, Difference and Records are only set when getting a reconciliation
+	Currency       string
+	BookBalance    int64
+	ClearedBalance int64
+	Difference     int64
+	Records        []ReconciliationRecord
+}
+
+// ReconciliationRecord is a record of the fund provider the reconciliation can clear, once finalised
+// only the records it cleared are listed.
+type ReconciliationRecord struct {
+	ID              uuid.UUID
+	WalletID        uuid.UUID
+	WalletName      string
+	TransactionNo   string
+	TransactionType string
+	Direction       string
+	Amount          int64
+	Description     string
+	OccurredAt      time.Time
+	Cleared         bool
+	Adjustment      bool
+}
+
+// WebhookSubscription is listed without its secret, only the client that created it knows it.
+type WebhookSubscription struct {
+	ID  uuid.UUID
+	URL string
+	// EventTypes is empty when every event is posted
+	EventTypes []string
+	CreatedAt  time.Time
+	Version    int32
+}
+
+// WebhookDelivery is an event posted to the endpoint of a subscription, with the log of its attempts.
+type WebhookDelivery struct {
+	ID        uuid.UUID
+	EventID   uuid.UUID
+	EventName string
+	// Status is PENDING, SUCCEEDED or DEAD
+	Status   string
+	Attempts int32
+	// NextAttemptAt is only set while the delivery is pending
+	NextAttemptAt  *time.Time
+	LastStatusCode *int32
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+	AttemptLog     []WebhookDeliveryAttempt
+}
+
+type WebhookDeliveryAttempt struct {
+	Attempt     int32
+	AttemptedAt time.Time
+	// StatusCode is nil when the endpoint could not be reached
+	StatusCode *int32
+	Error      string
+	DurationMs int32
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"sumni-finance-backend/internal/common/cqrs"
+	"sumni-finance-backend/internal/common/server/httperr"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PeriodContinuityGap             = "GAP"
+	PeriodContinuityOverlap         = "OVERLAP"
+	PeriodContinuityBalanceMismatch = "BALANCE_MISMATCH"
+)
+
+type VerifyPeriodContinuity struct {
+	WalletID uuid.UUID
+}
+
+type VerifyPeriodContinuityHandler cqrs.QueryHandler[VerifyPeriodContinuity, PeriodContinuityReport]
+
+type verifyPeriodContinuityHandler struct {
+	readModel ListAccountingPeriodsReadModel
+}
+
+func NewVerifyPeriodContinuityHandler(readModel ListAccountingPeriodsReadModel) VerifyPeriodContinuityHandler {
+	return &verifyPeriodContinuityHandler{
+		readModel: readModel,
+	}
+}
+
+func (h *verifyPeriodContinuityHandler) Handle(ctx context.Context, q VerifyPeriodContinuity) (PeriodContinuityReport, error) {
+	periods, err := h.readModel.ListAccountingPeriods(ctx, q.WalletID)
+	if err != nil {
+		return PeriodContinuityReport{}, httperr.NewUnknowError(err, "failed-to-list-accounting-periods")
+	}
+
+	periods = slices.Clone(periods)
+	slices.SortFunc(periods, func(a, b AccountingPeriod) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	issues := []PeriodContinuityIssue{}
+	for i := 1; i < len(periods); i++ {
+		previous, current := periods[i-1], periods[i]
+
+		issue := PeriodContinuityIssue{
+			YearMonth:              current.YearMonth,
+			PreviousYearMonth:      previous.YearMonth,
+			StartTime:              current.StartTime,
+			PreviousEndDate:        previous.EndDate,
+			OpeningBalance:         current.OpeningBalance,
+			PreviousClosingBalance: previous.ClosingBalance,
+		}
+
+		switch {
+		case current.StartTime.After(previous.EndDate):
+			issue.Kind = PeriodContinuityGap
+			issues = append(issues, issue)
+		case current.StartTime.Before(previous.EndDate):
+			issue.Kind = PeriodContinuityOverlap
+			issues = append(issues, issue)
+		}
+
+		// The closing balance of an open period is not calculated yet
+		if previous.Status == "CLOSE" && current.OpeningBalance != previous.ClosingBalance {
+			issue.Kind = PeriodContinuityBalanceMismatch
+			issues = append(issues, issue)
+		}
+	}
+
+	return PeriodContinuityReport{
+		WalletID:       q.WalletID,
+		CheckedPeriods: len(periods),
+		Continuous:     len(issues) == 0,
+		Issues:         issues,
+	}, nil
+}
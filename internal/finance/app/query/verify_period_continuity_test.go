@@ -0,0 +1,102 @@
+package query_test
+
+import (
+	"context"
+	"sumni-finance-backend/internal/finance/app/query"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type accountingPeriodReadModelStub struct {
+	periods []query.AccountingPeriod
+	err     error
+}
+
+func (s *accountingPeriodReadModelStub) ListAccountingPeriods(
+	ctx context.Context,
+	wID uuid.UUID,
+) ([]query.AccountingPeriod, error) {
+	return s.periods, s.err
+}
+
+func TestVerifyPeriodContinuityHandler_Handle(t *testing.T) {
+	monthStart := func(month time.Month) time.Time {
+		return time.Date(2026, month, 1, 0, 0, 0, 0, time.Local)
+	}
+
+	newPeriod := func(month time.Month, status string, opening, closing int64) query.AccountingPeriod {
+		return query.AccountingPeriod{
+			ID:             uuid.New(),
+			YearMonth:      monthStart(month).Format("2006,1"),
+			Status:         status,
+			StartTime:      monthStart(month),
+			EndDate:        monthStart(month + 1),
+			OpeningBalance: opening,
+			ClosingBalance: closing,
+		}
+	}
+
+	t.Run("returns error when read model fails", func(t *testing.T) {
+		stub := &accountingPeriodReadModelStub{err: assert.AnError}
+
+		_, err := query.NewVerifyPeriodContinuityHandler(stub).Handle(context.Background(), query.VerifyPeriodContinuity{
+			WalletID: uuid.New(),
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("reports continuous periods", func(t *testing.T) {
+		// The read model lists the latest period first
+		stub := &accountingPeriodReadModelStub{periods: []query.AccountingPeriod{
+			newPeriod(time.June, "OPEN", 1_500_000, 0),
+			newPeriod(time.May, "CLOSE", 1_200_000, 1_500_000),
+			newPeriod(time.April, "CLOSE", 1_000_000, 1_200_000),
+		}}
+
+		report, err := query.NewVerifyPeriodContinuityHandler(stub).Handle(context.Background(), query.VerifyPeriodContinuity{
+			WalletID: uuid.New(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.CheckedPeriods)
+		assert.True(t, report.Continuous)
+		assert.Empty(t, report.Issues)
+	})
+
+	t.Run("reports balance mismatch, gap and overlap", func(t *testing.T) {
+		overlapping := newPeriod(time.August, "OPEN", 900_000, 0)
+		overlapping.StartTime = overlapping.StartTime.AddDate(0, 0, -3)
+
+		stub := &accountingPeriodReadModelStub{periods: []query.AccountingPeriod{
+			overlapping,
+			newPeriod(time.July, "CLOSE", 900_000, 900_000),
+			newPeriod(time.May, "CLOSE", 1_100_000, 900_000),
+			newPeriod(time.April, "CLOSE", 1_000_000, 1_200_000),
+		}}
+
+		report, err := query.NewVerifyPeriodContinuityHandler(stub).Handle(context.Background(), query.VerifyPeriodContinuity{
+			WalletID: uuid.New(),
+		})
+
+		require.NoError(t, err)
+		assert.False(t, report.Continuous)
+		require.Len(t, report.Issues, 3)
+
+		assert.Equal(t, query.PeriodContinuityBalanceMismatch, report.Issues[0].Kind)
+		assert.Equal(t, "2026,5", report.Issues[0].YearMonth)
+		assert.Equal(t, int64(1_100_000), report.Issues[0].OpeningBalance)
+		assert.Equal(t, int64(1_200_000), report.Issues[0].PreviousClosingBalance)
+
+		assert.Equal(t, query.PeriodContinuityGap, report.Issues[1].Kind)
+		assert.Equal(t, "2026,7", report.Issues[1].YearMonth)
+		assert.Equal(t, "2026,5", report.Issues[1].PreviousYearMonth)
+
+		assert.Equal(t, query.PeriodContinuityOverlap, report.Issues[2].Kind)
+		assert.Equal(t, "2026,8", report.Issues[2].YearMonth)
+	})
+}
@@ -0,0 +1,169 @@
+package category
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCategoryTooDeep       = errors.New("categories can only be nested one level deep")
+	ErrParentKindMismatch    = errors.New("sub category must have the kind of its parent")
+	ErrCategoryHasChildren   = errors.New("category still has sub categories")
+	ErrCategoryNameTaken     = errors.New("category name is already used at this level")
+	ErrCategoryKindMismatch  = errors.New("income categories only apply to inflows and expense categories only to outflows")
+	ErrCategoryNotBudgetable = errors.New("only expense categories can be budgeted")
+)
+
+type Category struct {
+	id       uuid.UUID
+	userID   string
+	parentID uuid.UUID // uuid.Nil for a top level category
+	name     string
+	kind     Kind
+	version  int32
+}
+
+// NewCategory creates a category of userID. A sub category is created under parent, which must be a top level
+// category of the same user, and takes the kind of parent when kindStr is empty.
+func NewCategory(userID string, name string, kindStr string, parent *Category) (*Category, error) {
+	name = strings.TrimSpace(name)
+
+	v := validator.New()
+
+	v.Required(userID, "userID")
+	v.Required(name, "name")
+	v.Check(utf8.RuneCountInString(name) <= 100, "name", "name must not exceed 100 characters")
+	v.Check(parent != nil || kindStr != "", "kind", "kind is required for a top level category")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	var kind Kind
+	if kindStr != "" {
+		k, err := NewKind(kindStr)
+		if err != nil {
+			return nil, err
+		}
+		kind = k
+	}
+
+	parentID := uuid.Nil
+	if parent != nil {
+		if parent.userID != userID {
+			return nil, fmt.Errorf("parent category '%s' does not belong to user '%s'", parent.id, userID)
+		}
+
+		if !parent.IsTopLevel() {
+			return nil, ErrCategoryTooDeep
+		}
+
+		if kind.IsZero() {
+			kind = parent.kind
+		}
+
+		if kind != parent.kind {
+			return nil, ErrParentKindMismatch
+		}
+
+		parentID = parent.id
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create categoryID: %w", err)
+	}
+
+	return &Category{
+		id:       id,
+		userID:   userID,
+		parentID: parentID,
+		name:     name,
+		kind:     kind,
+		version:  0,
+	}, nil
+}
+
+func UnmarshalCategoryFromDatabase(
+	id uuid.UUID,
+	userID string,
+	parentID uuid.UUID,
+	name string,
+	kindStr string,
+	version int32,
+) (*Category, error) {
+	v := validator.New()
+
+	v.Check(id != uuid.Nil, "id", "id is required")
+	v.Required(userID, "userID")
+	v.Required(name, "name")
+	v.Check(version >= 0, "version", "version must greater or equal than 0")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	kind, err := NewKind(kindStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Category{
+		id:       id,
+		userID:   userID,
+		parentID: parentID,
+		name:     name,
+		kind:     kind,
+		version:  version,
+	}, nil
+}
+
+func (c *Category) ID() uuid.UUID       { return c.id }
+func (c *Category) UserID() string      { return c.userID }
+func (c *Category) ParentID() uuid.UUID { return c.parentID }
+func (c *Category) Name() string        { return c.name }
+func (c *Category) Kind() Kind          { return c.kind }
+func (c *Category) Version() int32      { return c.version }
+
+func (c *Category) IsTopLevel() bool {
+	return c.parentID == uuid.Nil
+}
+
+func (c *Category) Rename(name string) error {
+	name = strings.TrimSpace(name)
+
+	v := validator.New()
+	v.Required(name, "name")
+	v.Check(utf8.RuneCountInString(name) <= 100, "name", "name must not exceed 100 characters")
+
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	c.name = name
+	return nil
+}
+
+// Accepts reports whether a record counting as an expense, or as an income when expense is false,
+// can be filed under the category.
+func (c *Category) Accepts(expense bool) error {
+	if (c.kind == KindExpense) != expense {
+		return fmt.Errorf("%w: '%s' is an %s category", ErrCategoryKindMismatch, c.name, c.kind)
+	}
+
+	return nil
+}
+
+// CanBeBudgeted reports whether spending can be planned on the category.
+func (c *Category) CanBeBudgeted() error {
+	if c.kind != KindExpense {
+		return fmt.Errorf("%w: '%s' is an %s category", ErrCategoryNotBudgetable, c.name, c.kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package category_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/category"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategory(t *testing.T) {
+	const userID = "user-1"
+
+	newParent := func(t *testing.T, kind string) *category.Category {
+		t.Helper()
+
+		parent, err := category.NewCategory(userID, "Ăn uống", kind, nil)
+		require.NoError(t, err)
+
+		return parent
+	}
+
+	t.Run("returns error when name is empty", func(t *testing.T) {
+		_, err := category.NewCategory(userID, "  ", "EXPENSE", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when a top level category has no kind", func(t *testing.T) {
+		_, err := category.NewCategory(userID, "Ăn uống", "", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when kind is invalid", func(t *testing.T) {
+		_, err := category.NewCategory(userID, "Ăn uống", "SAVING", nil)
+		require.ErrorIs(t, err, category.ErrInvalidKind)
+	})
+
+	t.Run("creates a top level category", func(t *testing.T) {
+		c, err := category.NewCategory(userID, " Ăn uống ", "expense", nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Ăn uống", c.Name())
+		assert.Equal(t, category.KindExpense, c.Kind())
+		assert.True(t, c.IsTopLevel())
+	})
+
+	t.Run("creates a sub category with the kind of its parent", func(t *testing.T) {
+		parent := newParent(t, "EXPENSE")
+
+		c, err := category.NewCategory(userID, "Đi chợ", "", parent)
+		require.NoError(t, err)
+
+		assert.Equal(t, parent.ID(), c.ParentID())
+		assert.Equal(t, category.KindExpense, c.Kind())
+	})
+
+	t.Run("returns error when the kind differs from the parent", func(t *testing.T) {
+		_, err := category.NewCategory(userID, "Đi chợ", "INCOME", newParent(t, "EXPENSE"))
+		require.ErrorIs(t, err, category.ErrParentKindMismatch)
+	})
+
+	t.Run("returns error when the parent is a sub category", func(t *testing.T) {
+		child, err := category.NewCategory(userID, "Đi chợ", "", newParent(t, "EXPENSE"))
+		require.NoError(t, err)
+
+		_, err = category.NewCategory(userID, "Rau củ", "", child)
+		require.ErrorIs(t, err, category.ErrCategoryTooDeep)
+	})
+
+	t.Run("returns error when the parent belongs to another user", func(t *testing.T) {
+		_, err := category.NewCategory("user-2", "Đi chợ", "", newParent(t, "EXPENSE"))
+		require.Error(t, err)
+	})
+}
+
+func TestCategory_Accepts(t *testing.T) {
+	income, err := category.UnmarshalCategoryFromDatabase(uuid.New(), "user-1", uuid.Nil, "Lương", "INCOME", 0)
+	require.NoError(t, err)
+
+	expense, err := category.UnmarshalCategoryFromDatabase(uuid.New(), "user-1", uuid.Nil, "Ăn uống", "EXPENSE", 0)
+	require.NoError(t, err)
+
+	assert.NoError(t, income.Accepts(false))
+	assert.ErrorIs(t, income.Accepts(true), category.ErrCategoryKindMismatch)
+	assert.NoError(t, expense.Accepts(true))
+	assert.ErrorIs(t, expense.Accepts(false), category.ErrCategoryKindMismatch)
+}
+
+func TestNewDefaultCategories(t *testing.T) {
+	categories, err := category.NewDefaultCategories("user-1")
+	require.NoError(t, err)
+	require.NotEmpty(t, categories)
+
+	parents := make(map[uuid.UUID]*category.Category)
+	for _, c := range categories {
+		if c.IsTopLevel() {
+			parents[c.ID()] = c
+			continue
+		}
+
+		parent, exist := parents[c.ParentID()]
+		require.True(t, exist, "parent of %s must come first", c.Name())
+		assert.Equal(t, parent.Kind(), c.Kind())
+	}
+}
@@ -0,0 +1,51 @@
+package category
+
+import "fmt"
+
+type defaultCategory struct {
+	name     string
+	kind     Kind
+	children []string
+}
+
+// defaultCategories is the household category set a Vietnamese family starts with.
+var defaultCategories = []defaultCategory{
+	{name: "Lương", kind: KindIncome, children: []string{"Lương chính", "Làm thêm"}},
+	{name: "Thưởng", kind: KindIncome, children: []string{"Thưởng Tết", "Thưởng hiệu suất"}},
+	{name: "Đầu tư", kind: KindIncome, children: []string{"Lãi tiết kiệm", "Cổ tức", "Cho thuê"}},
+	{name: "Thu nhập khác", kind: KindIncome, children: []string{"Được tặng", "Lì xì"}},
+
+	{name: "Ăn uống", kind: KindExpense, children: []string{"Đi chợ", "Ăn ngoài", "Cà phê"}},
+	{name: "Nhà cửa", kind: KindExpense, children: []string{"Tiền nhà", "Điện", "Nước", "Internet", "Gas"}},
+	{name: "Đi lại", kind: KindExpense, children: []string{"Xăng xe", "Gửi xe", "Taxi", "Bảo dưỡng xe"}},
+	{name: "Mua sắm", kind: KindExpense, children: []string{"Quần áo", "Đồ gia dụng", "Đồ điện tử"}},
+	{name: "Sức khỏe", kind: KindExpense, children: []string{"Khám bệnh", "Thuốc", "Bảo hiểm y tế"}},
+	{name: "Giáo dục", kind: KindExpense, children: []string{"Học phí", "Sách vở", "Khóa học"}},
+	{name: "Con cái", kind: KindExpense, children: []string{"Sữa", "Đồ dùng trẻ em", "Tiêu vặt"}},
+	{name: "Hiếu hỉ", kind: KindExpense, children: []string{"Đám cưới", "Đám tang", "Biếu tặng"}},
+	{name: "Giải trí", kind: KindExpense, children: []string{"Du lịch", "Phim ảnh", "Thể thao"}},
+	{name: "Chi phí khác", kind: KindExpense, children: []string{"Phí ngân hàng", "Từ thiện"}},
+}
+
+// NewDefaultCategories builds the default household category tree of userID, parents before their children.
+func NewDefaultCategories(userID string) ([]*Category, error) {
+	categories := make([]*Category, 0, 64)
+
+	for _, d := range defaultCategories {
+		parent, err := NewCategory(userID, d.name, d.kind.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create default category '%s': %w", d.name, err)
+		}
+		categories = append(categories, parent)
+
+		for _, childName := range d.children {
+			child, err := NewCategory(userID, childName, "", parent)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create default category '%s': %w", childName, err)
+			}
+			categories = append(categories, child)
+		}
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,41 @@
+package category
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidKind = errors.New("invalid category kind")
+
+var (
+	KindIncome  Kind = Kind{value: "INCOME"}
+	KindExpense Kind = Kind{value: "EXPENSE"}
+)
+
+var supportedKind = map[string]Kind{
+	"INCOME":  KindIncome,
+	"EXPENSE": KindExpense,
+}
+
+type Kind struct {
+	value string
+}
+
+func NewKind(kindStr string) (Kind, error) {
+	kindCleaned := strings.TrimSpace(strings.ToUpper(kindStr))
+
+	k, ok := supportedKind[kindCleaned]
+	if !ok {
+		return Kind{}, ErrInvalidKind
+	}
+
+	return k, nil
+}
+
+func (k Kind) String() string {
+	return k.value
+}
+
+func (k Kind) IsZero() bool {
+	return k == Kind{}
+}
@@ -0,0 +1,361 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	category "sumni-finance-backend/internal/finance/domain/category"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, c
+func (_m *MockRepository) Create(ctx context.Context, c *category.Category) error {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Category) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *category.Category
+func (_e *MockRepository_Expecter) Create(ctx interface{}, c interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, c)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, c *category.Category)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*category.Category))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *category.Category) error) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDefaults provides a mock function with given fields: ctx, userID, categories
+func (_m *MockRepository) CreateDefaults(ctx context.Context, userID string, categories []*category.Category) (bool, error) {
+	ret := _m.Called(ctx, userID, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDefaults")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*category.Category) (bool, error)); ok {
+		return rf(ctx, userID, categories)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*category.Category) bool); ok {
+		r0 = rf(ctx, userID, categories)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*category.Category) error); ok {
+		r1 = rf(ctx, userID, categories)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CreateDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDefaults'
+type MockRepository_CreateDefaults_Call struct {
+	*mock.Call
+}
+
+// CreateDefaults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - categories []*category.Category
+func (_e *MockRepository_Expecter) CreateDefaults(ctx interface{}, userID interface{}, categories interface{}) *MockRepository_CreateDefaults_Call {
+	return &MockRepository_CreateDefaults_Call{Call: _e.mock.On("CreateDefaults", ctx, userID, categories)}
+}
+
+func (_c *MockRepository_CreateDefaults_Call) Run(run func(ctx context.Context, userID string, categories []*category.Category)) *MockRepository_CreateDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*category.Category))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateDefaults_Call) Return(_a0 bool, _a1 error) *MockRepository_CreateDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CreateDefaults_Call) RunAndReturn(run func(context.Context, string, []*category.Category) (bool, error)) *MockRepository_CreateDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, cID
+func (_m *MockRepository) Delete(ctx context.Context, userID string, cID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, cID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, cID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - cID uuid.UUID
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, userID interface{}, cID interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, cID)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, userID string, cID uuid.UUID)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, userID, cID
+func (_m *MockRepository) GetByID(ctx context.Context, userID string, cID uuid.UUID) (*category.Category, error) {
+	ret := _m.Called(ctx, userID, cID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *category.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*category.Category, error)); ok {
+		return rf(ctx, userID, cID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *category.Category); ok {
+		r0 = rf(ctx, userID, cID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, cID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - cID uuid.UUID
+func (_e *MockRepository_Expecter) GetByID(ctx interface{}, userID interface{}, cID interface{}) *MockRepository_GetByID_Call {
+	return &MockRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userID, cID)}
+}
+
+func (_c *MockRepository_GetByID_Call) Run(run func(ctx context.Context, userID string, cID uuid.UUID)) *MockRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByID_Call) Return(_a0 *category.Category, _a1 error) *MockRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetByID_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*category.Category, error)) *MockRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function with given fields: ctx, userID, cIDs
+func (_m *MockRepository) GetByIDs(ctx context.Context, userID string, cIDs []uuid.UUID) ([]*category.Category, error) {
+	ret := _m.Called(ctx, userID, cIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*category.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) ([]*category.Category, error)); ok {
+		return rf(ctx, userID, cIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) []*category.Category); ok {
+		r0 = rf(ctx, userID, cIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*category.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, cIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - cIDs []uuid.UUID
+func (_e *MockRepository_Expecter) GetByIDs(ctx interface{}, userID interface{}, cIDs interface{}) *MockRepository_GetByIDs_Call {
+	return &MockRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, userID, cIDs)}
+}
+
+func (_c *MockRepository_GetByIDs_Call) Run(run func(ctx context.Context, userID string, cIDs []uuid.UUID)) *MockRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByIDs_Call) Return(_a0 []*category.Category, _a1 error) *MockRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) ([]*category.Category, error)) *MockRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, userID, cID, updateFn
+func (_m *MockRepository) Update(ctx context.Context, userID string, cID uuid.UUID, updateFn func(*category.Category) error) error {
+	ret := _m.Called(ctx, userID, cID, updateFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, func(*category.Category) error) error); ok {
+		r0 = rf(ctx, userID, cID, updateFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - cID uuid.UUID
+//   - updateFn func(*category.Category) error
+func (_e *MockRepository_Expecter) Update(ctx interface{}, userID interface{}, cID interface{}, updateFn interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, userID, cID, updateFn)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, userID string, cID uuid.UUID, updateFn func(*category.Category) error)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(func(*category.Category) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, func(*category.Category) error) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
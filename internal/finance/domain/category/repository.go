@@ -0,0 +1,29 @@
+package category
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, c *Category) error
+
+	// CreateDefaults creates categories for userID only when the user has none yet.
+	// It reports whether the categories were created.
+	CreateDefaults(ctx context.Context, userID string, categories []*Category) (bool, error)
+
+	GetByID(ctx context.Context, userID string, cID uuid.UUID) (*Category, error)
+	GetByIDs(ctx context.Context, userID string, cIDs []uuid.UUID) ([]*Category, error)
+
+	Update(
+		ctx context.Context,
+		userID string,
+		cID uuid.UUID,
+		updateFn func(c *Category) error,
+	) error
+
+	// Delete removes the category, it fails with ErrCategoryHasChildren while sub categories exist.
+	// Transaction records filed under it become uncategorised.
+	Delete(ctx context.Context, userID string, cID uuid.UUID) error
+}
@@ -0,0 +1,86 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AggregateWallet           = "wallet"
+	AggregateFundProvider     = "fund_provider"
+	AggregateAccountingPeriod = "accounting_period"
+)
+
+// Event is a state change of an aggregate. It is stored in the outbox by the transaction that persists
+// the change and relayed to the subscribers once that transaction has committed.
+type Event interface {
+	// EventName identifies the kind of the event, it must not change once events of that kind were stored
+	EventName() string
+	AggregateType() string
+	AggregateID() uuid.UUID
+}
+
+// Envelope is an event as relayed from the outbox.
+type Envelope struct {
+	// ID identifies the outbox message, it is the same on every delivery of the event
+	ID uuid.UUID
+	// UserID is the user whose action raised the event, it is empty when no user acted
+	UserID     string
+	OccurredAt time.Time
+	Event      Event
+}
+
+// Subscriber reacts to the events relayed from the outbox.
+// An event is delivered at least once, Handle must cope with an envelope it has already handled.
+type Subscriber interface {
+	Name() string
+	Handle(ctx context.Context, env Envelope) error
+}
+
+// Recorder keeps the events raised by an aggregate until its repository stores them.
+type Recorder struct {
+	events []Event
+}
+
+func (r *Recorder) Record(e Event) {
+	r.events = append(r.events, e)
+}
+
+// Pull returns the recorded events in the order they were raised and forgets them, so they are stored once.
+func (r *Recorder) Pull() []Event {
+	events := r.events
+	r.events = nil
+
+	return events
+}
+
+// Unmarshal rebuilds the event stored in the outbox as name and payload.
+func Unmarshal(name string, payload []byte) (Event, error) {
+	switch name {
+	case NameFundAllocated:
+		return decode[FundAllocated](payload)
+	case NameFundProviderCreated:
+		return decode[FundProviderCreated](payload)
+	case NamePeriodClosed:
+		return decode[PeriodClosed](payload)
+	case NamePeriodOpened:
+		return decode[PeriodOpened](payload)
+	case NameTransactionRecorded:
+		return decode[TransactionRecorded](payload)
+	default:
+		return nil, fmt.Errorf("unknown event '%s'", name)
+	}
+}
+
+func decode[T Event](payload []byte) (Event, error) {
+	var e T
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, fmt.Errorf("failed to decode event '%s': %w", e.EventName(), err)
+	}
+
+	return e, nil
+}
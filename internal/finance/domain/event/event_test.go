@@ -0,0 +1,49 @@
+package event_test
+
+import (
+	"encoding/json"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("rebuilds the stored event", func(t *testing.T) {
+		e := event.TransactionRecorded{
+			WalletID:        uuid.New(),
+			TransactionID:   uuid.New(),
+			TransactionType: "WITHDRAWAL",
+			Direction:       "OUT",
+			Amount:          10,
+			Currency:        "USD",
+			FundProviderID:  uuid.New(),
+			OccurredAt:      time.Date(2026, time.April, 3, 0, 0, 0, 0, time.UTC),
+		}
+
+		payload, err := json.Marshal(e)
+		require.NoError(t, err)
+
+		unmarshalled, err := event.Unmarshal(e.EventName(), payload)
+		require.NoError(t, err)
+		assert.Equal(t, e, unmarshalled)
+	})
+
+	t.Run("returns error when the event is unknown", func(t *testing.T) {
+		_, err := event.Unmarshal("WalletRenamed", []byte(`{}`))
+		require.Error(t, err)
+	})
+}
+
+func TestRecorder_Pull(t *testing.T) {
+	var r event.Recorder
+	e := event.FundProviderCreated{FundProviderID: uuid.New()}
+
+	r.Record(e)
+
+	assert.Equal(t, []event.Event{e}, r.Pull())
+	assert.Empty(t, r.Pull())
+}
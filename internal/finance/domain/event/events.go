@@ -0,0 +1,104 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NameFundAllocated       = "FundAllocated"
+	NameFundProviderCreated = "FundProviderCreated"
+	NamePeriodClosed        = "PeriodClosed"
+	NamePeriodOpened        = "PeriodOpened"
+	NameTransactionRecorded = "TransactionRecorded"
+)
+
+// Names lists the kinds of events relayed from the outbox.
+func Names() []string {
+	return []string{
+		NameFundAllocated,
+		NameFundProviderCreated,
+		NamePeriodClosed,
+		NamePeriodOpened,
+		NameTransactionRecorded,
+	}
+}
+
+// FundAllocated is raised whenever the share of a fund provider reserved for a wallet changes.
+// Amount is negative when part of the allocation is released, AllocatedAmount is 0 once it is removed.
+type FundAllocated struct {
+	WalletID        uuid.UUID `json:"walletId"`
+	FundProviderID  uuid.UUID `json:"fundProviderId"`
+	Amount          int64     `json:"amount"`
+	AllocatedAmount int64     `json:"allocatedAmount"`
+	WalletBalance   int64     `json:"walletBalance"`
+	Currency        string    `json:"currency"`
+}
+
+func (e FundAllocated) EventName() string      { return NameFundAllocated }
+func (e FundAllocated) AggregateType() string  { return AggregateWallet }
+func (e FundAllocated) AggregateID() uuid.UUID { return e.WalletID }
+
+type FundProviderCreated struct {
+	FundProviderID uuid.UUID `json:"fundProviderId"`
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	Balance        int64     `json:"balance"`
+	Currency       string    `json:"currency"`
+}
+
+func (e FundProviderCreated) EventName() string      { return NameFundProviderCreated }
+func (e FundProviderCreated) AggregateType() string  { return AggregateFundProvider }
+func (e FundProviderCreated) AggregateID() uuid.UUID { return e.FundProviderID }
+
+type PeriodOpened struct {
+	WalletID           uuid.UUID `json:"walletId"`
+	AccountingPeriodID uuid.UUID `json:"accountingPeriodId"`
+	YearMonth          string    `json:"yearMonth"`
+	StartTime          time.Time `json:"startTime"`
+	EndTime            time.Time `json:"endTime"`
+	OpeningBalance     int64     `json:"openingBalance"`
+	Currency           string    `json:"currency"`
+}
+
+func (e PeriodOpened) EventName() string      { return NamePeriodOpened }
+func (e PeriodOpened) AggregateType() string  { return AggregateAccountingPeriod }
+func (e PeriodOpened) AggregateID() uuid.UUID { return e.AccountingPeriodID }
+
+type PeriodClosed struct {
+	WalletID           uuid.UUID `json:"walletId"`
+	AccountingPeriodID uuid.UUID `json:"accountingPeriodId"`
+	YearMonth          string    `json:"yearMonth"`
+	ClosedAt           time.Time `json:"closedAt"`
+	OpeningBalance     int64     `json:"openingBalance"`
+	ClosingBalance     int64     `json:"closingBalance"`
+	TotalIncome        int64     `json:"totalIncome"`
+	TotalExpense       int64     `json:"totalExpense"`
+	Currency           string    `json:"currency"`
+}
+
+func (e PeriodClosed) EventName() string      { return NamePeriodClosed }
+func (e PeriodClosed) AggregateType() string  { return AggregateAccountingPeriod }
+func (e PeriodClosed) AggregateID() uuid.UUID { return e.AccountingPeriodID }
+
+// TransactionRecorded is raised for every record booked into the ledger of a wallet,
+// including both sides of a transfer, its fee and the reversals.
+type TransactionRecorded struct {
+	WalletID           uuid.UUID `json:"walletId"`
+	AccountingPeriodID uuid.UUID `json:"accountingPeriodId"`
+	YearMonth          string    `json:"yearMonth"`
+	TransactionID      uuid.UUID `json:"transactionId"`
+	TransactionNo      string    `json:"transactionNo,omitempty"`
+	TransactionType    string    `json:"transactionType"`
+	Direction          string    `json:"direction"`
+	Amount             int64     `json:"amount"`
+	Currency           string    `json:"currency"`
+	FundProviderID     uuid.UUID `json:"fundProviderId"`
+	OccurredAt         time.Time `json:"occurredAt"`
+	WalletBalance      int64     `json:"walletBalance"`
+}
+
+func (e TransactionRecorded) EventName() string      { return NameTransactionRecorded }
+func (e TransactionRecorded) AggregateType() string  { return AggregateWallet }
+func (e TransactionRecorded) AggregateID() uuid.UUID { return e.WalletID }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sumni-finance-backend/internal/common/validator"
 	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/event"
 
 	"github.com/google/uuid"
 )
@@ -22,6 +23,15 @@ func (err ErrInsufficientAllocatedAmount) Error() string {
 	return fmt.Sprintf("allocated amount '%d' has exccedd unallocated amount '%d' of fund provider", err.AllocatedAmount, err.UnallocatedAmount)
 }
 
+type ErrInsufficientReleaseAmount struct {
+	ReleaseAmount   int64
+	AllocatedAmount int64
+}
+
+func (err ErrInsufficientReleaseAmount) Error() string {
+	return fmt.Sprintf("release amount '%d' has exceeded allocated amount '%d' of fund provider", err.ReleaseAmount, err.AllocatedAmount)
+}
+
 type ErrInsufficientWithdrawAmount struct {
 	WithdrawAmount  int64
 	AllocatedAmount int64
@@ -38,6 +48,8 @@ type FundProvider struct {
 	balance            valueobject.Money
 	unallocatedBalance valueobject.Money
 	version            int32
+
+	events event.Recorder
 }
 
 func NewFundProvider(
@@ -76,14 +88,24 @@ func NewFundProvider(
 		return nil, fmt.Errorf("failed to create fundProviderID: %w", err)
 	}
 
-	return &FundProvider{
+	fp := &FundProvider{
 		id:                 id,
 		name:               name,
 		fpType:             fpType,
 		balance:            initBalance,
 		unallocatedBalance: initBalance,
 		version:            0,
-	}, nil
+	}
+
+	fp.events.Record(event.FundProviderCreated{
+		FundProviderID: fp.id,
+		Name:           fp.name,
+		Type:           fp.fpType.String(),
+		Balance:        fp.balance.Amount(),
+		Currency:       fp.Currency().Code(),
+	})
+
+	return fp, nil
 }
 
 func UnmarshalFundProviderFromDatabase(
@@ -145,6 +167,9 @@ func (p *FundProvider) Balance() valueobject.Money            { return p.balance
 func (p *FundProvider) Currency() valueobject.Currency        { return p.balance.Currency() }
 func (p *FundProvider) UnallocatedBalance() valueobject.Money { return p.unallocatedBalance }
 func (p *FundProvider) Version() int32                        { return p.version }
+
+// PullEvents returns the events raised since the fund provider was created or loaded.
+func (p *FundProvider) PullEvents() []event.Event { return p.events.Pull() }
 func (p *FundProvider) AllocatedBalance() valueobject.Money {
 	allocatedBalance, _ := p.balance.Subtract(p.unallocatedBalance)
 	return allocatedBalance
@@ -210,3 +235,28 @@ func (p *FundProvider) Reserve(
 	p.unallocatedBalance = newUnallocatedAmount
 	return nil
 }
+
+// Release gives a reserved portion of the provider's funds back, it is the opposite of Reserve.
+// It increases the unallocated balance by the released amount.
+func (p *FundProvider) Release(
+	released valueobject.Money,
+) error {
+	if released.IsNegative() {
+		return ErrInsufficientAmount
+	}
+
+	if released.GreaterThan(p.AllocatedBalance()) {
+		return ErrInsufficientReleaseAmount{
+			ReleaseAmount:   released.Amount(),
+			AllocatedAmount: p.AllocatedBalance().Amount(),
+		}
+	}
+
+	newUnallocatedAmount, err := p.unallocatedBalance.Add(released)
+	if err != nil {
+		return err
+	}
+
+	p.unallocatedBalance = newUnallocatedAmount
+	return nil
+}
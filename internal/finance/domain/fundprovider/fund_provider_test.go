@@ -2,6 +2,7 @@ package fundprovider_test
 
 import (
 	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/event"
 	"sumni-finance-backend/internal/finance/domain/fundprovider"
 	"testing"
 
@@ -111,6 +112,25 @@ func TestFundProvider_NewFundProvider(t *testing.T) {
 	}
 }
 
+func TestFundProvider_PullEvents(t *testing.T) {
+	t.Run("raises FundProviderCreated once the fund provider is created", func(t *testing.T) {
+		fp, err := fundprovider.NewFundProvider("Techcombank7316", "BANK", 100, "USD")
+		require.NoError(t, err)
+
+		assert.Equal(t, []event.Event{
+			event.FundProviderCreated{FundProviderID: fp.ID(), Name: "Techcombank7316", Type: "BANK", Balance: 100, Currency: "USD"},
+		}, fp.PullEvents())
+		assert.Empty(t, fp.PullEvents())
+	})
+
+	t.Run("raises nothing when the fund provider is loaded", func(t *testing.T) {
+		fp, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 100, 100, "USD", 1)
+		require.NoError(t, err)
+
+		assert.Empty(t, fp.PullEvents())
+	})
+}
+
 func TestFundProvider_UnmarshallFromDatabase(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -484,3 +504,75 @@ func TestFundProvider_Withdraw(t *testing.T) {
 		})
 	}
 }
+
+func TestFundProvider_Release(t *testing.T) {
+	testCases := []struct {
+		name              string
+		balance           int64
+		unallocatedAmount int64
+		releasedAmount    int64
+		hasErr            bool
+	}{
+		{
+			name:              "returns error when released amount exceeds allocated amount",
+			balance:           100,
+			unallocatedAmount: 60,
+			releasedAmount:    50,
+			hasErr:            true,
+		},
+		{
+			name:              "returns error when released amount is negative",
+			balance:           100,
+			unallocatedAmount: 60,
+			releasedAmount:    -10,
+			hasErr:            true,
+		},
+		{
+			name:              "releases the whole allocated amount successfully",
+			balance:           100,
+			unallocatedAmount: 60,
+			releasedAmount:    40,
+			hasErr:            false,
+		},
+		{
+			name:              "releases part of the allocated amount successfully",
+			balance:           100,
+			unallocatedAmount: 60,
+			releasedAmount:    10,
+			hasErr:            false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Given
+			fundProvider, err := fundprovider.UnmarshalFundProviderFromDatabase(
+				uuid.New(),
+				"Techcombank7316",
+				"BANK",
+				tt.balance,
+				tt.unallocatedAmount,
+				"USD",
+				1,
+			)
+			require.NoError(t, err)
+
+			released, err := valueobject.NewMoney(tt.releasedAmount, valueobject.USD)
+			require.NoError(t, err)
+
+			// When
+			err = fundProvider.Release(released)
+
+			if tt.hasErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.unallocatedAmount+tt.releasedAmount, fundProvider.UnallocatedBalance().Amount())
+				assert.Equal(t, tt.balance, fundProvider.Balance().Amount())
+			}
+		})
+	}
+}
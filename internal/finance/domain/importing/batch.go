@@ -0,0 +1,304 @@
+package importing
+
+import (
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var ErrLedgerBalanceMismatch = errors.New("fund provider balance does not match the balances of the statement")
+
+var (
+	// RowNew is a row that will be recorded
+	RowNew = RowStatus{value: "NEW"}
+	// RowDuplicate is a row whose reference is already recorded or appears earlier in the file
+	RowDuplicate = RowStatus{value: "DUPLICATE"}
+	// RowInvalid is a row that can not be parsed
+	RowInvalid = RowStatus{value: "INVALID"}
+	// RowOutsidePeriod is a row that does not fall within the open accounting period
+	RowOutsidePeriod = RowStatus{value: "OUTSIDE_PERIOD"}
+)
+
+type RowStatus struct {
+	value string
+}
+
+func (s RowStatus) String() string { return s.value }
+
+// Line is a transaction read from a bank statement.
+type Line struct {
+	// Number is the line of the file the transaction is read from
+	Number     int
+	OccurredAt time.Time
+	// Amount is always positive, Direction tells inflows from outflows
+	Amount      int64
+	Direction   ledger.Direction
+	Description string
+	// Reference is the bank reference, empty when the bank gives none
+	Reference string
+}
+
+// TransactionType records inflows as DEPOSIT and outflows as WITHDRAWAL.
+func (l Line) TransactionType() ledger.TransactionType {
+	if l.Direction == ledger.DirectionIn {
+		return ledger.TransactionTypeDeposit
+	}
+
+	return ledger.TransactionTypeWithdrawal
+}
+
+// Balance is the ledger balance a statement reports as of a date.
+type Balance struct {
+	Amount int64
+	AsOf   time.Time
+}
+
+// Reconciliation compares the balances a statement reports with the balance of the fund provider.
+// Differences are the statement minus the fund provider.
+type Reconciliation struct {
+	// Opening is nil when the statement reports its closing balance only
+	Opening *Balance
+	Closing Balance
+	// FundProviderBalance is the balance of the fund provider before the import
+	FundProviderBalance int64
+	// ProjectedBalance is the balance of the fund provider once the NEW rows are recorded
+	ProjectedBalance int64
+	// OpeningDifference compares the opening balance, moved by the rows already recorded, with the balance
+	// of the fund provider. It is zero without opening balance.
+	OpeningDifference int64
+	// ClosingDifference compares the closing balance with the projected balance
+	ClosingDifference int64
+	// UnrecordedLines are the INVALID and OUTSIDE_PERIOD rows, the usual suspects of a difference
+	UnrecordedLines []int
+}
+
+// Balanced reports whether the statement and the fund provider agree.
+func (r Reconciliation) Balanced() bool {
+	return r.OpeningDifference == 0 && r.ClosingDifference == 0
+}
+
+type Row struct {
+	line    Line
+	status  RowStatus
+	problem string
+	// recorded is set on a DUPLICATE row already recorded against the fund provider
+	recorded bool
+}
+
+func (r Row) Line() Line        { return r.line }
+func (r Row) Status() RowStatus { return r.status }
+func (r Row) Problem() string   { return r.problem }
+
+// Batch holds the rows read from one bank statement in file order.
+// Only the NEW rows are recorded, a reference is recorded at most once.
+type Batch struct {
+	rows       []Row
+	references map[string]struct{}
+
+	// ledgerBalance is nil when the statement reports none, as in QIF and CSV exports
+	ledgerBalance *Balance
+	// openingBalance is nil when the statement reports none, only camt.053 and MT940 do
+	openingBalance *Balance
+}
+
+func newBatch() *Batch {
+	return &Batch{
+		references: make(map[string]struct{}),
+	}
+}
+
+func (b *Batch) Rows() []Row { return b.rows }
+
+// LedgerBalance returns the ledger balance of the statement, if it reports one.
+func (b *Batch) LedgerBalance() (Balance, bool) {
+	if b.ledgerBalance == nil {
+		return Balance{}, false
+	}
+
+	return *b.ledgerBalance, true
+}
+
+// NetAmount returns the inflows minus the outflows of the NEW rows.
+func (b *Batch) NetAmount() int64 {
+	return b.net(func(r Row) bool { return r.status == RowNew })
+}
+
+// Reconcile compares the balances of the statement with fpBalance, the balance of the fund provider before
+// the import. It returns false when the statement reports no balance.
+func (b *Batch) Reconcile(fpBalance int64) (Reconciliation, bool) {
+	if b.ledgerBalance == nil {
+		return Reconciliation{}, false
+	}
+
+	report := Reconciliation{
+		Opening:             b.openingBalance,
+		Closing:             *b.ledgerBalance,
+		FundProviderBalance: fpBalance,
+		ProjectedBalance:    fpBalance + b.NetAmount(),
+		UnrecordedLines:     []int{},
+	}
+
+	report.ClosingDifference = report.Closing.Amount - report.ProjectedBalance
+	if report.Opening != nil {
+		recordedNet := b.net(func(r Row) bool { return r.recorded })
+		report.OpeningDifference = report.Opening.Amount + recordedNet - fpBalance
+	}
+
+	for _, r := range b.rows {
+		if r.status == RowInvalid || r.status == RowOutsidePeriod {
+			report.UnrecordedLines = append(report.UnrecordedLines, r.line.Number)
+		}
+	}
+
+	return report, true
+}
+
+// CheckBalances refuses the import when the balances of the statement do not match fpBalance, the balance
+// of the fund provider before the import. A statement without balance always passes.
+func (b *Batch) CheckBalances(fpBalance int64) error {
+	report, exist := b.Reconcile(fpBalance)
+	if !exist || report.Balanced() {
+		return nil
+	}
+
+	return fmt.Errorf("%w: closing balance %d as of %s differs by %d, opening balance by %d",
+		ErrLedgerBalanceMismatch,
+		report.Closing.Amount,
+		report.Closing.AsOf.Format(time.DateOnly),
+		report.ClosingDifference,
+		report.OpeningDifference,
+	)
+}
+
+// Count returns the number of rows having status.
+func (b *Batch) Count(status RowStatus) int {
+	count := 0
+	for _, r := range b.rows {
+		if r.status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// References returns the references of the NEW rows.
+func (b *Batch) References() []string {
+	references := make([]string, 0, len(b.rows))
+	for _, r := range b.rows {
+		if r.status == RowNew && r.line.Reference != "" {
+			references = append(references, r.line.Reference)
+		}
+	}
+
+	return references
+}
+
+// MarkRecorded marks the NEW rows whose reference is among the recorded transactionNos as duplicates.
+func (b *Batch) MarkRecorded(recorded []string) {
+	recordedSet := make(map[string]struct{}, len(recorded))
+	for _, transactionNo := range recorded {
+		recordedSet[transactionNo] = struct{}{}
+	}
+
+	for i, r := range b.rows {
+		if _, exist := recordedSet[r.line.Reference]; exist && r.status == RowNew && r.line.Reference != "" {
+			b.rows[i].status = RowDuplicate
+			b.rows[i].problem = fmt.Sprintf("transactionNo '%s' is already recorded", r.line.Reference)
+			b.rows[i].recorded = true
+		}
+	}
+}
+
+// MarkOutsidePeriod marks the NEW rows that did not occur within [start, end).
+func (b *Batch) MarkOutsidePeriod(start time.Time, end time.Time) {
+	for i, r := range b.rows {
+		if r.status == RowNew && (r.line.OccurredAt.Before(start) || !r.line.OccurredAt.Before(end)) {
+			b.rows[i].status = RowOutsidePeriod
+			b.rows[i].problem = fmt.Sprintf("occurred outside the accounting period from %s to %s",
+				start.Format(time.DateOnly), end.Format(time.DateOnly))
+		}
+	}
+}
+
+// TransactionSpecs returns the specs recording the NEW rows against fpID.
+func (b *Batch) TransactionSpecs(fpID uuid.UUID, recordedAt time.Time) []wallet.TransactionSpec {
+	txSpecs := make([]wallet.TransactionSpec, 0, len(b.rows))
+	for _, r := range b.rows {
+		if r.status != RowNew {
+			continue
+		}
+
+		txSpecs = append(txSpecs, wallet.TransactionSpec{
+			TransactionNo:   r.line.Reference,
+			TransactionType: r.line.TransactionType().String(),
+			Amount:          r.line.Amount,
+			Description:     r.line.Description,
+			FpID:            fpID,
+			OccurredAt:      r.line.OccurredAt,
+			RecordedAt:      recordedAt,
+		})
+	}
+
+	return txSpecs
+}
+
+// add appends a parsed line, a reference seen earlier in the file makes it a duplicate.
+func (b *Batch) add(line Line) {
+	if line.Amount <= 0 {
+		b.reject(line.Number, "amount must not be zero")
+		return
+	}
+
+	if utf8.RuneCountInString(line.Reference) > 255 {
+		b.reject(line.Number, "reference must not exceed 255 characters")
+		return
+	}
+
+	if line.Reference != "" {
+		if _, seen := b.references[line.Reference]; seen {
+			b.rows = append(b.rows, Row{
+				line:    line,
+				status:  RowDuplicate,
+				problem: fmt.Sprintf("transactionNo '%s' appears earlier in the file", line.Reference),
+			})
+			return
+		}
+
+		b.references[line.Reference] = struct{}{}
+	}
+
+	b.rows = append(b.rows, Row{line: line, status: RowNew})
+}
+
+// net returns the inflows minus the outflows of the rows matching include.
+func (b *Batch) net(include func(r Row) bool) int64 {
+	var net int64
+	for _, r := range b.rows {
+		if !include(r) {
+			continue
+		}
+
+		if r.line.Direction == ledger.DirectionIn {
+			net += r.line.Amount
+		} else {
+			net -= r.line.Amount
+		}
+	}
+
+	return net
+}
+
+// reject appends a line that can not be parsed.
+func (b *Batch) reject(number int, problem string) {
+	b.rows = append(b.rows, Row{
+		line:    Line{Number: number},
+		status:  RowInvalid,
+		problem: problem,
+	})
+}
@@ -0,0 +1,208 @@
+package importing
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+)
+
+// camtBalance is a Bal element, Cd is OPBD or PRCD for the opening balance and CLBD for the closing one.
+type camtBalance struct {
+	Cd        string `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       string `xml:"Amt"`
+	CdtDbtInd string `xml:"CdtDbtInd"`
+	Dt        string `xml:"Dt>Dt"`
+	DtTm      string `xml:"Dt>DtTm"`
+}
+
+// camtStatus is the status of an entry, a plain code up to camt.053.001.07 and a Cd element since.
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Cd    string `xml:"Cd"`
+}
+
+// camtEntry is an Ntry element.
+type camtEntry struct {
+	Amt          string     `xml:"Amt"`
+	CdtDbtInd    string     `xml:"CdtDbtInd"`
+	Sts          camtStatus `xml:"Sts"`
+	BookgDt      string     `xml:"BookgDt>Dt"`
+	BookgDtTm    string     `xml:"BookgDt>DtTm"`
+	AcctSvcrRef  string     `xml:"AcctSvcrRef"`
+	NtryRef      string     `xml:"NtryRef"`
+	TxAcctSvcr   string     `xml:"NtryDtls>TxDtls>Refs>AcctSvcrRef"`
+	Ustrd        []string   `xml:"NtryDtls>TxDtls>RmtInf>Ustrd"`
+	AddtlTxInf   string     `xml:"NtryDtls>TxDtls>AddtlTxInf"`
+	AddtlNtryInf string     `xml:"AddtlNtryInf"`
+}
+
+// ParseCamt053 reads an ISO 20022 camt.053 bank to customer statement. CRDT entries are inflows and DBIT entries
+// outflows, the reference given by the bank is the reference of the row. Only booked entries are recorded.
+// The opening and closing booked balances become the balances of the batch.
+// A file holding the statements of several accounts is refused, they are imported one by one.
+func ParseCamt053(r io.Reader, loc *time.Location) (*Batch, error) {
+	decoder := xml.NewDecoder(r)
+	// Banks declare all sorts of encodings for what is ASCII in practice
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+
+	batch := newBatch()
+	statements := 0
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Stmt":
+			statements++
+		case "Bal":
+			var bal camtBalance
+			if err := decoder.DecodeElement(&bal, &start); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+			}
+
+			if err := batch.setCamtBalance(bal, loc); err != nil {
+				return nil, err
+			}
+		case "Ntry":
+			number, _ := decoder.InputPos()
+
+			var entry camtEntry
+			if err := decoder.DecodeElement(&entry, &start); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+			}
+
+			line, problem := parseCamtEntry(entry, loc)
+			if problem != "" {
+				batch.reject(number, problem)
+				continue
+			}
+
+			line.Number = number
+			batch.add(line)
+		}
+	}
+
+	if statements == 0 {
+		return nil, fmt.Errorf("%w: no camt.053 statement in the file", ErrUnreadableStatement)
+	}
+	if statements > 1 {
+		return nil, fmt.Errorf("%w: file holds %d statements", ErrUnreadableStatement, statements)
+	}
+
+	return batch, nil
+}
+
+func (b *Batch) setCamtBalance(bal camtBalance, loc *time.Location) error {
+	if bal.Cd != "OPBD" && bal.Cd != "PRCD" && bal.Cd != "CLBD" {
+		return nil
+	}
+
+	amount, err := parseCamtAmount(bal.Amt, bal.CdtDbtInd)
+	if err != nil {
+		return fmt.Errorf("%w: %s balance: %w", ErrUnreadableStatement, bal.Cd, err)
+	}
+
+	asOf, err := parseCamtDate(bal.Dt, bal.DtTm, loc)
+	if err != nil {
+		return fmt.Errorf("%w: %s balance: %w", ErrUnreadableStatement, bal.Cd, err)
+	}
+
+	balance := &Balance{Amount: amount, AsOf: asOf}
+	if bal.Cd == "CLBD" {
+		b.ledgerBalance = balance
+	} else if b.openingBalance == nil || bal.Cd == "OPBD" {
+		// The opening booked balance wins over the closing balance of the previous statement
+		b.openingBalance = balance
+	}
+
+	return nil
+}
+
+func parseCamtEntry(entry camtEntry, loc *time.Location) (Line, string) {
+	status := firstNonEmpty(entry.Sts.Cd, strings.TrimSpace(entry.Sts.Value))
+	if status != "" && status != "BOOK" {
+		return Line{}, fmt.Sprintf("entry is %s, only booked entries are recorded", status)
+	}
+
+	amount, err := parseCamtAmount(entry.Amt, entry.CdtDbtInd)
+	if err != nil {
+		return Line{}, err.Error()
+	}
+
+	occurredAt, err := parseCamtDate(entry.BookgDt, entry.BookgDtTm, loc)
+	if err != nil {
+		return Line{}, fmt.Sprintf("booking date: %s", err)
+	}
+
+	reference := firstNonEmpty(entry.AcctSvcrRef, entry.TxAcctSvcr, entry.NtryRef)
+	description := firstNonEmpty(strings.Join(entry.Ustrd, " "), entry.AddtlTxInf, entry.AddtlNtryInf)
+
+	direction := ledger.DirectionIn
+	if amount < 0 {
+		direction = ledger.DirectionOut
+	}
+
+	return Line{
+		OccurredAt:  occurredAt,
+		Amount:      abs(amount),
+		Direction:   direction,
+		Description: strings.Join(strings.Fields(description), " "),
+		Reference:   strings.TrimSpace(reference),
+	}, ""
+}
+
+// parseCamtAmount reads an unsigned amount, negative when indicator is DBIT.
+func parseCamtAmount(value string, indicator string) (int64, error) {
+	amount, err := parseAmount(strings.TrimSpace(value), "")
+	if err != nil {
+		return 0, err
+	}
+
+	switch strings.TrimSpace(indicator) {
+	case "CRDT":
+		return amount, nil
+	case "DBIT":
+		return -amount, nil
+	}
+
+	return 0, fmt.Errorf("credit debit indicator '%s' is neither CRDT nor DBIT", indicator)
+}
+
+// parseCamtDate reads an ISO date, or an ISO date time with or without zone when date is empty.
+func parseCamtDate(date string, datetime string, loc *time.Location) (time.Time, error) {
+	if date = strings.TrimSpace(date); date != "" {
+		return time.ParseInLocation(time.DateOnly, date, loc)
+	}
+
+	datetime = strings.TrimSpace(datetime)
+	if t, err := time.Parse(time.RFC3339, datetime); err == nil {
+		return t.In(loc), nil
+	}
+
+	return time.ParseInLocation("2006-01-02T15:04:05", datetime, loc)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+
+	return ""
+}
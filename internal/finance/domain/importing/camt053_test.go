@@ -0,0 +1,142 @@
+package importing_test
+
+import (
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const camt053 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>STMT20260406</MsgId><CreDtTm>2026-04-06T18:00:00+07:00</CreDtTm></GrpHdr>
+    <Stmt>
+      <Id>19036451234011-20260406</Id>
+      <Acct><Id><Othr><Id>19036451234011</Id></Othr></Id><Ccy>VND</Ccy></Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="VND">10000000</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Dt><Dt>2026-04-01</Dt></Dt>
+      </Bal>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="VND">34850000.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Dt><Dt>2026-04-06</Dt></Dt>
+      </Bal>
+      <Ntry>
+        <Amt Ccy="VND">150000</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2026-04-05</Dt></BookgDt>
+        <AcctSvcrRef>FT26095001</AcctSvcrRef>
+        <NtryDtls><TxDtls><RmtInf><Ustrd>Tien dien</Ustrd><Ustrd>thang 3</Ustrd></RmtInf></TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="VND">25000000</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><DtTm>2026-04-06T09:15:00+07:00</DtTm></BookgDt>
+        <NtryDtls><TxDtls><Refs><AcctSvcrRef>FT26096002</AcctSvcrRef></Refs></TxDtls></NtryDtls>
+        <AddtlNtryInf>Luong thang 3</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="VND">99000</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2026-04-06</Dt></BookgDt>
+        <AcctSvcrRef>FT26096003</AcctSvcrRef>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+func TestParseCamt053(t *testing.T) {
+	loc := time.FixedZone("ICT", 7*3600)
+
+	t.Run("reads the booked entries and the balances", func(t *testing.T) {
+		batch, err := importing.ParseCamt053(strings.NewReader(camt053), loc)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 3)
+
+		first := batch.Rows()[0].Line()
+		assert.Equal(t, 20, first.Number)
+		assert.Equal(t, time.Date(2026, time.April, 5, 0, 0, 0, 0, loc), first.OccurredAt)
+		assert.Equal(t, int64(150_000), first.Amount)
+		assert.Equal(t, ledger.TransactionTypeWithdrawal, first.TransactionType())
+		assert.Equal(t, "Tien dien thang 3", first.Description)
+		assert.Equal(t, "FT26095001", first.Reference)
+
+		second := batch.Rows()[1].Line()
+		assert.Equal(t, time.Date(2026, time.April, 6, 9, 15, 0, 0, loc), second.OccurredAt)
+		assert.Equal(t, ledger.TransactionTypeDeposit, second.TransactionType())
+		assert.Equal(t, "Luong thang 3", second.Description)
+		assert.Equal(t, "FT26096002", second.Reference)
+
+		assert.Equal(t, importing.RowInvalid, batch.Rows()[2].Status())
+		assert.Contains(t, batch.Rows()[2].Problem(), "PDNG")
+
+		closing, exist := batch.LedgerBalance()
+		require.True(t, exist)
+		assert.Equal(t, int64(34_850_000), closing.Amount)
+	})
+
+	t.Run("returns error when the file is not camt.053", func(t *testing.T) {
+		_, err := importing.ParseCamt053(strings.NewReader(`<Document><BkToCstmrDbtCdtNtfctn/></Document>`), loc)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+
+	t.Run("returns error when the file is not XML", func(t *testing.T) {
+		_, err := importing.ParseCamt053(strings.NewReader("<Document><Stmt>"), loc)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+}
+
+func TestBatch_Reconcile(t *testing.T) {
+	newBatch := func(t *testing.T, recorded ...string) *importing.Batch {
+		t.Helper()
+
+		batch, err := importing.ParseCamt053(strings.NewReader(camt053), time.Local)
+		require.NoError(t, err)
+		batch.MarkRecorded(recorded)
+
+		return batch
+	}
+
+	t.Run("balances when the fund provider holds the opening balance", func(t *testing.T) {
+		report, exist := newBatch(t).Reconcile(10_000_000)
+		require.True(t, exist)
+
+		assert.Equal(t, int64(10_000_000), report.Opening.Amount)
+		assert.Equal(t, int64(34_850_000), report.ProjectedBalance)
+		assert.Zero(t, report.OpeningDifference)
+		assert.Zero(t, report.ClosingDifference)
+		assert.Equal(t, []int{36}, report.UnrecordedLines)
+		assert.True(t, report.Balanced())
+	})
+
+	t.Run("counts the rows already recorded in the opening balance", func(t *testing.T) {
+		report, _ := newBatch(t, "FT26095001").Reconcile(9_850_000)
+
+		assert.Zero(t, report.OpeningDifference)
+		assert.Zero(t, report.ClosingDifference)
+		assert.True(t, report.Balanced())
+	})
+
+	t.Run("reports the differences when the balances do not match", func(t *testing.T) {
+		batch := newBatch(t)
+		report, _ := batch.Reconcile(9_000_000)
+
+		assert.Equal(t, int64(1_000_000), report.OpeningDifference)
+		assert.Equal(t, int64(1_000_000), report.ClosingDifference)
+		assert.False(t, report.Balanced())
+		assert.ErrorIs(t, batch.CheckBalances(9_000_000), importing.ErrLedgerBalanceMismatch)
+	})
+}
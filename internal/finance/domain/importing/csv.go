@@ -0,0 +1,242 @@
+package importing
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+)
+
+// ParseCSV reads the export of a bank with the profile. Dates without a zone are read in loc.
+// A row that can not be parsed becomes an INVALID row, the file fails as a whole only when
+// it can not be read or lacks a column of the profile.
+func (p *MappingProfile) ParseCSV(r io.Reader, loc *time.Location) (*Batch, error) {
+	br := bufio.NewReader(r)
+
+	// Excel saves UTF-8 exports with a byte order mark
+	if bom, err := br.Peek(3); err == nil && string(bom) == "\xef\xbb\xbf" {
+		_, _ = br.Discard(3)
+	}
+
+	for range p.skipRows {
+		if _, err := br.ReadString('\n'); err != nil {
+			return nil, fmt.Errorf("%w: file ends before the header", ErrUnreadableStatement)
+		}
+	}
+
+	reader := csv.NewReader(br)
+	reader.Comma = p.delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+	}
+
+	columns, err := p.columnIndexes(header)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := newBatch()
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			batch.reject(int(p.skipRows)+parseErr.Line, parseErr.Err.Error())
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		number := int(p.skipRows) + line
+
+		if isBlank(record) {
+			continue
+		}
+
+		parsed, problem := p.parseRecord(record, columns, loc)
+		if problem != "" {
+			batch.reject(number, problem)
+			continue
+		}
+
+		parsed.Number = number
+		batch.add(parsed)
+	}
+
+	return batch, nil
+}
+
+// columnIndexes finds the columns of the profile in the header, names are compared case insensitively.
+func (p *MappingProfile) columnIndexes(header []string) (map[string]int, error) {
+	indexByName := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, exist := indexByName[name]; !exist {
+			indexByName[name] = i
+		}
+	}
+
+	columns := make(map[string]int)
+	var missing []string
+	for _, column := range []string{
+		p.dateColumn,
+		p.amountColumn,
+		p.debitColumn,
+		p.creditColumn,
+		p.descriptionColumn,
+		p.referenceColumn,
+	} {
+		if column == "" {
+			continue
+		}
+
+		i, exist := indexByName[strings.ToLower(column)]
+		if !exist {
+			missing = append(missing, column)
+			continue
+		}
+
+		columns[column] = i
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingColumn, strings.Join(missing, ", "))
+	}
+
+	return columns, nil
+}
+
+// parseRecord reads a line from record, it returns a problem when the record can not be parsed.
+func (p *MappingProfile) parseRecord(record []string, columns map[string]int, loc *time.Location) (Line, string) {
+	field := func(column string) string {
+		i, exist := columns[column]
+		if !exist || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	occurredAt, err := time.ParseInLocation(p.dateLayout, field(p.dateColumn), loc)
+	if err != nil {
+		return Line{}, fmt.Sprintf("date '%s' does not match the format %s", field(p.dateColumn), p.dateFormat)
+	}
+
+	var amount int64
+	switch p.amountSign {
+	case AmountSplit:
+		debit, err := parseAmount(field(p.debitColumn), p.thousandsSeparator)
+		if err != nil {
+			return Line{}, fmt.Sprintf("debit: %s", err)
+		}
+
+		credit, err := parseAmount(field(p.creditColumn), p.thousandsSeparator)
+		if err != nil {
+			return Line{}, fmt.Sprintf("credit: %s", err)
+		}
+
+		if debit != 0 && credit != 0 {
+			return Line{}, "both debit and credit are set"
+		}
+
+		// Some banks print debits as negative numbers
+		amount = abs(credit) - abs(debit)
+	default:
+		amount, err = parseAmount(field(p.amountColumn), p.thousandsSeparator)
+		if err != nil {
+			return Line{}, fmt.Sprintf("amount: %s", err)
+		}
+
+		if p.amountSign == AmountInverted {
+			amount = -amount
+		}
+	}
+
+	direction := ledger.DirectionIn
+	if amount < 0 {
+		direction = ledger.DirectionOut
+	}
+
+	return Line{
+		OccurredAt:  occurredAt,
+		Amount:      abs(amount),
+		Direction:   direction,
+		Description: field(p.descriptionColumn),
+		Reference:   field(p.referenceColumn),
+	}, ""
+}
+
+// parseAmount reads a whole amount such as 1,500,000 or (25.000), parentheses mark a negative amount.
+// Decimals are accepted only when they are zero. An empty value is zero.
+func parseAmount(value string, thousandsSeparator string) (int64, error) {
+	original := value
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = strings.TrimSpace(value[1 : len(value)-1])
+	}
+
+	if value == "" {
+		return 0, nil
+	}
+
+	decimalSeparator := "."
+	if thousandsSeparator == "." {
+		decimalSeparator = ","
+	}
+
+	if thousandsSeparator != "" {
+		value = strings.ReplaceAll(value, thousandsSeparator, "")
+	}
+
+	if whole, decimals, found := strings.Cut(value, decimalSeparator); found {
+		if strings.Trim(decimals, "0") != "" {
+			return 0, fmt.Errorf("'%s' is not a whole amount", original)
+		}
+		value = whole
+	}
+
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not an amount", original)
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+
+	return amount
+}
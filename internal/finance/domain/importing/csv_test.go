@@ -0,0 +1,207 @@
+package importing_test
+
+import (
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProfile(t *testing.T, spec importing.ProfileSpec) *importing.MappingProfile {
+	t.Helper()
+
+	spec.Name = "Test"
+	p, err := importing.NewMappingProfile("user-1", spec)
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestMappingProfile_ParseCSV(t *testing.T) {
+	t.Run("reads debit and credit columns after the account details", func(t *testing.T) {
+		p := newProfile(t, importing.ProfileSpec{
+			SkipRows:           2,
+			DateColumn:         "Ngày giao dịch",
+			DateFormat:         "DD/MM/YYYY",
+			AmountSign:         "SPLIT",
+			DebitColumn:        "Nợ",
+			CreditColumn:       "Có",
+			DescriptionColumn:  "Diễn giải",
+			ReferenceColumn:    "Số tham chiếu",
+			ThousandsSeparator: ",",
+		})
+
+		csv := "\xef\xbb\xbfSao kê tài khoản,19036451234011\n" +
+			"Từ ngày 01/03/2026 đến ngày 31/03/2026\n" +
+			"Ngày giao dịch,Số tham chiếu,Diễn giải,Nợ,Có\n" +
+			"05/03/2026,FT26064001,\"Thanh toan tien dien, thang 2\",\"1,250,000\",\n" +
+			"06/03/2026,FT26065002,Luong thang 2,,\"25,000,000.00\"\n"
+
+		batch, err := p.ParseCSV(strings.NewReader(csv), time.Local)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 2)
+
+		first := batch.Rows()[0].Line()
+		assert.Equal(t, 4, first.Number)
+		assert.Equal(t, time.Date(2026, time.March, 5, 0, 0, 0, 0, time.Local), first.OccurredAt)
+		assert.Equal(t, int64(1_250_000), first.Amount)
+		assert.Equal(t, ledger.DirectionOut, first.Direction)
+		assert.Equal(t, "Thanh toan tien dien, thang 2", first.Description)
+		assert.Equal(t, "FT26064001", first.Reference)
+
+		second := batch.Rows()[1].Line()
+		assert.Equal(t, int64(25_000_000), second.Amount)
+		assert.Equal(t, ledger.DirectionIn, second.Direction)
+		assert.Equal(t, ledger.TransactionTypeDeposit, second.TransactionType())
+	})
+
+	t.Run("reads signed amounts with dots as thousands separator", func(t *testing.T) {
+		p := newProfile(t, importing.ProfileSpec{
+			Delimiter:          ";",
+			DateColumn:         "date",
+			DateFormat:         "YYYY-MM-DD",
+			AmountSign:         "SIGNED",
+			AmountColumn:       "amount",
+			ThousandsSeparator: ".",
+		})
+
+		batch, err := p.ParseCSV(strings.NewReader("Date;Amount\n2026-03-05;-150.000\n2026-03-06;(20.000)\n2026-03-07;1.000,00\n"), time.Local)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 3)
+		assert.Equal(t, ledger.DirectionOut, batch.Rows()[0].Line().Direction)
+		assert.Equal(t, int64(150_000), batch.Rows()[0].Line().Amount)
+		assert.Equal(t, ledger.DirectionOut, batch.Rows()[1].Line().Direction)
+		assert.Equal(t, int64(1_000), batch.Rows()[2].Line().Amount)
+		assert.Equal(t, ledger.DirectionIn, batch.Rows()[2].Line().Direction)
+	})
+
+	t.Run("reads positive amounts as outflows when inverted", func(t *testing.T) {
+		p := newProfile(t, importing.ProfileSpec{
+			DateColumn:   "date",
+			DateFormat:   "DD/MM/YYYY",
+			AmountSign:   "INVERTED",
+			AmountColumn: "amount",
+		})
+
+		batch, err := p.ParseCSV(strings.NewReader("date,amount\n05/03/2026,99000\n"), time.Local)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 1)
+		assert.Equal(t, ledger.DirectionOut, batch.Rows()[0].Line().Direction)
+		assert.Equal(t, ledger.TransactionTypeWithdrawal, batch.Rows()[0].Line().TransactionType())
+	})
+
+	t.Run("keeps the rows that can not be parsed as invalid", func(t *testing.T) {
+		p := newProfile(t, importing.ProfileSpec{
+			DateColumn:      "date",
+			DateFormat:      "DD/MM/YYYY",
+			AmountSign:      "SIGNED",
+			AmountColumn:    "amount",
+			ReferenceColumn: "ref",
+		})
+
+		csv := "date,amount,ref\n" +
+			"2026-03-05,-1000,A1\n" +
+			"05/03/2026,12.5,A2\n" +
+			"05/03/2026,0,A3\n" +
+			"\n" +
+			"Tổng cộng,,\n" +
+			"06/03/2026,-1000,A4\n"
+
+		batch, err := p.ParseCSV(strings.NewReader(csv), time.Local)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 5)
+		assert.Equal(t, 4, batch.Count(importing.RowInvalid))
+		assert.Equal(t, 1, batch.Count(importing.RowNew))
+		assert.Equal(t, 2, batch.Rows()[0].Line().Number)
+		assert.Contains(t, batch.Rows()[0].Problem(), "does not match the format DD/MM/YYYY")
+		assert.Equal(t, 7, batch.Rows()[4].Line().Number)
+	})
+
+	t.Run("returns error when a column of the profile is missing", func(t *testing.T) {
+		p := newProfile(t, importing.ProfileSpec{
+			DateColumn:   "date",
+			DateFormat:   "DD/MM/YYYY",
+			AmountSign:   "SIGNED",
+			AmountColumn: "amount",
+		})
+
+		_, err := p.ParseCSV(strings.NewReader("date,so tien\n05/03/2026,1000\n"), time.Local)
+		require.ErrorIs(t, err, importing.ErrMissingColumn)
+	})
+
+	t.Run("returns error when the file ends before the header", func(t *testing.T) {
+		p := newProfile(t, importing.ProfileSpec{
+			SkipRows:     3,
+			DateColumn:   "date",
+			DateFormat:   "DD/MM/YYYY",
+			AmountSign:   "SIGNED",
+			AmountColumn: "amount",
+		})
+
+		_, err := p.ParseCSV(strings.NewReader("date,amount\n"), time.Local)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+}
+
+func TestBatch(t *testing.T) {
+	p := newProfile(t, importing.ProfileSpec{
+		DateColumn:      "date",
+		DateFormat:      "DD/MM/YYYY",
+		AmountSign:      "SIGNED",
+		AmountColumn:    "amount",
+		ReferenceColumn: "ref",
+	})
+
+	csv := "date,amount,ref\n" +
+		"28/02/2026,-1000,A0\n" +
+		"05/03/2026,-2000,A1\n" +
+		"06/03/2026,3000,A2\n" +
+		"07/03/2026,-4000,A1\n" +
+		"08/03/2026,-5000,\n"
+
+	batch, err := p.ParseCSV(strings.NewReader(csv), time.Local)
+	require.NoError(t, err)
+
+	t.Run("marks a reference repeated in the file as duplicate", func(t *testing.T) {
+		assert.Equal(t, importing.RowDuplicate, batch.Rows()[3].Status())
+		assert.Equal(t, []string{"A0", "A1", "A2"}, batch.References())
+	})
+
+	batch.MarkRecorded([]string{"A2"})
+	batch.MarkOutsidePeriod(
+		time.Date(2026, time.March, 1, 0, 0, 0, 0, time.Local),
+		time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+	)
+
+	t.Run("marks recorded references and rows outside the period", func(t *testing.T) {
+		assert.Equal(t, importing.RowOutsidePeriod, batch.Rows()[0].Status())
+		assert.Equal(t, importing.RowNew, batch.Rows()[1].Status())
+		assert.Equal(t, importing.RowDuplicate, batch.Rows()[2].Status())
+		assert.Equal(t, importing.RowNew, batch.Rows()[4].Status())
+		assert.Equal(t, 2, batch.Count(importing.RowNew))
+	})
+
+	t.Run("builds the specs of the new rows", func(t *testing.T) {
+		fpID := uuid.New()
+		recordedAt := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.Local)
+
+		txSpecs := batch.TransactionSpecs(fpID, recordedAt)
+
+		require.Len(t, txSpecs, 2)
+		assert.Equal(t, "A1", txSpecs[0].TransactionNo)
+		assert.Equal(t, "WITHDRAWAL", txSpecs[0].TransactionType)
+		assert.Equal(t, int64(2000), txSpecs[0].Amount)
+		assert.Equal(t, fpID, txSpecs[0].FpID)
+		assert.Equal(t, recordedAt, txSpecs[0].RecordedAt)
+		assert.Empty(t, txSpecs[1].TransactionNo)
+	})
+}
@@ -0,0 +1,74 @@
+package importing
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidFormat   = errors.New("invalid statement format")
+	ErrProfileRequired = errors.New("an import profile is required to read a CSV statement")
+)
+
+var (
+	// FormatCSV is the CSV export of a bank, read with a mapping profile.
+	FormatCSV = Format{value: "CSV"}
+	// FormatOFX is an OFX 1.x SGML or OFX 2.x XML statement.
+	FormatOFX = Format{value: "OFX"}
+	// FormatQIF is a Quicken Interchange Format export, as written by GnuCash and Quicken.
+	FormatQIF = Format{value: "QIF"}
+	// FormatCamt053 is an ISO 20022 camt.053 bank to customer statement.
+	FormatCamt053 = Format{value: "CAMT053"}
+	// FormatMT940 is a SWIFT MT940 customer statement.
+	FormatMT940 = Format{value: "MT940"}
+)
+
+var supportedFormat = map[string]Format{
+	"CSV":     FormatCSV,
+	"OFX":     FormatOFX,
+	"QIF":     FormatQIF,
+	"CAMT053": FormatCamt053,
+	"MT940":   FormatMT940,
+}
+
+type Format struct {
+	value string
+}
+
+func NewFormat(formatStr string) (Format, error) {
+	formatCleaned := strings.TrimSpace(strings.ToUpper(formatStr))
+
+	f, ok := supportedFormat[formatCleaned]
+	if !ok {
+		return Format{}, fmt.Errorf("%w: %s", ErrInvalidFormat, formatStr)
+	}
+
+	return f, nil
+}
+
+func (f Format) String() string { return f.value }
+
+// ParseStatement reads a statement written in format. profile is required for CSV and ignored otherwise.
+func ParseStatement(format Format, profile *MappingProfile, r io.Reader, loc *time.Location) (*Batch, error) {
+	switch format {
+	case FormatCSV:
+		if profile == nil {
+			return nil, ErrProfileRequired
+		}
+
+		return profile.ParseCSV(r, loc)
+	case FormatOFX:
+		return ParseOFX(r, loc)
+	case FormatQIF:
+		return ParseQIF(r, loc)
+	case FormatCamt053:
+		return ParseCamt053(r, loc)
+	case FormatMT940:
+		return ParseMT940(r, loc)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidFormat, format.String())
+}
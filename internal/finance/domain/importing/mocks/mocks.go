@@ -0,0 +1,254 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	importing "sumni-finance-backend/internal/finance/domain/importing"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateProfile provides a mock function with given fields: ctx, p
+func (_m *MockRepository) CreateProfile(ctx context.Context, p *importing.MappingProfile) error {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *importing.MappingProfile) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_CreateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProfile'
+type MockRepository_CreateProfile_Call struct {
+	*mock.Call
+}
+
+// CreateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *importing.MappingProfile
+func (_e *MockRepository_Expecter) CreateProfile(ctx interface{}, p interface{}) *MockRepository_CreateProfile_Call {
+	return &MockRepository_CreateProfile_Call{Call: _e.mock.On("CreateProfile", ctx, p)}
+}
+
+func (_c *MockRepository_CreateProfile_Call) Run(run func(ctx context.Context, p *importing.MappingProfile)) *MockRepository_CreateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*importing.MappingProfile))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateProfile_Call) Return(_a0 error) *MockRepository_CreateProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_CreateProfile_Call) RunAndReturn(run func(context.Context, *importing.MappingProfile) error) *MockRepository_CreateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProfile provides a mock function with given fields: ctx, userID, pID
+func (_m *MockRepository) DeleteProfile(ctx context.Context, userID string, pID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, pID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, pID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProfile'
+type MockRepository_DeleteProfile_Call struct {
+	*mock.Call
+}
+
+// DeleteProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pID uuid.UUID
+func (_e *MockRepository_Expecter) DeleteProfile(ctx interface{}, userID interface{}, pID interface{}) *MockRepository_DeleteProfile_Call {
+	return &MockRepository_DeleteProfile_Call{Call: _e.mock.On("DeleteProfile", ctx, userID, pID)}
+}
+
+func (_c *MockRepository_DeleteProfile_Call) Run(run func(ctx context.Context, userID string, pID uuid.UUID)) *MockRepository_DeleteProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteProfile_Call) Return(_a0 error) *MockRepository_DeleteProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteProfile_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *MockRepository_DeleteProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function with given fields: ctx, userID, pID
+func (_m *MockRepository) GetProfile(ctx context.Context, userID string, pID uuid.UUID) (*importing.MappingProfile, error) {
+	ret := _m.Called(ctx, userID, pID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 *importing.MappingProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*importing.MappingProfile, error)); ok {
+		return rf(ctx, userID, pID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *importing.MappingProfile); ok {
+		r0 = rf(ctx, userID, pID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*importing.MappingProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, pID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockRepository_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pID uuid.UUID
+func (_e *MockRepository_Expecter) GetProfile(ctx interface{}, userID interface{}, pID interface{}) *MockRepository_GetProfile_Call {
+	return &MockRepository_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, userID, pID)}
+}
+
+func (_c *MockRepository_GetProfile_Call) Run(run func(ctx context.Context, userID string, pID uuid.UUID)) *MockRepository_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetProfile_Call) Return(_a0 *importing.MappingProfile, _a1 error) *MockRepository_GetProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetProfile_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*importing.MappingProfile, error)) *MockRepository_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecordedTransactionNos provides a mock function with given fields: ctx, fpID, transactionNos
+func (_m *MockRepository) ListRecordedTransactionNos(ctx context.Context, fpID uuid.UUID, transactionNos []string) ([]string, error) {
+	ret := _m.Called(ctx, fpID, transactionNos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecordedTransactionNos")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) ([]string, error)); ok {
+		return rf(ctx, fpID, transactionNos)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) []string); ok {
+		r0 = rf(ctx, fpID, transactionNos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, fpID, transactionNos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListRecordedTransactionNos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecordedTransactionNos'
+type MockRepository_ListRecordedTransactionNos_Call struct {
+	*mock.Call
+}
+
+// ListRecordedTransactionNos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fpID uuid.UUID
+//   - transactionNos []string
+func (_e *MockRepository_Expecter) ListRecordedTransactionNos(ctx interface{}, fpID interface{}, transactionNos interface{}) *MockRepository_ListRecordedTransactionNos_Call {
+	return &MockRepository_ListRecordedTransactionNos_Call{Call: _e.mock.On("ListRecordedTransactionNos", ctx, fpID, transactionNos)}
+}
+
+func (_c *MockRepository_ListRecordedTransactionNos_Call) Run(run func(ctx context.Context, fpID uuid.UUID, transactionNos []string)) *MockRepository_ListRecordedTransactionNos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListRecordedTransactionNos_Call) Return(_a0 []string, _a1 error) *MockRepository_ListRecordedTransactionNos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListRecordedTransactionNos_Call) RunAndReturn(run func(context.Context, uuid.UUID, []string) ([]string, error)) *MockRepository_ListRecordedTransactionNos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
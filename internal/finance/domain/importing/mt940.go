@@ -0,0 +1,231 @@
+package importing
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+)
+
+var (
+	// mt940Tag starts a field, such as :61: or :60F:
+	mt940Tag = regexp.MustCompile(`^:(\d{2}[A-Z]?):`)
+	// mt940Balance is the value of :60F:, :60M:, :62F: and :62M:, e.g. C260331VND1000000,
+	mt940Balance = regexp.MustCompile(`^([CD])(\d{6})([A-Z]{3})(\d+,\d*)$`)
+	// mt940StatementLine is the first line of :61:, e.g. 2604050405D150000,NTRFFT26095001//TCB0405123
+	// made of value date, entry date, mark, funds code, amount, transaction type and references
+	mt940StatementLine = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+,\d*)([NSF][A-Z0-9]{3})(.*)$`)
+)
+
+// mt940Field is a field of an MT940 message and the line of the file it starts on.
+type mt940Field struct {
+	tag    string
+	value  string
+	number int
+}
+
+// ParseMT940 reads a SWIFT MT940 customer statement, with or without its SWIFT envelope. C and RD lines are
+// inflows, D and RC lines outflows. The reference of the bank, after the double slash, is the reference of the row,
+// or the reference of the customer when the bank gives none. A statement split over several messages is read
+// as one, from the opening balance of the first message to the closing balance of the last.
+// A file holding the statements of several accounts is refused, they are imported one by one.
+func ParseMT940(r io.Reader, loc *time.Location) (*Batch, error) {
+	fields, err := readMT940Fields(r)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := newBatch()
+	accounts := make(map[string]struct{})
+
+	for i, field := range fields {
+		switch field.tag {
+		case "25":
+			accounts[field.value] = struct{}{}
+		case "60F", "60M":
+			if batch.openingBalance != nil {
+				continue
+			}
+
+			balance, err := parseMT940Balance(field.value, loc)
+			if err != nil {
+				return nil, fmt.Errorf("%w: opening balance on line %d: %w", ErrUnreadableStatement, field.number, err)
+			}
+			batch.openingBalance = &balance
+		case "62F", "62M":
+			balance, err := parseMT940Balance(field.value, loc)
+			if err != nil {
+				return nil, fmt.Errorf("%w: closing balance on line %d: %w", ErrUnreadableStatement, field.number, err)
+			}
+			batch.ledgerBalance = &balance
+		case "61":
+			information := ""
+			if i+1 < len(fields) && fields[i+1].tag == "86" {
+				information = fields[i+1].value
+			}
+
+			line, problem := parseMT940StatementLine(field.value, information, loc)
+			if problem != "" {
+				batch.reject(field.number, problem)
+				continue
+			}
+
+			line.Number = field.number
+			batch.add(line)
+		}
+	}
+
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("%w: no MT940 statement in the file", ErrUnreadableStatement)
+	}
+	if len(accounts) > 1 {
+		return nil, fmt.Errorf("%w: file holds the statements of %d accounts", ErrUnreadableStatement, len(accounts))
+	}
+
+	return batch, nil
+}
+
+// readMT940Fields splits the messages of the file into fields, the lines not starting a field continue
+// the previous one. The SWIFT blocks around the text block and the end of message marks are dropped.
+func readMT940Fields(r io.Reader) ([]mt940Field, error) {
+	scanner := bufio.NewScanner(r)
+
+	var fields []mt940Field
+	number := 0
+	for scanner.Scan() {
+		number++
+
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if number == 1 {
+			text = strings.TrimPrefix(text, "\xef\xbb\xbf")
+		}
+
+		// {1:F01TCBVVNVXAXXX0000000000}{2:I940TCBVVNVXXXXXN}{4: opens the text block
+		if i := strings.Index(text, "{4:"); i >= 0 {
+			text = text[i+3:]
+		}
+
+		if trimmed := strings.TrimSpace(text); trimmed == "" || trimmed == "-" || strings.HasPrefix(trimmed, "-}") {
+			continue
+		}
+
+		if match := mt940Tag.FindStringSubmatch(text); match != nil {
+			fields = append(fields, mt940Field{
+				tag:    match[1],
+				value:  strings.TrimSpace(text[len(match[0]):]),
+				number: number,
+			})
+			continue
+		}
+
+		if len(fields) > 0 {
+			last := &fields[len(fields)-1]
+			last.value += "\n" + strings.TrimSpace(text)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+	}
+
+	return fields, nil
+}
+
+func parseMT940Balance(value string, loc *time.Location) (Balance, error) {
+	match := mt940Balance.FindStringSubmatch(value)
+	if match == nil {
+		return Balance{}, fmt.Errorf("'%s' is not an MT940 balance", value)
+	}
+
+	asOf, err := time.ParseInLocation("060102", match[2], loc)
+	if err != nil {
+		return Balance{}, fmt.Errorf("'%s' is not an MT940 date", match[2])
+	}
+
+	amount, err := parseAmount(strings.Replace(match[4], ",", ".", 1), "")
+	if err != nil {
+		return Balance{}, err
+	}
+
+	if match[1] == "D" {
+		amount = -amount
+	}
+
+	return Balance{Amount: amount, AsOf: asOf}, nil
+}
+
+// parseMT940StatementLine reads a :61: field with the :86: information that follows it.
+func parseMT940StatementLine(value string, information string, loc *time.Location) (Line, string) {
+	firstLine, supplementary, _ := strings.Cut(value, "\n")
+
+	match := mt940StatementLine.FindStringSubmatch(firstLine)
+	if match == nil {
+		return Line{}, fmt.Sprintf("'%s' is not an MT940 statement line", firstLine)
+	}
+
+	valueDate, err := time.ParseInLocation("060102", match[1], loc)
+	if err != nil {
+		return Line{}, fmt.Sprintf("'%s' is not an MT940 date", match[1])
+	}
+
+	occurredAt := valueDate
+	if entryDate := match[2]; entryDate != "" {
+		occurredAt, err = entryDateNear(valueDate, entryDate)
+		if err != nil {
+			return Line{}, err.Error()
+		}
+	}
+
+	amount, err := parseAmount(strings.Replace(match[5], ",", ".", 1), "")
+	if err != nil {
+		return Line{}, err.Error()
+	}
+
+	// A reversal of a debit credits the account and the other way around
+	direction := ledger.DirectionIn
+	if match[3] == "D" || match[3] == "RC" {
+		direction = ledger.DirectionOut
+	}
+
+	customerReference, bankReference, _ := strings.Cut(match[7], "//")
+	reference := strings.TrimSpace(bankReference)
+	if reference == "" && !strings.EqualFold(strings.TrimSpace(customerReference), "NONREF") {
+		reference = strings.TrimSpace(customerReference)
+	}
+
+	description := firstNonEmpty(information, supplementary)
+
+	return Line{
+		OccurredAt:  occurredAt,
+		Amount:      amount,
+		Direction:   direction,
+		Description: strings.Join(strings.Fields(description), " "),
+		Reference:   reference,
+	}, ""
+}
+
+// entryDateNear dates the MMDD entry date in the year that brings it closest to the value date,
+// the booking of a line may fall in the year before or after its value date.
+func entryDateNear(valueDate time.Time, entryDate string) (time.Time, error) {
+	var closest time.Time
+	for _, year := range []int{valueDate.Year() - 1, valueDate.Year(), valueDate.Year() + 1} {
+		// 0229 exists in leap years only
+		date, err := time.ParseInLocation("20060102", fmt.Sprintf("%d%s", year, entryDate), valueDate.Location())
+		if err != nil {
+			continue
+		}
+
+		if closest.IsZero() || date.Sub(valueDate).Abs() < closest.Sub(valueDate).Abs() {
+			closest = date
+		}
+	}
+
+	if closest.IsZero() {
+		return time.Time{}, fmt.Errorf("'%s' is not an MT940 entry date", entryDate)
+	}
+
+	return closest, nil
+}
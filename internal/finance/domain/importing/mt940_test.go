@@ -0,0 +1,91 @@
+package importing_test
+
+import (
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMT940(t *testing.T) {
+	loc := time.FixedZone("ICT", 7*3600)
+
+	t.Run("reads a statement split over two messages", func(t *testing.T) {
+		mt940 := "{1:F01TCBVVNVXAXXX0000000000}{2:I940TCBVVNVXXXXXN}{4:\r\n" +
+			":20:STMT260406\r\n" +
+			":25:19036451234011\r\n" +
+			":28C:00001/001\r\n" +
+			":60F:C260331VND10000000,\r\n" +
+			":61:2604050405D150000,NTRFFT26095001//TCB0405001\r\n" +
+			":86:Tien dien\r\n" +
+			"thang 3\r\n" +
+			":61:2604060406C25000000,00NTRFNONREF//TCB0406002\r\n" +
+			":86:Luong thang 3\r\n" +
+			":62M:C260406VND34850000,\r\n" +
+			"-}\r\n" +
+			"{1:F01TCBVVNVXAXXX0000000000}{2:I940TCBVVNVXXXXXN}{4:\r\n" +
+			":20:STMT260406\r\n" +
+			":25:19036451234011\r\n" +
+			":28C:00001/002\r\n" +
+			":60M:C260406VND34850000,\r\n" +
+			":61:2512311231RD50000,NTRFINV-2025-118\r\n" +
+			":61:2604060406D1A,NTRF\r\n" +
+			":62F:C260406VND34900000,\r\n" +
+			"-}\r\n"
+
+		batch, err := importing.ParseMT940(strings.NewReader(mt940), loc)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 4)
+
+		first := batch.Rows()[0].Line()
+		assert.Equal(t, 6, first.Number)
+		assert.Equal(t, time.Date(2026, time.April, 5, 0, 0, 0, 0, loc), first.OccurredAt)
+		assert.Equal(t, int64(150_000), first.Amount)
+		assert.Equal(t, ledger.TransactionTypeWithdrawal, first.TransactionType())
+		assert.Equal(t, "Tien dien thang 3", first.Description)
+		assert.Equal(t, "TCB0405001", first.Reference)
+
+		second := batch.Rows()[1].Line()
+		assert.Equal(t, ledger.TransactionTypeDeposit, second.TransactionType())
+		assert.Equal(t, int64(25_000_000), second.Amount)
+		assert.Equal(t, "TCB0406002", second.Reference)
+
+		reversal := batch.Rows()[2].Line()
+		assert.Equal(t, ledger.DirectionIn, reversal.Direction)
+		assert.Equal(t, "INV-2025-118", reversal.Reference)
+		assert.Equal(t, time.Date(2025, time.December, 31, 0, 0, 0, 0, loc), reversal.OccurredAt)
+
+		assert.Equal(t, importing.RowInvalid, batch.Rows()[3].Status())
+		assert.Equal(t, 19, batch.Rows()[3].Line().Number)
+
+		report, exist := batch.Reconcile(10_000_000)
+		require.True(t, exist)
+		assert.Equal(t, int64(10_000_000), report.Opening.Amount)
+		assert.Equal(t, int64(34_900_000), report.Closing.Amount)
+		assert.True(t, report.Balanced())
+	})
+
+	t.Run("dates the entry in the year closest to the value date", func(t *testing.T) {
+		batch, err := importing.ParseMT940(strings.NewReader(":25:1903\n:61:2512310102D1000,NMSCNONREF\n"), loc)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 1)
+		assert.Equal(t, time.Date(2026, time.January, 2, 0, 0, 0, 0, loc), batch.Rows()[0].Line().OccurredAt)
+		assert.Empty(t, batch.Rows()[0].Line().Reference)
+	})
+
+	t.Run("returns error when the file holds several accounts", func(t *testing.T) {
+		_, err := importing.ParseMT940(strings.NewReader(":25:1903\n-\n:25:1904\n-\n"), loc)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+
+	t.Run("returns error when a balance can not be read", func(t *testing.T) {
+		_, err := importing.ParseMT940(strings.NewReader(":25:1903\n:60F:X260331VND1,\n"), loc)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+}
@@ -0,0 +1,279 @@
+package importing
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+)
+
+// ParseOFX reads an OFX statement, the SGML of OFX 1.x as well as the XML of OFX 2.x.
+// The FITID of a transaction is its reference and LEDGERBAL the ledger balance of the batch.
+// A file holding the statements of several accounts is refused, they are imported one by one.
+// Dates without a zone are read in loc.
+func ParseOFX(r io.Reader, loc *time.Location) (*Batch, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+	}
+
+	root, err := parseOFXTree(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []*ofxElement
+	statements = append(statements, root.findAll("STMTRS")...)
+	statements = append(statements, root.findAll("CCSTMTRS")...)
+
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("%w: no bank or credit card statement in the file", ErrUnreadableStatement)
+	}
+	if len(statements) > 1 {
+		return nil, fmt.Errorf("%w: file holds the statements of %d accounts", ErrUnreadableStatement, len(statements))
+	}
+	statement := statements[0]
+
+	batch := newBatch()
+	for _, trn := range statement.findAll("STMTTRN") {
+		line, problem := parseOFXTransaction(trn, loc)
+		if problem != "" {
+			batch.reject(trn.line, problem)
+			continue
+		}
+
+		batch.add(line)
+	}
+
+	if balance := statement.find("LEDGERBAL"); balance != nil {
+		amount, err := parseOFXAmount(balance.value("BALAMT"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: ledger balance: %w", ErrUnreadableStatement, err)
+		}
+
+		asOf, err := parseOFXDate(balance.value("DTASOF"), loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: ledger balance: %w", ErrUnreadableStatement, err)
+		}
+
+		batch.ledgerBalance = &Balance{Amount: amount, AsOf: asOf}
+	}
+
+	return batch, nil
+}
+
+func parseOFXTransaction(trn *ofxElement, loc *time.Location) (Line, string) {
+	occurredAt, err := parseOFXDate(trn.value("DTPOSTED"), loc)
+	if err != nil {
+		return Line{}, fmt.Sprintf("DTPOSTED: %s", err)
+	}
+
+	amount, err := parseOFXAmount(trn.value("TRNAMT"))
+	if err != nil {
+		return Line{}, fmt.Sprintf("TRNAMT: %s", err)
+	}
+
+	// OFX 2.x may put the payee in an aggregate instead of NAME
+	name := trn.value("NAME")
+	if payee := trn.find("PAYEE"); name == "" && payee != nil {
+		name = payee.value("NAME")
+	}
+
+	direction := ledger.DirectionIn
+	if amount < 0 {
+		direction = ledger.DirectionOut
+	}
+
+	return Line{
+		Number:      trn.line,
+		OccurredAt:  occurredAt,
+		Amount:      abs(amount),
+		Direction:   direction,
+		Description: describe(name, trn.value("MEMO")),
+		Reference:   trn.value("FITID"),
+	}, ""
+}
+
+// parseOFXDate reads a date such as 20260305, 20260305120000 or 20260305120000.000[+7:ICT].
+// The zone is ignored on a date without time, the day is the one printed on the statement.
+func parseOFXDate(value string, loc *time.Location) (time.Time, error) {
+	datetime, zone, hasZone := strings.Cut(strings.TrimSpace(value), "[")
+	datetime, _, _ = strings.Cut(datetime, ".")
+
+	var layout string
+	switch len(datetime) {
+	case 8:
+		layout = "20060102"
+		hasZone = false
+	case 12:
+		layout = "200601021504"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, fmt.Errorf("'%s' is not an OFX date", value)
+	}
+
+	dateLoc := loc
+	if hasZone {
+		offset, _, _ := strings.Cut(strings.TrimSuffix(zone, "]"), ":")
+
+		hours, err := strconv.ParseFloat(offset, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("'%s' has an invalid zone", value)
+		}
+
+		dateLoc = time.FixedZone("", int(hours*3600))
+	}
+
+	t, err := time.ParseInLocation(layout, datetime, dateLoc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("'%s' is not an OFX date", value)
+	}
+
+	return t.In(loc), nil
+}
+
+// parseOFXAmount reads a signed amount, some banks separate the decimals with a comma.
+func parseOFXAmount(value string) (int64, error) {
+	if !strings.Contains(value, ".") {
+		value = strings.ReplaceAll(value, ",", ".")
+	}
+
+	return parseAmount(value, "")
+}
+
+// describe joins the payee and the memo of a transaction.
+func describe(payee string, memo string) string {
+	switch {
+	case memo == "" || memo == payee:
+		return payee
+	case payee == "":
+		return memo
+	}
+
+	return payee + " - " + memo
+}
+
+// ofxElement is an element of an OFX file. An aggregate holds children, any other element a value.
+type ofxElement struct {
+	name     string
+	text     string
+	line     int
+	children []*ofxElement
+}
+
+// value returns the value of the first child element named name, empty when there is none.
+func (e *ofxElement) value(name string) string {
+	for _, child := range e.children {
+		if child.name == name {
+			return child.text
+		}
+	}
+
+	return ""
+}
+
+// find returns the first descendant named name in document order.
+func (e *ofxElement) find(name string) *ofxElement {
+	for _, child := range e.children {
+		if child.name == name {
+			return child
+		}
+
+		if found := child.find(name); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// findAll returns the descendants named name in document order, without looking into the matches.
+func (e *ofxElement) findAll(name string) []*ofxElement {
+	var found []*ofxElement
+	for _, child := range e.children {
+		if child.name == name {
+			found = append(found, child)
+			continue
+		}
+
+		found = append(found, child.findAll(name)...)
+	}
+
+	return found
+}
+
+// parseOFXTree builds the elements of the OFX root. SGML leaves are not closed, so an element directly followed
+// by text is a leaf ending at the text and its closing tag, if any, is ignored. The header before the root
+// is skipped, both the SGML key value lines of OFX 1.x and the processing instructions of OFX 2.x.
+func parseOFXTree(content string) (*ofxElement, error) {
+	start := strings.Index(strings.ToUpper(content), "<OFX>")
+	if start < 0 {
+		return nil, fmt.Errorf("%w: no OFX element in the file", ErrUnreadableStatement)
+	}
+
+	line := 1 + strings.Count(content[:start], "\n")
+	content = content[start:]
+
+	root := &ofxElement{}
+	stack := []*ofxElement{root}
+
+	for len(content) > 0 {
+		open := strings.IndexByte(content, '<')
+		if open < 0 {
+			break
+		}
+		line += strings.Count(content[:open], "\n")
+
+		end := strings.IndexByte(content[open:], '>')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unterminated tag on line %d", ErrUnreadableStatement, line)
+		}
+
+		tag := strings.TrimSpace(content[open+1 : open+end])
+		line += strings.Count(tag, "\n")
+		content = content[open+end+1:]
+
+		switch {
+		case tag == "" || strings.HasPrefix(tag, "?") || strings.HasPrefix(tag, "!"):
+			continue
+		case strings.HasPrefix(tag, "/"):
+			name := strings.ToUpper(strings.TrimSpace(tag[1:]))
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name == name {
+					stack = stack[:i]
+					break
+				}
+			}
+			continue
+		}
+
+		selfClosing := strings.HasSuffix(tag, "/")
+		name, _, _ := strings.Cut(strings.TrimSuffix(tag, "/"), " ")
+
+		element := &ofxElement{name: strings.ToUpper(name), line: line}
+		parent := stack[len(stack)-1]
+		parent.children = append(parent.children, element)
+
+		if selfClosing {
+			continue
+		}
+
+		text := content
+		if next := strings.IndexByte(content, '<'); next >= 0 {
+			text = content[:next]
+		}
+
+		if value := strings.TrimSpace(text); value != "" {
+			element.text = html.UnescapeString(value)
+			continue
+		}
+
+		stack = append(stack, element)
+	}
+
+	return root, nil
+}
@@ -0,0 +1,159 @@
+package importing_test
+
+import (
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOFX(t *testing.T) {
+	loc := time.FixedZone("ICT", 7*3600)
+
+	t.Run("reads an OFX 1.x SGML statement", func(t *testing.T) {
+		sgml := "OFXHEADER:100\r\n" +
+			"DATA:OFXSGML\r\n" +
+			"VERSION:102\r\n" +
+			"CHARSET:1252\r\n" +
+			"\r\n" +
+			"<OFX>\r\n" +
+			"<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20260406</SONRS></SIGNONMSGSRSV1>\r\n" +
+			"<BANKMSGSRSV1><STMTTRNRS><TRNUID>1<STMTRS>\r\n" +
+			"<CURDEF>VND\r\n" +
+			"<BANKTRANLIST>\r\n" +
+			"<DTSTART>20260401<DTEND>20260406\r\n" +
+			"<STMTTRN>\r\n" +
+			"<TRNTYPE>DEBIT\r\n" +
+			"<DTPOSTED>20260405\r\n" +
+			"<TRNAMT>-150000.00\r\n" +
+			"<FITID>FT26095001\r\n" +
+			"<NAME>EVN HCMC\r\n" +
+			"<MEMO>Tien dien thang 3\r\n" +
+			"</STMTTRN>\r\n" +
+			"<STMTTRN>\r\n" +
+			"<TRNTYPE>CREDIT\r\n" +
+			"<DTPOSTED>20260405233000[0:GMT]\r\n" +
+			"<TRNAMT>25000000\r\n" +
+			"<FITID>FT26095002\r\n" +
+			"<NAME>Luong T3 &amp; thuong\r\n" +
+			"</STMTTRN>\r\n" +
+			"<STMTTRN>\r\n" +
+			"<TRNTYPE>DEBIT\r\n" +
+			"<DTPOSTED>2026-04-06\r\n" +
+			"<TRNAMT>-1\r\n" +
+			"<FITID>FT26096003\r\n" +
+			"</STMTTRN>\r\n" +
+			"</BANKTRANLIST>\r\n" +
+			"<LEDGERBAL><BALAMT>30850000<DTASOF>20260406</LEDGERBAL>\r\n" +
+			"</STMTRS></STMTTRNRS></BANKMSGSRSV1>\r\n" +
+			"</OFX>\r\n"
+
+		batch, err := importing.ParseOFX(strings.NewReader(sgml), loc)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 3)
+
+		first := batch.Rows()[0].Line()
+		assert.Equal(t, 12, first.Number)
+		assert.Equal(t, time.Date(2026, time.April, 5, 0, 0, 0, 0, loc), first.OccurredAt)
+		assert.Equal(t, int64(150_000), first.Amount)
+		assert.Equal(t, ledger.DirectionOut, first.Direction)
+		assert.Equal(t, "EVN HCMC - Tien dien thang 3", first.Description)
+		assert.Equal(t, "FT26095001", first.Reference)
+
+		second := batch.Rows()[1].Line()
+		assert.Equal(t, time.Date(2026, time.April, 6, 6, 30, 0, 0, loc), second.OccurredAt)
+		assert.Equal(t, ledger.DirectionIn, second.Direction)
+		assert.Equal(t, "Luong T3 & thuong", second.Description)
+
+		assert.Equal(t, importing.RowInvalid, batch.Rows()[2].Status())
+		assert.Contains(t, batch.Rows()[2].Problem(), "DTPOSTED")
+
+		balance, exist := batch.LedgerBalance()
+		require.True(t, exist)
+		assert.Equal(t, int64(30_850_000), balance.Amount)
+		assert.Equal(t, time.Date(2026, time.April, 6, 0, 0, 0, 0, loc), balance.AsOf)
+	})
+
+	t.Run("reads an OFX 2.x XML statement of a credit card", func(t *testing.T) {
+		xml := `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <CREDITCARDMSGSRSV1>
+    <CCSTMTTRNRS>
+      <TRNUID>1</TRNUID>
+      <CCSTMTRS>
+        <CURDEF>VND</CURDEF>
+        <BANKTRANLIST>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTPOSTED>20260403120000.000[+7:ICT]</DTPOSTED>
+            <TRNAMT>-89000,00</TRNAMT>
+            <FITID>CC-0001</FITID>
+            <PAYEE><NAME>Highlands Coffee</NAME><CITY>Ha Noi</CITY></PAYEE>
+            <MEMO></MEMO>
+          </STMTTRN>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTPOSTED>20260404</DTPOSTED>
+            <TRNAMT>-89000</TRNAMT>
+            <FITID>CC-0001</FITID>
+          </STMTTRN>
+        </BANKTRANLIST>
+      </CCSTMTRS>
+    </CCSTMTTRNRS>
+  </CREDITCARDMSGSRSV1>
+</OFX>`
+
+		batch, err := importing.ParseOFX(strings.NewReader(xml), loc)
+		require.NoError(t, err)
+
+		require.Len(t, batch.Rows(), 2)
+
+		first := batch.Rows()[0].Line()
+		assert.Equal(t, 10, first.Number)
+		assert.Equal(t, time.Date(2026, time.April, 3, 12, 0, 0, 0, loc), first.OccurredAt)
+		assert.Equal(t, int64(89_000), first.Amount)
+		assert.Equal(t, "Highlands Coffee", first.Description)
+		assert.Equal(t, importing.RowNew, batch.Rows()[0].Status())
+
+		assert.Equal(t, importing.RowDuplicate, batch.Rows()[1].Status())
+
+		_, exist := batch.LedgerBalance()
+		assert.False(t, exist)
+		assert.NoError(t, batch.CheckBalances(123))
+	})
+
+	t.Run("returns error when the file is not OFX", func(t *testing.T) {
+		_, err := importing.ParseOFX(strings.NewReader("Date,Amount\n05/04/2026,-150000\n"), loc)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+
+	t.Run("returns error when the file holds several accounts", func(t *testing.T) {
+		sgml := "<OFX><BANKMSGSRSV1>" +
+			"<STMTTRNRS><STMTRS><BANKTRANLIST></BANKTRANLIST></STMTRS></STMTTRNRS>" +
+			"<STMTTRNRS><STMTRS><BANKTRANLIST></BANKTRANLIST></STMTRS></STMTTRNRS>" +
+			"</BANKMSGSRSV1></OFX>"
+
+		_, err := importing.ParseOFX(strings.NewReader(sgml), loc)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+}
+
+func TestBatch_CheckBalances(t *testing.T) {
+	sgml := "<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><BANKTRANLIST>" +
+		"<STMTTRN><DTPOSTED>20260405<TRNAMT>-150000<FITID>A1</STMTTRN>" +
+		"<STMTTRN><DTPOSTED>20260405<TRNAMT>50000<FITID>A2</STMTTRN>" +
+		"</BANKTRANLIST><LEDGERBAL><BALAMT>900000<DTASOF>20260406</LEDGERBAL></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>"
+
+	batch, err := importing.ParseOFX(strings.NewReader(sgml), time.Local)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(-100_000), batch.NetAmount())
+	assert.NoError(t, batch.CheckBalances(1_000_000))
+	assert.ErrorIs(t, batch.CheckBalances(950_000), importing.ErrLedgerBalanceMismatch)
+}
@@ -0,0 +1,223 @@
+package importing
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAmountSign   = errors.New("invalid amount sign convention")
+	ErrInvalidDateFormat   = errors.New("date format must combine DD, MM and YYYY or YY, optionally with HH, mm and ss")
+	ErrProfileNameTaken    = errors.New("mapping profile name is already used")
+	ErrMissingColumn       = errors.New("column of the mapping profile is missing from the file")
+	ErrUnreadableStatement = errors.New("statement file can not be read")
+)
+
+var (
+	// AmountSigned reads one amount column where negative amounts are outflows, as in bank account exports.
+	AmountSigned = AmountSign{value: "SIGNED"}
+	// AmountInverted reads one amount column where positive amounts are outflows, as in credit card exports.
+	AmountInverted = AmountSign{value: "INVERTED"}
+	// AmountSplit reads outflows from a debit column and inflows from a credit column.
+	AmountSplit = AmountSign{value: "SPLIT"}
+)
+
+var supportedAmountSign = map[string]AmountSign{
+	"SIGNED":   AmountSigned,
+	"INVERTED": AmountInverted,
+	"SPLIT":    AmountSplit,
+}
+
+type AmountSign struct {
+	value string
+}
+
+func NewAmountSign(amountSignStr string) (AmountSign, error) {
+	amountSignCleaned := strings.TrimSpace(strings.ToUpper(amountSignStr))
+
+	s, ok := supportedAmountSign[amountSignCleaned]
+	if !ok {
+		return AmountSign{}, ErrInvalidAmountSign
+	}
+
+	return s, nil
+}
+
+func (s AmountSign) String() string { return s.value }
+
+// ProfileSpec describes how the columns of a bank CSV export map onto transaction records.
+// Columns are named by their header.
+type ProfileSpec struct {
+	Name string
+	// Delimiter separates the fields, defaults to a comma
+	Delimiter string
+	// SkipRows is the number of lines before the header, banks put the account details there
+	SkipRows   int32
+	DateColumn string
+	// DateFormat is written with DD, MM, YYYY, YY, HH, mm and ss, e.g. DD/MM/YYYY
+	DateFormat   string
+	AmountSign   string
+	AmountColumn string // SIGNED and INVERTED only
+	DebitColumn  string // SPLIT only
+	CreditColumn string // SPLIT only
+	// DescriptionColumn is optional
+	DescriptionColumn string
+	// ReferenceColumn is optional, the reference becomes the transactionNo of the record
+	ReferenceColumn string
+	// ThousandsSeparator is one of "", ",", "." or " ", the other one of comma and dot separates decimals
+	ThousandsSeparator string
+}
+
+// MappingProfile is a saved mapping of the CSV export of a bank, owned by a user.
+type MappingProfile struct {
+	id                 uuid.UUID
+	userID             string
+	name               string
+	delimiter          rune
+	skipRows           int32
+	dateColumn         string
+	dateFormat         string
+	dateLayout         string
+	amountSign         AmountSign
+	amountColumn       string
+	debitColumn        string
+	creditColumn       string
+	descriptionColumn  string
+	referenceColumn    string
+	thousandsSeparator string
+	version            int32
+}
+
+func NewMappingProfile(userID string, spec ProfileSpec) (*MappingProfile, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, errors.New("userID is required")
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mappingProfileID: %w", err)
+	}
+
+	return newMappingProfile(id, userID, spec, 0)
+}
+
+func UnmarshalMappingProfileFromDatabase(
+	id uuid.UUID,
+	userID string,
+	spec ProfileSpec,
+	version int32,
+) (*MappingProfile, error) {
+	return newMappingProfile(id, userID, spec, version)
+}
+
+func newMappingProfile(id uuid.UUID, userID string, spec ProfileSpec, version int32) (*MappingProfile, error) {
+	spec.Name = strings.TrimSpace(spec.Name)
+	if spec.Delimiter == "" {
+		spec.Delimiter = ","
+	}
+
+	amountSign, err := NewAmountSign(spec.AmountSign)
+	if err != nil {
+		return nil, err
+	}
+
+	delimiter, _ := utf8.DecodeRuneInString(spec.Delimiter)
+
+	v := validator.New()
+
+	v.Required(spec.Name, "name")
+	v.Check(utf8.RuneCountInString(spec.Name) <= 100, "name", "name must not exceed 100 characters")
+	v.Check(utf8.RuneCountInString(spec.Delimiter) == 1 && !strings.ContainsRune("\"\r\n", delimiter),
+		"delimiter", "delimiter must be a single character other than a quote or a line break")
+	v.Check(spec.SkipRows >= 0 && spec.SkipRows <= 50, "skipRows", "skipRows must be between 0 and 50")
+	v.Required(spec.DateColumn, "dateColumn")
+	v.Required(spec.DateFormat, "dateFormat")
+	v.Check(slices.Contains([]string{"", ",", ".", " "}, spec.ThousandsSeparator),
+		"thousandsSeparator", "thousandsSeparator must be empty, a comma, a dot or a space")
+
+	if amountSign == AmountSplit {
+		v.Required(spec.DebitColumn, "debitColumn")
+		v.Required(spec.CreditColumn, "creditColumn")
+		spec.AmountColumn = ""
+	} else {
+		v.Required(spec.AmountColumn, "amountColumn")
+		spec.DebitColumn, spec.CreditColumn = "", ""
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	dateLayout, err := toDateLayout(spec.DateFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MappingProfile{
+		id:                 id,
+		userID:             userID,
+		name:               spec.Name,
+		delimiter:          delimiter,
+		skipRows:           spec.SkipRows,
+		dateColumn:         strings.TrimSpace(spec.DateColumn),
+		dateFormat:         spec.DateFormat,
+		dateLayout:         dateLayout,
+		amountSign:         amountSign,
+		amountColumn:       strings.TrimSpace(spec.AmountColumn),
+		debitColumn:        strings.TrimSpace(spec.DebitColumn),
+		creditColumn:       strings.TrimSpace(spec.CreditColumn),
+		descriptionColumn:  strings.TrimSpace(spec.DescriptionColumn),
+		referenceColumn:    strings.TrimSpace(spec.ReferenceColumn),
+		thousandsSeparator: spec.ThousandsSeparator,
+		version:            version,
+	}, nil
+}
+
+func (p *MappingProfile) ID() uuid.UUID              { return p.id }
+func (p *MappingProfile) UserID() string             { return p.userID }
+func (p *MappingProfile) Name() string               { return p.name }
+func (p *MappingProfile) Delimiter() string          { return string(p.delimiter) }
+func (p *MappingProfile) SkipRows() int32            { return p.skipRows }
+func (p *MappingProfile) DateColumn() string         { return p.dateColumn }
+func (p *MappingProfile) DateFormat() string         { return p.dateFormat }
+func (p *MappingProfile) AmountSign() AmountSign     { return p.amountSign }
+func (p *MappingProfile) AmountColumn() string       { return p.amountColumn }
+func (p *MappingProfile) DebitColumn() string        { return p.debitColumn }
+func (p *MappingProfile) CreditColumn() string       { return p.creditColumn }
+func (p *MappingProfile) DescriptionColumn() string  { return p.descriptionColumn }
+func (p *MappingProfile) ReferenceColumn() string    { return p.referenceColumn }
+func (p *MappingProfile) ThousandsSeparator() string { return p.thousandsSeparator }
+func (p *MappingProfile) Version() int32             { return p.version }
+
+var dateTokens = strings.NewReplacer(
+	"YYYY", "2006",
+	"YY", "06",
+	"MM", "01",
+	"DD", "02",
+	"HH", "15",
+	"mm", "04",
+	"ss", "05",
+)
+
+// toDateLayout turns a DD/MM/YYYY like date format into a layout of the time package.
+func toDateLayout(dateFormat string) (string, error) {
+	hasYear := strings.Contains(dateFormat, "YY")
+	if !hasYear || !strings.Contains(dateFormat, "MM") || !strings.Contains(dateFormat, "DD") {
+		return "", ErrInvalidDateFormat
+	}
+
+	layout := dateTokens.Replace(dateFormat)
+	for _, r := range layout {
+		if !strings.ContainsRune("0123456789/-.: T", r) {
+			return "", ErrInvalidDateFormat
+		}
+	}
+
+	return layout, nil
+}
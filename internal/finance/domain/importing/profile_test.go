@@ -0,0 +1,91 @@
+package importing_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMappingProfile(t *testing.T) {
+	valid := func() importing.ProfileSpec {
+		return importing.ProfileSpec{
+			Name:         "Techcombank",
+			DateColumn:   "Ngày giao dịch",
+			DateFormat:   "DD/MM/YYYY",
+			AmountSign:   "SPLIT",
+			DebitColumn:  "Nợ",
+			CreditColumn: "Có",
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(spec *importing.ProfileSpec)
+		wantErr error
+		hasErr  bool
+	}{
+		{
+			name:    "returns error when amount sign is invalid",
+			modify:  func(spec *importing.ProfileSpec) { spec.AmountSign = "NEGATIVE" },
+			wantErr: importing.ErrInvalidAmountSign,
+		},
+		{
+			name:    "returns error when date format has no year",
+			modify:  func(spec *importing.ProfileSpec) { spec.DateFormat = "DD/MM" },
+			wantErr: importing.ErrInvalidDateFormat,
+		},
+		{
+			name:    "returns error when date format has unknown tokens",
+			modify:  func(spec *importing.ProfileSpec) { spec.DateFormat = "DD/MMM/YYYY" },
+			wantErr: importing.ErrInvalidDateFormat,
+		},
+		{
+			name:   "returns error when a SPLIT profile has no credit column",
+			modify: func(spec *importing.ProfileSpec) { spec.CreditColumn = "" },
+			hasErr: true,
+		},
+		{
+			name: "returns error when a SIGNED profile has no amount column",
+			modify: func(spec *importing.ProfileSpec) {
+				spec.AmountSign = "SIGNED"
+			},
+			hasErr: true,
+		},
+		{
+			name:   "returns error when the delimiter is a quote",
+			modify: func(spec *importing.ProfileSpec) { spec.Delimiter = `"` },
+			hasErr: true,
+		},
+		{
+			name:   "returns error when the thousands separator is unsupported",
+			modify: func(spec *importing.ProfileSpec) { spec.ThousandsSeparator = "'" },
+			hasErr: true,
+		},
+		{
+			name:   "creates a profile",
+			modify: func(spec *importing.ProfileSpec) {},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := valid()
+			tt.modify(&spec)
+
+			p, err := importing.NewMappingProfile("user-1", spec)
+
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+			case tt.hasErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, ",", p.Delimiter())
+				assert.Equal(t, importing.AmountSplit, p.AmountSign())
+			}
+		})
+	}
+}
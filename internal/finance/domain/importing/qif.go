@@ -0,0 +1,218 @@
+package importing
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+)
+
+// qifAccountTypes are the account types whose transactions are read, investment accounts are left out.
+var qifAccountTypes = map[string]struct{}{
+	"BANK":  {},
+	"CASH":  {},
+	"CCARD": {},
+	"OTH A": {},
+	"OTH L": {},
+}
+
+// ParseQIF reads the transactions of a QIF export. Only bank, cash, credit card and other asset or liability
+// accounts are read, investment accounts and the lists of categories, classes and accounts are skipped.
+// A file holding several accounts is refused, they are imported one by one.
+// Dates are read in the order of Quicken, month first, or as YYYY-MM-DD, in loc.
+//
+// QIF carries neither transaction ids nor balances. The reference of a transaction is derived from its date, amount,
+// payee and memo so that importing overlapping exports records every transaction once.
+func ParseQIF(r io.Reader, loc *time.Location) (*Batch, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	batch := newBatch()
+	occurrences := make(map[string]int)
+
+	accounts := 0
+	reading := false
+	var record qifRecord
+
+	number := 0
+	for scanner.Scan() {
+		number++
+
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if number == 1 {
+			text = strings.TrimPrefix(text, "\xef\xbb\xbf")
+		}
+
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "!") {
+			header := strings.ToUpper(strings.TrimSpace(text))
+
+			switch {
+			case strings.HasPrefix(header, "!TYPE:"):
+				_, reading = qifAccountTypes[strings.TrimSpace(strings.TrimPrefix(header, "!TYPE:"))]
+				if reading {
+					accounts++
+				}
+			case header == "!ACCOUNT":
+				reading = false
+			}
+
+			record = qifRecord{}
+			continue
+		}
+
+		if !reading {
+			continue
+		}
+
+		if record.number == 0 {
+			record.number = number
+		}
+
+		code, value := text[0], strings.TrimSpace(text[1:])
+		switch code {
+		case 'D':
+			record.date = value
+		case 'T', 'U':
+			if record.amount == "" {
+				record.amount = value
+			}
+		case 'P':
+			record.payee = value
+		case 'M':
+			record.memo = value
+		case 'L':
+			record.category = value
+		case '^':
+			if !record.isOpeningBalance() {
+				line, problem := record.parse(loc, occurrences)
+				if problem != "" {
+					batch.reject(record.number, problem)
+				} else {
+					batch.add(line)
+				}
+			}
+
+			record = qifRecord{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnreadableStatement, err)
+	}
+
+	if accounts == 0 {
+		return nil, fmt.Errorf("%w: no bank, cash or credit card account in the file", ErrUnreadableStatement)
+	}
+	if accounts > 1 {
+		return nil, fmt.Errorf("%w: file holds %d accounts", ErrUnreadableStatement, accounts)
+	}
+
+	return batch, nil
+}
+
+// qifRecord holds the fields of a transaction, the lines of its splits are ignored.
+type qifRecord struct {
+	number   int
+	date     string
+	amount   string
+	payee    string
+	memo     string
+	category string
+}
+
+// isOpeningBalance tells the record Quicken writes first to carry the opening balance of the account,
+// booked as a transfer to the account itself.
+func (rec qifRecord) isOpeningBalance() bool {
+	return strings.EqualFold(rec.payee, "Opening Balance") && strings.HasPrefix(rec.category, "[")
+}
+
+// parse reads the record, occurrences counts the records sharing a fingerprint to keep their references apart.
+func (rec qifRecord) parse(loc *time.Location, occurrences map[string]int) (Line, string) {
+	occurredAt, err := parseQIFDate(rec.date, loc)
+	if err != nil {
+		return Line{}, err.Error()
+	}
+
+	amount, err := parseAmount(rec.amount, ",")
+	if err != nil {
+		return Line{}, fmt.Sprintf("amount: %s", err)
+	}
+
+	fingerprint := strings.Join([]string{
+		occurredAt.Format(time.DateOnly),
+		strconv.FormatInt(amount, 10),
+		rec.payee,
+		rec.memo,
+	}, "|")
+	occurrences[fingerprint]++
+
+	digest := sha256.Sum256(fmt.Appendf(nil, "%s|%d", fingerprint, occurrences[fingerprint]))
+
+	direction := ledger.DirectionIn
+	if amount < 0 {
+		direction = ledger.DirectionOut
+	}
+
+	return Line{
+		Number:      rec.number,
+		OccurredAt:  occurredAt,
+		Amount:      abs(amount),
+		Direction:   direction,
+		Description: describe(rec.payee, rec.memo),
+		Reference:   "QIF-" + hex.EncodeToString(digest[:16]),
+	}, ""
+}
+
+// parseQIFDate reads a date such as 3/5/2026, 03/05/26, 3/ 5'26 or 2026-03-05, month first.
+// Two digit years from 70 on are in the 1900s, as in Quicken.
+func parseQIFDate(value string, loc *time.Location) (time.Time, error) {
+	cleaned := strings.ReplaceAll(strings.ReplaceAll(value, " ", ""), "'", "/")
+
+	separator, yearFirst := "/", false
+	if strings.Contains(cleaned, "-") {
+		separator, yearFirst = "-", true
+	}
+
+	parts := strings.Split(cleaned, separator)
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("date '%s' is not a QIF date", value)
+	}
+
+	numbers := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("date '%s' is not a QIF date", value)
+		}
+		numbers = append(numbers, n)
+	}
+
+	month, day, year := numbers[0], numbers[1], numbers[2]
+	if yearFirst {
+		year, month, day = numbers[0], numbers[1], numbers[2]
+	}
+
+	if year < 100 {
+		if year >= 70 {
+			year += 1900
+		} else {
+			year += 2000
+		}
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return time.Time{}, fmt.Errorf("date '%s' is not a QIF date", value)
+	}
+
+	return date, nil
+}
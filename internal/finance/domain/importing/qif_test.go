@@ -0,0 +1,94 @@
+package importing_test
+
+import (
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/importing"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQIF(t *testing.T) {
+	qif := "!Option:AutoSwitch\n" +
+		"!Account\n" +
+		"NTechcombank\n" +
+		"TBank\n" +
+		"^\n" +
+		"!Clear:AutoSwitch\n" +
+		"!Type:Bank\n" +
+		"D3/ 1'26\n" +
+		"T1,000,000.00\n" +
+		"POpening Balance\n" +
+		"L[Techcombank]\n" +
+		"^\n" +
+		"D3/5/2026\n" +
+		"T-1,250,000.00\n" +
+		"PEVN HCMC\n" +
+		"MTien dien\n" +
+		"LUtilities:Electric\n" +
+		"SUtilities:Electric\n" +
+		"$-1,250,000.00\n" +
+		"^\n" +
+		"D03/06/26\n" +
+		"T-45,000\n" +
+		"PGrab\n" +
+		"^\n" +
+		"D2026-03-06\n" +
+		"U-45,000\n" +
+		"PGrab\n" +
+		"^\n" +
+		"D13/06/26\n" +
+		"T-45,000\n" +
+		"^\n" +
+		"!Type:Cat\n" +
+		"NUtilities\n" +
+		"E\n" +
+		"^\n"
+
+	batch, err := importing.ParseQIF(strings.NewReader(qif), time.Local)
+	require.NoError(t, err)
+
+	t.Run("skips the opening balance and the lists", func(t *testing.T) {
+		require.Len(t, batch.Rows(), 4)
+		assert.Equal(t, 13, batch.Rows()[0].Line().Number)
+	})
+
+	t.Run("reads the transactions of the account", func(t *testing.T) {
+		first := batch.Rows()[0].Line()
+		assert.Equal(t, time.Date(2026, time.March, 5, 0, 0, 0, 0, time.Local), first.OccurredAt)
+		assert.Equal(t, int64(1_250_000), first.Amount)
+		assert.Equal(t, ledger.DirectionOut, first.Direction)
+		assert.Equal(t, "EVN HCMC - Tien dien", first.Description)
+		assert.True(t, strings.HasPrefix(first.Reference, "QIF-"))
+
+		assert.Equal(t, time.Date(2026, time.March, 6, 0, 0, 0, 0, time.Local), batch.Rows()[1].Line().OccurredAt)
+		assert.Equal(t, importing.RowInvalid, batch.Rows()[3].Status())
+		assert.Contains(t, batch.Rows()[3].Problem(), "13/06/26")
+	})
+
+	t.Run("keeps identical transactions apart", func(t *testing.T) {
+		assert.Equal(t, importing.RowNew, batch.Rows()[1].Status())
+		assert.Equal(t, importing.RowNew, batch.Rows()[2].Status())
+		assert.NotEqual(t, batch.Rows()[1].Line().Reference, batch.Rows()[2].Line().Reference)
+	})
+
+	t.Run("derives the same references from the same export", func(t *testing.T) {
+		again, err := importing.ParseQIF(strings.NewReader(qif), time.Local)
+		require.NoError(t, err)
+
+		assert.Equal(t, batch.References(), again.References())
+	})
+
+	t.Run("returns error when the file holds several accounts", func(t *testing.T) {
+		_, err := importing.ParseQIF(strings.NewReader("!Type:Bank\nD3/5/2026\nT-1\n^\n!Type:CCard\nD3/5/2026\nT-1\n^\n"), time.Local)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+
+	t.Run("returns error when the file holds no bank account", func(t *testing.T) {
+		_, err := importing.ParseQIF(strings.NewReader("!Type:Invst\nD3/5/2026\nNBuy\nYVNM\nT-1\n^\n"), time.Local)
+		require.ErrorIs(t, err, importing.ErrUnreadableStatement)
+	})
+}
@@ -0,0 +1,17 @@
+package importing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	CreateProfile(ctx context.Context, p *MappingProfile) error
+	GetProfile(ctx context.Context, userID string, pID uuid.UUID) (*MappingProfile, error)
+	DeleteProfile(ctx context.Context, userID string, pID uuid.UUID) error
+
+	// ListRecordedTransactionNos returns the transactionNos among transactionNos already recorded
+	// against fpID, in any wallet. The reference of a reversed record counts as not recorded.
+	ListRecordedTransactionNos(ctx context.Context, fpID uuid.UUID, transactionNos []string) ([]string, error)
+}
@@ -5,11 +5,20 @@ import (
 	"fmt"
 	"sumni-finance-backend/internal/common/validator"
 	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/event"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrAccountingPeriodNotEnded      = errors.New("too early to close Account Period")
+	ErrAccountingPeriodAlreadyClosed = errors.New("account period is already closed")
+	ErrTransactionOutsidePeriod      = errors.New("transaction occurred outside of the accounting period")
+	ErrPreviousPeriodNotClosed       = errors.New("previous accounting period is not closed yet")
+	ErrAccountingPeriodNotContiguous = errors.New("accounting period does not follow the previous period")
+)
+
 type AccountingPeriod struct {
 	id        uuid.UUID
 	yearMonth YearMonth
@@ -20,27 +29,101 @@ type AccountingPeriod struct {
 	status AccountingPeriodStatus
 
 	openingBalance valueobject.Money
-	totalDebit     valueobject.Money
-	totalCredit    valueobject.Money
+	totalDebit     valueobject.Money // every outflow, it drives the closing balance
+	totalCredit    valueobject.Money // every inflow, it drives the closing balance
+	totalIncome    valueobject.Money // inflows of income types only, net of their reversals
+	totalExpense   valueobject.Money // outflows of expense types only, net of the refunds and reversals
 	closingBalance valueobject.Money
 
-	endDate time.Time
+	startTime time.Time
+	endDate   time.Time
 
 	version int32
 
+	// budgets are carried over to the next period when it opens
+	budgets []CategoryBudget
+
 	transactions []*TransactionRecord
+
+	events event.Recorder
 }
 
+// OpenAccountingPeriod opens the first period of the ledger of the wallet walletID.
 func OpenAccountingPeriod(
+	walletID uuid.UUID,
+	yearMonth YearMonth,
+	openBalance valueobject.Money,
+	startDate PeriodStartDay,
+	interval int32,
+) (*AccountingPeriod, error) {
+	startTime := time.Date(
+		yearMonth.year,
+		time.Month(yearMonth.month),
+		int(startDate.value),
+		0,
+		0,
+		0,
+		0,
+		time.Local,
+	)
+
+	ap, err := openAccountingPeriod(yearMonth, openBalance, startDate, interval, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ap.recordOpened(walletID)
+	return ap, nil
+}
+
+// OpenNext opens the period that follows ap, starting exactly when ap ends and carrying forward its closing balance
+// and its category budgets.
+// startDate and interval may differ from ap after a ledger config change, the new period then stretches
+// or shrinks so that it still starts at the end of ap and ends on its own start day.
+func (ap *AccountingPeriod) OpenNext(
+	walletID uuid.UUID,
+	yearMonth YearMonth,
+	startDate PeriodStartDay,
+	interval int32,
+) (*AccountingPeriod, error) {
+	if !ap.IsClose() {
+		return nil, fmt.Errorf("%w: %d/%d", ErrPreviousPeriodNotClosed, ap.yearMonth.month, ap.yearMonth.year)
+	}
+
+	if expected := ap.NextYearMonth(); yearMonth != expected {
+		return nil, fmt.Errorf(
+			"%w: expected %d/%d, got %d/%d",
+			ErrAccountingPeriodNotContiguous,
+			expected.month,
+			expected.year,
+			yearMonth.month,
+			yearMonth.year,
+		)
+	}
+
+	next, err := openAccountingPeriod(yearMonth, ap.closingBalance, startDate, interval, ap.endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	next.budgets = append([]CategoryBudget(nil), ap.budgets...)
+	next.recordOpened(walletID)
+	return next, nil
+}
+
+func openAccountingPeriod(
 	yearMonth YearMonth,
 	openBalance valueobject.Money,
 	startDate PeriodStartDay,
 	interval int32,
+	startTime time.Time,
 ) (*AccountingPeriod, error) {
 	v := validator.New()
 
-	v.Check(!openBalance.IsZero(), "openingBalance", "openingBalance is required")
+	// Money.IsZero reports an uninitialised value, an opening balance of 0 is valid
+	v.Check(!openBalance.Currency().IsZero(), "openingBalance", "openingBalance is required")
 	v.Check(!yearMonth.IsZero(), "yearMonth", "yearMonth is required")
+	v.Check(interval > 0, "interval", "interval must be greater than 0")
 	if err := v.Err(); err != nil {
 		return nil, err
 	}
@@ -63,9 +146,13 @@ func OpenAccountingPeriod(
 		0,
 		0,
 		0,
-		time.Local,
+		startTime.Location(),
 	).AddDate(0, int(interval), 0)
 
+	if !endDate.After(startTime) {
+		return nil, fmt.Errorf("%w: period would end before it starts", ErrAccountingPeriodNotContiguous)
+	}
+
 	return &AccountingPeriod{
 		id:             id,
 		yearMonth:      yearMonth,
@@ -75,7 +162,10 @@ func OpenAccountingPeriod(
 		openingBalance: openBalance,
 		totalDebit:     zeroMoney,
 		totalCredit:    zeroMoney,
+		totalIncome:    zeroMoney,
+		totalExpense:   zeroMoney,
 		closingBalance: zeroMoney,
+		startTime:      startTime,
 		endDate:        endDate,
 		version:        0,
 	}, nil
@@ -83,13 +173,21 @@ func OpenAccountingPeriod(
 
 func (ap *AccountingPeriod) IsClose() bool { return ap.status == AccountingPeriodClose }
 
-func (ap *AccountingPeriod) CloseAccountingPeriod() error {
-	if time.Now().Before(ap.endDate) {
-		return errors.New("too early to close Account Period")
-	}
+// Covers reports whether the period that spans [StartTime, EndDate) contains t.
+func (ap *AccountingPeriod) Covers(t time.Time) bool {
+	return !t.Before(ap.startTime) && t.Before(ap.endDate)
+}
 
+// CloseAccountingPeriod calculates the closing balance and marks the period as closed.
+// closedAt is the moment of closing, it must not be before the end date of the period.
+// walletID is the wallet owning the ledger, it is carried on the PeriodClosed event.
+func (ap *AccountingPeriod) CloseAccountingPeriod(walletID uuid.UUID, closedAt time.Time) error {
 	if ap.IsClose() {
-		return fmt.Errorf("account period is already closed: %d/%d", ap.yearMonth.month, ap.yearMonth.year)
+		return fmt.Errorf("%w: %d/%d", ErrAccountingPeriodAlreadyClosed, ap.yearMonth.month, ap.yearMonth.year)
+	}
+
+	if closedAt.Before(ap.endDate) {
+		return ErrAccountingPeriodNotEnded
 	}
 
 	closingBalance, err := ap.calculateClosingBalance()
@@ -100,9 +198,33 @@ func (ap *AccountingPeriod) CloseAccountingPeriod() error {
 	ap.closingBalance = closingBalance
 	ap.status = AccountingPeriodClose
 
+	ap.events.Record(event.PeriodClosed{
+		WalletID:           walletID,
+		AccountingPeriodID: ap.id,
+		YearMonth:          ap.yearMonth.String(),
+		ClosedAt:           closedAt,
+		OpeningBalance:     ap.openingBalance.Amount(),
+		ClosingBalance:     ap.closingBalance.Amount(),
+		TotalIncome:        ap.totalIncome.Amount(),
+		TotalExpense:       ap.totalExpense.Amount(),
+		Currency:           ap.openingBalance.Currency().Code(),
+	})
+
 	return nil
 }
 
+func (ap *AccountingPeriod) recordOpened(walletID uuid.UUID) {
+	ap.events.Record(event.PeriodOpened{
+		WalletID:           walletID,
+		AccountingPeriodID: ap.id,
+		YearMonth:          ap.yearMonth.String(),
+		StartTime:          ap.startTime,
+		EndTime:            ap.endDate,
+		OpeningBalance:     ap.openingBalance.Amount(),
+		Currency:           ap.openingBalance.Currency().Code(),
+	})
+}
+
 func (ap *AccountingPeriod) calculateClosingBalance() (valueobject.Money, error) {
 	totalChanged, err := ap.totalCredit.Subtract(ap.totalDebit)
 	if err != nil {
@@ -125,13 +247,56 @@ func (ap *AccountingPeriod) Status() AccountingPeriodStatus     { return ap.stat
 func (ap *AccountingPeriod) OpeningBalance() valueobject.Money  { return ap.openingBalance }
 func (ap *AccountingPeriod) TotalDebit() valueobject.Money      { return ap.totalDebit }
 func (ap *AccountingPeriod) TotalCredit() valueobject.Money     { return ap.totalCredit }
+func (ap *AccountingPeriod) TotalIncome() valueobject.Money     { return ap.totalIncome }
+func (ap *AccountingPeriod) TotalExpense() valueobject.Money    { return ap.totalExpense }
 func (ap *AccountingPeriod) ClosingBalance() valueobject.Money  { return ap.closingBalance }
+func (ap *AccountingPeriod) StartTime() time.Time               { return ap.startTime }
 func (ap *AccountingPeriod) EndDate() time.Time                 { return ap.endDate }
 func (ap *AccountingPeriod) Version() int32                     { return ap.version }
 func (ap *AccountingPeriod) Transactions() []*TransactionRecord { return ap.transactions }
+func (ap *AccountingPeriod) Budgets() []CategoryBudget          { return ap.budgets }
+
+// PullEvents returns the events raised since the period was loaded or last saved.
+func (ap *AccountingPeriod) PullEvents() []event.Event { return ap.events.Pull() }
+
+// SetBudgets rehydrates the persisted budgets of the period.
+func (ap *AccountingPeriod) SetBudgets(budgets ...CategoryBudget) {
+	ap.budgets = budgets
+}
+
+// PlanBudgets replaces the category budgets of the open period.
+func (ap *AccountingPeriod) PlanBudgets(budgets ...CategoryBudget) error {
+	if ap.IsClose() {
+		return fmt.Errorf("%w: %s", ErrAccountingPeriodAlreadyClosed, ap.yearMonth.String())
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(budgets))
+	for _, b := range budgets {
+		if _, exist := seen[b.categoryID]; exist {
+			return fmt.Errorf("%w: %s", ErrDuplicateCategoryBudget, b.categoryID)
+		}
+		seen[b.categoryID] = struct{}{}
+
+		if !b.amount.Currency().Equal(ap.openingBalance.Currency()) {
+			return fmt.Errorf("%w: %s", ErrBudgetCurrencyMismatched, b.amount.Currency().Code())
+		}
+	}
+
+	ap.budgets = budgets
+	return nil
+}
+
+// NextYearMonth is the year and month of the period that follows this one.
+func (ap *AccountingPeriod) NextYearMonth() YearMonth {
+	return ap.yearMonth.AddMonths(int(ap.interval))
+}
 
 func (ap *AccountingPeriod) Record(txRecord TransactionRecord) error {
-	if txRecord.IsDeposit() {
+	if err := ap.ensureWithinPeriod(txRecord); err != nil {
+		return err
+	}
+
+	if txRecord.IsInflow() {
 		newTotalCredit, err := ap.totalCredit.Add(txRecord.amount)
 		if err != nil {
 			return err
@@ -147,11 +312,82 @@ func (ap *AccountingPeriod) Record(txRecord TransactionRecord) error {
 		ap.totalDebit = newTotalDebit
 	}
 
+	if err := ap.addIncomeOrExpense(txRecord); err != nil {
+		return err
+	}
+
 	ap.transactions = append(ap.transactions, &txRecord)
 
 	return nil
 }
 
+// addIncomeOrExpense adds an income or expense record to its total. An inflow of an expense type such as a refund,
+// or an outflow of an income type, is taken off the total instead.
+func (ap *AccountingPeriod) addIncomeOrExpense(txRecord TransactionRecord) error {
+	txType := txRecord.transactionType
+	if !txType.IsIncomeOrExpense() {
+		return nil
+	}
+
+	total, countedDirection := &ap.totalIncome, DirectionIn
+	if txType.IsExpense() {
+		total, countedDirection = &ap.totalExpense, DirectionOut
+	}
+
+	var (
+		newTotal valueobject.Money
+		err      error
+	)
+
+	if txRecord.direction == countedDirection {
+		newTotal, err = total.Add(txRecord.amount)
+	} else {
+		newTotal, err = total.Subtract(txRecord.amount)
+	}
+	if err != nil {
+		return err
+	}
+
+	*total = newTotal
+	return nil
+}
+
+// RecordInternalTransfer records both sides of a transfer that stays inside the wallet.
+// The wallet balance does not change, so neither do the period totals.
+func (ap *AccountingPeriod) RecordInternalTransfer(outRecord TransactionRecord, inRecord TransactionRecord) error {
+	if outRecord.transactionType != TransactionTypeTransferOut || inRecord.transactionType != TransactionTypeTransferIn {
+		return errors.New("internal transfer must be a TRANSFER_OUT and a TRANSFER_IN record")
+	}
+
+	if outRecord.amount != inRecord.amount {
+		return errors.New("both sides of an internal transfer must have the same amount")
+	}
+
+	for _, txRecord := range []TransactionRecord{outRecord, inRecord} {
+		if err := ap.ensureWithinPeriod(txRecord); err != nil {
+			return err
+		}
+	}
+
+	ap.transactions = append(ap.transactions, &outRecord, &inRecord)
+
+	return nil
+}
+
+func (ap *AccountingPeriod) ensureWithinPeriod(txRecord TransactionRecord) error {
+	if !ap.Covers(txRecord.occurredAt) {
+		return fmt.Errorf(
+			"%w: %s is not in %d/%d",
+			ErrTransactionOutsidePeriod,
+			txRecord.occurredAt.Format(time.RFC3339),
+			ap.yearMonth.month,
+			ap.yearMonth.year,
+		)
+	}
+
+	return nil
+}
+
 // UnmarshalAccountingPeriodFromDatabase rehydrates an AccountingPeriod from persisted database state.
 func UnmarshalAccountingPeriodFromDatabase(
 	id uuid.UUID,
@@ -162,8 +398,11 @@ func UnmarshalAccountingPeriodFromDatabase(
 	openingBalanceAmount int64,
 	totalDebitAmount int64,
 	totalCreditAmount int64,
+	totalIncomeAmount int64,
+	totalExpenseAmount int64,
 	closingBalanceAmount int64,
 	currencyCode string,
+	startTime time.Time,
 	endDate time.Time,
 	version int32,
 	transactions ...*TransactionRecord,
@@ -175,6 +414,7 @@ func UnmarshalAccountingPeriodFromDatabase(
 	v.Check(interval > 0, "interval", "interval must be greater than 0")
 	v.Required(statusStr, "status")
 	v.Required(currencyCode, "currencyCode")
+	v.Check(!startTime.IsZero(), "startTime", "startTime is required")
 	v.Check(!endDate.IsZero(), "endDate", "endDate is required")
 
 	if err := v.Err(); err != nil {
@@ -216,6 +456,16 @@ func UnmarshalAccountingPeriodFromDatabase(
 		return nil, fmt.Errorf("failed to create total credit: %w", err)
 	}
 
+	totalIncome, err := valueobject.NewMoney(totalIncomeAmount, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create total income: %w", err)
+	}
+
+	totalExpense, err := valueobject.NewMoney(totalExpenseAmount, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create total expense: %w", err)
+	}
+
 	closingBalance, err := valueobject.NewMoney(closingBalanceAmount, currency)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create closing balance: %w", err)
@@ -234,7 +484,10 @@ func UnmarshalAccountingPeriodFromDatabase(
 		openingBalance: openingBalance,
 		totalDebit:     totalDebit,
 		totalCredit:    totalCredit,
+		totalIncome:    totalIncome,
+		totalExpense:   totalExpense,
 		closingBalance: closingBalance,
+		startTime:      startTime,
 		endDate:        endDate,
 		version:        version,
 		transactions:   transactions,
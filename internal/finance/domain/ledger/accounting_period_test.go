@@ -0,0 +1,409 @@
+package ledger_test
+
+import (
+	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountingPeriod_CloseAccountingPeriod(t *testing.T) {
+	endDate := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name      string
+		status    string
+		closedAt  time.Time
+		hasErr    bool
+		expectErr error
+	}{
+		{
+			name:      "returns error when closing before the end date",
+			status:    "OPEN",
+			closedAt:  endDate.Add(-time.Second),
+			hasErr:    true,
+			expectErr: ledger.ErrAccountingPeriodNotEnded,
+		},
+		{
+			name:      "returns error when period is already closed",
+			status:    "CLOSE",
+			closedAt:  endDate,
+			hasErr:    true,
+			expectErr: ledger.ErrAccountingPeriodAlreadyClosed,
+		},
+		{
+			name:     "closes period successfully at the end date",
+			status:   "OPEN",
+			closedAt: endDate,
+			hasErr:   false,
+		},
+		{
+			name:     "closes period successfully after the end date",
+			status:   "OPEN",
+			closedAt: endDate.AddDate(0, 0, 3),
+			hasErr:   false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+				uuid.New(),
+				"2026,4",
+				1,
+				1,
+				tt.status,
+				1_000_000,
+				300_000,
+				500_000,
+				0,
+				0,
+				0,
+				"VND",
+				endDate.AddDate(0, -1, 0),
+				endDate,
+				2,
+			)
+			require.NoError(t, err)
+
+			err = ap.CloseAccountingPeriod(uuid.New(), tt.closedAt)
+
+			if tt.hasErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, ap.IsClose())
+			assert.Equal(t, int64(1_200_000), ap.ClosingBalance().Amount())
+			assert.Equal(t, int32(2), ap.Version())
+		})
+	}
+}
+
+func TestAccountingPeriod_OpenAccountingPeriod_EndDate(t *testing.T) {
+	yearMonth, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+
+	startDay, err := ledger.NewPeriodStartDay(1)
+	require.NoError(t, err)
+
+	openingBalance, err := valueobject.NewMoney(1_000_000, valueobject.VND)
+	require.NoError(t, err)
+
+	ap, err := ledger.OpenAccountingPeriod(uuid.New(), yearMonth, openingBalance, startDay, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local), ap.EndDate())
+	assert.ErrorIs(t, ap.CloseAccountingPeriod(uuid.New(), ap.EndDate().Add(-time.Nanosecond)), ledger.ErrAccountingPeriodNotEnded)
+	assert.NoError(t, ap.CloseAccountingPeriod(uuid.New(), ap.EndDate()))
+}
+
+func TestAccountingPeriod_OpenAccountingPeriod_ZeroBalance(t *testing.T) {
+	yearMonth, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+
+	startDay, err := ledger.NewPeriodStartDay(1)
+	require.NoError(t, err)
+
+	zeroBalance, err := valueobject.NewMoney(0, valueobject.VND)
+	require.NoError(t, err)
+
+	ap, err := ledger.OpenAccountingPeriod(uuid.New(), yearMonth, zeroBalance, startDay, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), ap.OpeningBalance().Amount())
+
+	_, err = ledger.OpenAccountingPeriod(uuid.New(), yearMonth, valueobject.Money{}, startDay, 1)
+	require.Error(t, err)
+}
+
+func TestAccountingPeriod_OpenNext(t *testing.T) {
+	startDay, err := ledger.NewPeriodStartDay(1)
+	require.NoError(t, err)
+
+	salaryStartDay, err := ledger.NewPeriodStartDay(25)
+	require.NoError(t, err)
+
+	april, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+
+	may, err := ledger.NewYearMonth(5, 2026)
+	require.NoError(t, err)
+
+	june, err := ledger.NewYearMonth(6, 2026)
+	require.NoError(t, err)
+
+	newClosedApril := func(t *testing.T) *ledger.AccountingPeriod {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			april.String(),
+			1,
+			1,
+			"CLOSE",
+			1_000_000,
+			300_000,
+			500_000,
+			0,
+			0,
+			1_200_000,
+			"VND",
+			time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local),
+			3,
+		)
+		require.NoError(t, err)
+
+		return ap
+	}
+
+	t.Run("returns error when previous period is open", func(t *testing.T) {
+		openingBalance, err := valueobject.NewMoney(1_000_000, valueobject.VND)
+		require.NoError(t, err)
+
+		previous, err := ledger.OpenAccountingPeriod(uuid.New(), april, openingBalance, startDay, 1)
+		require.NoError(t, err)
+
+		_, err = previous.OpenNext(uuid.New(), may, startDay, 1)
+
+		require.ErrorIs(t, err, ledger.ErrPreviousPeriodNotClosed)
+	})
+
+	t.Run("returns error when a month is skipped", func(t *testing.T) {
+		_, err := newClosedApril(t).OpenNext(uuid.New(), june, startDay, 1)
+
+		require.ErrorIs(t, err, ledger.ErrAccountingPeriodNotContiguous)
+	})
+
+	t.Run("carries forward the closing balance", func(t *testing.T) {
+		previous := newClosedApril(t)
+
+		ap, err := previous.OpenNext(uuid.New(), may, startDay, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1_200_000), ap.OpeningBalance().Amount())
+		assert.Equal(t, previous.EndDate(), ap.StartTime())
+		assert.Equal(t, time.Date(2026, time.June, 1, 0, 0, 0, 0, time.Local), ap.EndDate())
+		assert.True(t, ap.Status() == ledger.AccountingPeriodOpen)
+	})
+
+	t.Run("stretches the first period after a start day change", func(t *testing.T) {
+		previous := newClosedApril(t)
+
+		ap, err := previous.OpenNext(uuid.New(), may, salaryStartDay, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, previous.EndDate(), ap.StartTime())
+		assert.Equal(t, time.Date(2026, time.June, 25, 0, 0, 0, 0, time.Local), ap.EndDate())
+		assert.Equal(t, june, ap.NextYearMonth())
+	})
+}
+
+func TestAccountingPeriod_Record_OccurredAt(t *testing.T) {
+	yearMonth, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+
+	startDay, err := ledger.NewPeriodStartDay(15)
+	require.NoError(t, err)
+
+	openingBalance, err := valueobject.NewMoney(1_000_000, valueobject.VND)
+	require.NoError(t, err)
+
+	amount, err := valueobject.NewMoney(100_000, valueobject.VND)
+	require.NoError(t, err)
+
+	periodStart := time.Date(2026, time.April, 15, 0, 0, 0, 0, time.Local)
+	periodEnd := time.Date(2026, time.May, 15, 0, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name       string
+		occurredAt time.Time
+		hasErr     bool
+	}{
+		{
+			name:       "returns error when occurred before the period starts",
+			occurredAt: periodStart.Add(-time.Nanosecond),
+			hasErr:     true,
+		},
+		{
+			name:       "returns error when occurred at the end of the period",
+			occurredAt: periodEnd,
+			hasErr:     true,
+		},
+		{
+			name:       "records transaction occurred at the start of the period",
+			occurredAt: periodStart,
+			hasErr:     false,
+		},
+		{
+			name:       "records transaction occurred right before the end of the period",
+			occurredAt: periodEnd.Add(-time.Nanosecond),
+			hasErr:     false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ap, err := ledger.OpenAccountingPeriod(uuid.New(), yearMonth, openingBalance, startDay, 1)
+			require.NoError(t, err)
+			assert.Equal(t, periodStart, ap.StartTime())
+
+			txRecord, err := ledger.NewTransactionRecord(
+				"TXN-001",
+				"DEPOSIT",
+				"",
+				amount,
+				"Salary",
+				uuid.New(),
+				tt.occurredAt,
+				periodEnd,
+			)
+			require.NoError(t, err)
+
+			err = ap.Record(*txRecord)
+
+			if tt.hasErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ledger.ErrTransactionOutsidePeriod)
+				assert.Empty(t, ap.Transactions())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, ap.Transactions(), 1)
+			assert.Equal(t, tt.occurredAt, ap.Transactions()[0].OccurredAt())
+			assert.Equal(t, "Salary", ap.Transactions()[0].Description())
+			assert.Equal(t, int64(100_000), ap.TotalCredit().Amount())
+		})
+	}
+}
+
+func TestAccountingPeriod_Record_IncomeAndExpense(t *testing.T) {
+	yearMonth, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+
+	startDay, err := ledger.NewPeriodStartDay(1)
+	require.NoError(t, err)
+
+	currency, err := valueobject.NewCurrency("VND")
+	require.NoError(t, err)
+
+	openingBalance, err := valueobject.NewMoney(1_000_000, currency)
+	require.NoError(t, err)
+
+	ap, err := ledger.OpenAccountingPeriod(uuid.New(), yearMonth, openingBalance, startDay, 1)
+	require.NoError(t, err)
+
+	occurredAt := time.Date(2026, time.April, 10, 0, 0, 0, 0, time.Local)
+	for _, tc := range []struct {
+		txType    string
+		direction string
+		amount    int64
+	}{
+		{txType: "DEPOSIT", amount: 500_000},
+		{txType: "INTEREST", amount: 10_000},
+		{txType: "WITHDRAWAL", amount: 200_000},
+		{txType: "FEE", amount: 3_300},
+		{txType: "REFUND", amount: 50_000},
+		{txType: "ADJUSTMENT", direction: "OUT", amount: 7_000},
+		{txType: "TRANSFER_OUT", amount: 100_000},
+	} {
+		amount, err := valueobject.NewMoney(tc.amount, currency)
+		require.NoError(t, err)
+
+		txRecord, err := ledger.NewTransactionRecord("", tc.txType, tc.direction, amount, "", uuid.New(), occurredAt, occurredAt)
+		require.NoError(t, err)
+
+		require.NoError(t, ap.Record(*txRecord))
+	}
+
+	// Every record moves the balance
+	assert.Equal(t, int64(560_000), ap.TotalCredit().Amount())
+	assert.Equal(t, int64(310_300), ap.TotalDebit().Amount())
+
+	// Transfers and adjustments are neither income nor expense, a refund is deducted from the expense
+	assert.Equal(t, int64(510_000), ap.TotalIncome().Amount())
+	assert.Equal(t, int64(153_300), ap.TotalExpense().Amount())
+}
+
+func TestAccountingPeriod_PlanBudgets(t *testing.T) {
+	startDay, err := ledger.NewPeriodStartDay(1)
+	require.NoError(t, err)
+
+	april, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+
+	may, err := ledger.NewYearMonth(5, 2026)
+	require.NoError(t, err)
+
+	newBudget := func(t *testing.T, categoryID uuid.UUID, amount int64) ledger.CategoryBudget {
+		t.Helper()
+
+		money, err := valueobject.NewMoney(amount, valueobject.VND)
+		require.NoError(t, err)
+
+		budget, err := ledger.NewCategoryBudget(categoryID, money)
+		require.NoError(t, err)
+
+		return budget
+	}
+
+	t.Run("returns error when period is closed", func(t *testing.T) {
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), april.String(), 1, 1, "CLOSE",
+			1_000_000, 0, 0, 0, 0, 1_000_000, "VND",
+			time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local),
+			1,
+		)
+		require.NoError(t, err)
+
+		err = ap.PlanBudgets(newBudget(t, uuid.New(), 500_000))
+
+		require.ErrorIs(t, err, ledger.ErrAccountingPeriodAlreadyClosed)
+	})
+
+	t.Run("returns error when a category is budgeted twice", func(t *testing.T) {
+		openingBalance, err := valueobject.NewMoney(1_000_000, valueobject.VND)
+		require.NoError(t, err)
+
+		ap, err := ledger.OpenAccountingPeriod(uuid.New(), april, openingBalance, startDay, 1)
+		require.NoError(t, err)
+
+		categoryID := uuid.New()
+		err = ap.PlanBudgets(newBudget(t, categoryID, 500_000), newBudget(t, categoryID, 200_000))
+
+		require.ErrorIs(t, err, ledger.ErrDuplicateCategoryBudget)
+	})
+
+	t.Run("carries budgets forward to the next period", func(t *testing.T) {
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), april.String(), 1, 1, "CLOSE",
+			1_000_000, 0, 0, 0, 0, 1_000_000, "VND",
+			time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local),
+			1,
+		)
+		require.NoError(t, err)
+
+		budget := newBudget(t, uuid.New(), 500_000)
+		ap.SetBudgets(budget)
+
+		next, err := ap.OpenNext(uuid.New(), may, startDay, 1)
+		require.NoError(t, err)
+
+		require.Len(t, next.Budgets(), 1)
+		assert.Equal(t, budget.CategoryID(), next.Budgets()[0].CategoryID())
+		assert.Equal(t, int64(500_000), next.Budgets()[0].Amount().Amount())
+	})
+}
@@ -0,0 +1,39 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/valueobject"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBudgetAmountNotPositive  = errors.New("budget amount must be positive")
+	ErrDuplicateCategoryBudget  = errors.New("category is budgeted more than once")
+	ErrBudgetCurrencyMismatched = errors.New("budget currency does not match the accounting period")
+)
+
+// CategoryBudget is the amount planned to be spent on a category during an accounting period.
+type CategoryBudget struct {
+	categoryID uuid.UUID
+	amount     valueobject.Money
+}
+
+func NewCategoryBudget(categoryID uuid.UUID, amount valueobject.Money) (CategoryBudget, error) {
+	if categoryID == uuid.Nil {
+		return CategoryBudget{}, errors.New("categoryID is required")
+	}
+
+	if amount.IsZero() || amount.Amount() <= 0 {
+		return CategoryBudget{}, fmt.Errorf("%w: category '%s'", ErrBudgetAmountNotPositive, categoryID)
+	}
+
+	return CategoryBudget{
+		categoryID: categoryID,
+		amount:     amount,
+	}, nil
+}
+
+func (b CategoryBudget) CategoryID() uuid.UUID     { return b.categoryID }
+func (b CategoryBudget) Amount() valueobject.Money { return b.amount }
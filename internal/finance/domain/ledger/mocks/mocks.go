@@ -72,6 +72,100 @@ func (_c *MockRepository_CreateAccountingPeriod_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// UpdateAccountingPeriod provides a mock function with given fields: ctx, ap
+func (_m *MockRepository) UpdateAccountingPeriod(ctx context.Context, ap *ledger.AccountingPeriod) error {
+	ret := _m.Called(ctx, ap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountingPeriod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledger.AccountingPeriod) error); ok {
+		r0 = rf(ctx, ap)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateAccountingPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountingPeriod'
+type MockRepository_UpdateAccountingPeriod_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountingPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ap *ledger.AccountingPeriod
+func (_e *MockRepository_Expecter) UpdateAccountingPeriod(ctx interface{}, ap interface{}) *MockRepository_UpdateAccountingPeriod_Call {
+	return &MockRepository_UpdateAccountingPeriod_Call{Call: _e.mock.On("UpdateAccountingPeriod", ctx, ap)}
+}
+
+func (_c *MockRepository_UpdateAccountingPeriod_Call) Run(run func(ctx context.Context, ap *ledger.AccountingPeriod)) *MockRepository_UpdateAccountingPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ledger.AccountingPeriod))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateAccountingPeriod_Call) Return(_a0 error) *MockRepository_UpdateAccountingPeriod_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateAccountingPeriod_Call) RunAndReturn(run func(context.Context, *ledger.AccountingPeriod) error) *MockRepository_UpdateAccountingPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategoryBudgets provides a mock function with given fields: ctx, ap
+func (_m *MockRepository) UpdateCategoryBudgets(ctx context.Context, ap *ledger.AccountingPeriod) error {
+	ret := _m.Called(ctx, ap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategoryBudgets")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledger.AccountingPeriod) error); ok {
+		r0 = rf(ctx, ap)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateCategoryBudgets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategoryBudgets'
+type MockRepository_UpdateCategoryBudgets_Call struct {
+	*mock.Call
+}
+
+// UpdateCategoryBudgets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ap *ledger.AccountingPeriod
+func (_e *MockRepository_Expecter) UpdateCategoryBudgets(ctx interface{}, ap interface{}) *MockRepository_UpdateCategoryBudgets_Call {
+	return &MockRepository_UpdateCategoryBudgets_Call{Call: _e.mock.On("UpdateCategoryBudgets", ctx, ap)}
+}
+
+func (_c *MockRepository_UpdateCategoryBudgets_Call) Run(run func(ctx context.Context, ap *ledger.AccountingPeriod)) *MockRepository_UpdateCategoryBudgets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ledger.AccountingPeriod))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateCategoryBudgets_Call) Return(_a0 error) *MockRepository_UpdateCategoryBudgets_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateCategoryBudgets_Call) RunAndReturn(run func(context.Context, *ledger.AccountingPeriod) error) *MockRepository_UpdateCategoryBudgets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepository(t interface {
@@ -7,9 +7,22 @@ import (
 )
 
 type Repository interface {
+	// CreateAccountingPeriod creates ap together with its category budgets.
 	CreateAccountingPeriod(
 		ctx context.Context,
 		wID uuid.UUID,
 		ap *AccountingPeriod,
 	) error
+
+	UpdateAccountingPeriod(
+		ctx context.Context,
+		ap *AccountingPeriod,
+	) error
+
+	// UpdateCategoryBudgets replaces the persisted category budgets of ap with its current ones.
+	// It fails when ap was changed or closed since it was loaded.
+	UpdateCategoryBudgets(
+		ctx context.Context,
+		ap *AccountingPeriod,
+	) error
 }
@@ -1,56 +1,63 @@
 package ledger
 
 import (
+	"errors"
 	"fmt"
-	"strings"
 	"sumni-finance-backend/internal/common/validator"
 	"sumni-finance-backend/internal/common/valueobject"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	TransactionTypeDeposit    TransactionType = TransactionType{"DEPOSIT"}
-	TransactionTypeWithdrawal TransactionType = TransactionType{"WITHDRAWAL"}
+	ErrTransactionAlreadyReversed = errors.New("transaction is already reversed")
+	ErrTransactionNotReversible   = errors.New("transfers and reversals can not be reversed")
+	ErrTransactionReconciled      = errors.New("transaction is cleared by a finalised reconciliation")
 )
 
-type TransactionType struct {
-	value string
-}
-
-func (t TransactionType) String() string { return t.value }
-
-func NewTransactionType(typeStr string) (TransactionType, error) {
-	typeStrCleaned := strings.ToUpper(strings.TrimSpace(typeStr))
-	if typeStrCleaned == TransactionTypeDeposit.value {
-		return TransactionTypeDeposit, nil
-	}
-
-	if typeStrCleaned == TransactionTypeWithdrawal.value {
-		return TransactionTypeWithdrawal, nil
-	}
-
-	return TransactionType{}, fmt.Errorf("unknown transaction type: %s", typeStr)
-}
-
 type TransactionRecord struct {
 	id              uuid.UUID
 	transactionNo   string
-	transactionType TransactionType // debit, credit
+	transactionType TransactionType
+	direction       Direction // IN credits the wallet, OUT debits it
 	amount          valueobject.Money
 	description     string
 
+	occurredAt time.Time // business date of the transaction
+	recordedAt time.Time // moment the transaction was entered
+
 	walletBalance valueobject.Money
 	fpID          uuid.UUID
 	fpBalance     valueobject.Money
+
+	// linkedID is the counterpart record of a transfer
+	linkedID uuid.UUID
+
+	// reversalOfID is the record compensated by this reversal
+	reversalOfID uuid.UUID
+	// reversedByID and reversedAt are set once a reversal compensated this record
+	reversedByID uuid.UUID
+	reversedAt   time.Time
+
+	// categoryID is uuid.Nil for an uncategorised record
+	categoryID uuid.UUID
+
+	// reconciled is set once a finalised reconciliation cleared the record, it can no longer be reversed
+	reconciled bool
 }
 
+// NewTransactionRecord creates a record of transactionType. direction is only required for an ADJUSTMENT,
+// the other types always move the balance in their own direction.
 func NewTransactionRecord(
 	transactionNo string,
 	transactionType string,
+	direction string,
 	amount valueobject.Money,
 	description string,
 	fpID uuid.UUID,
+	occurredAt time.Time,
+	recordedAt time.Time,
 ) (*TransactionRecord, error) {
 	v := validator.New()
 
@@ -58,6 +65,8 @@ func NewTransactionRecord(
 	v.Check(!amount.IsZero(), "amount", "amount is required")
 	v.Check(amount.Amount() > 0, "amount", "amount must be positive")
 	v.Check(fpID != uuid.Nil, "fpID", "fpID is required")
+	v.Check(!occurredAt.IsZero(), "occurredAt", "occurredAt is required")
+	v.Check(!recordedAt.IsZero(), "recordedAt", "recordedAt is required")
 
 	if err := v.Err(); err != nil {
 		return nil, fmt.Errorf("new transaction record: %w", err)
@@ -73,16 +82,171 @@ func NewTransactionRecord(
 		return nil, fmt.Errorf("new transaction record: %w", err)
 	}
 
+	txDirection, err := txType.ResolveDirection(direction)
+	if err != nil {
+		return nil, fmt.Errorf("new transaction record: %w", err)
+	}
+
 	return &TransactionRecord{
 		id:              id,
 		amount:          amount,
 		transactionNo:   transactionNo,
 		transactionType: txType,
+		direction:       txDirection,
 		description:     description,
+		occurredAt:      occurredAt,
+		recordedAt:      recordedAt,
 		fpID:            fpID,
 	}, nil
 }
 
+// UnmarshalTransactionRecordFromDatabase rehydrates a TransactionRecord from persisted database state.
+// The optional references are uuid.Nil and the zero time when not set.
+func UnmarshalTransactionRecordFromDatabase(
+	id uuid.UUID,
+	transactionNo string,
+	transactionType string,
+	direction string,
+	amount int64,
+	currencyCode string,
+	description string,
+	occurredAt time.Time,
+	recordedAt time.Time,
+	walletBalance int64,
+	fpID uuid.UUID,
+	fpBalance int64,
+	linkedID uuid.UUID,
+	reversalOfID uuid.UUID,
+	reversedByID uuid.UUID,
+	reversedAt time.Time,
+	categoryID uuid.UUID,
+) (*TransactionRecord, error) {
+	v := validator.New()
+
+	v.Check(id != uuid.Nil, "id", "id is required")
+	v.Required(transactionType, "transactionType")
+	v.Required(currencyCode, "currencyCode")
+	v.Check(fpID != uuid.Nil, "fpID", "fpID is required")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	txType, err := NewTransactionType(transactionType)
+	if err != nil {
+		return nil, err
+	}
+
+	txDirection, err := NewDirection(direction)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := valueobject.NewCurrency(currencyCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Currency: %w", err)
+	}
+
+	amountMoney, err := valueobject.NewMoney(amount, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create amount: %w", err)
+	}
+
+	walletBalanceMoney, err := valueobject.NewMoney(walletBalance, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wallet balance: %w", err)
+	}
+
+	fpBalanceMoney, err := valueobject.NewMoney(fpBalance, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fund provider balance: %w", err)
+	}
+
+	return &TransactionRecord{
+		id:              id,
+		transactionNo:   transactionNo,
+		transactionType: txType,
+		direction:       txDirection,
+		amount:          amountMoney,
+		description:     description,
+		occurredAt:      occurredAt,
+		recordedAt:      recordedAt,
+		walletBalance:   walletBalanceMoney,
+		fpID:            fpID,
+		fpBalance:       fpBalanceMoney,
+		linkedID:        linkedID,
+		reversalOfID:    reversalOfID,
+		reversedByID:    reversedByID,
+		reversedAt:      reversedAt,
+		categoryID:      categoryID,
+	}, nil
+}
+
+// Reverse creates the compensating record of tr, same type and amount in the opposite direction,
+// and marks tr as reversed by it. The reversal cancels tr out in the totals of the period both are in.
+func (tr *TransactionRecord) Reverse(description string, occurredAt time.Time, recordedAt time.Time) (*TransactionRecord, error) {
+	return tr.reverse(tr.transactionType, description, occurredAt, recordedAt)
+}
+
+// ReverseAsAdjustment creates the compensating record of tr as an ADJUSTMENT in the opposite direction
+// and marks tr as reversed by it. It is used once the period of tr is closed and its totals are final.
+func (tr *TransactionRecord) ReverseAsAdjustment(description string, occurredAt time.Time, recordedAt time.Time) (*TransactionRecord, error) {
+	return tr.reverse(TransactionTypeAdjustment, description, occurredAt, recordedAt)
+}
+
+func (tr *TransactionRecord) reverse(
+	reversalType TransactionType,
+	description string,
+	occurredAt time.Time,
+	recordedAt time.Time,
+) (*TransactionRecord, error) {
+	if tr.IsReversed() {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionAlreadyReversed, tr.id)
+	}
+
+	if tr.reconciled {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionReconciled, tr.id)
+	}
+
+	if tr.reversalOfID != uuid.Nil {
+		return nil, fmt.Errorf("%w: %s is itself a reversal", ErrTransactionNotReversible, tr.id)
+	}
+
+	// A transfer is undone with a transfer the other way
+	if tr.transactionType.IsTransfer() {
+		return nil, fmt.Errorf("%w: %s is a %s", ErrTransactionNotReversible, tr.id, tr.transactionType)
+	}
+
+	// Only an ADJUSTMENT takes the direction, the other types are created in their natural direction
+	var direction string
+	if !reversalType.HasDirection() {
+		direction = tr.direction.Opposite().String()
+	}
+
+	reversal, err := NewTransactionRecord(
+		"",
+		reversalType.String(),
+		direction,
+		tr.amount,
+		description,
+		tr.fpID,
+		occurredAt,
+		recordedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// A reversal of the same type goes against the natural direction of that type
+	reversal.direction = tr.direction.Opposite()
+	reversal.reversalOfID = tr.id
+	reversal.categoryID = tr.categoryID
+	tr.reversedByID = reversal.id
+	tr.reversedAt = recordedAt
+
+	return reversal, nil
+}
+
 func (tr *TransactionRecord) SetWalletBalance(walletBalance valueobject.Money) {
 	tr.walletBalance = walletBalance
 }
@@ -91,15 +255,55 @@ func (tr *TransactionRecord) SetFpBalance(fpBalance valueobject.Money) {
 	tr.fpBalance = fpBalance
 }
 
+// MarkReconciled locks the record cleared by a finalised reconciliation.
+func (tr *TransactionRecord) MarkReconciled() {
+	tr.reconciled = true
+}
+
+func (tr *TransactionRecord) LinkTo(linkedID uuid.UUID) {
+	tr.linkedID = linkedID
+}
+
+func (tr *TransactionRecord) Categorize(categoryID uuid.UUID) {
+	tr.categoryID = categoryID
+}
+
 func (t *TransactionRecord) ID() uuid.UUID                    { return t.id }
 func (t *TransactionRecord) TransactionNo() string            { return t.transactionNo }
 func (t *TransactionRecord) TransactionType() TransactionType { return t.transactionType }
+func (t *TransactionRecord) Direction() Direction             { return t.direction }
 func (t *TransactionRecord) Amount() valueobject.Money        { return t.amount }
 func (t *TransactionRecord) Description() string              { return t.description }
+func (t *TransactionRecord) OccurredAt() time.Time            { return t.occurredAt }
+func (t *TransactionRecord) RecordedAt() time.Time            { return t.recordedAt }
 func (t *TransactionRecord) WalletBalance() valueobject.Money { return t.walletBalance }
 func (t *TransactionRecord) FpID() uuid.UUID                  { return t.fpID }
 func (t *TransactionRecord) FpBalance() valueobject.Money     { return t.fpBalance }
+func (t *TransactionRecord) LinkedID() uuid.UUID              { return t.linkedID }
+func (t *TransactionRecord) ReversalOfID() uuid.UUID          { return t.reversalOfID }
+func (t *TransactionRecord) ReversedByID() uuid.UUID          { return t.reversedByID }
+func (t *TransactionRecord) ReversedAt() time.Time            { return t.reversedAt }
+func (t *TransactionRecord) CategoryID() uuid.UUID            { return t.categoryID }
+
+func (t *TransactionRecord) IsReversed() bool {
+	return t.reversedByID != uuid.Nil
+}
+
+func (t *TransactionRecord) IsReconciled() bool {
+	return t.reconciled
+}
+
+// IsInflow reports whether the record increases the wallet balance.
+func (t *TransactionRecord) IsInflow() bool {
+	return t.direction == DirectionIn
+}
+
+// IsExpense reports whether the record is filed under an expense category. The income and expense types decide
+// by themselves, so a REFUND is an expense, any other record is an expense when it is an outflow.
+func (t *TransactionRecord) IsExpense() bool {
+	if t.transactionType.IsIncomeOrExpense() {
+		return t.transactionType.IsExpense()
+	}
 
-func (t *TransactionRecord) IsDeposit() bool {
-	return t.transactionType.value == TransactionTypeDeposit.value
+	return !t.IsInflow()
 }
@@ -0,0 +1,127 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrDirectionRequired = errors.New("direction is required for an ADJUSTMENT")
+
+var (
+	DirectionIn  = Direction{value: "IN"}
+	DirectionOut = Direction{value: "OUT"}
+)
+
+// Direction says whether a transaction record increases (IN) or decreases (OUT) the wallet balance.
+type Direction struct {
+	value string
+}
+
+func NewDirection(direction string) (Direction, error) {
+	directionCleaned := strings.ToUpper(strings.TrimSpace(direction))
+
+	if directionCleaned == DirectionIn.value {
+		return DirectionIn, nil
+	}
+
+	if directionCleaned == DirectionOut.value {
+		return DirectionOut, nil
+	}
+
+	return Direction{}, fmt.Errorf("unknown direction: %s", direction)
+}
+
+func (d Direction) String() string { return d.value }
+func (d Direction) IsZero() bool   { return d == Direction{} }
+
+func (d Direction) Opposite() Direction {
+	if d == DirectionIn {
+		return DirectionOut
+	}
+
+	return DirectionIn
+}
+
+var (
+	TransactionTypeDeposit     = TransactionType{value: "DEPOSIT", direction: DirectionIn, incomeExpense: true}
+	TransactionTypeWithdrawal  = TransactionType{value: "WITHDRAWAL", direction: DirectionOut, incomeExpense: true, expense: true}
+	TransactionTypeFee         = TransactionType{value: "FEE", direction: DirectionOut, incomeExpense: true, expense: true}
+	TransactionTypeInterest    = TransactionType{value: "INTEREST", direction: DirectionIn, incomeExpense: true}
+	TransactionTypeRefund      = TransactionType{value: "REFUND", direction: DirectionIn, incomeExpense: true, expense: true}
+	TransactionTypeAdjustment  = TransactionType{value: "ADJUSTMENT"}
+	TransactionTypeTransferIn  = TransactionType{value: "TRANSFER_IN", direction: DirectionIn}
+	TransactionTypeTransferOut = TransactionType{value: "TRANSFER_OUT", direction: DirectionOut}
+)
+
+var transactionTypes = []TransactionType{
+	TransactionTypeDeposit,
+	TransactionTypeWithdrawal,
+	TransactionTypeFee,
+	TransactionTypeInterest,
+	TransactionTypeRefund,
+	TransactionTypeAdjustment,
+	TransactionTypeTransferIn,
+	TransactionTypeTransferOut,
+}
+
+type TransactionType struct {
+	value string
+	// direction is the natural direction of the type, an ADJUSTMENT has none and goes either way
+	direction Direction
+	// incomeExpense reports whether the type counts in the income and expense totals of a period
+	incomeExpense bool
+	// expense reports whether the type counts in the expense total, a REFUND gives back part of an expense
+	expense bool
+}
+
+func NewTransactionType(typeStr string) (TransactionType, error) {
+	typeStrCleaned := strings.ToUpper(strings.TrimSpace(typeStr))
+	for _, txType := range transactionTypes {
+		if typeStrCleaned == txType.value {
+			return txType, nil
+		}
+	}
+
+	return TransactionType{}, fmt.Errorf("unknown transaction type: %s", typeStr)
+}
+
+func (t TransactionType) String() string       { return t.value }
+func (t TransactionType) Direction() Direction { return t.direction }
+
+// HasDirection reports whether every record of the type moves the balance the same way.
+func (t TransactionType) HasDirection() bool { return !t.direction.IsZero() }
+
+// IsIncomeOrExpense reports whether the type counts as income (inflow) or expense (outflow) in period totals.
+// Transfers only move money around and adjustments correct balances, neither is income or expense.
+func (t TransactionType) IsIncomeOrExpense() bool { return t.incomeExpense }
+
+// IsExpense reports whether the type counts in the expense total. A REFUND is an inflow deducted from it.
+func (t TransactionType) IsExpense() bool { return t.expense }
+
+func (t TransactionType) IsTransfer() bool {
+	return t == TransactionTypeTransferIn || t == TransactionTypeTransferOut
+}
+
+// ResolveDirection returns the direction of a record of the type. direction is only needed for an ADJUSTMENT,
+// for the other types it may be empty or must match the natural direction.
+func (t TransactionType) ResolveDirection(direction string) (Direction, error) {
+	if strings.TrimSpace(direction) == "" {
+		if !t.HasDirection() {
+			return Direction{}, ErrDirectionRequired
+		}
+
+		return t.direction, nil
+	}
+
+	d, err := NewDirection(direction)
+	if err != nil {
+		return Direction{}, err
+	}
+
+	if t.HasDirection() && d != t.direction {
+		return Direction{}, fmt.Errorf("a %s always goes %s", t.value, t.direction.value)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,67 @@
+package ledger_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionType_ResolveDirection(t *testing.T) {
+	testCases := []struct {
+		name      string
+		txType    ledger.TransactionType
+		direction string
+		want      ledger.Direction
+		hasErr    bool
+	}{
+		{
+			name:   "uses the natural direction of an income type",
+			txType: ledger.TransactionTypeInterest,
+			want:   ledger.DirectionIn,
+		},
+		{
+			name:   "uses the natural direction of an expense type",
+			txType: ledger.TransactionTypeFee,
+			want:   ledger.DirectionOut,
+		},
+		{
+			name:      "accepts the natural direction when given",
+			txType:    ledger.TransactionTypeRefund,
+			direction: "in",
+			want:      ledger.DirectionIn,
+		},
+		{
+			name:      "returns error when the direction goes against the type",
+			txType:    ledger.TransactionTypeWithdrawal,
+			direction: "IN",
+			hasErr:    true,
+		},
+		{
+			name:   "returns error when an adjustment has no direction",
+			txType: ledger.TransactionTypeAdjustment,
+			hasErr: true,
+		},
+		{
+			name:      "uses the given direction of an adjustment",
+			txType:    ledger.TransactionTypeAdjustment,
+			direction: "OUT",
+			want:      ledger.DirectionOut,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			direction, err := tc.txType.ResolveDirection(tc.direction)
+
+			if tc.hasErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, direction)
+		})
+	}
+}
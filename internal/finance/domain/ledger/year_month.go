@@ -60,3 +60,13 @@ func (ym YearMonth) Year() int      { return ym.year }
 func (ym YearMonth) Month() int     { return ym.month }
 func (ym YearMonth) IsZero() bool   { return ym == YearMonth{} }
 func (ym YearMonth) String() string { return fmt.Sprintf("%d,%d", ym.year, ym.month) }
+
+// AddMonths returns the year and month that is months later.
+func (ym YearMonth) AddMonths(months int) YearMonth {
+	total := ym.year*12 + ym.month - 1 + months
+
+	return YearMonth{
+		year:  total / 12,
+		month: total%12 + 1,
+	}
+}
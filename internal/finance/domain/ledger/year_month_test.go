@@ -213,3 +213,27 @@ func TestYearMonth_String(t *testing.T) {
 		})
 	}
 }
+
+func TestYearMonth_AddMonths(t *testing.T) {
+	tests := []struct {
+		name     string
+		month    int
+		year     int
+		months   int
+		expected string
+	}{
+		{name: "adds one month", month: 4, year: 2026, months: 1, expected: "2026,5"},
+		{name: "rolls over to the next year", month: 12, year: 2025, months: 1, expected: "2026,1"},
+		{name: "adds a quarter across the year end", month: 11, year: 2025, months: 3, expected: "2026,2"},
+		{name: "adds zero months", month: 4, year: 2026, months: 0, expected: "2026,4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ym, err := ledger.NewYearMonth(tt.month, tt.year)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ym.AddMonths(tt.months).String())
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAttempts is the number of failed deliveries after which a message is given up
+	MaxAttempts = 10
+
+	baseRetryDelay = 30 * time.Second
+	maxRetryDelay  = 6 * time.Hour
+
+	maxErrorLength = 1000
+)
+
+var ErrMessageNotPending = errors.New("outbox message was already dispatched or given up")
+
+var (
+	StatusPending    = Status{value: "PENDING"}
+	StatusDispatched = Status{value: "DISPATCHED"}
+	StatusFailed     = Status{value: "FAILED"}
+)
+
+type Status struct {
+	value string
+}
+
+func NewStatus(statusStr string) (Status, error) {
+	switch strings.TrimSpace(strings.ToUpper(statusStr)) {
+	case StatusPending.value:
+		return StatusPending, nil
+	case StatusDispatched.value:
+		return StatusDispatched, nil
+	case StatusFailed.value:
+		return StatusFailed, nil
+	}
+
+	return Status{}, fmt.Errorf("unknown outbox status: %s", statusStr)
+}
+
+func (s Status) String() string { return s.value }
+
+// Message is an event waiting in the outbox to be relayed to the subscribers.
+// A failed delivery is retried with an exponential backoff until MaxAttempts, the message is then FAILED.
+type Message struct {
+	id            uuid.UUID
+	userID        string
+	event         event.Event
+	occurredAt    time.Time
+	status        Status
+	attempts      int32
+	lastError     string
+	nextAttemptAt time.Time
+	dispatchedAt  time.Time
+}
+
+// NewMessage wraps e raised at occurredAt by the action of the user userID, it is due right away.
+// userID is empty when no user acted.
+func NewMessage(e event.Event, userID string, occurredAt time.Time) (*Message, error) {
+	v := validator.New()
+
+	v.Check(e != nil, "event", "event is required")
+	v.Check(!occurredAt.IsZero(), "occurredAt", "occurredAt is required")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox messageID: %w", err)
+	}
+
+	return &Message{
+		id:            id,
+		userID:        userID,
+		event:         e,
+		occurredAt:    occurredAt,
+		status:        StatusPending,
+		nextAttemptAt: occurredAt,
+	}, nil
+}
+
+func UnmarshalMessageFromDatabase(
+	id uuid.UUID,
+	userID string,
+	eventName string,
+	payload []byte,
+	occurredAt time.Time,
+	statusStr string,
+	attempts int32,
+	lastError string,
+	nextAttemptAt time.Time,
+	dispatchedAt time.Time,
+) (*Message, error) {
+	v := validator.New()
+
+	v.Check(id != uuid.Nil, "id", "id is required")
+	v.Required(eventName, "eventName")
+	v.Check(attempts >= 0, "attempts", "attempts must be greater or equal than 0")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	status, err := NewStatus(statusStr)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := event.Unmarshal(eventName, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		id:            id,
+		userID:        userID,
+		event:         e,
+		occurredAt:    occurredAt,
+		status:        status,
+		attempts:      attempts,
+		lastError:     lastError,
+		nextAttemptAt: nextAttemptAt,
+		dispatchedAt:  dispatchedAt,
+	}, nil
+}
+
+func (m *Message) ID() uuid.UUID            { return m.id }
+func (m *Message) UserID() string           { return m.userID }
+func (m *Message) Event() event.Event       { return m.event }
+func (m *Message) OccurredAt() time.Time    { return m.occurredAt }
+func (m *Message) Status() Status           { return m.status }
+func (m *Message) Attempts() int32          { return m.attempts }
+func (m *Message) LastError() string        { return m.lastError }
+func (m *Message) NextAttemptAt() time.Time { return m.nextAttemptAt }
+func (m *Message) DispatchedAt() time.Time  { return m.dispatchedAt }
+
+func (m *Message) IsPending() bool { return m.status == StatusPending }
+
+// MarkDispatched records that every subscriber handled the event.
+func (m *Message) MarkDispatched(dispatchedAt time.Time) error {
+	if !m.IsPending() {
+		return ErrMessageNotPending
+	}
+
+	m.attempts++
+	m.status = StatusDispatched
+	m.dispatchedAt = dispatchedAt
+	m.lastError = ""
+
+	return nil
+}
+
+// MarkFailed records a failed delivery and schedules the next one, the message is given up after MaxAttempts.
+func (m *Message) MarkFailed(cause error, failedAt time.Time) error {
+	if !m.IsPending() {
+		return ErrMessageNotPending
+	}
+
+	m.attempts++
+	m.lastError = truncate(cause.Error(), maxErrorLength)
+
+	if m.attempts >= MaxAttempts {
+		m.status = StatusFailed
+		return nil
+	}
+
+	m.nextAttemptAt = failedAt.Add(RetryDelay(m.attempts))
+	return nil
+}
+
+// RetryDelay is the wait after the attempts-th failed delivery, doubling from 30 seconds up to 6 hours.
+func RetryDelay(attempts int32) time.Duration {
+	delay := baseRetryDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+
+	return delay
+}
+
+func truncate(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	return string([]rune(s)[:maxRunes])
+}
@@ -0,0 +1,83 @@
+package outbox_test
+
+import (
+	"errors"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/outbox"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_MarkFailed(t *testing.T) {
+	occurredAt := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	newMessage := func(t *testing.T) *outbox.Message {
+		t.Helper()
+
+		m, err := outbox.NewMessage(event.FundProviderCreated{FundProviderID: uuid.New()}, "user-1", occurredAt)
+		require.NoError(t, err)
+
+		return m
+	}
+
+	t.Run("schedules the next attempt with a growing delay", func(t *testing.T) {
+		m := newMessage(t)
+
+		require.NoError(t, m.MarkFailed(errors.New("subscriber down"), occurredAt))
+		assert.Equal(t, occurredAt.Add(30*time.Second), m.NextAttemptAt())
+
+		require.NoError(t, m.MarkFailed(errors.New("subscriber down"), occurredAt))
+		assert.Equal(t, occurredAt.Add(time.Minute), m.NextAttemptAt())
+
+		assert.True(t, m.IsPending())
+		assert.Equal(t, int32(2), m.Attempts())
+		assert.Equal(t, "subscriber down", m.LastError())
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		m := newMessage(t)
+
+		for range outbox.MaxAttempts {
+			require.NoError(t, m.MarkFailed(errors.New("subscriber down"), occurredAt))
+		}
+
+		assert.Equal(t, outbox.StatusFailed, m.Status())
+		require.ErrorIs(t, m.MarkFailed(errors.New("subscriber down"), occurredAt), outbox.ErrMessageNotPending)
+	})
+
+	t.Run("truncates a long error", func(t *testing.T) {
+		m := newMessage(t)
+
+		require.NoError(t, m.MarkFailed(errors.New(strings.Repeat("é", 2000)), occurredAt))
+
+		assert.Equal(t, 1000, len([]rune(m.LastError())))
+	})
+}
+
+func TestMessage_MarkDispatched(t *testing.T) {
+	occurredAt := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	m, err := outbox.NewMessage(event.FundProviderCreated{FundProviderID: uuid.New()}, "user-1", occurredAt)
+	require.NoError(t, err)
+
+	require.NoError(t, m.MarkFailed(errors.New("subscriber down"), occurredAt))
+	require.NoError(t, m.MarkDispatched(occurredAt.Add(time.Minute)))
+
+	assert.Equal(t, outbox.StatusDispatched, m.Status())
+	assert.Equal(t, int32(2), m.Attempts())
+	assert.Empty(t, m.LastError())
+	assert.Equal(t, occurredAt.Add(time.Minute), m.DispatchedAt())
+
+	require.ErrorIs(t, m.MarkDispatched(occurredAt), outbox.ErrMessageNotPending)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, outbox.RetryDelay(1))
+	assert.Equal(t, 4*time.Minute, outbox.RetryDelay(4))
+	assert.Equal(t, 6*time.Hour, outbox.RetryDelay(20))
+}
@@ -0,0 +1,87 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	outbox "sumni-finance-backend/internal/finance/domain/outbox"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Update provides a mock function with given fields: ctx, id, updateFn
+func (_m *MockRepository) Update(ctx context.Context, id uuid.UUID, updateFn func(*outbox.Message) error) error {
+	ret := _m.Called(ctx, id, updateFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*outbox.Message) error) error); ok {
+		r0 = rf(ctx, id, updateFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - updateFn func(*outbox.Message) error
+func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, updateFn interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, updateFn)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id uuid.UUID, updateFn func(*outbox.Message) error)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*outbox.Message) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*outbox.Message) error) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
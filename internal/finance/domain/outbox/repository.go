@@ -0,0 +1,13 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Update locks the message until updateFn returns and stores the result,
+	// so a message is relayed by a single relay at a time.
+	Update(ctx context.Context, id uuid.UUID, updateFn func(m *Message) error) error
+}
@@ -0,0 +1,187 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	reconciliation "sumni-finance-backend/internal/finance/domain/reconciliation"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+
+	wallet "sumni-finance-backend/internal/finance/domain/wallet"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Adjust provides a mock function with given fields: ctx, fpID, rID, wID, adjustFn
+func (_m *MockRepository) Adjust(ctx context.Context, fpID uuid.UUID, rID uuid.UUID, wID uuid.UUID, adjustFn func(*reconciliation.Reconciliation, *wallet.Wallet) error) error {
+	ret := _m.Called(ctx, fpID, rID, wID, adjustFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Adjust")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, func(*reconciliation.Reconciliation, *wallet.Wallet) error) error); ok {
+		r0 = rf(ctx, fpID, rID, wID, adjustFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Adjust_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Adjust'
+type MockRepository_Adjust_Call struct {
+	*mock.Call
+}
+
+// Adjust is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fpID uuid.UUID
+//   - rID uuid.UUID
+//   - wID uuid.UUID
+//   - adjustFn func(*reconciliation.Reconciliation , *wallet.Wallet) error
+func (_e *MockRepository_Expecter) Adjust(ctx interface{}, fpID interface{}, rID interface{}, wID interface{}, adjustFn interface{}) *MockRepository_Adjust_Call {
+	return &MockRepository_Adjust_Call{Call: _e.mock.On("Adjust", ctx, fpID, rID, wID, adjustFn)}
+}
+
+func (_c *MockRepository_Adjust_Call) Run(run func(ctx context.Context, fpID uuid.UUID, rID uuid.UUID, wID uuid.UUID, adjustFn func(*reconciliation.Reconciliation, *wallet.Wallet) error)) *MockRepository_Adjust_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(func(*reconciliation.Reconciliation, *wallet.Wallet) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Adjust_Call) Return(_a0 error) *MockRepository_Adjust_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Adjust_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, func(*reconciliation.Reconciliation, *wallet.Wallet) error) error) *MockRepository_Adjust_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, r
+func (_m *MockRepository) Create(ctx context.Context, r *reconciliation.Reconciliation) error {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *reconciliation.Reconciliation) error); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r *reconciliation.Reconciliation
+func (_e *MockRepository_Expecter) Create(ctx interface{}, r interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, r)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, r *reconciliation.Reconciliation)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*reconciliation.Reconciliation))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *reconciliation.Reconciliation) error) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, fpID, rID, updateFn
+func (_m *MockRepository) Update(ctx context.Context, fpID uuid.UUID, rID uuid.UUID, updateFn func(*reconciliation.Reconciliation) error) error {
+	ret := _m.Called(ctx, fpID, rID, updateFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, func(*reconciliation.Reconciliation) error) error); ok {
+		r0 = rf(ctx, fpID, rID, updateFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fpID uuid.UUID
+//   - rID uuid.UUID
+//   - updateFn func(*reconciliation.Reconciliation) error
+func (_e *MockRepository_Expecter) Update(ctx interface{}, fpID interface{}, rID interface{}, updateFn interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, fpID, rID, updateFn)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, fpID uuid.UUID, rID uuid.UUID, updateFn func(*reconciliation.Reconciliation) error)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(func(*reconciliation.Reconciliation) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, func(*reconciliation.Reconciliation) error) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
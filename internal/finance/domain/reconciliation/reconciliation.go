@@ -0,0 +1,387 @@
+package reconciliation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReconciliationInProgress = errors.New("fund provider already has a reconciliation in progress")
+	ErrReconciliationNotOpen    = errors.New("reconciliation is finalised or cancelled")
+	ErrRecordNotReconcilable    = errors.New("transaction record is not an unreconciled record of the fund provider")
+	ErrRecordAfterStatement     = errors.New("transaction record occurred after the statement date")
+	ErrNothingToAdjust          = errors.New("cleared balance already matches the statement balance")
+	ErrDifferenceNotZero        = errors.New("cleared balance does not match the statement balance")
+)
+
+var (
+	StatusInProgress = Status{value: "IN_PROGRESS"}
+	StatusFinalised  = Status{value: "FINALISED"}
+	StatusCancelled  = Status{value: "CANCELLED"}
+)
+
+type Status struct {
+	value string
+}
+
+func NewStatus(statusStr string) (Status, error) {
+	switch strings.TrimSpace(strings.ToUpper(statusStr)) {
+	case StatusInProgress.value:
+		return StatusInProgress, nil
+	case StatusFinalised.value:
+		return StatusFinalised, nil
+	case StatusCancelled.value:
+		return StatusCancelled, nil
+	}
+
+	return Status{}, fmt.Errorf("unknown reconciliation status: %s", statusStr)
+}
+
+func (s Status) String() string { return s.value }
+
+// Record is a transaction record of the fund provider, in any wallet, not yet locked by a finalised reconciliation.
+type Record struct {
+	transactionID uuid.UUID
+	walletID      uuid.UUID
+	direction     ledger.Direction
+	amount        int64
+	occurredAt    time.Time
+}
+
+func UnmarshalRecordFromDatabase(
+	transactionID uuid.UUID,
+	walletID uuid.UUID,
+	directionStr string,
+	amount int64,
+	occurredAt time.Time,
+) (Record, error) {
+	direction, err := ledger.NewDirection(directionStr)
+	if err != nil {
+		return Record{}, err
+	}
+
+	return Record{
+		transactionID: transactionID,
+		walletID:      walletID,
+		direction:     direction,
+		amount:        amount,
+		occurredAt:    occurredAt,
+	}, nil
+}
+
+func (r Record) TransactionID() uuid.UUID    { return r.transactionID }
+func (r Record) WalletID() uuid.UUID         { return r.walletID }
+func (r Record) Direction() ledger.Direction { return r.direction }
+func (r Record) Amount() int64               { return r.amount }
+func (r Record) OccurredAt() time.Time       { return r.occurredAt }
+
+func (r Record) signedAmount() int64 {
+	if r.direction == ledger.DirectionIn {
+		return r.amount
+	}
+
+	return -r.amount
+}
+
+// Reconciliation matches the records of a fund provider against the closing balance of a bank statement.
+// The records found on the statement are cleared, the balance they account for is the balance of the fund provider
+// less the records still uncleared. Once it matches the statement balance the reconciliation is finalised
+// and its cleared records are locked, later reconciliations no longer see them.
+type Reconciliation struct {
+	id               uuid.UUID
+	fpID             uuid.UUID
+	statementDate    time.Time
+	statementBalance int64
+	status           Status
+	createdAt        time.Time
+	finalisedAt      time.Time // zero until finalised
+	version          int32
+
+	// bookBalance is the balance of the fund provider when loaded, records the unlocked records of the fund provider
+	bookBalance int64
+	records     map[uuid.UUID]Record
+	cleared     map[uuid.UUID]bool
+	adjustments map[uuid.UUID]bool
+}
+
+// NewReconciliation starts reconciling fp with a statement closing at statementBalance at the end of statementDate.
+func NewReconciliation(
+	fp *fundprovider.FundProvider,
+	statementDate time.Time,
+	statementBalance int64,
+	createdAt time.Time,
+) (*Reconciliation, error) {
+	v := validator.New()
+
+	v.Check(fp != nil, "fundProvider", "fundProvider is required")
+	v.Check(!statementDate.IsZero(), "statementDate", "statementDate is required")
+	v.Check(!statementDate.After(createdAt), "statementDate", "statementDate must not be in the future")
+	v.Check(statementBalance >= 0, "statementBalance", "statementBalance must be greater or equal than 0")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reconciliationID: %w", err)
+	}
+
+	return &Reconciliation{
+		id:               id,
+		fpID:             fp.ID(),
+		statementDate:    statementDate,
+		statementBalance: statementBalance,
+		status:           StatusInProgress,
+		createdAt:        createdAt,
+		bookBalance:      fp.Balance().Amount(),
+		records:          make(map[uuid.UUID]Record),
+		cleared:          make(map[uuid.UUID]bool),
+		adjustments:      make(map[uuid.UUID]bool),
+	}, nil
+}
+
+// UnmarshalReconciliationFromDatabase rehydrates a reconciliation with the current balance of its fund provider
+// and the records it can clear. clearedIDs are the records cleared so far, adjustmentIDs the ones it posted.
+func UnmarshalReconciliationFromDatabase(
+	id uuid.UUID,
+	fpID uuid.UUID,
+	statementDate time.Time,
+	statementBalance int64,
+	statusStr string,
+	createdAt time.Time,
+	finalisedAt time.Time,
+	version int32,
+	bookBalance int64,
+	records []Record,
+	clearedIDs []uuid.UUID,
+	adjustmentIDs []uuid.UUID,
+) (*Reconciliation, error) {
+	status, err := NewStatus(statusStr)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reconciliation{
+		id:               id,
+		fpID:             fpID,
+		statementDate:    statementDate,
+		statementBalance: statementBalance,
+		status:           status,
+		createdAt:        createdAt,
+		finalisedAt:      finalisedAt,
+		version:          version,
+		bookBalance:      bookBalance,
+		records:          make(map[uuid.UUID]Record, len(records)),
+		cleared:          make(map[uuid.UUID]bool, len(clearedIDs)),
+		adjustments:      make(map[uuid.UUID]bool, len(adjustmentIDs)),
+	}
+
+	for _, record := range records {
+		r.records[record.transactionID] = record
+	}
+
+	for _, txID := range clearedIDs {
+		r.cleared[txID] = true
+	}
+
+	for _, txID := range adjustmentIDs {
+		r.adjustments[txID] = true
+	}
+
+	return r, nil
+}
+
+func (r *Reconciliation) ID() uuid.UUID            { return r.id }
+func (r *Reconciliation) FpID() uuid.UUID          { return r.fpID }
+func (r *Reconciliation) StatementDate() time.Time { return r.statementDate }
+func (r *Reconciliation) StatementBalance() int64  { return r.statementBalance }
+func (r *Reconciliation) Status() Status           { return r.status }
+func (r *Reconciliation) CreatedAt() time.Time     { return r.createdAt }
+func (r *Reconciliation) FinalisedAt() time.Time   { return r.finalisedAt }
+func (r *Reconciliation) Version() int32           { return r.version }
+func (r *Reconciliation) BookBalance() int64       { return r.bookBalance }
+
+func (r *Reconciliation) IsInProgress() bool { return r.status == StatusInProgress }
+
+// ClearedIDs returns the cleared records sorted by id.
+func (r *Reconciliation) ClearedIDs() []uuid.UUID {
+	return slices.SortedFunc(maps.Keys(r.cleared), compareIDs)
+}
+
+// AdjustmentIDs returns the adjustments posted by the reconciliation sorted by id.
+func (r *Reconciliation) AdjustmentIDs() []uuid.UUID {
+	return slices.SortedFunc(maps.Keys(r.adjustments), compareIDs)
+}
+
+func (r *Reconciliation) IsCleared(txID uuid.UUID) bool    { return r.cleared[txID] }
+func (r *Reconciliation) IsAdjustment(txID uuid.UUID) bool { return r.adjustments[txID] }
+
+// ClearedBalance is the balance of the fund provider without the records that are not cleared yet.
+func (r *Reconciliation) ClearedBalance() int64 {
+	balance := r.bookBalance
+	for txID, record := range r.records {
+		if !r.cleared[txID] {
+			balance -= record.signedAmount()
+		}
+	}
+
+	return balance
+}
+
+// Difference is what the cleared balance misses to match the statement, positive when the statement shows more.
+func (r *Reconciliation) Difference() int64 {
+	return r.statementBalance - r.ClearedBalance()
+}
+
+// Clear marks records as found on the statement. They must have occurred by the end of the statement date.
+func (r *Reconciliation) Clear(txIDs ...uuid.UUID) error {
+	if !r.IsInProgress() {
+		return ErrReconciliationNotOpen
+	}
+
+	statementEnd := r.statementDate.AddDate(0, 0, 1)
+	for _, txID := range txIDs {
+		record, exist := r.records[txID]
+		if !exist {
+			return fmt.Errorf("%w: %s", ErrRecordNotReconcilable, txID)
+		}
+
+		if !record.occurredAt.Before(statementEnd) {
+			return fmt.Errorf("%w: %s", ErrRecordAfterStatement, txID)
+		}
+	}
+
+	for _, txID := range txIDs {
+		r.cleared[txID] = true
+	}
+
+	return nil
+}
+
+// Unclear marks records as missing from the statement. An adjustment posted by the reconciliation stays cleared.
+func (r *Reconciliation) Unclear(txIDs ...uuid.UUID) error {
+	if !r.IsInProgress() {
+		return ErrReconciliationNotOpen
+	}
+
+	for _, txID := range txIDs {
+		if _, exist := r.records[txID]; !exist || r.adjustments[txID] {
+			return fmt.Errorf("%w: %s", ErrRecordNotReconcilable, txID)
+		}
+	}
+
+	for _, txID := range txIDs {
+		delete(r.cleared, txID)
+	}
+
+	return nil
+}
+
+// Adjust closes the difference with an ADJUSTMENT record booked into the open accounting period of w,
+// which must hold an allocation of the fund provider. The adjustment is cleared with the reconciliation.
+func (r *Reconciliation) Adjust(w *wallet.Wallet, description string, recordedAt time.Time) error {
+	if !r.IsInProgress() {
+		return ErrReconciliationNotOpen
+	}
+
+	difference := r.Difference()
+	if difference == 0 {
+		return ErrNothingToAdjust
+	}
+
+	allocation, exist := w.FundProviderManager().FindFundProviderAllocation(r.fpID)
+	if !exist {
+		return wallet.ErrFundAllocatedNotFound{FpID: r.fpID.String()}
+	}
+
+	ap, exist := w.LedgerManager().LatestAccountingPeriod()
+	if !exist || ap.IsClose() {
+		return wallet.ErrNoOpenAccountingPeriod
+	}
+
+	direction, amount := ledger.DirectionIn, difference
+	if difference < 0 {
+		direction, amount = ledger.DirectionOut, -difference
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		description = fmt.Sprintf("Reconciliation adjustment to the statement of %s", r.statementDate.Format(time.DateOnly))
+	}
+	if utf8.RuneCountInString(description) > 255 {
+		return errors.New("description must not exceed 255 characters")
+	}
+
+	if err := w.RecordTransactions(ap.YearMonth(), wallet.TransactionSpec{
+		TransactionType: ledger.TransactionTypeAdjustment.String(),
+		Direction:       direction.String(),
+		Amount:          amount,
+		Description:     description,
+		FpID:            r.fpID,
+		OccurredAt:      recordedAt,
+		RecordedAt:      recordedAt,
+	}); err != nil {
+		return err
+	}
+
+	txRecords := ap.Transactions()
+	adjustment := txRecords[len(txRecords)-1]
+
+	r.records[adjustment.ID()] = Record{
+		transactionID: adjustment.ID(),
+		walletID:      w.ID(),
+		direction:     direction,
+		amount:        amount,
+		occurredAt:    recordedAt,
+	}
+	r.cleared[adjustment.ID()] = true
+	r.adjustments[adjustment.ID()] = true
+	r.bookBalance = allocation.FundProvider().Balance().Amount()
+
+	return nil
+}
+
+// Finalise completes the reconciliation once the cleared balance matches the statement, locking the cleared records.
+func (r *Reconciliation) Finalise(finalisedAt time.Time) error {
+	if !r.IsInProgress() {
+		return ErrReconciliationNotOpen
+	}
+
+	if difference := r.Difference(); difference != 0 {
+		return fmt.Errorf("%w: difference is %d", ErrDifferenceNotZero, difference)
+	}
+
+	r.status = StatusFinalised
+	r.finalisedAt = finalisedAt
+	return nil
+}
+
+// Cancel abandons the reconciliation, its records are released for the next one.
+// The adjustments it posted stay in the ledger.
+func (r *Reconciliation) Cancel() error {
+	if !r.IsInProgress() {
+		return ErrReconciliationNotOpen
+	}
+
+	r.status = StatusCancelled
+	clear(r.cleared)
+	clear(r.adjustments)
+	return nil
+}
+
+func compareIDs(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
@@ -0,0 +1,220 @@
+package reconciliation_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/reconciliation"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func TestNewReconciliation(t *testing.T) {
+	provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1000, 0, "VND", 1)
+	require.NoError(t, err)
+
+	t.Run("returns error when the statement date is in the future", func(t *testing.T) {
+		_, err := reconciliation.NewReconciliation(provider, date(2026, time.May, 1), 1000, date(2026, time.April, 30))
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when the statement balance is negative", func(t *testing.T) {
+		_, err := reconciliation.NewReconciliation(provider, date(2026, time.April, 30), -1, date(2026, time.May, 2))
+		require.Error(t, err)
+	})
+
+	t.Run("starts a reconciliation with nothing cleared", func(t *testing.T) {
+		r, err := reconciliation.NewReconciliation(provider, date(2026, time.April, 30), 900, date(2026, time.May, 2))
+		require.NoError(t, err)
+
+		assert.Equal(t, reconciliation.StatusInProgress, r.Status())
+		assert.Equal(t, provider.ID(), r.FpID())
+		assert.Equal(t, int64(1000), r.ClearedBalance())
+		assert.Equal(t, int64(-100), r.Difference())
+	})
+}
+
+func TestReconciliation_Clear(t *testing.T) {
+	salary, rent, groceries := uuid.New(), uuid.New(), uuid.New()
+
+	// The fund provider holds 1.000 after a salary of 5.000, a rent of 3.000 and groceries of 1.000 on May 3
+	newReconciliation := func(t *testing.T, statementBalance int64, cleared ...uuid.UUID) *reconciliation.Reconciliation {
+		t.Helper()
+
+		records := []reconciliation.Record{
+			newRecord(t, salary, "IN", 5000, date(2026, time.April, 5)),
+			newRecord(t, rent, "OUT", 3000, date(2026, time.April, 10)),
+			newRecord(t, groceries, "OUT", 1000, date(2026, time.May, 3)),
+		}
+
+		r, err := reconciliation.UnmarshalReconciliationFromDatabase(
+			uuid.New(), uuid.New(), date(2026, time.April, 30), statementBalance, "IN_PROGRESS",
+			date(2026, time.May, 4), time.Time{}, 0, 1000, records, cleared, nil,
+		)
+		require.NoError(t, err)
+
+		return r
+	}
+
+	t.Run("leaves the uncleared records out of the cleared balance", func(t *testing.T) {
+		r := newReconciliation(t, 2000)
+
+		require.NoError(t, r.Clear(salary))
+
+		// Without the rent and the groceries the fund provider would hold 5.000
+		assert.Equal(t, int64(5000), r.ClearedBalance())
+		assert.Equal(t, int64(-3000), r.Difference())
+
+		require.NoError(t, r.Clear(rent))
+		assert.Equal(t, int64(0), r.Difference())
+		assert.ElementsMatch(t, []uuid.UUID{salary, rent}, r.ClearedIDs())
+	})
+
+	t.Run("returns error when the record occurred after the statement date", func(t *testing.T) {
+		r := newReconciliation(t, 2000)
+
+		require.ErrorIs(t, r.Clear(groceries), reconciliation.ErrRecordAfterStatement)
+	})
+
+	t.Run("returns error when the record is not reconcilable", func(t *testing.T) {
+		r := newReconciliation(t, 2000)
+
+		require.ErrorIs(t, r.Clear(salary, uuid.New()), reconciliation.ErrRecordNotReconcilable)
+		assert.False(t, r.IsCleared(salary))
+	})
+
+	t.Run("unclears a record", func(t *testing.T) {
+		r := newReconciliation(t, 2000, salary, rent)
+
+		require.NoError(t, r.Unclear(rent))
+
+		assert.False(t, r.IsCleared(rent))
+		assert.Equal(t, int64(-3000), r.Difference())
+	})
+}
+
+func TestReconciliation_Finalise(t *testing.T) {
+	txID := uuid.New()
+
+	newReconciliation := func(t *testing.T, statementBalance int64) *reconciliation.Reconciliation {
+		t.Helper()
+
+		r, err := reconciliation.UnmarshalReconciliationFromDatabase(
+			uuid.New(), uuid.New(), date(2026, time.April, 30), statementBalance, "IN_PROGRESS",
+			date(2026, time.May, 4), time.Time{}, 0, 1000,
+			[]reconciliation.Record{newRecord(t, txID, "IN", 1000, date(2026, time.April, 5))}, []uuid.UUID{txID}, nil,
+		)
+		require.NoError(t, err)
+
+		return r
+	}
+
+	t.Run("returns error while there is a difference", func(t *testing.T) {
+		r := newReconciliation(t, 900)
+
+		require.ErrorIs(t, r.Finalise(date(2026, time.May, 4)), reconciliation.ErrDifferenceNotZero)
+		assert.True(t, r.IsInProgress())
+	})
+
+	t.Run("finalises and locks the reconciliation", func(t *testing.T) {
+		r := newReconciliation(t, 1000)
+
+		require.NoError(t, r.Finalise(date(2026, time.May, 4)))
+
+		assert.Equal(t, reconciliation.StatusFinalised, r.Status())
+		assert.Equal(t, date(2026, time.May, 4), r.FinalisedAt())
+		require.ErrorIs(t, r.Unclear(txID), reconciliation.ErrReconciliationNotOpen)
+		require.ErrorIs(t, r.Cancel(), reconciliation.ErrReconciliationNotOpen)
+	})
+}
+
+func TestReconciliation_Adjust(t *testing.T) {
+	newWallet := func(t *testing.T, status string) (*wallet.Wallet, *fundprovider.FundProvider, *ledger.AccountingPeriod) {
+		t.Helper()
+
+		startOfMay := date(2026, time.May, 1)
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), "2026,5", 1, 1, status, 1000, 0, 0, 0, 0, 1000, "VND",
+			startOfMay, startOfMay.AddDate(0, 1, 0), 0,
+		)
+		require.NoError(t, err)
+
+		provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1000, 0, "VND", 1)
+		require.NoError(t, err)
+
+		allocation, err := wallet.NewFpAllocation(provider, 1000)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Gia đình", 1000, "VND", 0, 1, 1, []*ledger.AccountingPeriod{ap}, allocation)
+		require.NoError(t, err)
+
+		return w, provider, ap
+	}
+
+	newReconciliation := func(t *testing.T, fpID uuid.UUID, statementBalance int64) *reconciliation.Reconciliation {
+		t.Helper()
+
+		r, err := reconciliation.UnmarshalReconciliationFromDatabase(
+			uuid.New(), fpID, date(2026, time.April, 30), statementBalance, "IN_PROGRESS",
+			date(2026, time.May, 4), time.Time{}, 0, 1000, nil, nil, nil,
+		)
+		require.NoError(t, err)
+
+		return r
+	}
+
+	t.Run("posts an outgoing adjustment when the statement shows less", func(t *testing.T) {
+		w, provider, ap := newWallet(t, "OPEN")
+		r := newReconciliation(t, provider.ID(), 950)
+
+		require.NoError(t, r.Adjust(w, "", date(2026, time.May, 4)))
+
+		require.Len(t, ap.Transactions(), 1)
+		adjustment := ap.Transactions()[0]
+		assert.Equal(t, ledger.TransactionTypeAdjustment, adjustment.TransactionType())
+		assert.Equal(t, ledger.DirectionOut, adjustment.Direction())
+		assert.Equal(t, int64(50), adjustment.Amount().Amount())
+
+		assert.Equal(t, []uuid.UUID{adjustment.ID()}, r.AdjustmentIDs())
+		assert.True(t, r.IsCleared(adjustment.ID()))
+		assert.Equal(t, int64(950), r.BookBalance())
+		assert.Equal(t, int64(0), r.Difference())
+		require.NoError(t, r.Finalise(date(2026, time.May, 4)))
+	})
+
+	t.Run("returns error when there is no difference", func(t *testing.T) {
+		w, provider, _ := newWallet(t, "OPEN")
+
+		require.ErrorIs(t, newReconciliation(t, provider.ID(), 1000).Adjust(w, "", date(2026, time.May, 4)), reconciliation.ErrNothingToAdjust)
+	})
+
+	t.Run("returns error when the wallet does not hold the fund provider", func(t *testing.T) {
+		w, _, _ := newWallet(t, "OPEN")
+
+		var notFound wallet.ErrFundAllocatedNotFound
+		require.ErrorAs(t, newReconciliation(t, uuid.New(), 900).Adjust(w, "", date(2026, time.May, 4)), &notFound)
+	})
+
+	t.Run("returns error when the latest period is closed", func(t *testing.T) {
+		w, provider, _ := newWallet(t, "CLOSE")
+
+		require.ErrorIs(t, newReconciliation(t, provider.ID(), 900).Adjust(w, "", date(2026, time.May, 4)), wallet.ErrNoOpenAccountingPeriod)
+	})
+}
+
+func newRecord(t *testing.T, txID uuid.UUID, direction string, amount int64, occurredAt time.Time) reconciliation.Record {
+	t.Helper()
+
+	record, err := reconciliation.UnmarshalRecordFromDatabase(txID, uuid.New(), direction, amount, occurredAt)
+	require.NoError(t, err)
+
+	return record
+}
@@ -0,0 +1,32 @@
+package reconciliation
+
+import (
+	"context"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create fails with ErrReconciliationInProgress when the fund provider already has one in progress.
+	Create(ctx context.Context, r *Reconciliation) error
+
+	// Update loads the rID reconciliation of the fund provider with its balance and unlocked records,
+	// applies updateFn and saves its status together with the cleared records.
+	Update(
+		ctx context.Context,
+		fpID uuid.UUID,
+		rID uuid.UUID,
+		updateFn func(r *Reconciliation) error,
+	) error
+
+	// Adjust loads the reconciliation like Update and the wID wallet with the allocation of the fund provider
+	// and the latest accounting period, applies adjustFn and saves both atomically.
+	Adjust(
+		ctx context.Context,
+		fpID uuid.UUID,
+		rID uuid.UUID,
+		wID uuid.UUID,
+		adjustFn func(r *Reconciliation, w *wallet.Wallet) error,
+	) error
+}
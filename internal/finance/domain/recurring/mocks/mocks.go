@@ -0,0 +1,247 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	recurring "sumni-finance-backend/internal/finance/domain/recurring"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+
+	wallet "sumni-finance-backend/internal/finance/domain/wallet"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, t
+func (_m *MockRepository) Create(ctx context.Context, t *recurring.Template) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *recurring.Template) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *recurring.Template
+func (_e *MockRepository_Expecter) Create(ctx interface{}, t interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, t)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, t *recurring.Template)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*recurring.Template))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *recurring.Template) error) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveTemplateIDs provides a mock function with given fields: ctx, wID, startedBefore
+func (_m *MockRepository) ListActiveTemplateIDs(ctx context.Context, wID uuid.UUID, startedBefore time.Time) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, wID, startedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveTemplateIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]uuid.UUID, error)); ok {
+		return rf(ctx, wID, startedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []uuid.UUID); ok {
+		r0 = rf(ctx, wID, startedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, wID, startedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListActiveTemplateIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveTemplateIDs'
+type MockRepository_ListActiveTemplateIDs_Call struct {
+	*mock.Call
+}
+
+// ListActiveTemplateIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+//   - startedBefore time.Time
+func (_e *MockRepository_Expecter) ListActiveTemplateIDs(ctx interface{}, wID interface{}, startedBefore interface{}) *MockRepository_ListActiveTemplateIDs_Call {
+	return &MockRepository_ListActiveTemplateIDs_Call{Call: _e.mock.On("ListActiveTemplateIDs", ctx, wID, startedBefore)}
+}
+
+func (_c *MockRepository_ListActiveTemplateIDs_Call) Run(run func(ctx context.Context, wID uuid.UUID, startedBefore time.Time)) *MockRepository_ListActiveTemplateIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListActiveTemplateIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *MockRepository_ListActiveTemplateIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListActiveTemplateIDs_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) ([]uuid.UUID, error)) *MockRepository_ListActiveTemplateIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Materialize provides a mock function with given fields: ctx, tID, materializeFn
+func (_m *MockRepository) Materialize(ctx context.Context, tID uuid.UUID, materializeFn func(*recurring.Template, *wallet.Wallet) error) error {
+	ret := _m.Called(ctx, tID, materializeFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Materialize")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*recurring.Template, *wallet.Wallet) error) error); ok {
+		r0 = rf(ctx, tID, materializeFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Materialize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Materialize'
+type MockRepository_Materialize_Call struct {
+	*mock.Call
+}
+
+// Materialize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tID uuid.UUID
+//   - materializeFn func(*recurring.Template , *wallet.Wallet) error
+func (_e *MockRepository_Expecter) Materialize(ctx interface{}, tID interface{}, materializeFn interface{}) *MockRepository_Materialize_Call {
+	return &MockRepository_Materialize_Call{Call: _e.mock.On("Materialize", ctx, tID, materializeFn)}
+}
+
+func (_c *MockRepository_Materialize_Call) Run(run func(ctx context.Context, tID uuid.UUID, materializeFn func(*recurring.Template, *wallet.Wallet) error)) *MockRepository_Materialize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*recurring.Template, *wallet.Wallet) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Materialize_Call) Return(_a0 error) *MockRepository_Materialize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Materialize_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*recurring.Template, *wallet.Wallet) error) error) *MockRepository_Materialize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, wID, tID, updateFn
+func (_m *MockRepository) Update(ctx context.Context, wID uuid.UUID, tID uuid.UUID, updateFn func(*recurring.Template) error) error {
+	ret := _m.Called(ctx, wID, tID, updateFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, func(*recurring.Template) error) error); ok {
+		r0 = rf(ctx, wID, tID, updateFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+//   - tID uuid.UUID
+//   - updateFn func(*recurring.Template) error
+func (_e *MockRepository_Expecter) Update(ctx interface{}, wID interface{}, tID interface{}, updateFn interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, wID, tID, updateFn)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, wID uuid.UUID, tID uuid.UUID, updateFn func(*recurring.Template) error)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(func(*recurring.Template) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, func(*recurring.Template) error) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+package recurring
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	OccurrencePosted  = OccurrenceStatus{value: "POSTED"}
+	OccurrenceSkipped = OccurrenceStatus{value: "SKIPPED"}
+)
+
+type OccurrenceStatus struct {
+	value string
+}
+
+func NewOccurrenceStatus(statusStr string) (OccurrenceStatus, error) {
+	switch strings.TrimSpace(strings.ToUpper(statusStr)) {
+	case OccurrencePosted.value:
+		return OccurrencePosted, nil
+	case OccurrenceSkipped.value:
+		return OccurrenceSkipped, nil
+	}
+
+	return OccurrenceStatus{}, fmt.Errorf("unknown occurrence status: %s", statusStr)
+}
+
+func (s OccurrenceStatus) String() string { return s.value }
+
+// Occurrence is a handled date of a template, transactionID is the posted record and uuid.Nil when skipped.
+type Occurrence struct {
+	date          time.Time
+	status        OccurrenceStatus
+	transactionID uuid.UUID
+}
+
+func UnmarshalOccurrenceFromDatabase(date time.Time, statusStr string, transactionID uuid.UUID) (Occurrence, error) {
+	status, err := NewOccurrenceStatus(statusStr)
+	if err != nil {
+		return Occurrence{}, err
+	}
+
+	return Occurrence{
+		date:          truncateToDate(date),
+		status:        status,
+		transactionID: transactionID,
+	}, nil
+}
+
+func (o Occurrence) Date() time.Time          { return o.date }
+func (o Occurrence) Status() OccurrenceStatus { return o.status }
+func (o Occurrence) TransactionID() uuid.UUID { return o.transactionID }
@@ -0,0 +1,34 @@
+package recurring
+
+import (
+	"context"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, t *Template) error
+
+	// Update loads the tID template of the wallet with its occurrences, applies updateFn
+	// and saves its status together with the new occurrences.
+	Update(
+		ctx context.Context,
+		wID uuid.UUID,
+		tID uuid.UUID,
+		updateFn func(t *Template) error,
+	) error
+
+	// Materialize loads the tID template with its occurrences and its wallet with the allocation of the template
+	// fund provider and the latest accounting period, applies materializeFn and saves the wallet together with
+	// the new occurrences atomically.
+	Materialize(
+		ctx context.Context,
+		tID uuid.UUID,
+		materializeFn func(t *Template, w *wallet.Wallet) error,
+	) error
+
+	// ListActiveTemplateIDs lists the active templates of the wallet whose schedule started before startedBefore.
+	ListActiveTemplateIDs(ctx context.Context, wID uuid.UUID, startedBefore time.Time) ([]uuid.UUID, error)
+}
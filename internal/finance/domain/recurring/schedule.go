@@ -0,0 +1,145 @@
+package recurring
+
+import (
+	"errors"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"time"
+)
+
+var ErrInvalidFrequency = errors.New("invalid schedule frequency")
+
+var (
+	FrequencyMonthly = Frequency{value: "MONTHLY"}
+	FrequencyWeekly  = Frequency{value: "WEEKLY"}
+)
+
+var supportedFrequency = map[string]Frequency{
+	"MONTHLY": FrequencyMonthly,
+	"WEEKLY":  FrequencyWeekly,
+}
+
+type Frequency struct {
+	value string
+}
+
+func NewFrequency(frequencyStr string) (Frequency, error) {
+	frequencyCleaned := strings.TrimSpace(strings.ToUpper(frequencyStr))
+
+	f, ok := supportedFrequency[frequencyCleaned]
+	if !ok {
+		return Frequency{}, ErrInvalidFrequency
+	}
+
+	return f, nil
+}
+
+func (f Frequency) String() string { return f.value }
+
+// Schedule tells on which dates a template occurs.
+// A MONTHLY schedule occurs on dayOfMonth every interval months, counted from the month of startDate.
+// The day is moved to the last day of shorter months, day 31 is the end of every month.
+// A WEEKLY schedule occurs every interval weeks on the weekday of startDate.
+type Schedule struct {
+	frequency  Frequency
+	interval   int32
+	dayOfMonth int32 // MONTHLY only
+	startDate  time.Time
+}
+
+func NewSchedule(frequencyStr string, interval int32, dayOfMonth int32, startDate time.Time) (Schedule, error) {
+	frequency, err := NewFrequency(frequencyStr)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	v := validator.New()
+
+	v.Check(interval >= 1 && interval <= 12, "interval", "interval must be between 1 and 12")
+	v.Check(!startDate.IsZero(), "startDate", "startDate is required")
+	if frequency == FrequencyMonthly {
+		v.Check(dayOfMonth >= 1 && dayOfMonth <= 31, "dayOfMonth", "dayOfMonth must be between 1 and 31")
+	} else {
+		dayOfMonth = 0
+	}
+
+	if err := v.Err(); err != nil {
+		return Schedule{}, err
+	}
+
+	return Schedule{
+		frequency:  frequency,
+		interval:   interval,
+		dayOfMonth: dayOfMonth,
+		startDate:  truncateToDate(startDate),
+	}, nil
+}
+
+func (s Schedule) Frequency() Frequency { return s.frequency }
+func (s Schedule) Interval() int32      { return s.interval }
+func (s Schedule) DayOfMonth() int32    { return s.dayOfMonth }
+func (s Schedule) StartDate() time.Time { return s.startDate }
+
+// Occurrences returns the dates of the schedule within [from, to), in chronological order.
+func (s Schedule) Occurrences(from time.Time, to time.Time) []time.Time {
+	if from.Before(s.startDate) {
+		from = s.startDate
+	}
+
+	if !from.Before(to) {
+		return nil
+	}
+
+	var dates []time.Time
+	for k := s.firstIndexFrom(from); ; k++ {
+		date := s.nth(k)
+		if !date.Before(to) {
+			return dates
+		}
+
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+	}
+}
+
+// Includes reports whether date is one of the dates of the schedule.
+func (s Schedule) Includes(date time.Time) bool {
+	date = s.onCalendar(date)
+	occurrences := s.Occurrences(date, date.AddDate(0, 0, 1))
+
+	return len(occurrences) == 1 && occurrences[0].Equal(date)
+}
+
+// nth returns the k-th occurrence counted from the start of the schedule, the 0-th may fall before startDate.
+func (s Schedule) nth(k int) time.Time {
+	if s.frequency == FrequencyWeekly {
+		return s.startDate.AddDate(0, 0, 7*int(s.interval)*k)
+	}
+
+	firstOfMonth := time.Date(s.startDate.Year(), s.startDate.Month()+time.Month(int(s.interval)*k), 1, 0, 0, 0, 0, s.startDate.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(int(s.dayOfMonth), lastDay)-1)
+}
+
+// firstIndexFrom returns an index whose occurrence is on or before the first occurrence from from,
+// so that scanning forward from it does not miss any date.
+func (s Schedule) firstIndexFrom(from time.Time) int {
+	if s.frequency == FrequencyWeekly {
+		days := int(from.Sub(s.startDate).Hours() / 24)
+		return max(days/(7*int(s.interval))-1, 0)
+	}
+
+	months := (from.Year()-s.startDate.Year())*12 + int(from.Month()) - int(s.startDate.Month())
+	return max(months/int(s.interval)-1, 0)
+}
+
+// onCalendar returns the day of date, whatever its location, on the calendar of the schedule.
+func (s Schedule) onCalendar(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, s.startDate.Location())
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,139 @@
+package recurring_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func TestNewSchedule(t *testing.T) {
+	t.Run("returns error when frequency is invalid", func(t *testing.T) {
+		_, err := recurring.NewSchedule("DAILY", 1, 1, date(2026, time.January, 1))
+		require.ErrorIs(t, err, recurring.ErrInvalidFrequency)
+	})
+
+	t.Run("returns error when interval is out of range", func(t *testing.T) {
+		_, err := recurring.NewSchedule("MONTHLY", 13, 1, date(2026, time.January, 1))
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when a monthly schedule has no day of month", func(t *testing.T) {
+		_, err := recurring.NewSchedule("MONTHLY", 1, 0, date(2026, time.January, 1))
+		require.Error(t, err)
+	})
+
+	t.Run("ignores the day of month of a weekly schedule", func(t *testing.T) {
+		s, err := recurring.NewSchedule("weekly", 1, 15, date(2026, time.January, 1).Add(10*time.Hour))
+		require.NoError(t, err)
+
+		assert.Equal(t, recurring.FrequencyWeekly, s.Frequency())
+		assert.Equal(t, int32(0), s.DayOfMonth())
+		assert.Equal(t, date(2026, time.January, 1), s.StartDate())
+	})
+}
+
+func TestSchedule_Occurrences(t *testing.T) {
+	testCases := []struct {
+		name       string
+		frequency  string
+		interval   int32
+		dayOfMonth int32
+		startDate  time.Time
+		from       time.Time
+		to         time.Time
+		expected   []time.Time
+	}{
+		{
+			name:       "moves day 31 to the end of shorter months",
+			frequency:  "MONTHLY",
+			interval:   1,
+			dayOfMonth: 31,
+			startDate:  date(2026, time.January, 1),
+			from:       date(2026, time.January, 1),
+			to:         date(2026, time.May, 1),
+			expected: []time.Time{
+				date(2026, time.January, 31),
+				date(2026, time.February, 28),
+				date(2026, time.March, 31),
+				date(2026, time.April, 30),
+			},
+		},
+		{
+			name:       "occurs every interval months from the month of the start date",
+			frequency:  "MONTHLY",
+			interval:   3,
+			dayOfMonth: 10,
+			startDate:  date(2026, time.February, 1),
+			from:       date(2026, time.January, 1),
+			to:         date(2027, time.January, 1),
+			expected: []time.Time{
+				date(2026, time.February, 10),
+				date(2026, time.May, 10),
+				date(2026, time.August, 10),
+				date(2026, time.November, 10),
+			},
+		},
+		{
+			name:       "skips the day of the start month that falls before the start date",
+			frequency:  "MONTHLY",
+			interval:   1,
+			dayOfMonth: 5,
+			startDate:  date(2026, time.March, 20),
+			from:       date(2026, time.March, 1),
+			to:         date(2026, time.June, 1),
+			expected: []time.Time{
+				date(2026, time.April, 5),
+				date(2026, time.May, 5),
+			},
+		},
+		{
+			name:      "occurs every interval weeks on the weekday of the start date",
+			frequency: "WEEKLY",
+			interval:  2,
+			startDate: date(2026, time.March, 2),
+			from:      date(2026, time.March, 10),
+			to:        date(2026, time.April, 14),
+			expected: []time.Time{
+				date(2026, time.March, 16),
+				date(2026, time.March, 30),
+				date(2026, time.April, 13),
+			},
+		},
+		{
+			name:       "excludes the end of the range",
+			frequency:  "MONTHLY",
+			interval:   1,
+			dayOfMonth: 1,
+			startDate:  date(2026, time.January, 1),
+			from:       date(2026, time.April, 1),
+			to:         date(2026, time.May, 1),
+			expected:   []time.Time{date(2026, time.April, 1)},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := recurring.NewSchedule(tt.frequency, tt.interval, tt.dayOfMonth, tt.startDate)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, s.Occurrences(tt.from, tt.to))
+		})
+	}
+}
+
+func TestSchedule_Includes(t *testing.T) {
+	s, err := recurring.NewSchedule("MONTHLY", 1, 31, date(2026, time.January, 1))
+	require.NoError(t, err)
+
+	assert.True(t, s.Includes(date(2026, time.February, 28)))
+	assert.True(t, s.Includes(time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, s.Includes(date(2026, time.March, 30)))
+	assert.False(t, s.Includes(date(2025, time.December, 31)))
+}
@@ -0,0 +1,311 @@
+package recurring
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransactionTypeNotRecurring = errors.New("only income and expense transaction types can recur")
+	ErrTemplateAlreadyPaused       = errors.New("recurring template is already paused")
+	ErrTemplateNotPaused           = errors.New("recurring template is not paused")
+	ErrNotAnOccurrence             = errors.New("date is not an occurrence of the schedule")
+	ErrOccurrenceAlreadyHandled    = errors.New("occurrence is already posted or skipped")
+)
+
+var (
+	StatusActive = Status{value: "ACTIVE"}
+	StatusPaused = Status{value: "PAUSED"}
+)
+
+type Status struct {
+	value string
+}
+
+func NewStatus(statusStr string) (Status, error) {
+	switch strings.TrimSpace(strings.ToUpper(statusStr)) {
+	case StatusActive.value:
+		return StatusActive, nil
+	case StatusPaused.value:
+		return StatusPaused, nil
+	}
+
+	return Status{}, fmt.Errorf("unknown recurring template status: %s", statusStr)
+}
+
+func (s Status) String() string { return s.value }
+
+// Template posts the same transaction into the open accounting period of a wallet on every date of its schedule.
+// Every date is handled at most once, either posted or skipped.
+type Template struct {
+	id              uuid.UUID
+	userID          string
+	walletID        uuid.UUID
+	fpID            uuid.UUID
+	amount          int64
+	transactionType ledger.TransactionType
+	category        *category.Category // optional
+	description     string
+	schedule        Schedule
+	status          Status
+	pausedAt        time.Time // zero unless paused
+	version         int32
+
+	// occurrences are the handled dates keyed by day, newOccurrences the ones handled since loading
+	occurrences    map[string]Occurrence
+	newOccurrences []Occurrence
+}
+
+// NewTemplate creates an active template of userID. c is optional and must accept the direction of transactionTypeStr.
+func NewTemplate(
+	userID string,
+	walletID uuid.UUID,
+	fpID uuid.UUID,
+	amount int64,
+	transactionTypeStr string,
+	c *category.Category,
+	description string,
+	schedule Schedule,
+) (*Template, error) {
+	description = strings.TrimSpace(description)
+
+	v := validator.New()
+
+	v.Required(userID, "userID")
+	v.Check(walletID != uuid.Nil, "walletID", "walletID is required")
+	v.Check(fpID != uuid.Nil, "fundProviderID", "fundProviderID is required")
+	v.Check(amount > 0, "amount", "amount must be positive")
+	v.Check(utf8.RuneCountInString(description) <= 255, "description", "description must not exceed 255 characters")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	transactionType, err := ledger.NewTransactionType(transactionTypeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !transactionType.IsIncomeOrExpense() {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionTypeNotRecurring, transactionType.String())
+	}
+
+	if c != nil {
+		if c.UserID() != userID {
+			return nil, fmt.Errorf("category '%s' does not belong to user '%s'", c.ID(), userID)
+		}
+
+		if err := c.Accepts(transactionType.IsExpense()); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create templateID: %w", err)
+	}
+
+	return &Template{
+		id:              id,
+		userID:          userID,
+		walletID:        walletID,
+		fpID:            fpID,
+		amount:          amount,
+		transactionType: transactionType,
+		category:        c,
+		description:     description,
+		schedule:        schedule,
+		status:          StatusActive,
+		occurrences:     make(map[string]Occurrence),
+	}, nil
+}
+
+func UnmarshalTemplateFromDatabase(
+	id uuid.UUID,
+	userID string,
+	walletID uuid.UUID,
+	fpID uuid.UUID,
+	amount int64,
+	transactionTypeStr string,
+	c *category.Category,
+	description string,
+	schedule Schedule,
+	statusStr string,
+	pausedAt time.Time,
+	version int32,
+	occurrences ...Occurrence,
+) (*Template, error) {
+	transactionType, err := ledger.NewTransactionType(transactionTypeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := NewStatus(statusStr)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Template{
+		id:              id,
+		userID:          userID,
+		walletID:        walletID,
+		fpID:            fpID,
+		amount:          amount,
+		transactionType: transactionType,
+		category:        c,
+		description:     description,
+		schedule:        schedule,
+		status:          status,
+		pausedAt:        pausedAt,
+		version:         version,
+		occurrences:     make(map[string]Occurrence, len(occurrences)),
+	}
+
+	for _, o := range occurrences {
+		t.occurrences[dateKey(o.date)] = o
+	}
+
+	return t, nil
+}
+
+func (t *Template) ID() uuid.UUID                           { return t.id }
+func (t *Template) UserID() string                          { return t.userID }
+func (t *Template) WalletID() uuid.UUID                     { return t.walletID }
+func (t *Template) FpID() uuid.UUID                         { return t.fpID }
+func (t *Template) Amount() int64                           { return t.amount }
+func (t *Template) TransactionType() ledger.TransactionType { return t.transactionType }
+func (t *Template) Category() *category.Category            { return t.category }
+func (t *Template) Description() string                     { return t.description }
+func (t *Template) Schedule() Schedule                      { return t.schedule }
+func (t *Template) Status() Status                          { return t.status }
+func (t *Template) PausedAt() time.Time                     { return t.pausedAt }
+func (t *Template) Version() int32                          { return t.version }
+func (t *Template) NewOccurrences() []Occurrence            { return t.newOccurrences }
+
+func (t *Template) IsPaused() bool { return t.status == StatusPaused }
+
+// Pause stops posting occurrences until the template is resumed.
+func (t *Template) Pause(pausedAt time.Time) error {
+	if t.IsPaused() {
+		return ErrTemplateAlreadyPaused
+	}
+
+	t.status = StatusPaused
+	t.pausedAt = pausedAt
+	return nil
+}
+
+// Resume restarts posting occurrences. The dates that came due while the template was paused are skipped.
+func (t *Template) Resume(resumedAt time.Time) error {
+	if !t.IsPaused() {
+		return ErrTemplateNotPaused
+	}
+
+	for _, date := range t.pending(t.pausedAt, resumedAt) {
+		t.handle(Occurrence{date: date, status: OccurrenceSkipped})
+	}
+
+	t.status = StatusActive
+	t.pausedAt = time.Time{}
+	return nil
+}
+
+// Skip makes sure the occurrence of date is never posted.
+func (t *Template) Skip(date time.Time) error {
+	if !t.schedule.Includes(date) {
+		return fmt.Errorf("%w: %s", ErrNotAnOccurrence, date.Format(time.DateOnly))
+	}
+
+	date = t.schedule.onCalendar(date)
+	if _, handled := t.occurrences[dateKey(date)]; handled {
+		return fmt.Errorf("%w: %s", ErrOccurrenceAlreadyHandled, date.Format(time.DateOnly))
+	}
+
+	t.handle(Occurrence{date: date, status: OccurrenceSkipped})
+	return nil
+}
+
+// Materialize posts the occurrences that are due by now into the latest accounting period of w, which must be open.
+// Only the dates within that period are posted, the rollover materializes the templates of a wallet
+// before closing its period so the dates near its end are not left out.
+// It returns the number of posted occurrences, none while the template is paused.
+func (t *Template) Materialize(w *wallet.Wallet, now time.Time) (int, error) {
+	if w.ID() != t.walletID {
+		return 0, fmt.Errorf("recurring template '%s' does not belong to wallet '%s'", t.id, w.ID())
+	}
+
+	if t.IsPaused() {
+		return 0, nil
+	}
+
+	ap, exist := w.LedgerManager().LatestAccountingPeriod()
+	if !exist || ap.IsClose() {
+		return 0, wallet.ErrNoOpenAccountingPeriod
+	}
+
+	to := now
+	if ap.EndDate().Before(to) {
+		to = ap.EndDate()
+	}
+
+	dates := t.pending(ap.StartTime(), to)
+	if len(dates) == 0 {
+		return 0, nil
+	}
+
+	txSpecs := make([]wallet.TransactionSpec, 0, len(dates))
+	for _, date := range dates {
+		txSpecs = append(txSpecs, wallet.TransactionSpec{
+			TransactionType: t.transactionType.String(),
+			Amount:          t.amount,
+			Description:     t.description,
+			FpID:            t.fpID,
+			OccurredAt:      date,
+			RecordedAt:      now,
+			Category:        t.category,
+		})
+	}
+
+	if err := w.RecordTransactions(ap.YearMonth(), txSpecs...); err != nil {
+		return 0, err
+	}
+
+	// RecordTransactions appends the records in the order of the specs
+	txRecords := ap.Transactions()
+	posted := txRecords[len(txRecords)-len(dates):]
+	for i, date := range dates {
+		t.handle(Occurrence{date: date, status: OccurrencePosted, transactionID: posted[i].ID()})
+	}
+
+	return len(dates), nil
+}
+
+// pending returns the dates of the schedule within [from, to) that are neither posted nor skipped.
+func (t *Template) pending(from time.Time, to time.Time) []time.Time {
+	var dates []time.Time
+	for _, date := range t.schedule.Occurrences(from, to) {
+		if _, handled := t.occurrences[dateKey(date)]; !handled {
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+func (t *Template) handle(o Occurrence) {
+	t.occurrences[dateKey(o.date)] = o
+	t.newOccurrences = append(t.newOccurrences, o)
+}
+
+func dateKey(date time.Time) string {
+	return date.Format(time.DateOnly)
+}
@@ -0,0 +1,207 @@
+package recurring_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/recurring"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplate(t *testing.T) {
+	schedule, err := recurring.NewSchedule("MONTHLY", 1, 5, date(2026, time.January, 1))
+	require.NoError(t, err)
+
+	income, err := category.UnmarshalCategoryFromDatabase(uuid.New(), "user-1", uuid.Nil, "Lương", "INCOME", 0)
+	require.NoError(t, err)
+
+	t.Run("returns error when the transaction type is neither income nor expense", func(t *testing.T) {
+		_, err := recurring.NewTemplate("user-1", uuid.New(), uuid.New(), 100, "ADJUSTMENT", nil, "", schedule)
+		require.ErrorIs(t, err, recurring.ErrTransactionTypeNotRecurring)
+	})
+
+	t.Run("returns error when the amount is not positive", func(t *testing.T) {
+		_, err := recurring.NewTemplate("user-1", uuid.New(), uuid.New(), 0, "WITHDRAWAL", nil, "", schedule)
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when the category does not accept the direction", func(t *testing.T) {
+		_, err := recurring.NewTemplate("user-1", uuid.New(), uuid.New(), 100, "WITHDRAWAL", income, "", schedule)
+		require.ErrorIs(t, err, category.ErrCategoryKindMismatch)
+	})
+
+	t.Run("creates an active template", func(t *testing.T) {
+		tmpl, err := recurring.NewTemplate("user-1", uuid.New(), uuid.New(), 100, "deposit", income, " Lương tháng ", schedule)
+		require.NoError(t, err)
+
+		assert.Equal(t, recurring.StatusActive, tmpl.Status())
+		assert.Equal(t, ledger.TransactionTypeDeposit, tmpl.TransactionType())
+		assert.Equal(t, "Lương tháng", tmpl.Description())
+	})
+}
+
+func TestTemplate_PauseResume(t *testing.T) {
+	newTemplate := func(t *testing.T) *recurring.Template {
+		t.Helper()
+
+		schedule, err := recurring.NewSchedule("MONTHLY", 1, 10, date(2026, time.January, 1))
+		require.NoError(t, err)
+
+		tmpl, err := recurring.NewTemplate("user-1", uuid.New(), uuid.New(), 100, "WITHDRAWAL", nil, "Tiền nhà", schedule)
+		require.NoError(t, err)
+
+		return tmpl
+	}
+
+	t.Run("returns error when pausing a paused template", func(t *testing.T) {
+		tmpl := newTemplate(t)
+
+		require.NoError(t, tmpl.Pause(date(2026, time.March, 1)))
+		require.ErrorIs(t, tmpl.Pause(date(2026, time.March, 2)), recurring.ErrTemplateAlreadyPaused)
+	})
+
+	t.Run("returns error when resuming an active template", func(t *testing.T) {
+		require.ErrorIs(t, newTemplate(t).Resume(date(2026, time.March, 1)), recurring.ErrTemplateNotPaused)
+	})
+
+	t.Run("skips the dates that came due while paused", func(t *testing.T) {
+		tmpl := newTemplate(t)
+
+		require.NoError(t, tmpl.Pause(date(2026, time.March, 1)))
+		require.NoError(t, tmpl.Resume(date(2026, time.May, 15)))
+
+		assert.False(t, tmpl.IsPaused())
+		assert.True(t, tmpl.PausedAt().IsZero())
+
+		require.Len(t, tmpl.NewOccurrences(), 3)
+		for i, o := range tmpl.NewOccurrences() {
+			assert.Equal(t, date(2026, time.March+time.Month(i), 10), o.Date())
+			assert.Equal(t, recurring.OccurrenceSkipped, o.Status())
+		}
+	})
+}
+
+func TestTemplate_Skip(t *testing.T) {
+	schedule, err := recurring.NewSchedule("WEEKLY", 1, 0, date(2026, time.March, 2))
+	require.NoError(t, err)
+
+	tmpl, err := recurring.NewTemplate("user-1", uuid.New(), uuid.New(), 100, "WITHDRAWAL", nil, "", schedule)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, tmpl.Skip(date(2026, time.March, 10)), recurring.ErrNotAnOccurrence)
+
+	require.NoError(t, tmpl.Skip(date(2026, time.March, 9)))
+	require.ErrorIs(t, tmpl.Skip(date(2026, time.March, 9)), recurring.ErrOccurrenceAlreadyHandled)
+
+	require.Len(t, tmpl.NewOccurrences(), 1)
+	assert.Equal(t, recurring.OccurrenceSkipped, tmpl.NewOccurrences()[0].Status())
+}
+
+func TestTemplate_Materialize(t *testing.T) {
+	startOfApril := date(2026, time.April, 1)
+
+	newWallet := func(t *testing.T, status string) (*wallet.Wallet, *fundprovider.FundProvider, *ledger.AccountingPeriod) {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), "2026,4", 1, 1, status, 1000, 0, 0, 0, 0, 1000, "USD",
+			startOfApril, startOfApril.AddDate(0, 1, 0), 0,
+		)
+		require.NoError(t, err)
+
+		provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 1000, 0, "USD", 1)
+		require.NoError(t, err)
+
+		allocation, err := wallet.NewFpAllocation(provider, 1000)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 1000, "USD", 0, 1, 1, []*ledger.AccountingPeriod{ap}, allocation)
+		require.NoError(t, err)
+
+		return w, provider, ap
+	}
+
+	newTemplate := func(t *testing.T, w *wallet.Wallet, fpID uuid.UUID) *recurring.Template {
+		t.Helper()
+
+		schedule, err := recurring.NewSchedule("WEEKLY", 1, 0, date(2026, time.March, 23))
+		require.NoError(t, err)
+
+		tmpl, err := recurring.NewTemplate("user-1", w.ID(), fpID, 100, "WITHDRAWAL", nil, "Đi chợ", schedule)
+		require.NoError(t, err)
+
+		return tmpl
+	}
+
+	t.Run("posts the dates of the open period that are due", func(t *testing.T) {
+		w, provider, ap := newWallet(t, "OPEN")
+		tmpl := newTemplate(t, w, provider.ID())
+
+		posted, err := tmpl.Materialize(w, date(2026, time.April, 14))
+		require.NoError(t, err)
+
+		// March 30 belongs to the previous period, April 13 is due and April 20 is not
+		assert.Equal(t, 2, posted)
+		require.Len(t, ap.Transactions(), 2)
+		assert.Equal(t, date(2026, time.April, 6), ap.Transactions()[0].OccurredAt())
+		assert.Equal(t, int64(800), w.Balance().Amount())
+
+		require.Len(t, tmpl.NewOccurrences(), 2)
+		assert.Equal(t, recurring.OccurrencePosted, tmpl.NewOccurrences()[1].Status())
+		assert.Equal(t, ap.Transactions()[1].ID(), tmpl.NewOccurrences()[1].TransactionID())
+	})
+
+	t.Run("posts every date only once", func(t *testing.T) {
+		w, provider, ap := newWallet(t, "OPEN")
+		tmpl := newTemplate(t, w, provider.ID())
+
+		_, err := tmpl.Materialize(w, date(2026, time.April, 14))
+		require.NoError(t, err)
+
+		posted, err := tmpl.Materialize(w, date(2026, time.April, 15))
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, posted)
+		assert.Len(t, ap.Transactions(), 2)
+	})
+
+	t.Run("does not post skipped dates", func(t *testing.T) {
+		w, provider, ap := newWallet(t, "OPEN")
+		tmpl := newTemplate(t, w, provider.ID())
+
+		require.NoError(t, tmpl.Skip(date(2026, time.April, 6)))
+
+		posted, err := tmpl.Materialize(w, date(2026, time.April, 14))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, posted)
+		require.Len(t, ap.Transactions(), 1)
+		assert.Equal(t, date(2026, time.April, 13), ap.Transactions()[0].OccurredAt())
+	})
+
+	t.Run("posts nothing while paused", func(t *testing.T) {
+		w, provider, ap := newWallet(t, "OPEN")
+		tmpl := newTemplate(t, w, provider.ID())
+
+		require.NoError(t, tmpl.Pause(date(2026, time.April, 1)))
+
+		posted, err := tmpl.Materialize(w, date(2026, time.April, 14))
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, posted)
+		assert.Empty(t, ap.Transactions())
+	})
+
+	t.Run("returns error when the latest period is closed", func(t *testing.T) {
+		w, provider, _ := newWallet(t, "CLOSE")
+
+		_, err := newTemplate(t, w, provider.ID()).Materialize(w, date(2026, time.April, 14))
+		require.ErrorIs(t, err, wallet.ErrNoOpenAccountingPeriod)
+	})
+}
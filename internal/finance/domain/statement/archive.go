@@ -0,0 +1,88 @@
+package statement
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotPDF              = errors.New("statement content is not a PDF document")
+	ErrAlreadyArchived     = errors.New("statement of the accounting period is already archived")
+	ErrPeriodNotArchivable = errors.New("only the statement of a closed accounting period can be archived")
+)
+
+// Archive is the PDF statement of a closed accounting period as it was first printed. It is never
+// rendered again, later changes to names or categories do not alter a statement handed out.
+type Archive struct {
+	id                 uuid.UUID
+	walletID           uuid.UUID
+	accountingPeriodID uuid.UUID
+	yearMonth          ledger.YearMonth
+	fileName           string
+	content            []byte
+	sha256             string
+	archivedAt         time.Time
+}
+
+func NewArchive(
+	walletID uuid.UUID,
+	accountingPeriodID uuid.UUID,
+	yearMonth ledger.YearMonth,
+	content []byte,
+	archivedAt time.Time,
+) (*Archive, error) {
+	v := validator.New()
+
+	v.Check(walletID != uuid.Nil, "walletID", "walletID is required")
+	v.Check(accountingPeriodID != uuid.Nil, "accountingPeriodID", "accountingPeriodID is required")
+	v.Check(!yearMonth.IsZero(), "yearMonth", "yearMonth is required")
+	v.Check(!archivedAt.IsZero(), "archivedAt", "archivedAt is required")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(content, []byte("%PDF-")) {
+		return nil, ErrNotPDF
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create statement archiveID: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	return &Archive{
+		id:                 id,
+		walletID:           walletID,
+		accountingPeriodID: accountingPeriodID,
+		yearMonth:          yearMonth,
+		fileName:           FileName(yearMonth),
+		content:            content,
+		sha256:             hex.EncodeToString(sum[:]),
+		archivedAt:         archivedAt,
+	}, nil
+}
+
+// FileName is the name a statement is downloaded as, statement-2026-4.pdf for the period 2026,4.
+func FileName(yearMonth ledger.YearMonth) string {
+	return fmt.Sprintf("statement-%d-%d.pdf", yearMonth.Year(), yearMonth.Month())
+}
+
+func (a *Archive) ID() uuid.UUID                 { return a.id }
+func (a *Archive) WalletID() uuid.UUID           { return a.walletID }
+func (a *Archive) AccountingPeriodID() uuid.UUID { return a.accountingPeriodID }
+func (a *Archive) YearMonth() ledger.YearMonth   { return a.yearMonth }
+func (a *Archive) FileName() string              { return a.fileName }
+func (a *Archive) Content() []byte               { return a.content }
+func (a *Archive) SHA256() string                { return a.sha256 }
+func (a *Archive) ArchivedAt() time.Time         { return a.archivedAt }
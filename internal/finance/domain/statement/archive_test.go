@@ -0,0 +1,37 @@
+package statement_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/statement"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewArchive(t *testing.T) {
+	yearMonth, err := ledger.NewYearMonth(4, 2026)
+	require.NoError(t, err)
+	archivedAt := time.Date(2026, time.May, 1, 0, 15, 0, 0, time.UTC)
+
+	t.Run("returns error when the content is not a PDF", func(t *testing.T) {
+		_, err := statement.NewArchive(uuid.New(), uuid.New(), yearMonth, []byte("<html>"), archivedAt)
+		require.ErrorIs(t, err, statement.ErrNotPDF)
+	})
+
+	t.Run("returns error when the period is missing", func(t *testing.T) {
+		_, err := statement.NewArchive(uuid.New(), uuid.Nil, yearMonth, []byte("%PDF-1.7"), archivedAt)
+		require.Error(t, err)
+	})
+
+	t.Run("names the file after the period and checksums the content", func(t *testing.T) {
+		a, err := statement.NewArchive(uuid.New(), uuid.New(), yearMonth, []byte("%PDF-1.7"), archivedAt)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, uuid.Nil, a.ID())
+		assert.Equal(t, "statement-2026-4.pdf", a.FileName())
+		assert.Equal(t, "86edbaa24831badfa0a8b04bb410141e2ee4182b6d0014493fe262a7a331c20b", a.SHA256())
+	})
+}
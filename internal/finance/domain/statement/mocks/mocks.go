@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	statement "sumni-finance-backend/internal/finance/domain/statement"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateArchive provides a mock function with given fields: ctx, a
+func (_m *MockRepository) CreateArchive(ctx context.Context, a *statement.Archive) error {
+	ret := _m.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateArchive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statement.Archive) error); ok {
+		r0 = rf(ctx, a)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_CreateArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateArchive'
+type MockRepository_CreateArchive_Call struct {
+	*mock.Call
+}
+
+// CreateArchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a *statement.Archive
+func (_e *MockRepository_Expecter) CreateArchive(ctx interface{}, a interface{}) *MockRepository_CreateArchive_Call {
+	return &MockRepository_CreateArchive_Call{Call: _e.mock.On("CreateArchive", ctx, a)}
+}
+
+func (_c *MockRepository_CreateArchive_Call) Run(run func(ctx context.Context, a *statement.Archive)) *MockRepository_CreateArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*statement.Archive))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateArchive_Call) Return(_a0 error) *MockRepository_CreateArchive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_CreateArchive_Call) RunAndReturn(run func(context.Context, *statement.Archive) error) *MockRepository_CreateArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
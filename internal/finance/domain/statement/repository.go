@@ -0,0 +1,9 @@
+package statement
+
+import "context"
+
+type Repository interface {
+	// CreateArchive stores a, it fails with ErrAlreadyArchived when the period already has a statement
+	// and with ErrPeriodNotArchivable when the period is not closed.
+	CreateArchive(ctx context.Context, a *Archive) error
+}
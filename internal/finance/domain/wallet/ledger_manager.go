@@ -3,8 +3,17 @@ package wallet
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sumni-finance-backend/internal/common/valueobject"
 	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidPeriodInterval = errors.New("period interval must be 1 (monthly), 2 (bi-monthly) or 3 (quarterly) months")
+	ErrLedgerConfigLocked    = errors.New("ledger config can only be changed when no accounting period is open")
 )
 
 type LedgerConfig struct {
@@ -12,18 +21,26 @@ type LedgerConfig struct {
 	interval  int32                 // month
 }
 
-func NewDefaultLedgerConfig() (LedgerConfig, error) {
-	startDate, err := ledger.NewPeriodStartDay(1)
+func NewLedgerConfig(startDay int32, interval int32) (LedgerConfig, error) {
+	startDate, err := ledger.NewPeriodStartDay(startDay)
 	if err != nil {
-		return LedgerConfig{}, nil
+		return LedgerConfig{}, err
+	}
+
+	if interval < 1 || interval > 3 {
+		return LedgerConfig{}, ErrInvalidPeriodInterval
 	}
 
 	return LedgerConfig{
 		startDate: startDate,
-		interval:  1,
+		interval:  interval,
 	}, nil
 }
 
+func NewDefaultLedgerConfig() (LedgerConfig, error) {
+	return NewLedgerConfig(1, 1)
+}
+
 func (lc LedgerConfig) StartDate() ledger.PeriodStartDay { return lc.startDate }
 func (lc LedgerConfig) Interval() int32                  { return lc.interval }
 
@@ -33,10 +50,9 @@ type LedgerManager struct {
 	accountPeriods map[ledger.YearMonth]*ledger.AccountingPeriod
 }
 
-func NewLedgerManager(accountPeriods []*ledger.AccountingPeriod) (*LedgerManager, error) {
-	ledgerConfig, err := NewDefaultLedgerConfig()
-	if err != nil {
-		return nil, err
+func NewLedgerManager(config LedgerConfig, accountPeriods []*ledger.AccountingPeriod) (*LedgerManager, error) {
+	if config.startDate.Value() == 0 || config.interval == 0 {
+		return nil, errors.New("ledger config is required")
 	}
 
 	// Initialize map with appropriate capacity
@@ -46,7 +62,7 @@ func NewLedgerManager(accountPeriods []*ledger.AccountingPeriod) (*LedgerManager
 	}
 
 	ledgerManager := &LedgerManager{
-		config:         ledgerConfig,
+		config:         config,
 		accountPeriods: make(map[ledger.YearMonth]*ledger.AccountingPeriod, capacity),
 	}
 
@@ -61,6 +77,22 @@ func NewLedgerManager(accountPeriods []*ledger.AccountingPeriod) (*LedgerManager
 	return ledgerManager, nil
 }
 
+func (m *LedgerManager) Config() LedgerConfig { return m.config }
+
+// ChangeConfig replaces the config used to open the next accounting periods.
+// The periods already opened keep their own start day and interval, so the config
+// can only change between periods, when none of the loaded periods is open.
+func (m *LedgerManager) ChangeConfig(config LedgerConfig) error {
+	for _, ap := range m.accountPeriods {
+		if !ap.IsClose() {
+			return fmt.Errorf("%w: %s is open", ErrLedgerConfigLocked, ap.YearMonth().String())
+		}
+	}
+
+	m.config = config
+	return nil
+}
+
 func (m *LedgerManager) FindAccountingPeriod(yearMonth ledger.YearMonth) (*ledger.AccountingPeriod, bool) {
 	ap, exist := m.accountPeriods[yearMonth]
 	if !exist || ap == nil {
@@ -70,9 +102,50 @@ func (m *LedgerManager) FindAccountingPeriod(yearMonth ledger.YearMonth) (*ledge
 	return ap, true
 }
 
+// FindAccountingPeriodCovering returns the loaded period whose time range contains t.
+func (m *LedgerManager) FindAccountingPeriodCovering(t time.Time) (*ledger.AccountingPeriod, bool) {
+	for _, ap := range m.accountPeriods {
+		if ap.Covers(t) {
+			return ap, true
+		}
+	}
+
+	return nil, false
+}
+
+// AccountingPeriods returns the loaded periods ordered by end date.
+func (m *LedgerManager) AccountingPeriods() []*ledger.AccountingPeriod {
+	periods := make([]*ledger.AccountingPeriod, 0, len(m.accountPeriods))
+	for _, ap := range m.accountPeriods {
+		periods = append(periods, ap)
+	}
+
+	slices.SortFunc(periods, func(a, b *ledger.AccountingPeriod) int {
+		return a.EndDate().Compare(b.EndDate())
+	})
+
+	return periods
+}
+
+// LatestAccountingPeriod returns the loaded period that ends last.
+func (m *LedgerManager) LatestAccountingPeriod() (*ledger.AccountingPeriod, bool) {
+	var latest *ledger.AccountingPeriod
+	for _, ap := range m.accountPeriods {
+		if latest == nil || ap.EndDate().After(latest.EndDate()) {
+			latest = ap
+		}
+	}
+
+	return latest, latest != nil
+}
+
+// OpenAccountingPeriod opens the yearMonth period with the current config.
+// When a previous period is loaded, the new period must directly follow it and carries forward its closing balance,
+// initialBalance is only used for the very first period of the wallet walletID.
 func (m *LedgerManager) OpenAccountingPeriod(
+	walletID uuid.UUID,
 	yearMonth ledger.YearMonth,
-	openBalance valueobject.Money,
+	initialBalance valueobject.Money,
 ) error {
 	if yearMonth.IsZero() {
 		return errors.New("open account period: year and month is required")
@@ -82,12 +155,22 @@ func (m *LedgerManager) OpenAccountingPeriod(
 		return fmt.Errorf("accounting period %s already opened", yearMonth.String())
 	}
 
-	newAccountingPeriod, err := ledger.OpenAccountingPeriod(
-		yearMonth,
-		openBalance,
-		m.config.startDate,
-		m.config.interval,
+	var (
+		newAccountingPeriod *ledger.AccountingPeriod
+		err                 error
 	)
+
+	if previous, exist := m.LatestAccountingPeriod(); exist {
+		newAccountingPeriod, err = previous.OpenNext(walletID, yearMonth, m.config.startDate, m.config.interval)
+	} else {
+		newAccountingPeriod, err = ledger.OpenAccountingPeriod(
+			walletID,
+			yearMonth,
+			initialBalance,
+			m.config.startDate,
+			m.config.interval,
+		)
+	}
 	if err != nil {
 		return fmt.Errorf("open new period: %w", err)
 	}
@@ -96,6 +179,23 @@ func (m *LedgerManager) OpenAccountingPeriod(
 	return nil
 }
 
+func (m *LedgerManager) CloseAccountingPeriod(
+	walletID uuid.UUID,
+	yearMonth ledger.YearMonth,
+	closedAt time.Time,
+) error {
+	ap, exist := m.FindAccountingPeriod(yearMonth)
+	if !exist {
+		return fmt.Errorf("account period %s not found", yearMonth.String())
+	}
+
+	if err := ap.CloseAccountingPeriod(walletID, closedAt); err != nil {
+		return fmt.Errorf("close period: %w", err)
+	}
+
+	return nil
+}
+
 func (m *LedgerManager) Record(yearMonth ledger.YearMonth, txRecord ledger.TransactionRecord) error {
 	ap, exist := m.FindAccountingPeriod(yearMonth)
 	if !exist {
@@ -104,3 +204,16 @@ func (m *LedgerManager) Record(yearMonth ledger.YearMonth, txRecord ledger.Trans
 
 	return ap.Record(txRecord)
 }
+
+func (m *LedgerManager) RecordInternalTransfer(
+	yearMonth ledger.YearMonth,
+	outRecord ledger.TransactionRecord,
+	inRecord ledger.TransactionRecord,
+) error {
+	ap, exist := m.FindAccountingPeriod(yearMonth)
+	if !exist {
+		return fmt.Errorf("account period %s not found", yearMonth.String())
+	}
+
+	return ap.RecordInternalTransfer(outRecord, inRecord)
+}
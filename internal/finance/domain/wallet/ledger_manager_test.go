@@ -5,7 +5,10 @@ import (
 	"sumni-finance-backend/internal/finance/domain/ledger"
 	"sumni-finance-backend/internal/finance/domain/wallet"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,7 +33,7 @@ func TestLedgerManager_OpenAccountingPeriod(t *testing.T) {
 			inputBalance:   validMoney,
 			setupManager: func(t *testing.T) *wallet.LedgerManager {
 				t.Helper()
-				m, err := wallet.NewLedgerManager(nil)
+				m, err := wallet.NewLedgerManager(NewDefaultLedgerConfig(t), nil)
 				require.NoError(t, err)
 				return m
 			},
@@ -43,7 +46,7 @@ func TestLedgerManager_OpenAccountingPeriod(t *testing.T) {
 			inputBalance:   valueobject.Money{},
 			setupManager: func(t *testing.T) *wallet.LedgerManager {
 				t.Helper()
-				m, err := wallet.NewLedgerManager(nil)
+				m, err := wallet.NewLedgerManager(NewDefaultLedgerConfig(t), nil)
 				require.NoError(t, err)
 				return m
 			},
@@ -55,9 +58,9 @@ func TestLedgerManager_OpenAccountingPeriod(t *testing.T) {
 			inputBalance:   validMoney,
 			setupManager: func(t *testing.T) *wallet.LedgerManager {
 				t.Helper()
-				m, err := wallet.NewLedgerManager(nil)
+				m, err := wallet.NewLedgerManager(NewDefaultLedgerConfig(t), nil)
 				require.NoError(t, err)
-				err = m.OpenAccountingPeriod(validYearMonth, validMoney)
+				err = m.OpenAccountingPeriod(uuid.New(), validYearMonth, validMoney)
 				require.NoError(t, err)
 				return m
 			},
@@ -70,7 +73,7 @@ func TestLedgerManager_OpenAccountingPeriod(t *testing.T) {
 			inputBalance:   validMoney,
 			setupManager: func(t *testing.T) *wallet.LedgerManager {
 				t.Helper()
-				m, err := wallet.NewLedgerManager(nil)
+				m, err := wallet.NewLedgerManager(NewDefaultLedgerConfig(t), nil)
 				require.NoError(t, err)
 				return m
 			},
@@ -84,7 +87,7 @@ func TestLedgerManager_OpenAccountingPeriod(t *testing.T) {
 			manager := tt.setupManager(t)
 
 			// Act
-			err := manager.OpenAccountingPeriod(tt.inputYearMonth, tt.inputBalance)
+			err := manager.OpenAccountingPeriod(uuid.New(), tt.inputYearMonth, tt.inputBalance)
 
 			// Assert
 			if tt.hasErr {
@@ -103,6 +106,101 @@ func TestLedgerManager_OpenAccountingPeriod(t *testing.T) {
 	}
 }
 
+func TestLedgerManager_OpenAccountingPeriodWithConfig(t *testing.T) {
+	validCurrency, err := valueobject.NewCurrency("VND")
+	require.NoError(t, err)
+	validMoney, err := valueobject.NewMoney(1_000_000, validCurrency)
+	require.NoError(t, err)
+
+	ledgerConfig, err := wallet.NewLedgerConfig(25, 3)
+	require.NoError(t, err)
+
+	m, err := wallet.NewLedgerManager(ledgerConfig, nil)
+	require.NoError(t, err)
+
+	yearMonth := NewValidYearMonth(t, 4, 2026)
+	require.NoError(t, m.OpenAccountingPeriod(uuid.New(), yearMonth, validMoney))
+
+	ap, exists := m.FindAccountingPeriod(yearMonth)
+	require.True(t, exists)
+	assert.Equal(t, int32(25), ap.StartDate().Value())
+	assert.Equal(t, int32(3), ap.Interval())
+	assert.Equal(t, time.Date(2026, time.April, 25, 0, 0, 0, 0, time.Local), ap.StartTime())
+	assert.Equal(t, time.Date(2026, time.July, 25, 0, 0, 0, 0, time.Local), ap.EndDate())
+}
+
+func TestNewLedgerConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		startDay int32
+		interval int32
+		hasErr   bool
+	}{
+		{name: "returns error when start day is zero", startDay: 0, interval: 1, hasErr: true},
+		{name: "returns error when start day is after the 28th", startDay: 29, interval: 1, hasErr: true},
+		{name: "returns error when interval is zero", startDay: 1, interval: 0, hasErr: true},
+		{name: "returns error when interval is longer than a quarter", startDay: 1, interval: 4, hasErr: true},
+		{name: "creates monthly config", startDay: 25, interval: 1},
+		{name: "creates bi-monthly config", startDay: 1, interval: 2},
+		{name: "creates quarterly config", startDay: 28, interval: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledgerConfig, err := wallet.NewLedgerConfig(tt.startDay, tt.interval)
+
+			if tt.hasErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.startDay, ledgerConfig.StartDate().Value())
+			assert.Equal(t, tt.interval, ledgerConfig.Interval())
+		})
+	}
+}
+
+func TestLedgerManager_ChangeConfig(t *testing.T) {
+	validCurrency, err := valueobject.NewCurrency("VND")
+	require.NoError(t, err)
+	validMoney, err := valueobject.NewMoney(1_000_000, validCurrency)
+	require.NoError(t, err)
+
+	salaryConfig, err := wallet.NewLedgerConfig(25, 1)
+	require.NoError(t, err)
+
+	t.Run("returns error while an accounting period is open", func(t *testing.T) {
+		m, err := wallet.NewLedgerManager(NewDefaultLedgerConfig(t), nil)
+		require.NoError(t, err)
+		require.NoError(t, m.OpenAccountingPeriod(uuid.New(), NewValidYearMonth(t, 4, 2026), validMoney))
+
+		err = m.ChangeConfig(salaryConfig)
+
+		require.ErrorIs(t, err, wallet.ErrLedgerConfigLocked)
+		assert.Equal(t, int32(1), m.Config().StartDate().Value())
+	})
+
+	t.Run("changes config between periods", func(t *testing.T) {
+		yearMonth := NewValidYearMonth(t, 4, 2026)
+
+		m, err := wallet.NewLedgerManager(NewDefaultLedgerConfig(t), nil)
+		require.NoError(t, err)
+		require.NoError(t, m.OpenAccountingPeriod(uuid.New(), yearMonth, validMoney))
+		require.NoError(t, m.CloseAccountingPeriod(uuid.New(), yearMonth, time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local)))
+
+		require.NoError(t, m.ChangeConfig(salaryConfig))
+		assert.Equal(t, int32(25), m.Config().StartDate().Value())
+
+		nextYearMonth := NewValidYearMonth(t, 5, 2026)
+		require.NoError(t, m.OpenAccountingPeriod(uuid.New(), nextYearMonth, validMoney))
+
+		ap, exists := m.FindAccountingPeriod(nextYearMonth)
+		require.True(t, exists)
+		assert.Equal(t, int32(25), ap.StartDate().Value())
+	})
+}
+
 func NewValidYearMonth(t *testing.T, month, year int) ledger.YearMonth {
 	t.Helper()
 
@@ -111,3 +209,12 @@ func NewValidYearMonth(t *testing.T, month, year int) ledger.YearMonth {
 
 	return yearMonth
 }
+
+func NewDefaultLedgerConfig(t *testing.T) wallet.LedgerConfig {
+	t.Helper()
+
+	ledgerConfig, err := wallet.NewDefaultLedgerConfig()
+	require.NoError(t, err)
+
+	return ledgerConfig
+}
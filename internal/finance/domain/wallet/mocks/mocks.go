@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 
 	wallet "sumni-finance-backend/internal/finance/domain/wallet"
@@ -26,17 +28,17 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, _a1
-func (_m *MockRepository) Create(ctx context.Context, _a1 *wallet.Wallet) error {
-	ret := _m.Called(ctx, _a1)
+// Create provides a mock function with given fields: ctx, userID, _a2
+func (_m *MockRepository) Create(ctx context.Context, userID string, _a2 *wallet.Wallet) error {
+	ret := _m.Called(ctx, userID, _a2)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *wallet.Wallet) error); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *wallet.Wallet) error); ok {
+		r0 = rf(ctx, userID, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -51,14 +53,15 @@ type MockRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 *wallet.Wallet
-func (_e *MockRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockRepository_Create_Call {
-	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+//   - userID string
+//   - _a2 *wallet.Wallet
+func (_e *MockRepository_Expecter) Create(ctx interface{}, userID interface{}, _a2 interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, userID, _a2)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, _a1 *wallet.Wallet)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, userID string, _a2 *wallet.Wallet)) *MockRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*wallet.Wallet))
+		run(args[0].(context.Context), args[1].(string), args[2].(*wallet.Wallet))
 	})
 	return _c
 }
@@ -68,7 +71,7 @@ func (_c *MockRepository_Create_Call) Return(_a0 error) *MockRepository_Create_C
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *wallet.Wallet) error) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, string, *wallet.Wallet) error) *MockRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -172,6 +175,57 @@ func (_c *MockRepository_CreateTransactionRecords_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CreateTransfer provides a mock function with given fields: ctx, srcWID, dstWID, fpID, occurredAt, transferFunc
+func (_m *MockRepository) CreateTransfer(ctx context.Context, srcWID uuid.UUID, dstWID uuid.UUID, fpID uuid.UUID, occurredAt time.Time, transferFunc func(*wallet.Wallet, *wallet.Wallet) error) error {
+	ret := _m.Called(ctx, srcWID, dstWID, fpID, occurredAt, transferFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransfer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, func(*wallet.Wallet, *wallet.Wallet) error) error); ok {
+		r0 = rf(ctx, srcWID, dstWID, fpID, occurredAt, transferFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_CreateTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransfer'
+type MockRepository_CreateTransfer_Call struct {
+	*mock.Call
+}
+
+// CreateTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - srcWID uuid.UUID
+//   - dstWID uuid.UUID
+//   - fpID uuid.UUID
+//   - occurredAt time.Time
+//   - transferFunc func(*wallet.Wallet , *wallet.Wallet) error
+func (_e *MockRepository_Expecter) CreateTransfer(ctx interface{}, srcWID interface{}, dstWID interface{}, fpID interface{}, occurredAt interface{}, transferFunc interface{}) *MockRepository_CreateTransfer_Call {
+	return &MockRepository_CreateTransfer_Call{Call: _e.mock.On("CreateTransfer", ctx, srcWID, dstWID, fpID, occurredAt, transferFunc)}
+}
+
+func (_c *MockRepository_CreateTransfer_Call) Run(run func(ctx context.Context, srcWID uuid.UUID, dstWID uuid.UUID, fpID uuid.UUID, occurredAt time.Time, transferFunc func(*wallet.Wallet, *wallet.Wallet) error)) *MockRepository_CreateTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time), args[5].(func(*wallet.Wallet, *wallet.Wallet) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateTransfer_Call) Return(_a0 error) *MockRepository_CreateTransfer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_CreateTransfer_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time, func(*wallet.Wallet, *wallet.Wallet) error) error) *MockRepository_CreateTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, wID
 func (_m *MockRepository) GetByID(ctx context.Context, wID uuid.UUID) (*wallet.Wallet, error) {
 	ret := _m.Called(ctx, wID)
@@ -291,6 +345,65 @@ func (_c *MockRepository_GetByIDWithAccountingPeriod_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetByIDWithLatestAccountingPeriod provides a mock function with given fields: ctx, wID
+func (_m *MockRepository) GetByIDWithLatestAccountingPeriod(ctx context.Context, wID uuid.UUID) (*wallet.Wallet, error) {
+	ret := _m.Called(ctx, wID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithLatestAccountingPeriod")
+	}
+
+	var r0 *wallet.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*wallet.Wallet, error)); ok {
+		return rf(ctx, wID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *wallet.Wallet); ok {
+		r0 = rf(ctx, wID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wallet.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, wID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetByIDWithLatestAccountingPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithLatestAccountingPeriod'
+type MockRepository_GetByIDWithLatestAccountingPeriod_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithLatestAccountingPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+func (_e *MockRepository_Expecter) GetByIDWithLatestAccountingPeriod(ctx interface{}, wID interface{}) *MockRepository_GetByIDWithLatestAccountingPeriod_Call {
+	return &MockRepository_GetByIDWithLatestAccountingPeriod_Call{Call: _e.mock.On("GetByIDWithLatestAccountingPeriod", ctx, wID)}
+}
+
+func (_c *MockRepository_GetByIDWithLatestAccountingPeriod_Call) Run(run func(ctx context.Context, wID uuid.UUID)) *MockRepository_GetByIDWithLatestAccountingPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByIDWithLatestAccountingPeriod_Call) Return(_a0 *wallet.Wallet, _a1 error) *MockRepository_GetByIDWithLatestAccountingPeriod_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetByIDWithLatestAccountingPeriod_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*wallet.Wallet, error)) *MockRepository_GetByIDWithLatestAccountingPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByIDWithProviders provides a mock function with given fields: ctx, wID, spec
 func (_m *MockRepository) GetByIDWithProviders(ctx context.Context, wID uuid.UUID, spec wallet.ProviderAllocationSpec) (*wallet.Wallet, error) {
 	ret := _m.Called(ctx, wID, spec)
@@ -351,6 +464,200 @@ func (_c *MockRepository_GetByIDWithProviders_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ReverseTransactionRecord provides a mock function with given fields: ctx, wID, txID, reverseFunc
+func (_m *MockRepository) ReverseTransactionRecord(ctx context.Context, wID uuid.UUID, txID uuid.UUID, reverseFunc func(*wallet.Wallet, *ledger.TransactionRecord) error) error {
+	ret := _m.Called(ctx, wID, txID, reverseFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReverseTransactionRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, func(*wallet.Wallet, *ledger.TransactionRecord) error) error); ok {
+		r0 = rf(ctx, wID, txID, reverseFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_ReverseTransactionRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReverseTransactionRecord'
+type MockRepository_ReverseTransactionRecord_Call struct {
+	*mock.Call
+}
+
+// ReverseTransactionRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+//   - txID uuid.UUID
+//   - reverseFunc func(*wallet.Wallet , *ledger.TransactionRecord) error
+func (_e *MockRepository_Expecter) ReverseTransactionRecord(ctx interface{}, wID interface{}, txID interface{}, reverseFunc interface{}) *MockRepository_ReverseTransactionRecord_Call {
+	return &MockRepository_ReverseTransactionRecord_Call{Call: _e.mock.On("ReverseTransactionRecord", ctx, wID, txID, reverseFunc)}
+}
+
+func (_c *MockRepository_ReverseTransactionRecord_Call) Run(run func(ctx context.Context, wID uuid.UUID, txID uuid.UUID, reverseFunc func(*wallet.Wallet, *ledger.TransactionRecord) error)) *MockRepository_ReverseTransactionRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(func(*wallet.Wallet, *ledger.TransactionRecord) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReverseTransactionRecord_Call) Return(_a0 error) *MockRepository_ReverseTransactionRecord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_ReverseTransactionRecord_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, func(*wallet.Wallet, *ledger.TransactionRecord) error) error) *MockRepository_ReverseTransactionRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAllocations provides a mock function with given fields: ctx, wID, allocationSpec, updateFunc
+func (_m *MockRepository) UpdateAllocations(ctx context.Context, wID uuid.UUID, allocationSpec wallet.ProviderAllocationSpec, updateFunc func(*wallet.Wallet) error) error {
+	ret := _m.Called(ctx, wID, allocationSpec, updateFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAllocations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, wallet.ProviderAllocationSpec, func(*wallet.Wallet) error) error); ok {
+		r0 = rf(ctx, wID, allocationSpec, updateFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateAllocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAllocations'
+type MockRepository_UpdateAllocations_Call struct {
+	*mock.Call
+}
+
+// UpdateAllocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+//   - allocationSpec wallet.ProviderAllocationSpec
+//   - updateFunc func(*wallet.Wallet) error
+func (_e *MockRepository_Expecter) UpdateAllocations(ctx interface{}, wID interface{}, allocationSpec interface{}, updateFunc interface{}) *MockRepository_UpdateAllocations_Call {
+	return &MockRepository_UpdateAllocations_Call{Call: _e.mock.On("UpdateAllocations", ctx, wID, allocationSpec, updateFunc)}
+}
+
+func (_c *MockRepository_UpdateAllocations_Call) Run(run func(ctx context.Context, wID uuid.UUID, allocationSpec wallet.ProviderAllocationSpec, updateFunc func(*wallet.Wallet) error)) *MockRepository_UpdateAllocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(wallet.ProviderAllocationSpec), args[3].(func(*wallet.Wallet) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateAllocations_Call) Return(_a0 error) *MockRepository_UpdateAllocations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateAllocations_Call) RunAndReturn(run func(context.Context, uuid.UUID, wallet.ProviderAllocationSpec, func(*wallet.Wallet) error) error) *MockRepository_UpdateAllocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLedgerConfig provides a mock function with given fields: ctx, wID, updateFunc
+func (_m *MockRepository) UpdateLedgerConfig(ctx context.Context, wID uuid.UUID, updateFunc func(*wallet.Wallet) error) error {
+	ret := _m.Called(ctx, wID, updateFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLedgerConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*wallet.Wallet) error) error); ok {
+		r0 = rf(ctx, wID, updateFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateLedgerConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLedgerConfig'
+type MockRepository_UpdateLedgerConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateLedgerConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+//   - updateFunc func(*wallet.Wallet) error
+func (_e *MockRepository_Expecter) UpdateLedgerConfig(ctx interface{}, wID interface{}, updateFunc interface{}) *MockRepository_UpdateLedgerConfig_Call {
+	return &MockRepository_UpdateLedgerConfig_Call{Call: _e.mock.On("UpdateLedgerConfig", ctx, wID, updateFunc)}
+}
+
+func (_c *MockRepository_UpdateLedgerConfig_Call) Run(run func(ctx context.Context, wID uuid.UUID, updateFunc func(*wallet.Wallet) error)) *MockRepository_UpdateLedgerConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*wallet.Wallet) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateLedgerConfig_Call) Return(_a0 error) *MockRepository_UpdateLedgerConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateLedgerConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*wallet.Wallet) error) error) *MockRepository_UpdateLedgerConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWithLatestAccountingPeriod provides a mock function with given fields: ctx, wID, updateFunc
+func (_m *MockRepository) UpdateWithLatestAccountingPeriod(ctx context.Context, wID uuid.UUID, updateFunc func(*wallet.Wallet) error) error {
+	ret := _m.Called(ctx, wID, updateFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithLatestAccountingPeriod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*wallet.Wallet) error) error); ok {
+		r0 = rf(ctx, wID, updateFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateWithLatestAccountingPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithLatestAccountingPeriod'
+type MockRepository_UpdateWithLatestAccountingPeriod_Call struct {
+	*mock.Call
+}
+
+// UpdateWithLatestAccountingPeriod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wID uuid.UUID
+//   - updateFunc func(*wallet.Wallet) error
+func (_e *MockRepository_Expecter) UpdateWithLatestAccountingPeriod(ctx interface{}, wID interface{}, updateFunc interface{}) *MockRepository_UpdateWithLatestAccountingPeriod_Call {
+	return &MockRepository_UpdateWithLatestAccountingPeriod_Call{Call: _e.mock.On("UpdateWithLatestAccountingPeriod", ctx, wID, updateFunc)}
+}
+
+func (_c *MockRepository_UpdateWithLatestAccountingPeriod_Call) Run(run func(ctx context.Context, wID uuid.UUID, updateFunc func(*wallet.Wallet) error)) *MockRepository_UpdateWithLatestAccountingPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*wallet.Wallet) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateWithLatestAccountingPeriod_Call) Return(_a0 error) *MockRepository_UpdateWithLatestAccountingPeriod_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateWithLatestAccountingPeriod_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*wallet.Wallet) error) error) *MockRepository_UpdateWithLatestAccountingPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepository(t interface {
@@ -71,3 +71,53 @@ func (pa *FpAllocation) WithdrawFundProviderAndAllocation(amount valueobject.Mon
 	pa.allocated = newAllocated
 	return nil
 }
+
+// Reserve takes more of the fund provider's unallocated balance into the allocation.
+func (pa *FpAllocation) Reserve(amount valueobject.Money) error {
+	if err := pa.fp.Reserve(amount); err != nil {
+		return err
+	}
+
+	return pa.increaseAllocated(amount)
+}
+
+// Release gives part of the allocation back to the fund provider's unallocated balance.
+func (pa *FpAllocation) Release(amount valueobject.Money) error {
+	if amount.GreaterThan(pa.allocated) {
+		return ErrInsufficientAllocated
+	}
+
+	if err := pa.fp.Release(amount); err != nil {
+		return err
+	}
+
+	return pa.decreaseAllocated(amount)
+}
+
+// increaseAllocated moves amount into the allocation without touching the fund provider,
+// the amount must come from another allocation of the same fund provider.
+func (pa *FpAllocation) increaseAllocated(amount valueobject.Money) error {
+	newAllocated, err := pa.allocated.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	pa.allocated = newAllocated
+	return nil
+}
+
+// decreaseAllocated moves amount out of the allocation without touching the fund provider,
+// the amount must go to another allocation of the same fund provider.
+func (pa *FpAllocation) decreaseAllocated(amount valueobject.Money) error {
+	if amount.GreaterThan(pa.allocated) {
+		return ErrInsufficientAllocated
+	}
+
+	newAllocated, err := pa.allocated.Subtract(amount)
+	if err != nil {
+		return err
+	}
+
+	pa.allocated = newAllocated
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 
 type FundProviderAllocationManager struct {
 	fpAllocations map[uuid.UUID]*FpAllocation
+
+	// removedFpAllocations keeps the allocations removed from the wallet until they are persisted
+	removedFpAllocations []*FpAllocation
 }
 
 func NewFpAllocationManager(fpAllocations []*FpAllocation) (*FundProviderAllocationManager, error) {
@@ -54,6 +57,18 @@ func (m *FundProviderAllocationManager) FpAllocations() []FpAllocation {
 	return fpAllocations
 }
 
+// RemovedFpAllocations returns the allocations removed by RemoveFundProviderAndRelease.
+// Their fund providers hold the released balance and still need to be persisted.
+func (m *FundProviderAllocationManager) RemovedFpAllocations() []FpAllocation {
+	fpAllocations := make([]FpAllocation, 0, len(m.removedFpAllocations))
+
+	for _, allocation := range m.removedFpAllocations {
+		fpAllocations = append(fpAllocations, *allocation)
+	}
+
+	return fpAllocations
+}
+
 // FindFundProviderAllocation returns the ProviderAllocation for the given fund provider ID.
 // When the bool return value is true, the returned *ProviderAllocation and its provider are guaranteed to be non-nil.
 func (m *FundProviderAllocationManager) FindFundProviderAllocation(fpID uuid.UUID) (*FpAllocation, bool) {
@@ -90,3 +105,42 @@ func (m *FundProviderAllocationManager) AddFundProviderAndReserve(
 	m.fpAllocations[fp.ID()] = fpAllocation
 	return nil
 }
+
+// findOrAddEmptyAllocation returns the allocation of fp, registering an empty one when the wallet has none.
+// It does not reserve anything from the fund provider.
+func (m *FundProviderAllocationManager) findOrAddEmptyAllocation(fp *fundprovider.FundProvider) (*FpAllocation, error) {
+	if fp == nil {
+		return nil, errors.New("fund provider is nil")
+	}
+
+	if allocation, exist := m.FindFundProviderAllocation(fp.ID()); exist {
+		return allocation, nil
+	}
+
+	allocation, err := NewFpAllocation(fp, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	m.fpAllocations[fp.ID()] = allocation
+	return allocation, nil
+}
+
+// RemoveFundProviderAndRelease releases the whole allocation back to the fund provider and removes it.
+// It returns the released amount.
+func (m *FundProviderAllocationManager) RemoveFundProviderAndRelease(fpID uuid.UUID) (valueobject.Money, error) {
+	allocation, exist := m.FindFundProviderAllocation(fpID)
+	if !exist {
+		return valueobject.Money{}, ErrFundAllocatedNotFound{FpID: fpID.String()}
+	}
+
+	released := allocation.allocated
+	if err := allocation.Release(released); err != nil {
+		return valueobject.Money{}, err
+	}
+
+	delete(m.fpAllocations, fpID)
+	m.removedFpAllocations = append(m.removedFpAllocations, allocation)
+
+	return released, nil
+}
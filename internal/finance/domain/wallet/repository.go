@@ -3,6 +3,7 @@ package wallet
 import (
 	"context"
 	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -25,7 +26,22 @@ type Repository interface {
 		yearMonth ledger.YearMonth,
 	) (*Wallet, error)
 
-	Create(ctx context.Context, wallet *Wallet) error
+	// GetByIDWithLatestAccountingPeriod loads the wallet with the period that ends last, if any.
+	GetByIDWithLatestAccountingPeriod(
+		ctx context.Context,
+		wID uuid.UUID,
+	) (*Wallet, error)
+
+	// UpdateWithLatestAccountingPeriod loads the wallet with the period that ends last and applies updateFunc.
+	// The loaded period is saved and the periods opened by updateFunc are created atomically.
+	UpdateWithLatestAccountingPeriod(
+		ctx context.Context,
+		wID uuid.UUID,
+		updateFunc func(w *Wallet) error,
+	) error
+
+	// Create stores the wallet owned by the user userID
+	Create(ctx context.Context, userID string, wallet *Wallet) error
 
 	CreateAllocations(
 		ctx context.Context,
@@ -34,6 +50,23 @@ type Repository interface {
 		allocatedFunc func(*Wallet) error,
 	) error
 
+	// UpdateAllocations loads the wallet with the allocations matching allocationSpec, applies updateFunc
+	// and saves the wallet balance, the allocations and their fund providers. Removed allocations are deleted.
+	UpdateAllocations(
+		ctx context.Context,
+		wID uuid.UUID,
+		allocationSpec ProviderAllocationSpec,
+		updateFunc func(w *Wallet) error,
+	) error
+
+	// UpdateLedgerConfig loads the wallet with its open accounting periods, applies updateFunc
+	// and saves the ledger config of the wallet.
+	UpdateLedgerConfig(
+		ctx context.Context,
+		wID uuid.UUID,
+		updateFunc func(w *Wallet) error,
+	) error
+
 	CreateTransactionRecords(
 		ctx context.Context,
 		wID uuid.UUID,
@@ -41,4 +74,25 @@ type Repository interface {
 		yearMonth ledger.YearMonth,
 		updateFunc func(w *Wallet) error,
 	) error
+
+	// ReverseTransactionRecord loads the txID record of the wallet, the wallet with the allocation of its fund provider,
+	// the period of the record and the latest period, applies reverseFunc and saves the reversal together with
+	// the reversed record atomically.
+	ReverseTransactionRecord(
+		ctx context.Context,
+		wID uuid.UUID,
+		txID uuid.UUID,
+		reverseFunc func(w *Wallet, original *ledger.TransactionRecord) error,
+	) error
+
+	// CreateTransfer loads both wallets with their allocation of fpID and their own period containing occurredAt,
+	// applies transferFunc and saves both wallets atomically.
+	CreateTransfer(
+		ctx context.Context,
+		srcWID uuid.UUID,
+		dstWID uuid.UUID,
+		fpID uuid.UUID,
+		occurredAt time.Time,
+		transferFunc func(src *Wallet, dst *Wallet) error,
+	) error
 }
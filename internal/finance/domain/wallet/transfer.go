@@ -0,0 +1,261 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferToSameWallet       = errors.New("can not transfer to the same wallet")
+	ErrTransferToSameFundProvider = errors.New("can not transfer to the same fund provider")
+)
+
+type TransferSpec struct {
+	FpID          uuid.UUID
+	Amount        int64
+	TransactionNo string
+	Description   string
+	OccurredAt    time.Time
+	RecordedAt    time.Time
+}
+
+type FundProviderTransferSpec struct {
+	FromFpID      uuid.UUID
+	ToFpID        uuid.UUID
+	Amount        int64
+	Fee           int64 // optional, charged on FromFpID on top of Amount
+	TransactionNo string
+	Description   string
+	OccurredAt    time.Time
+	RecordedAt    time.Time
+}
+
+// TransferBetweenFundProviders moves money from one fund provider of the wallet to another, e.g. an ATM withdrawal.
+// Both fund provider balances and allocations change, the wallet balance and the period totals do not.
+// The optional fee leaves the wallet and is booked as a FEE expense linked to the TRANSFER_OUT record.
+func (w *Wallet) TransferBetweenFundProviders(yearMonth ledger.YearMonth, spec FundProviderTransferSpec) error {
+	v := validator.New()
+
+	v.Check(spec.FromFpID != uuid.Nil, "fromFpID", "fromFpID is required")
+	v.Check(spec.ToFpID != uuid.Nil, "toFpID", "toFpID is required")
+	v.Check(spec.Amount > 0, "amount", "amount must be positive")
+	v.Check(spec.Fee >= 0, "fee", "fee must be greater or equal 0")
+
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	if spec.FromFpID == spec.ToFpID {
+		return ErrTransferToSameFundProvider
+	}
+
+	if err := w.ensureAccountingPeriodOpen(yearMonth); err != nil {
+		return err
+	}
+
+	fromAllocation, exist := w.fpAllocationManager.FindFundProviderAllocation(spec.FromFpID)
+	if !exist {
+		return ErrFundAllocatedNotFound{FpID: spec.FromFpID.String()}
+	}
+
+	toAllocation, exist := w.fpAllocationManager.FindFundProviderAllocation(spec.ToFpID)
+	if !exist {
+		return ErrFundAllocatedNotFound{FpID: spec.ToFpID.String()}
+	}
+
+	amount, err := valueobject.NewMoney(spec.Amount, w.Currency())
+	if err != nil {
+		return err
+	}
+
+	if err = fromAllocation.WithdrawFundProviderAndAllocation(amount); err != nil {
+		return fmt.Errorf("transfer out of fund provider %s: %w", spec.FromFpID, err)
+	}
+
+	if err = toAllocation.TopUpFundProviderAndAllocation(amount); err != nil {
+		return fmt.Errorf("transfer into fund provider %s: %w", spec.ToFpID, err)
+	}
+
+	outRecord, err := ledger.NewTransactionRecord(
+		spec.TransactionNo,
+		ledger.TransactionTypeTransferOut.String(),
+		"",
+		amount,
+		spec.Description,
+		spec.FromFpID,
+		spec.OccurredAt,
+		spec.RecordedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	inRecord, err := ledger.NewTransactionRecord(
+		spec.TransactionNo,
+		ledger.TransactionTypeTransferIn.String(),
+		"",
+		amount,
+		spec.Description,
+		spec.ToFpID,
+		spec.OccurredAt,
+		spec.RecordedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	outRecord.LinkTo(inRecord.ID())
+	outRecord.SetWalletBalance(w.balance)
+	outRecord.SetFpBalance(fromAllocation.fp.Balance())
+
+	inRecord.LinkTo(outRecord.ID())
+	inRecord.SetWalletBalance(w.balance)
+	inRecord.SetFpBalance(toAllocation.fp.Balance())
+
+	if err = w.ledgerManager.RecordInternalTransfer(yearMonth, *outRecord, *inRecord); err != nil {
+		return err
+	}
+
+	w.recordTransactionRecorded(yearMonth, *outRecord)
+	w.recordTransactionRecorded(yearMonth, *inRecord)
+
+	if spec.Fee == 0 {
+		return nil
+	}
+
+	feeRecord, err := w.buildTransactionRecordsFromSpec(TransactionSpec{
+		TransactionNo:   spec.TransactionNo,
+		TransactionType: ledger.TransactionTypeFee.String(),
+		Amount:          spec.Fee,
+		Description:     "Transfer fee",
+		FpID:            spec.FromFpID,
+		OccurredAt:      spec.OccurredAt,
+		RecordedAt:      spec.RecordedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to charge transfer fee: %w", err)
+	}
+
+	feeRecord.LinkTo(outRecord.ID())
+
+	return w.record(yearMonth, feeRecord)
+}
+
+// TransferBetweenWallets moves part of src's allocation of a fund provider to dst.
+// The fund provider balance is untouched, only the split between the wallets changes.
+// A TRANSFER_OUT record is written in src and a linked TRANSFER_IN record in dst, each in the open period
+// of its own wallet containing spec.OccurredAt since the wallets may have different ledger configs.
+func TransferBetweenWallets(
+	src *Wallet,
+	dst *Wallet,
+	spec TransferSpec,
+) error {
+	v := validator.New()
+
+	v.Check(src != nil, "src", "source wallet is required")
+	v.Check(dst != nil, "dst", "destination wallet is required")
+	v.Check(spec.FpID != uuid.Nil, "fpID", "fpID is required")
+	v.Check(spec.Amount > 0, "amount", "amount must be positive")
+
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	if src.id == dst.id {
+		return ErrTransferToSameWallet
+	}
+
+	if src.Currency() != dst.Currency() {
+		return errors.New("can not transfer between wallets of different currencies")
+	}
+
+	srcPeriod, err := src.openAccountingPeriodCovering(spec.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("transfer out of wallet %s: %w", src.id, err)
+	}
+
+	dstPeriod, err := dst.openAccountingPeriodCovering(spec.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("transfer into wallet %s: %w", dst.id, err)
+	}
+
+	srcAllocation, exist := src.fpAllocationManager.FindFundProviderAllocation(spec.FpID)
+	if !exist {
+		return ErrFundAllocatedNotFound{FpID: spec.FpID.String()}
+	}
+
+	// dst may not hold the fund provider yet, it then shares src's instance of it
+	dstAllocation, err := dst.fpAllocationManager.findOrAddEmptyAllocation(srcAllocation.fp)
+	if err != nil {
+		return err
+	}
+
+	amount, err := valueobject.NewMoney(spec.Amount, src.Currency())
+	if err != nil {
+		return err
+	}
+
+	if err = srcAllocation.decreaseAllocated(amount); err != nil {
+		return fmt.Errorf("transfer out of wallet %s: %w", src.id, err)
+	}
+
+	if err = dstAllocation.increaseAllocated(amount); err != nil {
+		return fmt.Errorf("transfer into wallet %s: %w", dst.id, err)
+	}
+
+	if src.balance, err = src.balance.Subtract(amount); err != nil {
+		return err
+	}
+
+	if dst.balance, err = dst.balance.Add(amount); err != nil {
+		return err
+	}
+
+	outRecord, err := ledger.NewTransactionRecord(
+		spec.TransactionNo,
+		ledger.TransactionTypeTransferOut.String(),
+		"",
+		amount,
+		spec.Description,
+		spec.FpID,
+		spec.OccurredAt,
+		spec.RecordedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	inRecord, err := ledger.NewTransactionRecord(
+		spec.TransactionNo,
+		ledger.TransactionTypeTransferIn.String(),
+		"",
+		amount,
+		spec.Description,
+		spec.FpID,
+		spec.OccurredAt,
+		spec.RecordedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	outRecord.LinkTo(inRecord.ID())
+	outRecord.SetWalletBalance(src.balance)
+	outRecord.SetFpBalance(srcAllocation.fp.Balance())
+
+	inRecord.LinkTo(outRecord.ID())
+	inRecord.SetWalletBalance(dst.balance)
+	inRecord.SetFpBalance(dstAllocation.fp.Balance())
+
+	if err = src.record(srcPeriod.YearMonth(), *outRecord); err != nil {
+		return err
+	}
+
+	return dst.record(dstPeriod.YearMonth(), *inRecord)
+}
@@ -0,0 +1,462 @@
+package wallet_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"sumni-finance-backend/internal/finance/domain/wallet"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferBetweenWallets(t *testing.T) {
+	yearMonth := NewValidYearMonth(t, 4, 2026)
+	occurredAt := time.Date(2026, time.April, 10, 9, 0, 0, 0, time.Local)
+
+	newPeriod := func(t *testing.T, yearMonth ledger.YearMonth, startDay int32, status string, balance int64) *ledger.AccountingPeriod {
+		t.Helper()
+
+		startTime := time.Date(yearMonth.Year(), time.Month(yearMonth.Month()), int(startDay), 0, 0, 0, 0, time.Local)
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			yearMonth.String(),
+			startDay,
+			1,
+			status,
+			balance,
+			0,
+			0,
+			0,
+			0,
+			0,
+			"VND",
+			startTime,
+			startTime.AddDate(0, 1, 0),
+			0,
+		)
+		require.NoError(t, err)
+
+		return ap
+	}
+
+	newWalletWithPeriod := func(
+		t *testing.T,
+		name string,
+		balance int64,
+		ap *ledger.AccountingPeriod,
+		allocations ...*wallet.FpAllocation,
+	) *wallet.Wallet {
+		t.Helper()
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+			uuid.New(),
+			name,
+			balance,
+			"VND",
+			0,
+			ap.StartDate().Value(),
+			1,
+			[]*ledger.AccountingPeriod{ap},
+			allocations...,
+		)
+		require.NoError(t, err)
+
+		return w
+	}
+
+	newWallet := func(t *testing.T, name string, balance int64, allocations ...*wallet.FpAllocation) *wallet.Wallet {
+		t.Helper()
+
+		return newWalletWithPeriod(t, name, balance, newPeriod(t, yearMonth, 1, "OPEN", balance), allocations...)
+	}
+
+	newFundProvider := func(t *testing.T) *fundprovider.FundProvider {
+		t.Helper()
+
+		fp, err := fundprovider.UnmarshalFundProviderFromDatabase(
+			uuid.New(),
+			"Techcombank7316",
+			"BANK",
+			10_000_000,
+			4_000_000,
+			"VND",
+			1,
+		)
+		require.NoError(t, err)
+
+		return fp
+	}
+
+	newAllocation := func(t *testing.T, fp *fundprovider.FundProvider, allocated int64) *wallet.FpAllocation {
+		t.Helper()
+
+		allocation, err := wallet.NewFpAllocation(fp, allocated)
+		require.NoError(t, err)
+
+		return allocation
+	}
+
+	newSpec := func(fpID uuid.UUID, amount int64) wallet.TransferSpec {
+		return wallet.TransferSpec{
+			FpID:          fpID,
+			Amount:        amount,
+			TransactionNo: "TRF-001",
+			Description:   "Top up office fund",
+			OccurredAt:    occurredAt,
+			RecordedAt:    occurredAt,
+		}
+	}
+
+	t.Run("returns error when transferring to the same wallet", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 5_000_000, newAllocation(t, fp, 5_000_000))
+
+		err := wallet.TransferBetweenWallets(src, src, newSpec(fp.ID(), 1_000_000))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wallet.ErrTransferToSameWallet)
+	})
+
+	t.Run("returns error when source does not hold the fund provider", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 0)
+		dst := newWallet(t, "Quy Van Phong", 0)
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 1_000_000))
+
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &wallet.ErrFundAllocatedNotFound{})
+	})
+
+	t.Run("returns error when amount exceeds the allocation", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 1_000_000, newAllocation(t, fp, 1_000_000))
+		dst := newWallet(t, "Quy Van Phong", 0)
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 2_000_000))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wallet.ErrInsufficientAllocated)
+	})
+
+	t.Run("returns error when amount is not positive", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 1_000_000, newAllocation(t, fp, 1_000_000))
+		dst := newWallet(t, "Quy Van Phong", 0)
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 0))
+
+		require.Error(t, err)
+	})
+
+	t.Run("moves allocation to a wallet that already holds the fund provider", func(t *testing.T) {
+		fp := newFundProvider(t)
+		dstFp, err := fundprovider.UnmarshalFundProviderFromDatabase(
+			fp.ID(), fp.Name(), "BANK", 10_000_000, 4_000_000, "VND", 1,
+		)
+		require.NoError(t, err)
+
+		src := newWallet(t, "Tai Chinh Tong", 5_000_000, newAllocation(t, fp, 5_000_000))
+		dst := newWallet(t, "Quy Van Phong", 1_000_000, newAllocation(t, dstFp, 1_000_000))
+
+		err = wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 2_000_000))
+		require.NoError(t, err)
+
+		srcAllocation, found := src.FundProviderManager().FindFundProviderAllocation(fp.ID())
+		require.True(t, found)
+		dstAllocation, found := dst.FundProviderManager().FindFundProviderAllocation(fp.ID())
+		require.True(t, found)
+
+		assert.Equal(t, int64(3_000_000), src.Balance().Amount())
+		assert.Equal(t, int64(3_000_000), srcAllocation.Allocated().Amount())
+		assert.Equal(t, int64(3_000_000), dst.Balance().Amount())
+		assert.Equal(t, int64(3_000_000), dstAllocation.Allocated().Amount())
+
+		// The fund provider is untouched
+		assert.Equal(t, int64(10_000_000), fp.Balance().Amount())
+		assert.Equal(t, int64(4_000_000), fp.UnallocatedBalance().Amount())
+
+		srcPeriod, _ := src.LedgerManager().FindAccountingPeriod(yearMonth)
+		dstPeriod, _ := dst.LedgerManager().FindAccountingPeriod(yearMonth)
+		require.Len(t, srcPeriod.Transactions(), 1)
+		require.Len(t, dstPeriod.Transactions(), 1)
+
+		out := srcPeriod.Transactions()[0]
+		in := dstPeriod.Transactions()[0]
+
+		assert.Equal(t, ledger.TransactionTypeTransferOut, out.TransactionType())
+		assert.Equal(t, ledger.TransactionTypeTransferIn, in.TransactionType())
+		assert.Equal(t, in.ID(), out.LinkedID())
+		assert.Equal(t, out.ID(), in.LinkedID())
+		assert.Equal(t, int64(3_000_000), out.WalletBalance().Amount())
+		assert.Equal(t, int64(3_000_000), in.WalletBalance().Amount())
+		assert.Equal(t, int64(10_000_000), out.FpBalance().Amount())
+
+		assert.Equal(t, int64(2_000_000), srcPeriod.TotalDebit().Amount())
+		assert.Equal(t, int64(2_000_000), dstPeriod.TotalCredit().Amount())
+	})
+
+	t.Run("records each leg in the period of its own wallet", func(t *testing.T) {
+		fp := newFundProvider(t)
+		// The destination periods start on the 25th, April 10 falls in its March period
+		march := NewValidYearMonth(t, 3, 2026)
+		src := newWallet(t, "Tai Chinh Tong", 5_000_000, newAllocation(t, fp, 5_000_000))
+		dst := newWalletWithPeriod(t, "Quy Van Phong", 0, newPeriod(t, march, 25, "OPEN", 0))
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 2_000_000))
+		require.NoError(t, err)
+
+		srcPeriod, _ := src.LedgerManager().FindAccountingPeriod(yearMonth)
+		dstPeriod, _ := dst.LedgerManager().FindAccountingPeriod(march)
+		require.Len(t, srcPeriod.Transactions(), 1)
+		require.Len(t, dstPeriod.Transactions(), 1)
+		assert.Equal(t, ledger.TransactionTypeTransferIn, dstPeriod.Transactions()[0].TransactionType())
+		assert.Equal(t, int64(2_000_000), dstPeriod.TotalCredit().Amount())
+	})
+
+	t.Run("returns error when a wallet has no period containing the transfer", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 5_000_000, newAllocation(t, fp, 5_000_000))
+		dst := newWalletWithPeriod(t, "Quy Van Phong", 0, newPeriod(t, NewValidYearMonth(t, 5, 2026), 1, "OPEN", 0))
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 2_000_000))
+
+		require.ErrorIs(t, err, wallet.ErrNoOpenAccountingPeriod)
+		assert.Equal(t, int64(5_000_000), src.Balance().Amount())
+	})
+
+	t.Run("returns error when the period containing the transfer is closed", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 5_000_000, newAllocation(t, fp, 5_000_000))
+		dst := newWalletWithPeriod(t, "Quy Van Phong", 0, newPeriod(t, yearMonth, 1, "CLOSE", 0))
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 2_000_000))
+
+		require.ErrorIs(t, err, ledger.ErrAccountingPeriodAlreadyClosed)
+	})
+
+	t.Run("creates the allocation when destination does not hold the fund provider", func(t *testing.T) {
+		fp := newFundProvider(t)
+		src := newWallet(t, "Tai Chinh Tong", 5_000_000, newAllocation(t, fp, 5_000_000))
+		dst := newWallet(t, "Quy Van Phong", 0)
+
+		err := wallet.TransferBetweenWallets(src, dst, newSpec(fp.ID(), 5_000_000))
+		require.NoError(t, err)
+
+		srcAllocation, found := src.FundProviderManager().FindFundProviderAllocation(fp.ID())
+		require.True(t, found)
+		dstAllocation, found := dst.FundProviderManager().FindFundProviderAllocation(fp.ID())
+		require.True(t, found)
+
+		assert.Equal(t, int64(0), srcAllocation.Allocated().Amount())
+		assert.Equal(t, int64(5_000_000), dstAllocation.Allocated().Amount())
+		assert.Equal(t, int64(0), src.Balance().Amount())
+		assert.Equal(t, int64(5_000_000), dst.Balance().Amount())
+	})
+}
+
+func TestWallet_TransferBetweenFundProviders(t *testing.T) {
+	yearMonth := NewValidYearMonth(t, 4, 2026)
+	occurredAt := time.Date(2026, time.April, 10, 9, 0, 0, 0, time.Local)
+
+	type fixture struct {
+		w    *wallet.Wallet
+		bank *fundprovider.FundProvider
+		cash *fundprovider.FundProvider
+	}
+
+	newFixture := func(t *testing.T) fixture {
+		t.Helper()
+
+		bank, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 5_000_000, 0, "VND", 1)
+		require.NoError(t, err)
+
+		cash, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Tien mat", "CASH", 1_000_000, 0, "VND", 1)
+		require.NoError(t, err)
+
+		bankAllocation, err := wallet.NewFpAllocation(bank, 5_000_000)
+		require.NoError(t, err)
+
+		cashAllocation, err := wallet.NewFpAllocation(cash, 1_000_000)
+		require.NoError(t, err)
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			yearMonth.String(),
+			1,
+			1,
+			"OPEN",
+			6_000_000,
+			0,
+			0,
+			0,
+			0,
+			0,
+			"VND",
+			time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local),
+			0,
+		)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+			uuid.New(),
+			"Tai Chinh Tong",
+			6_000_000,
+			"VND",
+			0,
+			1,
+			1,
+			[]*ledger.AccountingPeriod{ap},
+			bankAllocation,
+			cashAllocation,
+		)
+		require.NoError(t, err)
+
+		return fixture{w: w, bank: bank, cash: cash}
+	}
+
+	t.Run("returns error when transferring to the same fund provider", func(t *testing.T) {
+		f := newFixture(t)
+
+		err := f.w.TransferBetweenFundProviders(yearMonth, wallet.FundProviderTransferSpec{
+			FromFpID:   f.bank.ID(),
+			ToFpID:     f.bank.ID(),
+			Amount:     1_000_000,
+			OccurredAt: occurredAt,
+			RecordedAt: occurredAt,
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wallet.ErrTransferToSameFundProvider)
+	})
+
+	t.Run("returns error when destination is not allocated to the wallet", func(t *testing.T) {
+		f := newFixture(t)
+
+		err := f.w.TransferBetweenFundProviders(yearMonth, wallet.FundProviderTransferSpec{
+			FromFpID:   f.bank.ID(),
+			ToFpID:     uuid.New(),
+			Amount:     1_000_000,
+			OccurredAt: occurredAt,
+			RecordedAt: occurredAt,
+		})
+
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &wallet.ErrFundAllocatedNotFound{})
+	})
+
+	t.Run("returns error when the period is not loaded", func(t *testing.T) {
+		f := newFixture(t)
+
+		err := f.w.TransferBetweenFundProviders(NewValidYearMonth(t, 5, 2026), wallet.FundProviderTransferSpec{
+			FromFpID:   f.bank.ID(),
+			ToFpID:     f.cash.ID(),
+			Amount:     1_000_000,
+			OccurredAt: occurredAt,
+			RecordedAt: occurredAt,
+		})
+
+		require.ErrorIs(t, err, wallet.ErrNoOpenAccountingPeriod)
+	})
+
+	t.Run("returns error when the period is closed", func(t *testing.T) {
+		f := newFixture(t)
+		ap, _ := f.w.LedgerManager().FindAccountingPeriod(yearMonth)
+		require.NoError(t, ap.CloseAccountingPeriod(f.w.ID(), ap.EndDate()))
+
+		err := f.w.TransferBetweenFundProviders(yearMonth, wallet.FundProviderTransferSpec{
+			FromFpID:   f.bank.ID(),
+			ToFpID:     f.cash.ID(),
+			Amount:     1_000_000,
+			OccurredAt: occurredAt,
+			RecordedAt: occurredAt,
+		})
+
+		require.ErrorIs(t, err, ledger.ErrAccountingPeriodAlreadyClosed)
+	})
+
+	t.Run("returns error when amount exceeds the source allocation", func(t *testing.T) {
+		f := newFixture(t)
+
+		err := f.w.TransferBetweenFundProviders(yearMonth, wallet.FundProviderTransferSpec{
+			FromFpID:   f.cash.ID(),
+			ToFpID:     f.bank.ID(),
+			Amount:     2_000_000,
+			OccurredAt: occurredAt,
+			RecordedAt: occurredAt,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("transfers without changing wallet balance and period totals", func(t *testing.T) {
+		f := newFixture(t)
+
+		err := f.w.TransferBetweenFundProviders(yearMonth, wallet.FundProviderTransferSpec{
+			FromFpID:      f.bank.ID(),
+			ToFpID:        f.cash.ID(),
+			Amount:        2_000_000,
+			TransactionNo: "ATM-001",
+			Description:   "ATM withdrawal",
+			OccurredAt:    occurredAt,
+			RecordedAt:    occurredAt,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(6_000_000), f.w.Balance().Amount())
+		assert.Equal(t, int64(3_000_000), f.bank.Balance().Amount())
+		assert.Equal(t, int64(3_000_000), f.cash.Balance().Amount())
+
+		bankAllocation, _ := f.w.FundProviderManager().FindFundProviderAllocation(f.bank.ID())
+		cashAllocation, _ := f.w.FundProviderManager().FindFundProviderAllocation(f.cash.ID())
+		assert.Equal(t, int64(3_000_000), bankAllocation.Allocated().Amount())
+		assert.Equal(t, int64(3_000_000), cashAllocation.Allocated().Amount())
+
+		ap, _ := f.w.LedgerManager().FindAccountingPeriod(yearMonth)
+		assert.Equal(t, int64(0), ap.TotalDebit().Amount())
+		assert.Equal(t, int64(0), ap.TotalCredit().Amount())
+
+		require.Len(t, ap.Transactions(), 2)
+		out, in := ap.Transactions()[0], ap.Transactions()[1]
+		assert.Equal(t, ledger.TransactionTypeTransferOut, out.TransactionType())
+		assert.Equal(t, ledger.TransactionTypeTransferIn, in.TransactionType())
+		assert.Equal(t, in.ID(), out.LinkedID())
+		assert.Equal(t, int64(3_000_000), out.FpBalance().Amount())
+		assert.Equal(t, int64(3_000_000), in.FpBalance().Amount())
+	})
+
+	t.Run("books the fee as an expense of the source fund provider", func(t *testing.T) {
+		f := newFixture(t)
+
+		err := f.w.TransferBetweenFundProviders(yearMonth, wallet.FundProviderTransferSpec{
+			FromFpID:   f.bank.ID(),
+			ToFpID:     f.cash.ID(),
+			Amount:     2_000_000,
+			Fee:        3_300,
+			OccurredAt: occurredAt,
+			RecordedAt: occurredAt,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(6_000_000-3_300), f.w.Balance().Amount())
+		assert.Equal(t, int64(3_000_000-3_300), f.bank.Balance().Amount())
+		assert.Equal(t, int64(3_000_000), f.cash.Balance().Amount())
+
+		ap, _ := f.w.LedgerManager().FindAccountingPeriod(yearMonth)
+		assert.Equal(t, int64(3_300), ap.TotalDebit().Amount())
+		assert.Equal(t, int64(0), ap.TotalCredit().Amount())
+		// Only the fee is an expense, the transfer itself is not
+		assert.Equal(t, int64(3_300), ap.TotalExpense().Amount())
+
+		require.Len(t, ap.Transactions(), 3)
+		fee := ap.Transactions()[2]
+		assert.Equal(t, ledger.TransactionTypeFee, fee.TransactionType())
+		assert.Equal(t, ap.Transactions()[0].ID(), fee.LinkedID())
+		assert.Equal(t, f.bank.ID(), fee.FpID())
+	})
+}
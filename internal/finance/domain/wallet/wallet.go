@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"sumni-finance-backend/internal/common/validator"
 	"sumni-finance-backend/internal/common/valueobject"
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/event"
 	"sumni-finance-backend/internal/finance/domain/fundprovider"
 	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,6 +17,12 @@ import (
 var (
 	ErrFundProviderAlreadyRegistered = errors.New("fund provider already registered")
 	ErrAllocationAmountNegative      = errors.New("allocated amount is negative")
+	ErrInsufficientAllocated         = errors.New("amount exceeds the allocated amount")
+	ErrAllocationAmountNotPositive   = errors.New("allocation amount must be positive")
+	ErrNoAccountingPeriod            = errors.New("wallet has no accounting period")
+	ErrNoOpenAccountingPeriod        = errors.New("wallet has no open accounting period")
+	ErrTransferRecordNotAllowed      = errors.New("transfer records can only be written by a transfer")
+	ErrDuplicateTransactionNo        = errors.New("transaction number is already recorded for the fund provider")
 )
 
 type ErrFundAllocatedNotFound struct {
@@ -27,9 +36,14 @@ func (e ErrFundAllocatedNotFound) Error() string {
 type TransactionSpec struct {
 	TransactionNo   string
 	TransactionType string
+	Direction       string // IN or OUT, only required for an ADJUSTMENT
 	Amount          int64
 	Description     string
 	FpID            uuid.UUID
+	OccurredAt      time.Time
+	RecordedAt      time.Time
+	// Category is optional, its kind must match the direction of the record
+	Category *category.Category
 }
 
 type Wallet struct {
@@ -40,11 +54,13 @@ type Wallet struct {
 
 	fpAllocationManager *FundProviderAllocationManager
 	ledgerManager       *LedgerManager
+
+	events event.Recorder
 }
 
 // NewWallet constructs a new Wallet aggregate.
 // Note: To rehydrate a Wallet from database state, use UnmarshalWalletFromDatabase, UnmarshalWalletWithLedgerFromDatabase instead
-func NewWallet(currencyCode string, name string) (*Wallet, error) {
+func NewWallet(currencyCode string, name string, ledgerConfig LedgerConfig) (*Wallet, error) {
 	if name == "" {
 		return nil, errors.New("name is required")
 	}
@@ -69,7 +85,7 @@ func NewWallet(currencyCode string, name string) (*Wallet, error) {
 		return nil, err
 	}
 
-	ledgerManager, err := NewLedgerManager(nil)
+	ledgerManager, err := NewLedgerManager(ledgerConfig, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +109,8 @@ func UnmarshalWalletFromDatabase(
 	balanceAmount int64,
 	currencyCode string,
 	version int32,
+	periodStartDay int32,
+	periodInterval int32,
 	providerAllocations ...*FpAllocation,
 ) (*Wallet, error) {
 	v := validator.New()
@@ -121,7 +139,12 @@ func UnmarshalWalletFromDatabase(
 		return nil, err
 	}
 
-	ledgerManager, err := NewLedgerManager(nil)
+	ledgerConfig, err := NewLedgerConfig(periodStartDay, periodInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerManager, err := NewLedgerManager(ledgerConfig, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +167,8 @@ func UnmarshalWalletWithLedgerFromDatabase(
 	balanceAmount int64,
 	currencyCode string,
 	version int32,
+	periodStartDay int32,
+	periodInterval int32,
 	accountingPeriods []*ledger.AccountingPeriod,
 	providerAllocations ...*FpAllocation,
 ) (*Wallet, error) {
@@ -153,13 +178,15 @@ func UnmarshalWalletWithLedgerFromDatabase(
 		balanceAmount,
 		currencyCode,
 		version,
+		periodStartDay,
+		periodInterval,
 		providerAllocations...,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	ledgerManager, err := NewLedgerManager(accountingPeriods)
+	ledgerManager, err := NewLedgerManager(w.ledgerManager.Config(), accountingPeriods)
 	if err != nil {
 		return nil, err
 	}
@@ -177,8 +204,19 @@ func (w *Wallet) Version() int32                                      { return w
 func (w *Wallet) FundProviderManager() *FundProviderAllocationManager { return w.fpAllocationManager }
 func (w *Wallet) LedgerManager() *LedgerManager                       { return w.ledgerManager }
 
+// PullEvents returns the events raised since the wallet was loaded or last saved,
+// the ones of its accounting periods first.
+func (w *Wallet) PullEvents() []event.Event {
+	var events []event.Event
+	for _, ap := range w.ledgerManager.AccountingPeriods() {
+		events = append(events, ap.PullEvents()...)
+	}
+
+	return append(events, w.events.Pull()...)
+}
+
 func (w *Wallet) SetAccountingPeriods(accountingPeriod ...*ledger.AccountingPeriod) error {
-	ledgerManager, err := NewLedgerManager(accountingPeriod)
+	ledgerManager, err := NewLedgerManager(w.ledgerManager.Config(), accountingPeriod)
 	if err != nil {
 		return err
 	}
@@ -187,6 +225,12 @@ func (w *Wallet) SetAccountingPeriods(accountingPeriod ...*ledger.AccountingPeri
 	return nil
 }
 
+// ChangeLedgerConfig changes the start day and interval of the accounting periods opened from now on.
+// It fails while an accounting period of the wallet is still open.
+func (w *Wallet) ChangeLedgerConfig(ledgerConfig LedgerConfig) error {
+	return w.ledgerManager.ChangeConfig(ledgerConfig)
+}
+
 // AllocateFundProvider registers a fund provider and increases the wallet balance by the allocated amount.
 // The allocated amount represents the portion of the provider’s funds reserved for this wallet.
 // It also updates the provider’s unallocated balance accordingly.
@@ -213,6 +257,86 @@ func (w *Wallet) AllocateFundProvider(
 	}
 
 	w.balance = newWalletBalance
+	w.recordFundAllocated(fp.ID(), allocatedAmount)
+	return nil
+}
+
+// IncreaseAllocation reserves more of an already allocated fund provider for the wallet.
+// The wallet balance grows by the same amount.
+func (w *Wallet) IncreaseAllocation(fpID uuid.UUID, amount int64) error {
+	if amount <= 0 {
+		return ErrAllocationAmountNotPositive
+	}
+
+	allocation, exist := w.fpAllocationManager.FindFundProviderAllocation(fpID)
+	if !exist {
+		return ErrFundAllocatedNotFound{FpID: fpID.String()}
+	}
+
+	increased, err := valueobject.NewMoney(amount, w.Currency())
+	if err != nil {
+		return err
+	}
+
+	if err = allocation.Reserve(increased); err != nil {
+		return err
+	}
+
+	newWalletBalance, err := w.balance.Add(increased)
+	if err != nil {
+		return err
+	}
+
+	w.balance = newWalletBalance
+	w.recordFundAllocated(fpID, amount)
+	return nil
+}
+
+// DecreaseAllocation releases part of a fund provider allocation back to the provider's unallocated balance.
+// The wallet balance shrinks by the same amount.
+func (w *Wallet) DecreaseAllocation(fpID uuid.UUID, amount int64) error {
+	if amount <= 0 {
+		return ErrAllocationAmountNotPositive
+	}
+
+	allocation, exist := w.fpAllocationManager.FindFundProviderAllocation(fpID)
+	if !exist {
+		return ErrFundAllocatedNotFound{FpID: fpID.String()}
+	}
+
+	released, err := valueobject.NewMoney(amount, w.Currency())
+	if err != nil {
+		return err
+	}
+
+	if err = allocation.Release(released); err != nil {
+		return err
+	}
+
+	newWalletBalance, err := w.balance.Subtract(released)
+	if err != nil {
+		return err
+	}
+
+	w.balance = newWalletBalance
+	w.recordFundAllocated(fpID, -amount)
+	return nil
+}
+
+// RemoveFundProvider releases the whole allocation of a fund provider and removes it from the wallet.
+func (w *Wallet) RemoveFundProvider(fpID uuid.UUID) error {
+	released, err := w.fpAllocationManager.RemoveFundProviderAndRelease(fpID)
+	if err != nil {
+		return err
+	}
+
+	newWalletBalance, err := w.balance.Subtract(released)
+	if err != nil {
+		return err
+	}
+
+	w.balance = newWalletBalance
+	w.recordFundAllocated(fpID, -released.Amount())
 	return nil
 }
 
@@ -266,8 +390,43 @@ func (w *Wallet) Withdraw(amount valueobject.Money, fpID uuid.UUID) error {
 	return nil
 }
 
+// OpenAccountingPeriod opens the yearMonth period. The opening balance carries forward from the latest loaded period,
+// the wallet balance is only used when the wallet has no period yet.
 func (w *Wallet) OpenAccountingPeriod(yearMonth ledger.YearMonth) error {
-	return w.ledgerManager.OpenAccountingPeriod(yearMonth, w.balance)
+	return w.ledgerManager.OpenAccountingPeriod(w.id, yearMonth, w.balance)
+}
+
+func (w *Wallet) CloseAccountingPeriod(yearMonth ledger.YearMonth, closedAt time.Time) error {
+	return w.ledgerManager.CloseAccountingPeriod(w.id, yearMonth, closedAt)
+}
+
+// RolloverAccountingPeriods closes the latest period once it has ended and opens the one that follows,
+// repeating until the latest period covers now. It returns the number of opened periods.
+func (w *Wallet) RolloverAccountingPeriods(now time.Time) (int, error) {
+	opened := 0
+
+	for {
+		latest, exist := w.ledgerManager.LatestAccountingPeriod()
+		if !exist {
+			return opened, ErrNoAccountingPeriod
+		}
+
+		if now.Before(latest.EndDate()) {
+			return opened, nil
+		}
+
+		if !latest.IsClose() {
+			if err := w.ledgerManager.CloseAccountingPeriod(w.id, latest.YearMonth(), now); err != nil {
+				return opened, err
+			}
+		}
+
+		if err := w.ledgerManager.OpenAccountingPeriod(w.id, latest.NextYearMonth(), w.balance); err != nil {
+			return opened, err
+		}
+
+		opened++
+	}
 }
 
 func (w *Wallet) RecordTransactions(yearMonth ledger.YearMonth, txSpecs ...TransactionSpec) error {
@@ -275,13 +434,12 @@ func (w *Wallet) RecordTransactions(yearMonth ledger.YearMonth, txSpecs ...Trans
 		return errors.New("transaction specs is empty")
 	}
 
-	accountingPeriod, exist := w.ledgerManager.FindAccountingPeriod(yearMonth)
-	if !exist {
-		return fmt.Errorf("account period: %s not found", yearMonth.String())
+	if err := w.ensureAccountingPeriodOpen(yearMonth); err != nil {
+		return err
 	}
 
-	if accountingPeriod.IsClose() {
-		return fmt.Errorf("accounting period: %s has been closed", yearMonth.String())
+	if err := w.ensureUniqueTransactionNos(txSpecs); err != nil {
+		return err
 	}
 
 	for _, txSpec := range txSpecs {
@@ -290,7 +448,7 @@ func (w *Wallet) RecordTransactions(yearMonth ledger.YearMonth, txSpecs ...Trans
 			return fmt.Errorf("failed to build transaction record: %w", err)
 		}
 
-		if err = w.ledgerManager.Record(yearMonth, txRecord); err != nil {
+		if err = w.record(yearMonth, txRecord); err != nil {
 			return err
 		}
 	}
@@ -298,6 +456,188 @@ func (w *Wallet) RecordTransactions(yearMonth ledger.YearMonth, txSpecs ...Trans
 	return nil
 }
 
+// ReverseTransaction books the compensating record of original and undoes its effect on the wallet balance,
+// the allocation and the fund provider. While the period of original is open the reversal is dated like original
+// so both cancel out in that period. Once it is closed, the reversal is booked in the latest open period
+// as an adjustment dated reversedAt.
+func (w *Wallet) ReverseTransaction(original *ledger.TransactionRecord, description string, reversedAt time.Time) error {
+	if original == nil {
+		return errors.New("transaction to reverse is required")
+	}
+
+	originalPeriod, exist := w.ledgerManager.FindAccountingPeriodCovering(original.OccurredAt())
+	if !exist {
+		return fmt.Errorf("accounting period of transaction %s not found", original.ID())
+	}
+
+	var err error
+
+	var reversal *ledger.TransactionRecord
+	targetPeriod := originalPeriod
+
+	if originalPeriod.IsClose() {
+		latest, exist := w.ledgerManager.LatestAccountingPeriod()
+		if !exist || latest.IsClose() {
+			return ErrNoOpenAccountingPeriod
+		}
+
+		if description == "" {
+			description = fmt.Sprintf("Adjustment for %s reversed after closing %s", original.ID(), originalPeriod.YearMonth().String())
+		}
+
+		targetPeriod = latest
+		reversal, err = original.ReverseAsAdjustment(description, reversedAt, reversedAt)
+	} else {
+		if description == "" {
+			description = fmt.Sprintf("Reversal of %s", original.ID())
+		}
+
+		reversal, err = original.Reverse(description, original.OccurredAt(), reversedAt)
+	}
+	if err != nil {
+		return err
+	}
+
+	allocation, exist := w.fpAllocationManager.FindFundProviderAllocation(reversal.FpID())
+	if !exist {
+		return ErrFundAllocatedNotFound{FpID: reversal.FpID().String()}
+	}
+
+	if reversal.IsInflow() {
+		err = w.TopUp(reversal.Amount(), reversal.FpID())
+	} else {
+		err = w.Withdraw(reversal.Amount(), reversal.FpID())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to reverse transaction %s: %w", original.ID(), err)
+	}
+
+	reversal.SetFpBalance(allocation.FundProvider().Balance())
+	reversal.SetWalletBalance(w.balance)
+
+	return w.record(targetPeriod.YearMonth(), *reversal)
+}
+
+// PlanBudgets replaces the category budgets of the yearMonth period.
+func (w *Wallet) PlanBudgets(yearMonth ledger.YearMonth, budgets ...ledger.CategoryBudget) error {
+	ap, exist := w.ledgerManager.FindAccountingPeriod(yearMonth)
+	if !exist {
+		return fmt.Errorf("account period: %s not found", yearMonth.String())
+	}
+
+	return ap.PlanBudgets(budgets...)
+}
+
+// record books txRecord into the yearMonth period.
+func (w *Wallet) record(yearMonth ledger.YearMonth, txRecord ledger.TransactionRecord) error {
+	if err := w.ledgerManager.Record(yearMonth, txRecord); err != nil {
+		return err
+	}
+
+	w.recordTransactionRecorded(yearMonth, txRecord)
+	return nil
+}
+
+func (w *Wallet) recordTransactionRecorded(yearMonth ledger.YearMonth, txRecord ledger.TransactionRecord) {
+	var apID uuid.UUID
+	if ap, exist := w.ledgerManager.FindAccountingPeriod(yearMonth); exist {
+		apID = ap.ID()
+	}
+
+	w.events.Record(event.TransactionRecorded{
+		WalletID:           w.id,
+		AccountingPeriodID: apID,
+		YearMonth:          yearMonth.String(),
+		TransactionID:      txRecord.ID(),
+		TransactionNo:      txRecord.TransactionNo(),
+		TransactionType:    txRecord.TransactionType().String(),
+		Direction:          txRecord.Direction().String(),
+		Amount:             txRecord.Amount().Amount(),
+		Currency:           txRecord.Amount().Currency().Code(),
+		FundProviderID:     txRecord.FpID(),
+		OccurredAt:         txRecord.OccurredAt(),
+		WalletBalance:      txRecord.WalletBalance().Amount(),
+	})
+}
+
+// recordFundAllocated records the change of the fund provider allocation by amount, the allocation is 0 once removed.
+func (w *Wallet) recordFundAllocated(fpID uuid.UUID, amount int64) {
+	var allocatedAmount int64
+	if allocation, exist := w.fpAllocationManager.FindFundProviderAllocation(fpID); exist {
+		allocatedAmount = allocation.Allocated().Amount()
+	}
+
+	w.events.Record(event.FundAllocated{
+		WalletID:        w.id,
+		FundProviderID:  fpID,
+		Amount:          amount,
+		AllocatedAmount: allocatedAmount,
+		WalletBalance:   w.balance.Amount(),
+		Currency:        w.Currency().Code(),
+	})
+}
+
+func (w *Wallet) ensureAccountingPeriodOpen(yearMonth ledger.YearMonth) error {
+	accountingPeriod, exist := w.ledgerManager.FindAccountingPeriod(yearMonth)
+	if !exist {
+		return fmt.Errorf("%w: %s", ErrNoOpenAccountingPeriod, yearMonth.String())
+	}
+
+	if accountingPeriod.IsClose() {
+		return fmt.Errorf("%w: %s", ledger.ErrAccountingPeriodAlreadyClosed, yearMonth.String())
+	}
+
+	return nil
+}
+
+// openAccountingPeriodCovering returns the loaded period containing t, it must be open.
+func (w *Wallet) openAccountingPeriodCovering(t time.Time) (*ledger.AccountingPeriod, error) {
+	ap, exist := w.ledgerManager.FindAccountingPeriodCovering(t)
+	if !exist {
+		return nil, ErrNoOpenAccountingPeriod
+	}
+
+	if ap.IsClose() {
+		return nil, fmt.Errorf("%w: %s", ledger.ErrAccountingPeriodAlreadyClosed, ap.YearMonth().String())
+	}
+
+	return ap, nil
+}
+
+// ensureUniqueTransactionNos rejects a spec reusing the transactionNo of another spec or of a record of the loaded
+// periods on the same fund provider. The records of the other periods and wallets are checked by the repository.
+// Linked records share the transactionNo of their transfer and a reversed record gives its transactionNo back.
+func (w *Wallet) ensureUniqueTransactionNos(txSpecs []TransactionSpec) error {
+	type reference struct {
+		fpID          uuid.UUID
+		transactionNo string
+	}
+
+	recorded := make(map[reference]struct{})
+	for _, ap := range w.ledgerManager.AccountingPeriods() {
+		for _, tr := range ap.Transactions() {
+			if tr.TransactionNo() == "" || tr.LinkedID() != uuid.Nil || tr.IsReversed() {
+				continue
+			}
+			recorded[reference{fpID: tr.FpID(), transactionNo: tr.TransactionNo()}] = struct{}{}
+		}
+	}
+
+	for _, txSpec := range txSpecs {
+		if txSpec.TransactionNo == "" {
+			continue
+		}
+
+		ref := reference{fpID: txSpec.FpID, transactionNo: txSpec.TransactionNo}
+		if _, exist := recorded[ref]; exist {
+			return fmt.Errorf("%w: %s on fund provider %s", ErrDuplicateTransactionNo, txSpec.TransactionNo, txSpec.FpID)
+		}
+		recorded[ref] = struct{}{}
+	}
+
+	return nil
+}
+
 func (w *Wallet) buildTransactionRecordsFromSpec(txSpec TransactionSpec) (ledger.TransactionRecord, error) {
 	allocation, exist := w.fpAllocationManager.FindFundProviderAllocation(txSpec.FpID)
 	if !exist {
@@ -315,15 +655,29 @@ func (w *Wallet) buildTransactionRecordsFromSpec(txSpec TransactionSpec) (ledger
 	txRecord, err := ledger.NewTransactionRecord(
 		txSpec.TransactionNo,
 		txSpec.TransactionType,
+		txSpec.Direction,
 		amount,
 		txSpec.Description,
 		txSpec.FpID,
+		txSpec.OccurredAt,
+		txSpec.RecordedAt,
 	)
 	if err != nil {
 		return ledger.TransactionRecord{}, err
 	}
 
-	if txRecord.IsDeposit() {
+	if txRecord.TransactionType().IsTransfer() {
+		return ledger.TransactionRecord{}, ErrTransferRecordNotAllowed
+	}
+
+	if txSpec.Category != nil {
+		if err = txSpec.Category.Accepts(txRecord.IsExpense()); err != nil {
+			return ledger.TransactionRecord{}, err
+		}
+		txRecord.Categorize(txSpec.Category.ID())
+	}
+
+	if txRecord.IsInflow() {
 		if err = w.TopUp(txRecord.Amount(), txSpec.FpID); err != nil {
 			return ledger.TransactionRecord{}, err
 		}
@@ -1,9 +1,13 @@
 package wallet_test
 
 import (
+	"sumni-finance-backend/internal/finance/domain/category"
+	"sumni-finance-backend/internal/finance/domain/event"
 	"sumni-finance-backend/internal/finance/domain/fundprovider"
+	"sumni-finance-backend/internal/finance/domain/ledger"
 	"sumni-finance-backend/internal/finance/domain/wallet"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -43,7 +47,7 @@ func TestNewWallet(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			wallet, err := wallet.NewWallet(tt.currencyCode, tt.walletName)
+			wallet, err := wallet.NewWallet(tt.currencyCode, tt.walletName, NewDefaultLedgerConfig(t))
 
 			if tt.hasErr {
 				require.Error(t, err)
@@ -128,6 +132,8 @@ func TestUnmarshalWalletFromDatabase(t *testing.T) {
 				tt.balanceAmount,
 				tt.currencyCode,
 				version,
+				1,
+				1,
 			)
 
 			if tt.hasErr {
@@ -159,6 +165,8 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 			0,
 			"USD",
 			0,
+			1,
+			1,
 			allocationProvider,
 		)
 		require.NoError(t, err)
@@ -170,7 +178,7 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 	})
 
 	t.Run("returns error when fund provider is nil", func(t *testing.T) {
-		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong")
+		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 		require.NoError(t, err)
 
 		err = walletDomain.AllocateFundProvider(nil, 100)
@@ -182,7 +190,7 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 		provider, err := fundprovider.NewFundProvider("Techcombank7316", "BANK", 100, "USD")
 		require.NoError(t, err)
 
-		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong")
+		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 		require.NoError(t, err)
 
 		err = walletDomain.AllocateFundProvider(provider, -100)
@@ -195,7 +203,7 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 		provider, err := fundprovider.NewFundProvider("Techcombank7316", "BANK", 100, "USD")
 		require.NoError(t, err)
 
-		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong")
+		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 		require.NoError(t, err)
 
 		err = walletDomain.AllocateFundProvider(provider, 110)
@@ -209,7 +217,7 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 
 		unallocatedBalance := provider.UnallocatedBalance()
 
-		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong")
+		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 		require.NoError(t, err)
 
 		err = walletDomain.AllocateFundProvider(provider, 0)
@@ -228,7 +236,7 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 
 		unallocatedBalance := provider.UnallocatedBalance()
 
-		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong")
+		walletDomain, err := wallet.NewWallet("USD", "Tai chinh tong", NewDefaultLedgerConfig(t))
 		require.NoError(t, err)
 
 		err = walletDomain.AllocateFundProvider(provider, 50)
@@ -241,3 +249,673 @@ func TestWallet_AllocateFromFundProvider(t *testing.T) {
 		assert.Equal(t, unallocatedBalance.Amount()-50, actualAllocation.FundProvider().UnallocatedBalance().Amount())
 	})
 }
+
+func TestWallet_ChangeAllocation(t *testing.T) {
+	newWalletWithAllocation := func(t *testing.T) (*wallet.Wallet, *fundprovider.FundProvider) {
+		t.Helper()
+
+		// 100 in the provider, 60 allocated to the wallet
+		provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 100, 40, "USD", 1)
+		require.NoError(t, err)
+
+		allocation, err := wallet.NewFpAllocation(provider, 60)
+		require.NoError(t, err)
+
+		walletDomain, err := wallet.UnmarshalWalletFromDatabase(uuid.New(), "Tai chinh tong", 60, "USD", 0, 1, 1, allocation)
+		require.NoError(t, err)
+
+		return walletDomain, provider
+	}
+
+	assertAllocated := func(t *testing.T, walletDomain *wallet.Wallet, provider *fundprovider.FundProvider, allocated int64) {
+		t.Helper()
+
+		allocation, found := walletDomain.FundProviderManager().FindFundProviderAllocation(provider.ID())
+		require.True(t, found)
+		assert.Equal(t, allocated, allocation.Allocated().Amount())
+		assert.Equal(t, allocated, walletDomain.Balance().Amount())
+		assert.Equal(t, allocated, provider.AllocatedBalance().Amount())
+		assert.Equal(t, int64(100), provider.Balance().Amount())
+	}
+
+	t.Run("returns error when increasing an allocation that does not exist", func(t *testing.T) {
+		walletDomain, _ := newWalletWithAllocation(t)
+
+		err := walletDomain.IncreaseAllocation(uuid.New(), 10)
+
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &wallet.ErrFundAllocatedNotFound{})
+	})
+
+	t.Run("returns error when increasing more than the unallocated balance", func(t *testing.T) {
+		walletDomain, provider := newWalletWithAllocation(t)
+
+		err := walletDomain.IncreaseAllocation(provider.ID(), 50)
+
+		require.Error(t, err)
+		assertAllocated(t, walletDomain, provider, 60)
+	})
+
+	t.Run("returns error when amount is not positive", func(t *testing.T) {
+		walletDomain, provider := newWalletWithAllocation(t)
+
+		assert.ErrorIs(t, walletDomain.IncreaseAllocation(provider.ID(), 0), wallet.ErrAllocationAmountNotPositive)
+		assert.ErrorIs(t, walletDomain.DecreaseAllocation(provider.ID(), -1), wallet.ErrAllocationAmountNotPositive)
+	})
+
+	t.Run("increases allocation successfully", func(t *testing.T) {
+		walletDomain, provider := newWalletWithAllocation(t)
+
+		err := walletDomain.IncreaseAllocation(provider.ID(), 40)
+
+		require.NoError(t, err)
+		assertAllocated(t, walletDomain, provider, 100)
+	})
+
+	t.Run("returns error when decreasing more than allocated", func(t *testing.T) {
+		walletDomain, provider := newWalletWithAllocation(t)
+
+		err := walletDomain.DecreaseAllocation(provider.ID(), 61)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, wallet.ErrInsufficientAllocated)
+		assertAllocated(t, walletDomain, provider, 60)
+	})
+
+	t.Run("decreases allocation successfully", func(t *testing.T) {
+		walletDomain, provider := newWalletWithAllocation(t)
+
+		err := walletDomain.DecreaseAllocation(provider.ID(), 25)
+
+		require.NoError(t, err)
+		assertAllocated(t, walletDomain, provider, 35)
+	})
+
+	t.Run("removes fund provider successfully", func(t *testing.T) {
+		walletDomain, provider := newWalletWithAllocation(t)
+
+		err := walletDomain.RemoveFundProvider(provider.ID())
+
+		require.NoError(t, err)
+		_, found := walletDomain.FundProviderManager().FindFundProviderAllocation(provider.ID())
+		assert.False(t, found)
+		assert.Equal(t, int64(0), walletDomain.Balance().Amount())
+		assert.Equal(t, int64(100), provider.UnallocatedBalance().Amount())
+
+		removed := walletDomain.FundProviderManager().RemovedFpAllocations()
+		require.Len(t, removed, 1)
+		assert.Equal(t, provider.ID(), removed[0].FundProvider().ID())
+	})
+
+	t.Run("returns error when removing a fund provider that is not allocated", func(t *testing.T) {
+		walletDomain, _ := newWalletWithAllocation(t)
+
+		err := walletDomain.RemoveFundProvider(uuid.New())
+
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &wallet.ErrFundAllocatedNotFound{})
+	})
+}
+
+func TestWallet_OpenAccountingPeriod_CarryForward(t *testing.T) {
+	april := NewValidYearMonth(t, 4, 2026)
+
+	closedApril, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+		uuid.New(),
+		april.String(),
+		1,
+		1,
+		"CLOSE",
+		1_000_000,
+		300_000,
+		500_000,
+		0,
+		0,
+		1_200_000,
+		"VND",
+		time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local),
+		time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local),
+		3,
+	)
+	require.NoError(t, err)
+
+	newWallet := func(t *testing.T, accountingPeriods ...*ledger.AccountingPeriod) *wallet.Wallet {
+		t.Helper()
+
+		// The wallet balance moved after April was closed, e.g. by a new allocation
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 2_000_000, "VND", 4, 1, 1, accountingPeriods)
+		require.NoError(t, err)
+
+		return w
+	}
+
+	t.Run("uses the wallet balance for the first period", func(t *testing.T) {
+		w := newWallet(t)
+
+		require.NoError(t, w.OpenAccountingPeriod(april))
+
+		ap, exists := w.LedgerManager().FindAccountingPeriod(april)
+		require.True(t, exists)
+		assert.Equal(t, int64(2_000_000), ap.OpeningBalance().Amount())
+	})
+
+	t.Run("carries forward the closing balance of the previous period", func(t *testing.T) {
+		w := newWallet(t, closedApril)
+		may := NewValidYearMonth(t, 5, 2026)
+
+		require.NoError(t, w.OpenAccountingPeriod(may))
+
+		ap, exists := w.LedgerManager().FindAccountingPeriod(may)
+		require.True(t, exists)
+		assert.Equal(t, int64(1_200_000), ap.OpeningBalance().Amount())
+		assert.Equal(t, closedApril.EndDate(), ap.StartTime())
+	})
+
+	t.Run("returns error when the period does not follow the previous one", func(t *testing.T) {
+		w := newWallet(t, closedApril)
+
+		err := w.OpenAccountingPeriod(NewValidYearMonth(t, 7, 2026))
+
+		require.ErrorIs(t, err, ledger.ErrAccountingPeriodNotContiguous)
+	})
+}
+
+func TestWallet_RolloverAccountingPeriods(t *testing.T) {
+	april := NewValidYearMonth(t, 4, 2026)
+	endOfApril := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local)
+
+	newWallet := func(t *testing.T, accountingPeriods ...*ledger.AccountingPeriod) *wallet.Wallet {
+		t.Helper()
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 1_000_000, "VND", 1, 1, 1, accountingPeriods)
+		require.NoError(t, err)
+
+		return w
+	}
+
+	newOpenApril := func(t *testing.T) *ledger.AccountingPeriod {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			april.String(),
+			1,
+			1,
+			"OPEN",
+			1_000_000,
+			0,
+			0,
+			0,
+			0,
+			0,
+			"VND",
+			endOfApril.AddDate(0, -1, 0),
+			endOfApril,
+			0,
+		)
+		require.NoError(t, err)
+
+		return ap
+	}
+
+	t.Run("returns error when wallet has no accounting period", func(t *testing.T) {
+		_, err := newWallet(t).RolloverAccountingPeriods(endOfApril)
+
+		require.ErrorIs(t, err, wallet.ErrNoAccountingPeriod)
+	})
+
+	t.Run("does nothing while the latest period has not ended", func(t *testing.T) {
+		ap := newOpenApril(t)
+		w := newWallet(t, ap)
+
+		opened, err := w.RolloverAccountingPeriods(endOfApril.Add(-time.Second))
+
+		require.NoError(t, err)
+		assert.Zero(t, opened)
+		assert.False(t, ap.IsClose())
+	})
+
+	t.Run("closes the ended period and opens the next one", func(t *testing.T) {
+		ap := newOpenApril(t)
+		w := newWallet(t, ap)
+
+		opened, err := w.RolloverAccountingPeriods(endOfApril)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, opened)
+		assert.True(t, ap.IsClose())
+
+		may, exists := w.LedgerManager().FindAccountingPeriod(NewValidYearMonth(t, 5, 2026))
+		require.True(t, exists)
+		assert.False(t, may.IsClose())
+		assert.Equal(t, ap.ClosingBalance().Amount(), may.OpeningBalance().Amount())
+	})
+
+	t.Run("catches up every missed period", func(t *testing.T) {
+		w := newWallet(t, newOpenApril(t))
+
+		opened, err := w.RolloverAccountingPeriods(time.Date(2026, time.July, 15, 0, 0, 0, 0, time.Local))
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, opened)
+
+		latest, exists := w.LedgerManager().LatestAccountingPeriod()
+		require.True(t, exists)
+		assert.Equal(t, NewValidYearMonth(t, 7, 2026), latest.YearMonth())
+		assert.False(t, latest.IsClose())
+	})
+}
+
+func TestWallet_ReverseTransaction(t *testing.T) {
+	april := NewValidYearMonth(t, 4, 2026)
+	may := NewValidYearMonth(t, 5, 2026)
+	startOfApril := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local)
+	startOfMay := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.Local)
+	reversedAt := time.Date(2026, time.May, 10, 0, 0, 0, 0, time.Local)
+
+	newPeriod := func(t *testing.T, yearMonth ledger.YearMonth, status string, start time.Time) *ledger.AccountingPeriod {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(),
+			yearMonth.String(),
+			1,
+			1,
+			status,
+			100,
+			0,
+			0,
+			0,
+			0,
+			100,
+			"USD",
+			start,
+			start.AddDate(0, 1, 0),
+			0,
+		)
+		require.NoError(t, err)
+
+		return ap
+	}
+
+	// 100 in the provider, all allocated to the wallet
+	newWallet := func(t *testing.T, periods ...*ledger.AccountingPeriod) (*wallet.Wallet, *fundprovider.FundProvider) {
+		t.Helper()
+
+		provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 100, 0, "USD", 1)
+		require.NoError(t, err)
+
+		allocation, err := wallet.NewFpAllocation(provider, 100)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 100, "USD", 0, 1, 1, periods, allocation)
+		require.NoError(t, err)
+
+		return w, provider
+	}
+
+	newRecord := func(t *testing.T, txType string, direction string, fpID uuid.UUID, occurredAt time.Time, reversalOfID uuid.UUID) *ledger.TransactionRecord {
+		t.Helper()
+
+		tr, err := ledger.UnmarshalTransactionRecordFromDatabase(
+			uuid.New(),
+			"TXN-001",
+			txType,
+			direction,
+			30,
+			"USD",
+			"Groceries",
+			occurredAt,
+			occurredAt,
+			100,
+			fpID,
+			100,
+			uuid.Nil,
+			reversalOfID,
+			uuid.Nil,
+			time.Time{},
+			uuid.Nil,
+		)
+		require.NoError(t, err)
+
+		return tr
+	}
+
+	t.Run("reverses a withdrawal in its open period", func(t *testing.T) {
+		aprilPeriod := newPeriod(t, april, "OPEN", startOfApril)
+		w, provider := newWallet(t, aprilPeriod)
+		original := newRecord(t, "WITHDRAWAL", "OUT", provider.ID(), startOfApril.AddDate(0, 0, 5), uuid.Nil)
+
+		require.NoError(t, w.ReverseTransaction(original, "", reversedAt))
+
+		assert.Equal(t, int64(130), w.Balance().Amount())
+		assert.Equal(t, int64(130), provider.Balance().Amount())
+		assert.True(t, original.IsReversed())
+		assert.Equal(t, reversedAt, original.ReversedAt())
+
+		require.Len(t, aprilPeriod.Transactions(), 1)
+		reversal := aprilPeriod.Transactions()[0]
+		assert.Equal(t, ledger.TransactionTypeWithdrawal, reversal.TransactionType())
+		assert.Equal(t, ledger.DirectionIn, reversal.Direction())
+		assert.Equal(t, original.ID(), reversal.ReversalOfID())
+		assert.Equal(t, reversal.ID(), original.ReversedByID())
+		assert.Equal(t, original.OccurredAt(), reversal.OccurredAt())
+		assert.Equal(t, int64(30), aprilPeriod.TotalCredit().Amount())
+		// The reversal takes the withdrawal off the expenses
+		assert.Equal(t, int64(-30), aprilPeriod.TotalExpense().Amount())
+	})
+
+	t.Run("books the reversal of a closed period in the latest open period", func(t *testing.T) {
+		aprilPeriod := newPeriod(t, april, "CLOSE", startOfApril)
+		mayPeriod := newPeriod(t, may, "OPEN", startOfMay)
+		w, provider := newWallet(t, aprilPeriod, mayPeriod)
+		original := newRecord(t, "DEPOSIT", "IN", provider.ID(), startOfApril.AddDate(0, 0, 5), uuid.Nil)
+
+		require.NoError(t, w.ReverseTransaction(original, "", reversedAt))
+
+		assert.Equal(t, int64(70), w.Balance().Amount())
+		assert.Empty(t, aprilPeriod.Transactions())
+		require.Len(t, mayPeriod.Transactions(), 1)
+		adjustment := mayPeriod.Transactions()[0]
+		assert.Equal(t, ledger.TransactionTypeAdjustment, adjustment.TransactionType())
+		assert.Equal(t, ledger.DirectionOut, adjustment.Direction())
+		assert.Equal(t, reversedAt, adjustment.OccurredAt())
+		assert.Equal(t, int64(30), mayPeriod.TotalDebit().Amount())
+		assert.Zero(t, mayPeriod.TotalIncome().Amount())
+	})
+
+	t.Run("returns error when no period is open", func(t *testing.T) {
+		w, provider := newWallet(t, newPeriod(t, april, "CLOSE", startOfApril))
+		original := newRecord(t, "DEPOSIT", "IN", provider.ID(), startOfApril.AddDate(0, 0, 5), uuid.Nil)
+
+		err := w.ReverseTransaction(original, "", reversedAt)
+
+		require.ErrorIs(t, err, wallet.ErrNoOpenAccountingPeriod)
+		assert.False(t, original.IsReversed())
+	})
+
+	t.Run("returns error when the transaction is already reversed", func(t *testing.T) {
+		w, provider := newWallet(t, newPeriod(t, april, "OPEN", startOfApril))
+		original := newRecord(t, "WITHDRAWAL", "OUT", provider.ID(), startOfApril.AddDate(0, 0, 5), uuid.Nil)
+		require.NoError(t, w.ReverseTransaction(original, "", reversedAt))
+
+		err := w.ReverseTransaction(original, "", reversedAt)
+
+		require.ErrorIs(t, err, ledger.ErrTransactionAlreadyReversed)
+	})
+
+	t.Run("returns error when the transaction can not be reversed", func(t *testing.T) {
+		w, provider := newWallet(t, newPeriod(t, april, "OPEN", startOfApril))
+		occurredAt := startOfApril.AddDate(0, 0, 5)
+
+		for _, original := range []*ledger.TransactionRecord{
+			newRecord(t, "TRANSFER_OUT", "OUT", provider.ID(), occurredAt, uuid.Nil),
+			newRecord(t, "DEPOSIT", "IN", provider.ID(), occurredAt, uuid.New()),
+		} {
+			err := w.ReverseTransaction(original, "", reversedAt)
+
+			require.ErrorIs(t, err, ledger.ErrTransactionNotReversible)
+		}
+	})
+
+	t.Run("returns error when the transaction is cleared by a finalised reconciliation", func(t *testing.T) {
+		aprilPeriod := newPeriod(t, april, "OPEN", startOfApril)
+		w, provider := newWallet(t, aprilPeriod)
+		original := newRecord(t, "WITHDRAWAL", "OUT", provider.ID(), startOfApril.AddDate(0, 0, 5), uuid.Nil)
+		original.MarkReconciled()
+
+		err := w.ReverseTransaction(original, "", reversedAt)
+
+		require.ErrorIs(t, err, ledger.ErrTransactionReconciled)
+		assert.False(t, original.IsReversed())
+		assert.Empty(t, aprilPeriod.Transactions())
+		assert.Equal(t, int64(100), w.Balance().Amount())
+	})
+}
+
+func TestWallet_RecordTransactions_Category(t *testing.T) {
+	april := NewValidYearMonth(t, 4, 2026)
+	startOfApril := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local)
+
+	newWallet := func(t *testing.T) (*wallet.Wallet, *fundprovider.FundProvider, *ledger.AccountingPeriod) {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), april.String(), 1, 1, "OPEN", 100, 0, 0, 0, 0, 100, "USD",
+			startOfApril, startOfApril.AddDate(0, 1, 0), 0,
+		)
+		require.NoError(t, err)
+
+		provider, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 100, 0, "USD", 1)
+		require.NoError(t, err)
+
+		allocation, err := wallet.NewFpAllocation(provider, 100)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(uuid.New(), "Tai chinh tong", 100, "USD", 0, 1, 1, []*ledger.AccountingPeriod{ap}, allocation)
+		require.NoError(t, err)
+
+		return w, provider, ap
+	}
+
+	expense, err := category.UnmarshalCategoryFromDatabase(uuid.New(), "user-1", uuid.Nil, "Ăn uống", "EXPENSE", 0)
+	require.NoError(t, err)
+
+	newSpec := func(txType string, fpID uuid.UUID, c *category.Category) wallet.TransactionSpec {
+		return wallet.TransactionSpec{
+			TransactionNo:   "TXN-001",
+			TransactionType: txType,
+			Amount:          30,
+			Description:     "Đi chợ",
+			FpID:            fpID,
+			OccurredAt:      startOfApril.AddDate(0, 0, 5),
+			RecordedAt:      startOfApril.AddDate(0, 0, 5),
+			Category:        c,
+		}
+	}
+
+	t.Run("files the record under the category", func(t *testing.T) {
+		w, provider, ap := newWallet(t)
+
+		require.NoError(t, w.RecordTransactions(april, newSpec("WITHDRAWAL", provider.ID(), expense)))
+
+		require.Len(t, ap.Transactions(), 1)
+		assert.Equal(t, expense.ID(), ap.Transactions()[0].CategoryID())
+	})
+
+	t.Run("returns error when an expense category is used on an income", func(t *testing.T) {
+		w, provider, _ := newWallet(t)
+
+		err := w.RecordTransactions(april, newSpec("DEPOSIT", provider.ID(), expense))
+		require.ErrorIs(t, err, category.ErrCategoryKindMismatch)
+	})
+
+	t.Run("files a refund under the expense category it gives back", func(t *testing.T) {
+		w, provider, ap := newWallet(t)
+
+		require.NoError(t, w.RecordTransactions(april, newSpec("REFUND", provider.ID(), expense)))
+
+		require.Len(t, ap.Transactions(), 1)
+		assert.Equal(t, expense.ID(), ap.Transactions()[0].CategoryID())
+		assert.Equal(t, int64(-30), ap.TotalExpense().Amount())
+	})
+}
+
+func TestWallet_RecordTransactions_TransactionNo(t *testing.T) {
+	april := NewValidYearMonth(t, 4, 2026)
+	startOfApril := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local)
+
+	newWallet := func(t *testing.T) (*wallet.Wallet, *fundprovider.FundProvider, *fundprovider.FundProvider) {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), april.String(), 1, 1, "OPEN", 200, 0, 0, 0, 0, 200, "USD",
+			startOfApril, startOfApril.AddDate(0, 1, 0), 0,
+		)
+		require.NoError(t, err)
+
+		bank, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 100, 0, "USD", 1)
+		require.NoError(t, err)
+
+		cash, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Tiền mặt", "CASH", 100, 0, "USD", 1)
+		require.NoError(t, err)
+
+		bankAllocation, err := wallet.NewFpAllocation(bank, 100)
+		require.NoError(t, err)
+
+		cashAllocation, err := wallet.NewFpAllocation(cash, 100)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+			uuid.New(), "Tai chinh tong", 200, "USD", 0, 1, 1, []*ledger.AccountingPeriod{ap}, bankAllocation, cashAllocation,
+		)
+		require.NoError(t, err)
+
+		return w, bank, cash
+	}
+
+	newSpec := func(transactionNo string, fpID uuid.UUID) wallet.TransactionSpec {
+		return wallet.TransactionSpec{
+			TransactionNo:   transactionNo,
+			TransactionType: "WITHDRAWAL",
+			Amount:          10,
+			Description:     "Coffee",
+			FpID:            fpID,
+			OccurredAt:      startOfApril.AddDate(0, 0, 2),
+			RecordedAt:      startOfApril.AddDate(0, 0, 2),
+		}
+	}
+
+	t.Run("returns error when a transactionNo is repeated on the same fund provider", func(t *testing.T) {
+		w, bank, _ := newWallet(t)
+
+		err := w.RecordTransactions(april, newSpec("TXN-001", bank.ID()), newSpec("TXN-001", bank.ID()))
+		require.ErrorIs(t, err, wallet.ErrDuplicateTransactionNo)
+	})
+
+	t.Run("returns error when the transactionNo is already recorded", func(t *testing.T) {
+		w, bank, _ := newWallet(t)
+
+		require.NoError(t, w.RecordTransactions(april, newSpec("TXN-001", bank.ID())))
+
+		err := w.RecordTransactions(april, newSpec("TXN-001", bank.ID()))
+		require.ErrorIs(t, err, wallet.ErrDuplicateTransactionNo)
+	})
+
+	t.Run("accepts the same transactionNo on other fund providers and empty ones", func(t *testing.T) {
+		w, bank, cash := newWallet(t)
+
+		require.NoError(t, w.RecordTransactions(april,
+			newSpec("TXN-001", bank.ID()),
+			newSpec("TXN-001", cash.ID()),
+			newSpec("", bank.ID()),
+			newSpec("", bank.ID()),
+		))
+	})
+}
+
+func TestWallet_PullEvents(t *testing.T) {
+	april := NewValidYearMonth(t, 4, 2026)
+	startOfApril := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.Local)
+
+	newWallet := func(t *testing.T) (*wallet.Wallet, *ledger.AccountingPeriod, *fundprovider.FundProvider) {
+		t.Helper()
+
+		ap, err := ledger.UnmarshalAccountingPeriodFromDatabase(
+			uuid.New(), april.String(), 1, 1, "OPEN", 100, 0, 0, 0, 0, 100, "USD",
+			startOfApril, startOfApril.AddDate(0, 1, 0), 0,
+		)
+		require.NoError(t, err)
+
+		bank, err := fundprovider.UnmarshalFundProviderFromDatabase(uuid.New(), "Techcombank7316", "BANK", 150, 50, "USD", 1)
+		require.NoError(t, err)
+
+		bankAllocation, err := wallet.NewFpAllocation(bank, 100)
+		require.NoError(t, err)
+
+		w, err := wallet.UnmarshalWalletWithLedgerFromDatabase(
+			uuid.New(), "Tai chinh tong", 100, "USD", 0, 1, 1, []*ledger.AccountingPeriod{ap}, bankAllocation,
+		)
+		require.NoError(t, err)
+
+		return w, ap, bank
+	}
+
+	t.Run("raises nothing when the wallet is loaded", func(t *testing.T) {
+		w, _, _ := newWallet(t)
+
+		assert.Empty(t, w.PullEvents())
+	})
+
+	t.Run("raises FundAllocated with the allocation after the change", func(t *testing.T) {
+		w, _, bank := newWallet(t)
+
+		require.NoError(t, w.IncreaseAllocation(bank.ID(), 30))
+		require.NoError(t, w.DecreaseAllocation(bank.ID(), 10))
+
+		assert.Equal(t, []event.Event{
+			event.FundAllocated{WalletID: w.ID(), FundProviderID: bank.ID(), Amount: 30, AllocatedAmount: 130, WalletBalance: 130, Currency: "USD"},
+			event.FundAllocated{WalletID: w.ID(), FundProviderID: bank.ID(), Amount: -10, AllocatedAmount: 120, WalletBalance: 120, Currency: "USD"},
+		}, w.PullEvents())
+	})
+
+	t.Run("raises TransactionRecorded for every record and forgets the pulled events", func(t *testing.T) {
+		w, ap, bank := newWallet(t)
+		occurredAt := startOfApril.AddDate(0, 0, 2)
+
+		require.NoError(t, w.RecordTransactions(april, wallet.TransactionSpec{
+			TransactionNo:   "TXN-001",
+			TransactionType: "WITHDRAWAL",
+			Amount:          10,
+			Description:     "Coffee",
+			FpID:            bank.ID(),
+			OccurredAt:      occurredAt,
+			RecordedAt:      occurredAt,
+		}))
+
+		events := w.PullEvents()
+		require.Len(t, events, 1)
+
+		recorded, ok := events[0].(event.TransactionRecorded)
+		require.True(t, ok)
+		assert.Equal(t, w.ID(), recorded.AggregateID())
+		assert.Equal(t, ap.ID(), recorded.AccountingPeriodID)
+		assert.Equal(t, ap.Transactions()[0].ID(), recorded.TransactionID)
+		assert.Equal(t, "TXN-001", recorded.TransactionNo)
+		assert.Equal(t, "OUT", recorded.Direction)
+		assert.Equal(t, int64(10), recorded.Amount)
+		assert.Equal(t, int64(90), recorded.WalletBalance)
+
+		assert.Empty(t, w.PullEvents())
+	})
+
+	t.Run("raises nothing when the change is rejected", func(t *testing.T) {
+		w, _, bank := newWallet(t)
+
+		require.Error(t, w.DecreaseAllocation(bank.ID(), 500))
+
+		assert.Empty(t, w.PullEvents())
+	})
+
+	t.Run("raises the events of the accounting periods first, carrying the wallet", func(t *testing.T) {
+		w, ap, _ := newWallet(t)
+
+		_, err := w.RolloverAccountingPeriods(startOfApril.AddDate(0, 1, 0))
+		require.NoError(t, err)
+
+		events := w.PullEvents()
+		require.Len(t, events, 2)
+
+		closed, ok := events[0].(event.PeriodClosed)
+		require.True(t, ok)
+		assert.Equal(t, w.ID(), closed.WalletID)
+		assert.Equal(t, ap.ID(), closed.AccountingPeriodID)
+		assert.Equal(t, int64(100), closed.ClosingBalance)
+
+		opened, ok := events[1].(event.PeriodOpened)
+		require.True(t, ok)
+		assert.Equal(t, w.ID(), opened.WalletID)
+		assert.Equal(t, NewValidYearMonth(t, 5, 2026).String(), opened.YearMonth)
+		assert.Equal(t, int64(100), opened.OpeningBalance)
+	})
+}
@@ -0,0 +1,273 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAttempts is the number of failed attempts after which a delivery is dead
+	MaxAttempts = 8
+
+	baseRetryDelay = time.Minute
+	maxRetryDelay  = 12 * time.Hour
+
+	maxErrorLength = 1000
+)
+
+var ErrDeliveryNotPending = errors.New("webhook delivery already succeeded or is dead")
+
+var (
+	StatusPending   = Status{value: "PENDING"}
+	StatusSucceeded = Status{value: "SUCCEEDED"}
+	// StatusDead is the dead letter state, the delivery is kept for the logs and never attempted again
+	StatusDead = Status{value: "DEAD"}
+)
+
+type Status struct {
+	value string
+}
+
+func NewStatus(statusStr string) (Status, error) {
+	switch strings.TrimSpace(strings.ToUpper(statusStr)) {
+	case StatusPending.value:
+		return StatusPending, nil
+	case StatusSucceeded.value:
+		return StatusSucceeded, nil
+	case StatusDead.value:
+		return StatusDead, nil
+	}
+
+	return Status{}, fmt.Errorf("unknown webhook delivery status: %s", statusStr)
+}
+
+func (s Status) String() string { return s.value }
+
+// Attempt is one POST of a delivery to the endpoint of the subscription.
+type Attempt struct {
+	AttemptedAt time.Time
+	// StatusCode is 0 when the endpoint could not be reached
+	StatusCode int32
+	Error      string
+	Duration   time.Duration
+}
+
+// Succeeded reports whether the endpoint acknowledged the delivery with a 2xx response.
+func (a Attempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// Delivery is an event to be posted to the endpoint of a subscription.
+// A failed attempt is retried with an exponential backoff until MaxAttempts, the delivery is then DEAD.
+type Delivery struct {
+	id             uuid.UUID
+	subscriptionID uuid.UUID
+	eventID        uuid.UUID
+	eventName      string
+	payload        []byte
+	status         Status
+	attempts       int32
+	nextAttemptAt  time.Time
+	lastStatusCode int32
+	lastError      string
+	createdAt      time.Time
+	deliveredAt    time.Time
+
+	// recorded keeps the attempts made since the delivery was loaded until its repository stores them
+	recorded []Attempt
+}
+
+// payload is the body posted to the endpoint, the id of the event is the same on every delivery of the event.
+type payload struct {
+	ID         uuid.UUID   `json:"id"`
+	Event      string      `json:"event"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Data       event.Event `json:"data"`
+}
+
+// NewDelivery schedules env for the subscription subscriptionID, it is due right away.
+// The body is encoded once so every attempt posts the same bytes.
+func NewDelivery(subscriptionID uuid.UUID, env event.Envelope, createdAt time.Time) (*Delivery, error) {
+	v := validator.New()
+
+	v.Check(subscriptionID != uuid.Nil, "subscriptionID", "subscriptionID is required")
+	v.Check(env.ID != uuid.Nil, "eventID", "eventID is required")
+	v.Check(env.Event != nil, "event", "event is required")
+	v.Check(!createdAt.IsZero(), "createdAt", "createdAt is required")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhookDeliveryID: %w", err)
+	}
+
+	body, err := json.Marshal(payload{
+		ID:         env.ID,
+		Event:      env.Event.EventName(),
+		OccurredAt: env.OccurredAt,
+		Data:       env.Event,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event %s: %w", env.Event.EventName(), err)
+	}
+
+	return &Delivery{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        env.ID,
+		eventName:      env.Event.EventName(),
+		payload:        body,
+		status:         StatusPending,
+		nextAttemptAt:  createdAt,
+		createdAt:      createdAt,
+	}, nil
+}
+
+func UnmarshalDeliveryFromDatabase(
+	id uuid.UUID,
+	subscriptionID uuid.UUID,
+	eventID uuid.UUID,
+	eventName string,
+	payload []byte,
+	statusStr string,
+	attempts int32,
+	nextAttemptAt time.Time,
+	lastStatusCode int32,
+	lastError string,
+	createdAt time.Time,
+	deliveredAt time.Time,
+) (*Delivery, error) {
+	v := validator.New()
+
+	v.Check(id != uuid.Nil, "id", "id is required")
+	v.Check(subscriptionID != uuid.Nil, "subscriptionID", "subscriptionID is required")
+	v.Check(eventID != uuid.Nil, "eventID", "eventID is required")
+	v.Required(eventName, "eventName")
+	v.Check(attempts >= 0, "attempts", "attempts must be greater or equal than 0")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	status, err := NewStatus(statusStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Delivery{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventName:      eventName,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		deliveredAt:    deliveredAt,
+	}, nil
+}
+
+func (d *Delivery) ID() uuid.UUID             { return d.id }
+func (d *Delivery) SubscriptionID() uuid.UUID { return d.subscriptionID }
+func (d *Delivery) EventID() uuid.UUID        { return d.eventID }
+func (d *Delivery) EventName() string         { return d.eventName }
+func (d *Delivery) Payload() []byte           { return d.payload }
+func (d *Delivery) Status() Status            { return d.status }
+func (d *Delivery) Attempts() int32           { return d.attempts }
+func (d *Delivery) NextAttemptAt() time.Time  { return d.nextAttemptAt }
+func (d *Delivery) LastStatusCode() int32     { return d.lastStatusCode }
+func (d *Delivery) LastError() string         { return d.lastError }
+func (d *Delivery) CreatedAt() time.Time      { return d.createdAt }
+func (d *Delivery) DeliveredAt() time.Time    { return d.deliveredAt }
+
+func (d *Delivery) IsPending() bool { return d.status == StatusPending }
+
+// Request is the signed POST of the delivery to the endpoint of s, sentAt is the signed timestamp.
+func (d *Delivery) Request(s *Subscription, sentAt time.Time) Request {
+	return Request{
+		URL: s.URL(),
+		Headers: map[string]string{
+			"Content-Type":  "application/json",
+			HeaderEvent:     d.eventName,
+			HeaderDelivery:  d.id.String(),
+			HeaderTimestamp: formatTimestamp(sentAt),
+			HeaderSignature: Sign(s.Secret(), sentAt, d.payload),
+		},
+		Body: d.payload,
+	}
+}
+
+// RecordAttempt stores the outcome of an attempt. A delivery that is not acknowledged with a 2xx response
+// is attempted again after RetryDelay, it is DEAD after MaxAttempts.
+func (d *Delivery) RecordAttempt(a Attempt) error {
+	if !d.IsPending() {
+		return ErrDeliveryNotPending
+	}
+
+	if a.Error == "" && !a.Succeeded() {
+		a.Error = fmt.Sprintf("endpoint responded with status %d", a.StatusCode)
+	}
+	a.Error = truncate(a.Error, maxErrorLength)
+
+	d.attempts++
+	d.lastStatusCode = a.StatusCode
+	d.lastError = a.Error
+	d.recorded = append(d.recorded, a)
+
+	if a.Succeeded() {
+		d.status = StatusSucceeded
+		d.deliveredAt = a.AttemptedAt
+		return nil
+	}
+
+	if d.attempts >= MaxAttempts {
+		d.status = StatusDead
+		return nil
+	}
+
+	d.nextAttemptAt = a.AttemptedAt.Add(RetryDelay(d.attempts))
+	return nil
+}
+
+// PullAttempts returns the attempts recorded since the delivery was loaded and forgets them, so they are stored once.
+func (d *Delivery) PullAttempts() []Attempt {
+	attempts := d.recorded
+	d.recorded = nil
+
+	return attempts
+}
+
+// RetryDelay is the wait after the attempts-th failed attempt, doubling from a minute up to 12 hours.
+func RetryDelay(attempts int32) time.Duration {
+	delay := baseRetryDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+
+	return delay
+}
+
+func truncate(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	return string([]rune(s)[:maxRunes])
+}
@@ -0,0 +1,136 @@
+package webhook_test
+
+import (
+	"encoding/json"
+	"strings"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDelivery(t *testing.T, createdAt time.Time) *webhook.Delivery {
+	t.Helper()
+
+	d, err := webhook.NewDelivery(uuid.New(), event.Envelope{
+		ID:         uuid.New(),
+		OccurredAt: createdAt,
+		Event:      event.PeriodClosed{AccountingPeriodID: uuid.New(), YearMonth: "2026-04"},
+	}, createdAt)
+	require.NoError(t, err)
+
+	return d
+}
+
+func TestNewDelivery(t *testing.T) {
+	createdAt := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+	eventID := uuid.New()
+
+	d, err := webhook.NewDelivery(uuid.New(), event.Envelope{
+		ID:         eventID,
+		OccurredAt: createdAt,
+		Event:      event.PeriodClosed{YearMonth: "2026-04", ClosingBalance: 1200},
+	}, createdAt)
+	require.NoError(t, err)
+
+	var body struct {
+		ID    uuid.UUID `json:"id"`
+		Event string    `json:"event"`
+		Data  struct {
+			YearMonth      string `json:"yearMonth"`
+			ClosingBalance int64  `json:"closingBalance"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(d.Payload(), &body))
+
+	assert.Equal(t, eventID, body.ID)
+	assert.Equal(t, event.NamePeriodClosed, body.Event)
+	assert.Equal(t, "2026-04", body.Data.YearMonth)
+	assert.Equal(t, int64(1200), body.Data.ClosingBalance)
+	assert.True(t, d.IsPending())
+	assert.Equal(t, createdAt, d.NextAttemptAt())
+}
+
+func TestDelivery_RecordAttempt(t *testing.T) {
+	attemptedAt := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("succeeds on a 2xx response", func(t *testing.T) {
+		d := newDelivery(t, attemptedAt)
+
+		require.NoError(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, StatusCode: 204}))
+
+		assert.Equal(t, webhook.StatusSucceeded, d.Status())
+		assert.Equal(t, attemptedAt, d.DeliveredAt())
+		assert.Len(t, d.PullAttempts(), 1)
+		assert.Empty(t, d.PullAttempts())
+	})
+
+	t.Run("retries with a growing delay", func(t *testing.T) {
+		d := newDelivery(t, attemptedAt)
+
+		require.NoError(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, StatusCode: 503}))
+		assert.Equal(t, attemptedAt.Add(time.Minute), d.NextAttemptAt())
+		assert.Equal(t, "endpoint responded with status 503", d.LastError())
+
+		require.NoError(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, Error: "connection refused"}))
+		assert.Equal(t, attemptedAt.Add(2*time.Minute), d.NextAttemptAt())
+		assert.Equal(t, int32(0), d.LastStatusCode())
+
+		assert.True(t, d.IsPending())
+		assert.Equal(t, int32(2), d.Attempts())
+	})
+
+	t.Run("is dead after the last attempt", func(t *testing.T) {
+		d := newDelivery(t, attemptedAt)
+
+		for range webhook.MaxAttempts {
+			require.NoError(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, StatusCode: 500}))
+		}
+
+		assert.Equal(t, webhook.StatusDead, d.Status())
+		require.ErrorIs(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, StatusCode: 200}),
+			webhook.ErrDeliveryNotPending)
+	})
+
+	t.Run("truncates a long error", func(t *testing.T) {
+		d := newDelivery(t, attemptedAt)
+
+		require.NoError(t, d.RecordAttempt(webhook.Attempt{AttemptedAt: attemptedAt, Error: strings.Repeat("é", 2000)}))
+
+		assert.Equal(t, 1000, len([]rune(d.LastError())))
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, webhook.RetryDelay(1))
+	assert.Equal(t, 64*time.Minute, webhook.RetryDelay(7))
+	assert.Equal(t, 12*time.Hour, webhook.RetryDelay(20))
+}
+
+func TestDelivery_Request(t *testing.T) {
+	sentAt := time.Unix(1_777_593_600, 0)
+
+	s, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", "0123456789abcdef", nil, sentAt)
+	require.NoError(t, err)
+	d := newDelivery(t, sentAt)
+
+	req := d.Request(s, sentAt)
+
+	assert.Equal(t, "https://bot.example.com/hook", req.URL)
+	assert.Equal(t, d.Payload(), req.Body)
+	assert.Equal(t, "1777593600", req.Headers[webhook.HeaderTimestamp])
+	assert.Equal(t, d.ID().String(), req.Headers[webhook.HeaderDelivery])
+	assert.Equal(t, event.NamePeriodClosed, req.Headers[webhook.HeaderEvent])
+	assert.Equal(t, webhook.Sign("0123456789abcdef", sentAt, d.Payload()), req.Headers[webhook.HeaderSignature])
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1777593600.{"id":1}' | openssl dgst -sha256 -hmac 0123456789abcdef
+	got := webhook.Sign("0123456789abcdef", time.Unix(1_777_593_600, 0), []byte(`{"id":1}`))
+
+	assert.Equal(t, "sha256=09d85cd5cb0b62020b4552cb414c8fc691e575eb86e504611f3a0a8dd27caa5d", got)
+}
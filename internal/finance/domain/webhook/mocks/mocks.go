@@ -0,0 +1,348 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+
+	webhook "sumni-finance-backend/internal/finance/domain/webhook"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateDeliveries provides a mock function with given fields: ctx, deliveries
+func (_m *MockRepository) CreateDeliveries(ctx context.Context, deliveries []*webhook.Delivery) error {
+	ret := _m.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*webhook.Delivery) error); ok {
+		r0 = rf(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_CreateDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeliveries'
+type MockRepository_CreateDeliveries_Call struct {
+	*mock.Call
+}
+
+// CreateDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveries []*webhook.Delivery
+func (_e *MockRepository_Expecter) CreateDeliveries(ctx interface{}, deliveries interface{}) *MockRepository_CreateDeliveries_Call {
+	return &MockRepository_CreateDeliveries_Call{Call: _e.mock.On("CreateDeliveries", ctx, deliveries)}
+}
+
+func (_c *MockRepository_CreateDeliveries_Call) Run(run func(ctx context.Context, deliveries []*webhook.Delivery)) *MockRepository_CreateDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*webhook.Delivery))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateDeliveries_Call) Return(_a0 error) *MockRepository_CreateDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_CreateDeliveries_Call) RunAndReturn(run func(context.Context, []*webhook.Delivery) error) *MockRepository_CreateDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubscription provides a mock function with given fields: ctx, s
+func (_m *MockRepository) CreateSubscription(ctx context.Context, s *webhook.Subscription) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.Subscription) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_CreateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubscription'
+type MockRepository_CreateSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *webhook.Subscription
+func (_e *MockRepository_Expecter) CreateSubscription(ctx interface{}, s interface{}) *MockRepository_CreateSubscription_Call {
+	return &MockRepository_CreateSubscription_Call{Call: _e.mock.On("CreateSubscription", ctx, s)}
+}
+
+func (_c *MockRepository_CreateSubscription_Call) Run(run func(ctx context.Context, s *webhook.Subscription)) *MockRepository_CreateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Subscription))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateSubscription_Call) Return(_a0 error) *MockRepository_CreateSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_CreateSubscription_Call) RunAndReturn(run func(context.Context, *webhook.Subscription) error) *MockRepository_CreateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubscription provides a mock function with given fields: ctx, userID, id
+func (_m *MockRepository) DeleteSubscription(ctx context.Context, userID string, id uuid.UUID) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubscription'
+type MockRepository_DeleteSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) DeleteSubscription(ctx interface{}, userID interface{}, id interface{}) *MockRepository_DeleteSubscription_Call {
+	return &MockRepository_DeleteSubscription_Call{Call: _e.mock.On("DeleteSubscription", ctx, userID, id)}
+}
+
+func (_c *MockRepository_DeleteSubscription_Call) Run(run func(ctx context.Context, userID string, id uuid.UUID)) *MockRepository_DeleteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteSubscription_Call) Return(_a0 error) *MockRepository_DeleteSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteSubscription_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) error) *MockRepository_DeleteSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscription provides a mock function with given fields: ctx, userID, id
+func (_m *MockRepository) GetSubscription(ctx context.Context, userID string, id uuid.UUID) (*webhook.Subscription, error) {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*webhook.Subscription, error)); ok {
+		return rf(ctx, userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *webhook.Subscription); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscription'
+type MockRepository_GetSubscription_Call struct {
+	*mock.Call
+}
+
+// GetSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) GetSubscription(ctx interface{}, userID interface{}, id interface{}) *MockRepository_GetSubscription_Call {
+	return &MockRepository_GetSubscription_Call{Call: _e.mock.On("GetSubscription", ctx, userID, id)}
+}
+
+func (_c *MockRepository_GetSubscription_Call) Run(run func(ctx context.Context, userID string, id uuid.UUID)) *MockRepository_GetSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetSubscription_Call) Return(_a0 *webhook.Subscription, _a1 error) *MockRepository_GetSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetSubscription_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*webhook.Subscription, error)) *MockRepository_GetSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptions provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) ListSubscriptions(ctx context.Context, userID string) ([]*webhook.Subscription, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptions")
+	}
+
+	var r0 []*webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*webhook.Subscription, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*webhook.Subscription); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptions'
+type MockRepository_ListSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRepository_Expecter) ListSubscriptions(ctx interface{}, userID interface{}) *MockRepository_ListSubscriptions_Call {
+	return &MockRepository_ListSubscriptions_Call{Call: _e.mock.On("ListSubscriptions", ctx, userID)}
+}
+
+func (_c *MockRepository_ListSubscriptions_Call) Run(run func(ctx context.Context, userID string)) *MockRepository_ListSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListSubscriptions_Call) Return(_a0 []*webhook.Subscription, _a1 error) *MockRepository_ListSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListSubscriptions_Call) RunAndReturn(run func(context.Context, string) ([]*webhook.Subscription, error)) *MockRepository_ListSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelivery provides a mock function with given fields: ctx, id, updateFn
+func (_m *MockRepository) UpdateDelivery(ctx context.Context, id uuid.UUID, updateFn func(*webhook.Subscription, *webhook.Delivery) error) error {
+	ret := _m.Called(ctx, id, updateFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*webhook.Subscription, *webhook.Delivery) error) error); ok {
+		r0 = rf(ctx, id, updateFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type MockRepository_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - updateFn func(*webhook.Subscription , *webhook.Delivery) error
+func (_e *MockRepository_Expecter) UpdateDelivery(ctx interface{}, id interface{}, updateFn interface{}) *MockRepository_UpdateDelivery_Call {
+	return &MockRepository_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", ctx, id, updateFn)}
+}
+
+func (_c *MockRepository_UpdateDelivery_Call) Run(run func(ctx context.Context, id uuid.UUID, updateFn func(*webhook.Subscription, *webhook.Delivery) error)) *MockRepository_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*webhook.Subscription, *webhook.Delivery) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateDelivery_Call) Return(_a0 error) *MockRepository_UpdateDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateDelivery_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*webhook.Subscription, *webhook.Delivery) error) error) *MockRepository_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	webhook "sumni-finance-backend/internal/finance/domain/webhook"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSender is an autogenerated mock type for the Sender type
+type MockSender struct {
+	mock.Mock
+}
+
+type MockSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSender) EXPECT() *MockSender_Expecter {
+	return &MockSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, req
+func (_m *MockSender) Send(ctx context.Context, req webhook.Request) (int32, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Request) (int32, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Request) int32); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.Request) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req webhook.Request
+func (_e *MockSender_Expecter) Send(ctx interface{}, req interface{}) *MockSender_Send_Call {
+	return &MockSender_Send_Call{Call: _e.mock.On("Send", ctx, req)}
+}
+
+func (_c *MockSender_Send_Call) Run(run func(ctx context.Context, req webhook.Request)) *MockSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.Request))
+	})
+	return _c
+}
+
+func (_c *MockSender_Send_Call) Return(statusCode int32, err error) *MockSender_Send_Call {
+	_c.Call.Return(statusCode, err)
+	return _c
+}
+
+func (_c *MockSender_Send_Call) RunAndReturn(run func(context.Context, webhook.Request) (int32, error)) *MockSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSender creates a new instance of MockSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSender {
+	mock := &MockSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
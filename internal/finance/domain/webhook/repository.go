@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	CreateSubscription(ctx context.Context, s *Subscription) error
+	GetSubscription(ctx context.Context, userID string, id uuid.UUID) (*Subscription, error)
+	// DeleteSubscription deletes the subscription with its deliveries
+	DeleteSubscription(ctx context.Context, userID string, id uuid.UUID) error
+	// ListSubscriptions returns the subscriptions of the user userID
+	ListSubscriptions(ctx context.Context, userID string) ([]*Subscription, error)
+
+	// CreateDeliveries stores the deliveries, skipping those whose event was already scheduled for the subscription
+	CreateDeliveries(ctx context.Context, deliveries []*Delivery) error
+	// UpdateDelivery locks the delivery until updateFn returns and stores the result with the attempts it recorded,
+	// so a delivery is attempted by a single worker at a time.
+	UpdateDelivery(ctx context.Context, id uuid.UUID, updateFn func(s *Subscription, d *Delivery) error) error
+}
+
+// Request is a webhook POST.
+type Request struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+type Sender interface {
+	// Send posts req and returns the status code of the response, err is set when no response was received
+	Send(ctx context.Context, req Request) (statusCode int32, err error)
+}
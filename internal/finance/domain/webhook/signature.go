@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// Headers of every webhook request, the receiver checks the signature before trusting the body.
+const (
+	HeaderEvent     = "X-Sumni-Event"
+	HeaderDelivery  = "X-Sumni-Delivery"
+	HeaderTimestamp = "X-Sumni-Timestamp"
+	HeaderSignature = "X-Sumni-Signature"
+)
+
+// Sign returns "sha256=" followed by the hex encoded HMAC-SHA256, keyed with secret, of the unix timestamp
+// in seconds, a dot and the body. Signing the timestamp lets the receiver reject replayed requests.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(formatTimestamp(timestamp)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func formatTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sumni-finance-backend/internal/common/validator"
+	"sumni-finance-backend/internal/finance/domain/event"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	minSecretLength = 16
+	maxSecretLength = 255
+	maxURLLength    = 2000
+)
+
+var (
+	ErrInvalidURL       = errors.New("url must be an absolute http or https URL")
+	ErrUnknownEventType = errors.New("unknown event type")
+)
+
+// Subscription asks for the events of the given types to be posted to url, signed with secret.
+// It is owned by a user, an empty eventTypes subscribes to every event.
+type Subscription struct {
+	id         uuid.UUID
+	userID     string
+	url        string
+	secret     string
+	eventTypes []string
+	createdAt  time.Time
+	version    int32
+}
+
+func NewSubscription(
+	userID string,
+	rawURL string,
+	secret string,
+	eventTypes []string,
+	createdAt time.Time,
+) (*Subscription, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhookSubscriptionID: %w", err)
+	}
+
+	rawURL = strings.TrimSpace(rawURL)
+	if err := validateURL(rawURL); err != nil {
+		return nil, err
+	}
+
+	eventTypes, err = normalizeEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSubscription(id, userID, rawURL, secret, eventTypes, createdAt, 0)
+}
+
+func UnmarshalSubscriptionFromDatabase(
+	id uuid.UUID,
+	userID string,
+	rawURL string,
+	secret string,
+	eventTypes []string,
+	createdAt time.Time,
+	version int32,
+) (*Subscription, error) {
+	return newSubscription(id, userID, rawURL, secret, eventTypes, createdAt, version)
+}
+
+func newSubscription(
+	id uuid.UUID,
+	userID string,
+	rawURL string,
+	secret string,
+	eventTypes []string,
+	createdAt time.Time,
+	version int32,
+) (*Subscription, error) {
+	v := validator.New()
+
+	v.Check(id != uuid.Nil, "id", "id is required")
+	v.Check(strings.TrimSpace(userID) != "", "userID", "userID is required")
+	v.Required(rawURL, "url")
+	v.MaxLength(rawURL, "url", maxURLLength)
+	v.MinLength(secret, "secret", minSecretLength)
+	v.MaxLength(secret, "secret", maxSecretLength)
+	v.Check(!createdAt.IsZero(), "createdAt", "createdAt is required")
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	return &Subscription{
+		id:         id,
+		userID:     userID,
+		url:        rawURL,
+		secret:     secret,
+		eventTypes: eventTypes,
+		createdAt:  createdAt,
+		version:    version,
+	}, nil
+}
+
+func (s *Subscription) ID() uuid.UUID        { return s.id }
+func (s *Subscription) UserID() string       { return s.userID }
+func (s *Subscription) URL() string          { return s.url }
+func (s *Subscription) Secret() string       { return s.secret }
+func (s *Subscription) EventTypes() []string { return slices.Clone(s.eventTypes) }
+func (s *Subscription) CreatedAt() time.Time { return s.createdAt }
+func (s *Subscription) Version() int32       { return s.version }
+
+// Accepts reports whether events named eventName are posted to the subscription.
+func (s *Subscription) Accepts(eventName string) bool {
+	return len(s.eventTypes) == 0 || slices.Contains(s.eventTypes, eventName)
+}
+
+func validateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || !u.IsAbs() || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return ErrInvalidURL
+	}
+
+	return nil
+}
+
+// normalizeEventTypes checks the event types against the events relayed from the outbox, sorted and without duplicates.
+func normalizeEventTypes(eventTypes []string) ([]string, error) {
+	known := event.Names()
+
+	normalized := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !slices.Contains(known, eventType) {
+			return nil, fmt.Errorf("event type '%s': %w", eventType, ErrUnknownEventType)
+		}
+
+		normalized = append(normalized, eventType)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
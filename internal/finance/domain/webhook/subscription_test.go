@@ -0,0 +1,60 @@
+package webhook_test
+
+import (
+	"sumni-finance-backend/internal/finance/domain/event"
+	"sumni-finance-backend/internal/finance/domain/webhook"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscription(t *testing.T) {
+	createdAt := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+	secret := "0123456789abcdef"
+
+	t.Run("sorts and deduplicates the event types", func(t *testing.T) {
+		s, err := webhook.NewSubscription("user-1", " https://bot.example.com/hook ", secret,
+			[]string{event.NameTransactionRecorded, event.NamePeriodClosed, event.NameTransactionRecorded}, createdAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://bot.example.com/hook", s.URL())
+		assert.Equal(t, []string{event.NamePeriodClosed, event.NameTransactionRecorded}, s.EventTypes())
+	})
+
+	t.Run("rejects an unknown event type", func(t *testing.T) {
+		_, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", secret, []string{"WalletDeleted"}, createdAt)
+
+		require.ErrorIs(t, err, webhook.ErrUnknownEventType)
+	})
+
+	t.Run("rejects a URL that is not http", func(t *testing.T) {
+		for _, rawURL := range []string{"ftp://bot.example.com/hook", "/hook", "https://", "not a url"} {
+			_, err := webhook.NewSubscription("user-1", rawURL, secret, nil, createdAt)
+
+			require.ErrorIs(t, err, webhook.ErrInvalidURL, rawURL)
+		}
+	})
+
+	t.Run("rejects a short secret", func(t *testing.T) {
+		_, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", "secret", nil, createdAt)
+
+		require.Error(t, err)
+	})
+}
+
+func TestSubscription_Accepts(t *testing.T) {
+	createdAt := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	all, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", "0123456789abcdef", nil, createdAt)
+	require.NoError(t, err)
+
+	closings, err := webhook.NewSubscription("user-1", "https://bot.example.com/hook", "0123456789abcdef",
+		[]string{event.NamePeriodClosed}, createdAt)
+	require.NoError(t, err)
+
+	assert.True(t, all.Accepts(event.NameTransactionRecorded))
+	assert.True(t, closings.Accepts(event.NamePeriodClosed))
+	assert.False(t, closings.Accepts(event.NameTransactionRecorded))
+}
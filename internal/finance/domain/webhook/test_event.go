@@ -0,0 +1,16 @@
+package webhook
+
+import "github.com/google/uuid"
+
+const NameTestEvent = "WebhookTest"
+
+// TestEvent is posted on demand to check the endpoint of a subscription, whatever its event types.
+// It is not a domain event and never goes through the outbox.
+type TestEvent struct {
+	SubscriptionID uuid.UUID `json:"subscriptionId"`
+	Message        string    `json:"message"`
+}
+
+func (e TestEvent) EventName() string      { return NameTestEvent }
+func (e TestEvent) AggregateType() string  { return "webhook_subscription" }
+func (e TestEvent) AggregateID() uuid.UUID { return e.SubscriptionID }
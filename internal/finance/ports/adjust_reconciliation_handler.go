@@ -0,0 +1,43 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Post an adjustment closing the difference of a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/adjustment)
+func (hs HttpServer) AdjustReconciliation(
+	w http.ResponseWriter,
+	r *http.Request,
+	fundProviderId openapi_types.UUID,
+	reconciliationId openapi_types.UUID,
+) {
+	var req AdjustReconciliationRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.AdjustReconciliation.Handle(r.Context(), command.AdjustReconciliationCmd{
+		FundProviderID:   fundProviderId,
+		ReconciliationID: reconciliationId,
+		WalletID:         req.WalletId,
+		Description:      convert.SafeDeref(req.Description, ""),
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
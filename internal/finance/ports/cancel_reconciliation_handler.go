@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Cancel a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/cancel)
+func (hs HttpServer) CancelReconciliation(
+	w http.ResponseWriter,
+	r *http.Request,
+	fundProviderId openapi_types.UUID,
+	reconciliationId openapi_types.UUID,
+) {
+	if err := hs.application.Commands.CancelReconciliation.Handle(r.Context(), command.CancelReconciliationCmd{
+		FundProviderID:   fundProviderId,
+		ReconciliationID: reconciliationId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
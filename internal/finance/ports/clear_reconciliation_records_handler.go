@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Clear or unclear records of a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/clear)
+func (hs HttpServer) ClearReconciliationRecords(
+	w http.ResponseWriter,
+	r *http.Request,
+	fundProviderId openapi_types.UUID,
+	reconciliationId openapi_types.UUID,
+) {
+	var req ClearReconciliationRecordsRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.ClearReconciliationRecords.Handle(r.Context(), command.ClearReconciliationRecordsCmd{
+		FundProviderID:   fundProviderId,
+		ReconciliationID: reconciliationId,
+		TransactionIDs:   req.TransactionIds,
+		Cleared:          req.Cleared,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,60 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Close an accounting period of a wallet
+// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth}/close)
+func (hs HttpServer) CloseAccountingPeriod(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	yearMonth string,
+) {
+	if err := hs.application.Commands.CloseAccountingPeriod.Handle(
+		r.Context(),
+		command.CloseAccountingPeriodCmd{
+			WalletID:  walletId,
+			YearMonth: yearMonth,
+		},
+	); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	report, err := hs.application.Queries.AccountingPeriodClosingReport.Handle(
+		r.Context(),
+		query.GetAccountingPeriodClosingReport{
+			WalletID:  walletId,
+			YearMonth: yearMonth,
+		},
+	)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"closingReport": AccountingPeriodClosingReport{
+			Id:               report.ID,
+			YearMonth:        report.YearMonth,
+			Status:           report.Status,
+			EndDate:          report.EndDate,
+			Currency:         report.Currency,
+			OpeningBalance:   report.OpeningBalance,
+			TotalDebit:       report.TotalDebit,
+			TotalCredit:      report.TotalCredit,
+			TotalIncome:      report.TotalIncome,
+			TotalExpense:     report.TotalExpense,
+			ClosingBalance:   report.ClosingBalance,
+			TransactionCount: report.TransactionCount,
+		},
+	}, nil)
+}
@@ -0,0 +1,43 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+)
+
+// Create a category
+// (POST /v1/categories)
+func (hs HttpServer) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateCategoryRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.CreateCategory.Handle(r.Context(), command.CreateCategoryCmd{
+		UserID:   user.ID,
+		Name:     req.Name,
+		Kind:     string(convert.SafeDeref(req.Kind, "")),
+		ParentID: req.ParentId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
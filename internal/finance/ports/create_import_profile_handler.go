@@ -0,0 +1,55 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/domain/importing"
+)
+
+// Create an import profile
+// (POST /v1/import-profiles)
+func (hs HttpServer) CreateImportProfile(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateImportProfileRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.CreateImportProfile.Handle(r.Context(), command.CreateImportProfileCmd{
+		UserID: user.ID,
+		Spec: importing.ProfileSpec{
+			Name:               req.Name,
+			Delimiter:          convert.SafeDeref(req.Delimiter, ""),
+			SkipRows:           convert.SafeDeref(req.SkipRows, 0),
+			DateColumn:         req.DateColumn,
+			DateFormat:         req.DateFormat,
+			AmountSign:         string(req.AmountSign),
+			AmountColumn:       convert.SafeDeref(req.AmountColumn, ""),
+			DebitColumn:        convert.SafeDeref(req.DebitColumn, ""),
+			CreditColumn:       convert.SafeDeref(req.CreditColumn, ""),
+			DescriptionColumn:  convert.SafeDeref(req.DescriptionColumn, ""),
+			ReferenceColumn:    convert.SafeDeref(req.ReferenceColumn, ""),
+			ThousandsSeparator: convert.SafeDeref(req.ThousandsSeparator, ""),
+		},
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
@@ -0,0 +1,56 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Create a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates)
+func (hs HttpServer) CreateRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateRecurringTemplateRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	// Occurrences fall on local calendar days, like the accounting periods
+	startDate := time.Date(req.StartDate.Year(), req.StartDate.Month(), req.StartDate.Day(), 0, 0, 0, 0, time.Local)
+
+	if err := hs.application.Commands.CreateRecurringTemplate.Handle(r.Context(), command.CreateRecurringTemplateCmd{
+		UserID:          user.ID,
+		WalletID:        walletId,
+		FundProviderID:  req.FundProviderId,
+		Amount:          req.Amount,
+		TransactionType: string(req.TransactionType),
+		CategoryID:      req.CategoryId,
+		Description:     convert.SafeDeref(req.Description, ""),
+		Frequency:       string(req.Frequency),
+		Interval:        convert.SafeDeref(req.Interval, 1),
+		DayOfMonth:      convert.SafeDeref(req.DayOfMonth, 0),
+		StartDate:       startDate,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
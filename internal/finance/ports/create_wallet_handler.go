@@ -3,6 +3,8 @@ package ports
 import (
 	"encoding/json"
 	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
 	"sumni-finance-backend/internal/common/server/httperr"
 	"sumni-finance-backend/internal/common/server/response"
 	"sumni-finance-backend/internal/finance/app/command"
@@ -11,6 +13,12 @@ import (
 // Create a new wallet
 // (POST /v1/wallets)
 func (hs HttpServer) CreateWallet(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	var req CreateWalletRequest
 
 	decoder := json.NewDecoder(r.Body)
@@ -22,8 +30,11 @@ func (hs HttpServer) CreateWallet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := hs.application.Commands.CreateWallet.Handle(r.Context(), command.CreateWalletCmd{
-		Name:         req.Name,
-		CurrencyCode: req.Currency,
+		UserID:         user.ID,
+		Name:           req.Name,
+		CurrencyCode:   req.Currency,
+		PeriodStartDay: convert.SafeDeref(req.PeriodStartDay, 1),
+		PeriodInterval: convert.SafeDeref(req.PeriodInterval, 1),
 	}); err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
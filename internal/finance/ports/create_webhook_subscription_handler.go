@@ -0,0 +1,49 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+)
+
+// Create a webhook subscription
+// (POST /v1/webhooks)
+func (hs HttpServer) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req CreateWebhookSubscriptionRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	eventTypes := convert.SafeDeref(req.EventTypes, nil)
+	cmdEventTypes := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		cmdEventTypes = append(cmdEventTypes, string(eventType))
+	}
+
+	if err := hs.application.Commands.CreateWebhookSubscription.Handle(r.Context(), command.CreateWebhookSubscriptionCmd{
+		UserID:     user.ID,
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: cmdEventTypes,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
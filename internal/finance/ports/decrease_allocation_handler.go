@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Decrease an allocation
+// (POST /v1/wallets/{walletId}/fund-providers/{fundProviderId}/decrease-allocation)
+func (hs HttpServer) DecreaseAllocation(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	fundProviderId openapi_types.UUID,
+) {
+	var req UpdateAllocationRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.DecreaseAllocation.Handle(
+		r.Context(),
+		command.DecreaseAllocationCmd{
+			WalletID:       walletId,
+			FundProviderID: fundProviderId,
+			Amount:         req.Amount,
+		},
+	); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
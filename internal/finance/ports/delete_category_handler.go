@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Delete a category
+// (DELETE /v1/categories/{categoryId})
+func (hs HttpServer) DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.DeleteCategory.Handle(r.Context(), command.DeleteCategoryCmd{
+		UserID:     user.ID,
+		CategoryID: categoryId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Delete an import profile
+// (DELETE /v1/import-profiles/{profileId})
+func (hs HttpServer) DeleteImportProfile(w http.ResponseWriter, r *http.Request, profileId openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.DeleteImportProfile.Handle(r.Context(), command.DeleteImportProfileCmd{
+		UserID:    user.ID,
+		ProfileID: profileId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,76 @@
+package ports
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sumni-finance-backend/internal/common/logs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Export the ledger of an accounting period
+// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/export)
+func (hs HttpServer) ExportLedger(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	yearMonth string,
+	params ExportLedgerParams,
+) {
+	var contentType string
+	switch params.Format {
+	case ExportFormatCSV:
+		contentType = "text/csv; charset=utf-8"
+	case ExportFormatXLSX:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ExportFormatJSON:
+		contentType = "application/json"
+	default:
+		httperr.BadRequest("invalid-export-format", fmt.Errorf("export format '%s' is not one of csv, xlsx and json", params.Format), w, r)
+		return
+	}
+
+	export, err := hs.application.Queries.LedgerExport.Handle(r.Context(), query.ExportLedger{
+		WalletID:  walletId,
+		YearMonth: yearMonth,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(
+		`attachment; filename="ledger-%s.%s"`,
+		strings.ReplaceAll(export.Period.YearMonth, ",", "-"),
+		params.Format,
+	))
+	w.WriteHeader(http.StatusOK)
+
+	if err := writeLedger(newLedgerWriter(params.Format, w), export); err != nil {
+		// The status is sent already, aborting tells the client the file is incomplete
+		logs.FromContext(r.Context()).Error("failed to export ledger", "error", err, "walletId", walletId, "yearMonth", yearMonth)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func writeLedger(lw ledgerWriter, export query.LedgerExport) error {
+	if err := lw.WriteHeader(export.Period); err != nil {
+		return err
+	}
+
+	for tr, err := range export.Records {
+		if err != nil {
+			return err
+		}
+
+		if err := lw.WriteRecord(tr); err != nil {
+			return err
+		}
+	}
+
+	return lw.Close()
+}
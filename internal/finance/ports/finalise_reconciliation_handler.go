@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Finalise a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/finalise)
+func (hs HttpServer) FinaliseReconciliation(
+	w http.ResponseWriter,
+	r *http.Request,
+	fundProviderId openapi_types.UUID,
+	reconciliationId openapi_types.UUID,
+) {
+	if err := hs.application.Commands.FinaliseReconciliation.Handle(r.Context(), command.FinaliseReconciliationCmd{
+		FundProviderID:   fundProviderId,
+		ReconciliationID: reconciliationId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
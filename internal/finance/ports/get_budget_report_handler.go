@@ -0,0 +1,53 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Get the budget report of an accounting period
+// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets)
+func (hs HttpServer) GetBudgetReport(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	yearMonth string,
+) {
+	report, err := hs.application.Queries.BudgetReport.Handle(r.Context(), query.GetBudgetReport{
+		WalletID:  walletId,
+		YearMonth: yearMonth,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	categories := make([]CategoryBudgetStatus, 0, len(report.Categories))
+	for _, c := range report.Categories {
+		categories = append(categories, CategoryBudgetStatus{
+			CategoryId: c.CategoryID,
+			ParentId:   c.ParentID,
+			Name:       c.Name,
+			Budgeted:   c.Budgeted,
+			Actual:     c.Actual,
+			Remaining:  c.Remaining,
+			OverBudget: c.OverBudget,
+		})
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"budgetReport": BudgetReport{
+			AccountingPeriodId: report.AccountingPeriodID,
+			YearMonth:          report.YearMonth,
+			Currency:           report.Currency,
+			TotalBudgeted:      report.TotalBudgeted,
+			TotalActual:        report.TotalActual,
+			TotalRemaining:     report.TotalRemaining,
+			Categories:         categories,
+		},
+	}, nil)
+}
@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Get a fund provider
+// (GET /v1/fund-providers/{fundProviderId})
+func (hs HttpServer) GetFundProvider(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID) {
+	fundProvider, err := hs.application.Queries.FundProvider.Handle(r.Context(), query.GetFundProvider{
+		FundProviderID: fundProviderId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	allocations := make([]FundProviderAllocation, 0, len(fundProvider.Allocations))
+	for _, allocation := range fundProvider.Allocations {
+		allocations = append(allocations, FundProviderAllocation{
+			WalletId:   allocation.WalletID,
+			WalletName: allocation.WalletName,
+			Allocated:  allocation.Allocated,
+		})
+	}
+
+	resp := mapFundProviderToResponse(fundProvider)
+	resp.Allocations = &allocations
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"fundProvider": resp,
+	}, nil)
+}
+
+func mapFundProviderToResponse(fundProvider query.FundProvider) FundProvider {
+	return FundProvider{
+		Id:                 fundProvider.ID,
+		Name:               fundProvider.Name,
+		FpType:             fundProvider.Type,
+		Currency:           fundProvider.Currency,
+		Balance:            fundProvider.Balance,
+		AllocatedBalance:   fundProvider.AllocatedBalance,
+		UnallocatedBalance: fundProvider.UnallocatedBalance,
+		Version:            fundProvider.Version,
+	}
+}
@@ -0,0 +1,63 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Get a reconciliation
+// (GET /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId})
+func (hs HttpServer) GetReconciliation(
+	w http.ResponseWriter,
+	r *http.Request,
+	fundProviderId openapi_types.UUID,
+	reconciliationId openapi_types.UUID,
+) {
+	rec, err := hs.application.Queries.Reconciliation.Handle(r.Context(), query.GetReconciliation{
+		FundProviderID:   fundProviderId,
+		ReconciliationID: reconciliationId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	records := make([]ReconciliationRecord, 0, len(rec.Records))
+	for _, record := range rec.Records {
+		item := ReconciliationRecord{
+			Id:              record.ID,
+			WalletId:        record.WalletID,
+			WalletName:      record.WalletName,
+			TransactionType: TransactionType(record.TransactionType),
+			Direction:       TransactionDirection(record.Direction),
+			Amount:          record.Amount,
+			Description:     record.Description,
+			OccurredAt:      record.OccurredAt,
+			Cleared:         record.Cleared,
+			Adjustment:      record.Adjustment,
+		}
+
+		if record.TransactionNo != "" {
+			item.TransactionNo = &record.TransactionNo
+		}
+
+		records = append(records, item)
+	}
+
+	resp := mapReconciliationToResponse(rec)
+	resp.Currency = &rec.Currency
+	resp.BookBalance = &rec.BookBalance
+	resp.Records = &records
+	if rec.Status == string(ReconciliationStatusInProgress) {
+		resp.ClearedBalance = &rec.ClearedBalance
+		resp.Difference = &rec.Difference
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"reconciliation": resp,
+	}, nil)
+}
@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Get a wallet
+// (GET /v1/wallets/{walletId})
+func (hs HttpServer) GetWallet(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	wallet, err := hs.application.Queries.Wallet.Handle(r.Context(), query.GetWallet{
+		WalletID: walletId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"wallet": mapWalletToResponse(wallet),
+	}, nil)
+}
+
+func mapWalletToResponse(wallet query.Wallet) Wallet {
+	allocations := make([]WalletAllocation, 0, len(wallet.Allocations))
+	for _, allocation := range wallet.Allocations {
+		allocations = append(allocations, WalletAllocation{
+			FundProviderId:   allocation.FundProviderID,
+			FundProviderName: allocation.FundProviderName,
+			FundProviderType: allocation.FundProviderType,
+			Allocated:        allocation.Allocated,
+		})
+	}
+
+	return Wallet{
+		Id:       wallet.ID,
+		Name:     wallet.Name,
+		Balance:  wallet.Balance,
+		Currency: wallet.Currency,
+		Version:  wallet.Version,
+		LedgerConfig: LedgerConfig{
+			PeriodStartDay: wallet.PeriodStartDay,
+			PeriodInterval: wallet.PeriodInterval,
+		},
+		Allocations: allocations,
+	}
+}
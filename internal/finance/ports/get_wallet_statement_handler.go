@@ -0,0 +1,66 @@
+package ports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	common_db "sumni-finance-backend/internal/common/db"
+	"sumni-finance-backend/internal/common/logs"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Get the PDF statement of an accounting period
+// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/statement)
+func (hs HttpServer) GetWalletStatement(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	yearMonth string,
+) {
+	// A closed period is served as it was archived, the others are rendered from the current records
+	archive, err := hs.application.Queries.StatementArchive.Handle(r.Context(), query.GetStatementArchive{
+		WalletID:  walletId,
+		YearMonth: yearMonth,
+	})
+	if err == nil {
+		writeStatementPDF(w, archive.FileName, archive.Content)
+		return
+	}
+	if !errors.Is(err, common_db.ErrNotFound) {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	statement, err := hs.application.Queries.WalletStatement.Handle(r.Context(), query.GetWalletStatement{
+		WalletID:  walletId,
+		YearMonth: yearMonth,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := writeStatement(&buf, statement, time.Now()); err != nil {
+		logs.FromContext(r.Context()).Error("failed to render statement", "error", err, "walletId", walletId, "yearMonth", yearMonth)
+		httperr.RespondWithSlugError(httperr.NewUnknowError(err, "failed-to-render-statement"), w, r)
+		return
+	}
+
+	writeStatementPDF(w, fmt.Sprintf("statement-%s.pdf", strings.ReplaceAll(statement.Period.YearMonth, ",", "-")), buf.Bytes())
+}
+
+func writeStatementPDF(w http.ResponseWriter, fileName string, content []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, fileName))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
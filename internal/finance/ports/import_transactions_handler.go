@@ -0,0 +1,177 @@
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const maxStatementFileSize = 5 << 20
+
+// Import a bank statement
+// (POST /v1/wallets/{walletId}/imports)
+func (hs HttpServer) ImportTransactions(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	params ImportTransactionsParams,
+) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	// The form fields come on top of the file
+	r.Body = http.MaxBytesReader(w, r.Body, maxStatementFileSize+1<<20)
+	if err := r.ParseMultipartForm(maxStatementFileSize); err != nil {
+		httperr.BadRequest("failed-to-parse-form", err, w, r)
+		return
+	}
+
+	format := r.FormValue("format")
+	if format == "" {
+		format = string(StatementFormatCSV)
+	}
+
+	// Only CSV statements are read with a profile
+	var profileID uuid.UUID
+	if profileIDStr := r.FormValue("profileId"); profileIDStr != "" {
+		profileID, err = uuid.Parse(profileIDStr)
+		if err != nil {
+			httperr.BadRequest("invalid-profile-id", err, w, r)
+			return
+		}
+	}
+
+	fpID, err := uuid.Parse(r.FormValue("fundProviderId"))
+	if err != nil {
+		httperr.BadRequest("invalid-fund-provider-id", err, w, r)
+		return
+	}
+
+	content, err := readStatementFile(r)
+	if err != nil {
+		httperr.BadRequest("invalid-statement-file", err, w, r)
+		return
+	}
+
+	if convert.SafeDeref(params.Commit, false) {
+		if err := hs.application.Commands.ImportTransactions.Handle(r.Context(), command.ImportTransactionsCmd{
+			UserID:         user.ID,
+			WalletID:       walletId,
+			FundProviderID: fpID,
+			Format:         format,
+			ProfileID:      profileID,
+			Content:        content,
+		}); err != nil {
+			httperr.RespondWithSlugError(err, w, r)
+			return
+		}
+
+		response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+		return
+	}
+
+	preview, err := hs.application.Queries.ImportPreview.Handle(r.Context(), query.PreviewImport{
+		UserID:         user.ID,
+		WalletID:       walletId,
+		FundProviderID: fpID,
+		Format:         format,
+		ProfileID:      profileID,
+		Content:        content,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"preview": mapImportPreviewToResponse(preview),
+	}, nil)
+}
+
+// readStatementFile reads the file field of the form.
+func readStatementFile(r *http.Request) ([]byte, error) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxStatementFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > maxStatementFileSize {
+		return nil, fmt.Errorf("file exceeds %d bytes", maxStatementFileSize)
+	}
+
+	if len(content) == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	return content, nil
+}
+
+func mapImportPreviewToResponse(preview query.ImportPreview) ImportPreview {
+	rows := make([]ImportRow, 0, len(preview.Rows))
+	for _, row := range preview.Rows {
+		resp := ImportRow{
+			Line:       row.Line,
+			OccurredAt: row.OccurredAt,
+			Status:     ImportRowStatus(row.Status),
+		}
+
+		if row.Problem != "" {
+			resp.Problem = &row.Problem
+		}
+
+		if row.OccurredAt != nil {
+			resp.Amount = &row.Amount
+			resp.Direction = convert.SafePtr(TransactionDirection(row.Direction))
+			resp.TransactionType = convert.SafePtr(TransactionType(row.TransactionType))
+			resp.Description = &row.Description
+			resp.TransactionNo = &row.TransactionNo
+		}
+
+		rows = append(rows, resp)
+	}
+
+	resp := ImportPreview{
+		YearMonth:          preview.YearMonth,
+		Rows:               rows,
+		NewCount:           preview.NewCount,
+		DuplicateCount:     preview.DuplicateCount,
+		InvalidCount:       preview.InvalidCount,
+		OutsidePeriodCount: preview.OutsidePeriodCount,
+	}
+
+	if report := preview.Reconciliation; report != nil {
+		resp.Reconciliation = &ImportReconciliation{
+			OpeningBalance:      report.OpeningBalance,
+			OpeningAsOf:         report.OpeningAsOf,
+			ClosingBalance:      report.ClosingBalance,
+			ClosingAsOf:         report.ClosingAsOf,
+			FundProviderBalance: report.FundProviderBalance,
+			ProjectedBalance:    report.ProjectedBalance,
+			OpeningDifference:   report.OpeningDifference,
+			ClosingDifference:   report.ClosingDifference,
+			UnrecordedLines:     report.UnrecordedLines,
+			Balanced:            report.Balanced,
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,236 @@
+package ports
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/common/xlsx"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+)
+
+var (
+	ledgerPeriodColumns = []any{
+		"Year month", "Status", "Currency", "Opening balance", "Total debit", "Total credit", "Closing balance",
+	}
+	ledgerRecordColumns = []any{
+		"Transaction no", "Occurred at", "Recorded at", "Fund provider", "Type", "Direction", "Amount",
+		"Fund provider balance", "Wallet balance", "Category", "Description",
+	}
+)
+
+// ledgerWriter writes a ledger export, the header of the period first and then one record at a time.
+type ledgerWriter interface {
+	WriteHeader(period query.AccountingPeriodClosingReport) error
+	WriteRecord(tr query.Transaction) error
+	Close() error
+}
+
+func newLedgerWriter(format ExportFormat, w io.Writer) ledgerWriter {
+	switch format {
+	case ExportFormatCSV:
+		return &csvLedgerWriter{w: csv.NewWriter(w)}
+	case ExportFormatXLSX:
+		return &xlsxLedgerWriter{out: w}
+	case ExportFormatJSON:
+		return &jsonLedgerWriter{w: bufio.NewWriter(w)}
+	}
+
+	return nil
+}
+
+// ledgerPeriodRow and ledgerRecordRow are the rows of the CSV and XLSX exports, the period and its columns,
+// a blank row, then the columns of the records and a row per record.
+func ledgerPeriodRow(period query.AccountingPeriodClosingReport) []any {
+	return []any{
+		period.YearMonth,
+		period.Status,
+		period.Currency,
+		period.OpeningBalance,
+		period.TotalDebit,
+		period.TotalCredit,
+		period.ClosingBalance,
+	}
+}
+
+func ledgerRecordRow(tr query.Transaction) []any {
+	var category any
+	if tr.CategoryName != nil {
+		category = *tr.CategoryName
+	}
+
+	return []any{
+		tr.TransactionNo,
+		tr.OccurredAt,
+		tr.RecordedAt,
+		tr.FundProviderName,
+		tr.TransactionType,
+		tr.Direction,
+		tr.Amount,
+		tr.FpBalance,
+		tr.WalletBalance,
+		category,
+		tr.Description,
+	}
+}
+
+type csvLedgerWriter struct {
+	w *csv.Writer
+}
+
+func (lw *csvLedgerWriter) WriteHeader(period query.AccountingPeriodClosingReport) error {
+	for _, row := range [][]any{ledgerPeriodColumns, ledgerPeriodRow(period), {}, ledgerRecordColumns} {
+		if err := lw.write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lw *csvLedgerWriter) WriteRecord(tr query.Transaction) error {
+	return lw.write(ledgerRecordRow(tr))
+}
+
+func (lw *csvLedgerWriter) Close() error {
+	lw.w.Flush()
+	return lw.w.Error()
+}
+
+func (lw *csvLedgerWriter) write(row []any) error {
+	fields := make([]string, 0, len(row))
+	for _, cell := range row {
+		switch v := cell.(type) {
+		case nil:
+			fields = append(fields, "")
+		case string:
+			fields = append(fields, csvText(v))
+		case int64:
+			fields = append(fields, strconv.FormatInt(v, 10))
+		case time.Time:
+			fields = append(fields, v.Format(time.RFC3339))
+		default:
+			return fmt.Errorf("unsupported CSV cell type %T", cell)
+		}
+	}
+
+	return lw.w.Write(fields)
+}
+
+// csvText keeps spreadsheets from running a description such as =HYPERLINK(...) as a formula.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+
+	return s
+}
+
+// xlsxLedgerWriter writes the rows of the CSV export on a single worksheet named after the period,
+// amounts as numbers and times as date times.
+type xlsxLedgerWriter struct {
+	out io.Writer
+	w   *xlsx.Writer
+}
+
+func (lw *xlsxLedgerWriter) WriteHeader(period query.AccountingPeriodClosingReport) error {
+	w, err := xlsx.NewWriter(lw.out, "Ledger "+strings.ReplaceAll(period.YearMonth, ",", "-"))
+	if err != nil {
+		return err
+	}
+	lw.w = w
+
+	for _, row := range [][]any{ledgerPeriodColumns, ledgerPeriodRow(period), {}, ledgerRecordColumns} {
+		if err := lw.w.WriteRow(row...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lw *xlsxLedgerWriter) WriteRecord(tr query.Transaction) error {
+	return lw.w.WriteRow(ledgerRecordRow(tr)...)
+}
+
+func (lw *xlsxLedgerWriter) Close() error {
+	return lw.w.Close()
+}
+
+// jsonLedgerWriter writes a LedgerExport document, the transactions array one element at a time.
+type jsonLedgerWriter struct {
+	w       *bufio.Writer
+	records int
+}
+
+func (lw *jsonLedgerWriter) WriteHeader(period query.AccountingPeriodClosingReport) error {
+	header, err := json.Marshal(AccountingPeriodClosingReport{
+		Id:               period.ID,
+		YearMonth:        period.YearMonth,
+		Status:           period.Status,
+		EndDate:          period.EndDate,
+		Currency:         period.Currency,
+		OpeningBalance:   period.OpeningBalance,
+		TotalDebit:       period.TotalDebit,
+		TotalCredit:      period.TotalCredit,
+		TotalIncome:      period.TotalIncome,
+		TotalExpense:     period.TotalExpense,
+		ClosingBalance:   period.ClosingBalance,
+		TransactionCount: period.TransactionCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(lw.w, `{"period":%s,"transactions":[`, header)
+	return err
+}
+
+func (lw *jsonLedgerWriter) WriteRecord(tr query.Transaction) error {
+	record, err := json.Marshal(Transaction{
+		Id:               tr.ID,
+		TransactionNo:    tr.TransactionNo,
+		TransactionType:  TransactionType(tr.TransactionType),
+		Direction:        TransactionDirection(tr.Direction),
+		Amount:           tr.Amount,
+		WalletBalance:    tr.WalletBalance,
+		FundProviderId:   tr.FundProviderID,
+		FundProviderName: tr.FundProviderName,
+		FpBalance:        tr.FpBalance,
+		Description:      tr.Description,
+		OccurredAt:       tr.OccurredAt,
+		RecordedAt:       tr.RecordedAt,
+		LinkedId:         tr.LinkedID,
+		ReversalOfId:     tr.ReversalOfID,
+		ReversedById:     tr.ReversedByID,
+		ReversedAt:       tr.ReversedAt,
+		CategoryId:       tr.CategoryID,
+		CategoryName:     tr.CategoryName,
+		YearMonth:        tr.YearMonth,
+	})
+	if err != nil {
+		return err
+	}
+
+	if lw.records > 0 {
+		if err := lw.w.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	lw.records++
+
+	_, err = lw.w.Write(record)
+	return err
+}
+
+func (lw *jsonLedgerWriter) Close() error {
+	if _, err := lw.w.WriteString("]}"); err != nil {
+		return err
+	}
+
+	return lw.w.Flush()
+}
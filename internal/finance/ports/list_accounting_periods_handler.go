@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// List accounting periods of a wallet
+// (GET /v1/wallets/{walletId}/accounting-periods)
+func (hs HttpServer) ListAccountingPeriods(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	periods, err := hs.application.Queries.AccountingPeriods.Handle(r.Context(), query.ListAccountingPeriods{
+		WalletID: walletId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]AccountingPeriod, 0, len(periods))
+	for _, period := range periods {
+		resp = append(resp, AccountingPeriod{
+			Id:             period.ID,
+			YearMonth:      period.YearMonth,
+			Status:         period.Status,
+			StartDay:       period.StartDay,
+			Interval:       period.Interval,
+			StartTime:      period.StartTime,
+			EndDate:        period.EndDate,
+			OpeningBalance: period.OpeningBalance,
+			TotalDebit:     period.TotalDebit,
+			TotalCredit:    period.TotalCredit,
+			TotalIncome:    period.TotalIncome,
+			TotalExpense:   period.TotalExpense,
+			ClosingBalance: period.ClosingBalance,
+		})
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"accountingPeriods": resp,
+	}, nil)
+}
@@ -0,0 +1,54 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+)
+
+// List categories
+// (GET /v1/categories)
+func (hs HttpServer) ListCategories(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	categories, err := hs.application.Queries.Categories.Handle(r.Context(), query.ListCategories{UserID: user.ID})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]Category, 0, len(categories))
+	for _, c := range categories {
+		resp = append(resp, mapCategoryToResponse(c))
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"categories": resp,
+	}, nil)
+}
+
+func mapCategoryToResponse(c query.Category) Category {
+	resp := Category{
+		Id:       c.ID,
+		ParentId: c.ParentID,
+		Name:     c.Name,
+		Kind:     CategoryKind(c.Kind),
+		Version:  c.Version,
+	}
+
+	if c.Children != nil {
+		children := make([]Category, 0, len(c.Children))
+		for _, child := range c.Children {
+			children = append(children, mapCategoryToResponse(child))
+		}
+		resp.Children = &children
+	}
+
+	return resp
+}
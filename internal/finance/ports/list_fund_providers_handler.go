@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+)
+
+// List fund providers
+// (GET /v1/fund-providers)
+func (hs HttpServer) ListFundProviders(w http.ResponseWriter, r *http.Request) {
+	fundProviders, err := hs.application.Queries.FundProviders.Handle(r.Context(), query.ListFundProviders{})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]FundProvider, 0, len(fundProviders))
+	for _, fundProvider := range fundProviders {
+		resp = append(resp, mapFundProviderToResponse(fundProvider))
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"fundProviders": resp,
+	}, nil)
+}
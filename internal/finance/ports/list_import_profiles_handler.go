@@ -0,0 +1,49 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+)
+
+// List import profiles
+// (GET /v1/import-profiles)
+func (hs HttpServer) ListImportProfiles(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	profiles, err := hs.application.Queries.ImportProfiles.Handle(r.Context(), query.ListImportProfiles{UserID: user.ID})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]ImportProfile, 0, len(profiles))
+	for _, p := range profiles {
+		resp = append(resp, ImportProfile{
+			Id:                 p.ID,
+			Name:               p.Name,
+			Delimiter:          p.Delimiter,
+			SkipRows:           p.SkipRows,
+			DateColumn:         p.DateColumn,
+			DateFormat:         p.DateFormat,
+			AmountSign:         AmountSign(p.AmountSign),
+			AmountColumn:       p.AmountColumn,
+			DebitColumn:        p.DebitColumn,
+			CreditColumn:       p.CreditColumn,
+			DescriptionColumn:  p.DescriptionColumn,
+			ReferenceColumn:    p.ReferenceColumn,
+			ThousandsSeparator: p.ThousandsSeparator,
+			Version:            p.Version,
+		})
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"importProfiles": resp,
+	}, nil)
+}
@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// List reconciliations of a fund provider
+// (GET /v1/fund-providers/{fundProviderId}/reconciliations)
+func (hs HttpServer) ListReconciliations(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID) {
+	reconciliations, err := hs.application.Queries.Reconciliations.Handle(r.Context(), query.ListReconciliations{
+		FundProviderID: fundProviderId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]Reconciliation, 0, len(reconciliations))
+	for _, rec := range reconciliations {
+		resp = append(resp, mapReconciliationToResponse(rec))
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"reconciliations": resp,
+	}, nil)
+}
+
+func mapReconciliationToResponse(rec query.Reconciliation) Reconciliation {
+	return Reconciliation{
+		Id:               rec.ID,
+		FundProviderId:   rec.FundProviderID,
+		StatementDate:    openapi_types.Date{Time: rec.StatementDate},
+		StatementBalance: rec.StatementBalance,
+		Status:           ReconciliationStatus(rec.Status),
+		CreatedAt:        rec.CreatedAt,
+		FinalisedAt:      rec.FinalisedAt,
+		ClearedCount:     rec.ClearedCount,
+		Version:          rec.Version,
+	}
+}
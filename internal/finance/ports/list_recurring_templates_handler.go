@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// List recurring templates of a wallet
+// (GET /v1/wallets/{walletId}/recurring-templates)
+func (hs HttpServer) ListRecurringTemplates(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	templates, err := hs.application.Queries.RecurringTemplates.Handle(r.Context(), query.ListRecurringTemplates{
+		WalletID: walletId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]RecurringTemplate, 0, len(templates))
+	for _, t := range templates {
+		item := RecurringTemplate{
+			Id:              t.ID,
+			FundProviderId:  t.FundProviderID,
+			Amount:          t.Amount,
+			TransactionType: TransactionType(t.TransactionType),
+			CategoryId:      t.CategoryID,
+			CategoryName:    t.CategoryName,
+			Description:     t.Description,
+			Frequency:       RecurringFrequency(t.Frequency),
+			Interval:        t.Interval,
+			StartDate:       openapi_types.Date{Time: t.StartDate},
+			Status:          RecurringTemplateStatus(t.Status),
+			PausedAt:        t.PausedAt,
+			Version:         t.Version,
+		}
+
+		if t.DayOfMonth > 0 {
+			item.DayOfMonth = &t.DayOfMonth
+		}
+
+		resp = append(resp, item)
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"recurringTemplates": resp,
+	}, nil)
+}
@@ -0,0 +1,72 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// List transactions of a wallet
+// (GET /v1/wallets/{walletId}/transactions)
+func (hs HttpServer) ListTransactions(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	params ListTransactionsParams,
+) {
+	page, err := hs.application.Queries.Transactions.Handle(r.Context(), query.ListTransactions{
+		WalletID:        walletId,
+		Cursor:          params.Cursor,
+		Limit:           int(convert.SafeDeref(params.Limit, 0)),
+		FromYearMonth:   convert.SafeDeref(params.FromYearMonth, ""),
+		ToYearMonth:     convert.SafeDeref(params.ToYearMonth, ""),
+		FundProviderID:  params.FundProviderId,
+		TransactionType: string(convert.SafeDeref(params.TransactionType, "")),
+		MinAmount:       params.MinAmount,
+		MaxAmount:       params.MaxAmount,
+		TransactionNo:   convert.SafeDeref(params.TransactionNo, ""),
+		CategoryID:      params.CategoryId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	transactions := make([]Transaction, 0, len(page.Transactions))
+	for _, tr := range page.Transactions {
+		transactions = append(transactions, Transaction{
+			Id:               tr.ID,
+			TransactionNo:    tr.TransactionNo,
+			TransactionType:  TransactionType(tr.TransactionType),
+			Direction:        TransactionDirection(tr.Direction),
+			Amount:           tr.Amount,
+			WalletBalance:    tr.WalletBalance,
+			FundProviderId:   tr.FundProviderID,
+			FundProviderName: tr.FundProviderName,
+			FpBalance:        tr.FpBalance,
+			Description:      tr.Description,
+			OccurredAt:       tr.OccurredAt,
+			RecordedAt:       tr.RecordedAt,
+			LinkedId:         tr.LinkedID,
+			ReversalOfId:     tr.ReversalOfID,
+			ReversedById:     tr.ReversedByID,
+			ReversedAt:       tr.ReversedAt,
+			CategoryId:       tr.CategoryID,
+			CategoryName:     tr.CategoryName,
+			YearMonth:        tr.YearMonth,
+		})
+	}
+
+	envelop := response.Envelop{
+		"transactions": transactions,
+	}
+	if page.NextCursor != nil {
+		envelop["nextCursor"] = page.NextCursor
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, envelop, nil)
+}
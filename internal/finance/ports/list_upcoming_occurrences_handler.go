@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// List upcoming occurrences of the recurring templates of a wallet
+// (GET /v1/wallets/{walletId}/recurring-occurrences)
+func (hs HttpServer) ListUpcomingOccurrences(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	params ListUpcomingOccurrencesParams,
+) {
+	occurrences, err := hs.application.Queries.UpcomingOccurrences.Handle(r.Context(), query.ListUpcomingOccurrences{
+		WalletID: walletId,
+		Days:     convert.SafeDeref(params.Days, 0),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]UpcomingOccurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		resp = append(resp, UpcomingOccurrence{
+			TemplateId:      o.TemplateID,
+			Date:            openapi_types.Date{Time: o.Date},
+			FundProviderId:  o.FundProviderID,
+			Amount:          o.Amount,
+			TransactionType: TransactionType(o.TransactionType),
+			CategoryId:      o.CategoryID,
+			CategoryName:    o.CategoryName,
+			Description:     o.Description,
+			Status:          UpcomingOccurrenceStatus(o.Status),
+		})
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"occurrences": resp,
+	}, nil)
+}
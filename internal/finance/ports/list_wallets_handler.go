@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+)
+
+// List wallets
+// (GET /v1/wallets)
+func (hs HttpServer) ListWallets(w http.ResponseWriter, r *http.Request) {
+	wallets, err := hs.application.Queries.Wallets.Handle(r.Context(), query.ListWallets{})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		resp = append(resp, mapWalletToResponse(wallet))
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"wallets": resp,
+	}, nil)
+}
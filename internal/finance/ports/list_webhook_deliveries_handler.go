@@ -0,0 +1,80 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// List webhook deliveries
+// (GET /v1/webhooks/{subscriptionId}/deliveries)
+func (hs HttpServer) ListWebhookDeliveries(
+	w http.ResponseWriter,
+	r *http.Request,
+	subscriptionId openapi_types.UUID,
+	params ListWebhookDeliveriesParams,
+) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	deliveries, err := hs.application.Queries.WebhookDeliveries.Handle(r.Context(), query.ListWebhookDeliveries{
+		UserID:         user.ID,
+		SubscriptionID: subscriptionId,
+		Status:         string(convert.SafeDeref(params.Status, "")),
+		Limit:          int(convert.SafeDeref(params.Limit, 0)),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		attemptLog := make([]WebhookDeliveryAttempt, 0, len(d.AttemptLog))
+		for _, a := range d.AttemptLog {
+			item := WebhookDeliveryAttempt{
+				Attempt:     a.Attempt,
+				AttemptedAt: a.AttemptedAt,
+				StatusCode:  a.StatusCode,
+				DurationMs:  a.DurationMs,
+			}
+
+			if a.Error != "" {
+				item.Error = &a.Error
+			}
+
+			attemptLog = append(attemptLog, item)
+		}
+
+		item := WebhookDelivery{
+			Id:             d.ID,
+			EventId:        d.EventID,
+			EventName:      d.EventName,
+			Status:         WebhookDeliveryStatus(d.Status),
+			Attempts:       d.Attempts,
+			NextAttemptAt:  d.NextAttemptAt,
+			LastStatusCode: d.LastStatusCode,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt,
+			AttemptLog:     attemptLog,
+		}
+
+		if d.LastError != "" {
+			item.LastError = &d.LastError
+		}
+
+		resp = append(resp, item)
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"deliveries": resp,
+	}, nil)
+}
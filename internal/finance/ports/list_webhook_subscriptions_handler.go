@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+)
+
+// List webhook subscriptions
+// (GET /v1/webhooks)
+func (hs HttpServer) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	subscriptions, err := hs.application.Queries.WebhookSubscriptions.Handle(r.Context(), query.ListWebhookSubscriptions{
+		UserID: user.ID,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp := make([]WebhookSubscription, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		eventTypes := make([]WebhookEventType, 0, len(s.EventTypes))
+		for _, eventType := range s.EventTypes {
+			eventTypes = append(eventTypes, WebhookEventType(eventType))
+		}
+
+		resp = append(resp, WebhookSubscription{
+			Id:         s.ID,
+			Url:        s.URL,
+			EventTypes: eventTypes,
+			CreatedAt:  s.CreatedAt,
+			Version:    s.Version,
+		})
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"webhooks": resp,
+	}, nil)
+}
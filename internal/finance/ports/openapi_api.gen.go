@@ -14,71 +14,1776 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List categories
+	// (GET /v1/categories)
+	ListCategories(w http.ResponseWriter, r *http.Request)
+	// Create a category
+	// (POST /v1/categories)
+	CreateCategory(w http.ResponseWriter, r *http.Request)
+	// Seed the default categories
+	// (POST /v1/categories/defaults)
+	SeedDefaultCategories(w http.ResponseWriter, r *http.Request)
+	// Delete a category
+	// (DELETE /v1/categories/{categoryId})
+	DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId openapi_types.UUID)
+	// Update a category
+	// (PUT /v1/categories/{categoryId})
+	UpdateCategory(w http.ResponseWriter, r *http.Request, categoryId openapi_types.UUID)
+	// List fund providers
+	// (GET /v1/fund-providers)
+	ListFundProviders(w http.ResponseWriter, r *http.Request)
 	// Create a new fund provider
 	// (POST /v1/fund-providers)
 	CreateFundProvider(w http.ResponseWriter, r *http.Request)
+	// Get a fund provider
+	// (GET /v1/fund-providers/{fundProviderId})
+	GetFundProvider(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID)
+	// List reconciliations of a fund provider
+	// (GET /v1/fund-providers/{fundProviderId}/reconciliations)
+	ListReconciliations(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID)
+	// Start a reconciliation
+	// (POST /v1/fund-providers/{fundProviderId}/reconciliations)
+	StartReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID)
+	// Get a reconciliation
+	// (GET /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId})
+	GetReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID)
+	// Post an adjustment closing the difference of a reconciliation
+	// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/adjustment)
+	AdjustReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID)
+	// Cancel a reconciliation
+	// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/cancel)
+	CancelReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID)
+	// Clear or unclear records of a reconciliation
+	// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/clear)
+	ClearReconciliationRecords(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID)
+	// Finalise a reconciliation
+	// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/finalise)
+	FinaliseReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID)
+	// List import profiles
+	// (GET /v1/import-profiles)
+	ListImportProfiles(w http.ResponseWriter, r *http.Request)
+	// Create an import profile
+	// (POST /v1/import-profiles)
+	CreateImportProfile(w http.ResponseWriter, r *http.Request)
+	// Delete an import profile
+	// (DELETE /v1/import-profiles/{profileId})
+	DeleteImportProfile(w http.ResponseWriter, r *http.Request, profileId openapi_types.UUID)
+	// List wallets
+	// (GET /v1/wallets)
+	ListWallets(w http.ResponseWriter, r *http.Request)
 	// Create a new wallet
 	// (POST /v1/wallets)
 	CreateWallet(w http.ResponseWriter, r *http.Request)
+	// Get a wallet
+	// (GET /v1/wallets/{walletId})
+	GetWallet(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// List accounting periods of a wallet
+	// (GET /v1/wallets/{walletId}/accounting-periods)
+	ListAccountingPeriods(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
 	// Open a new accounting period for a wallet
 	// (POST /v1/wallets/{walletId}/accounting-periods)
 	OpenAccountingPeriod(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// Verify the continuity of the accounting periods of a wallet
+	// (GET /v1/wallets/{walletId}/accounting-periods/continuity)
+	VerifyPeriodContinuity(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
 	// Record transaction records for an accounting period
 	// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth})
-	RecordTransactionRecords(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string)
+	RecordTransactionRecords(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string, params RecordTransactionRecordsParams)
+	// Get the budget report of an accounting period
+	// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets)
+	GetBudgetReport(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string)
+	// Plan the category budgets of an accounting period
+	// (PUT /v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets)
+	PlanCategoryBudgets(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string)
+	// Close an accounting period of a wallet
+	// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth}/close)
+	CloseAccountingPeriod(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string)
+	// Export the ledger of an accounting period
+	// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/export)
+	ExportLedger(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string, params ExportLedgerParams)
+	// Transfer money between fund providers of a wallet
+	// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth}/fund-provider-transfers)
+	TransferBetweenFundProviders(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string)
+	// Get the PDF statement of an accounting period
+	// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/statement)
+	GetWalletStatement(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string)
 	// Allocate funds to a wallet
 	// (POST /v1/wallets/{walletId}/allocate-fund-providers)
 	AllocateFund(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// Remove a fund provider from a wallet
+	// (DELETE /v1/wallets/{walletId}/fund-providers/{fundProviderId})
+	RemoveAllocation(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, fundProviderId openapi_types.UUID)
+	// Decrease an allocation
+	// (POST /v1/wallets/{walletId}/fund-providers/{fundProviderId}/decrease-allocation)
+	DecreaseAllocation(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, fundProviderId openapi_types.UUID)
+	// Increase an allocation
+	// (POST /v1/wallets/{walletId}/fund-providers/{fundProviderId}/increase-allocation)
+	IncreaseAllocation(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, fundProviderId openapi_types.UUID)
+	// Import a bank statement
+	// (POST /v1/wallets/{walletId}/imports)
+	ImportTransactions(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, params ImportTransactionsParams)
+	// Update the ledger config of a wallet
+	// (PUT /v1/wallets/{walletId}/ledger-config)
+	UpdateLedgerConfig(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// List upcoming occurrences of the recurring templates of a wallet
+	// (GET /v1/wallets/{walletId}/recurring-occurrences)
+	ListUpcomingOccurrences(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, params ListUpcomingOccurrencesParams)
+	// List recurring templates of a wallet
+	// (GET /v1/wallets/{walletId}/recurring-templates)
+	ListRecurringTemplates(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// Create a recurring template
+	// (POST /v1/wallets/{walletId}/recurring-templates)
+	CreateRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// Pause a recurring template
+	// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/pause)
+	PauseRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, templateId openapi_types.UUID)
+	// Resume a recurring template
+	// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/resume)
+	ResumeRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, templateId openapi_types.UUID)
+	// Skip an occurrence of a recurring template
+	// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/skip)
+	SkipRecurringOccurrence(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, templateId openapi_types.UUID)
+	// List transactions of a wallet
+	// (GET /v1/wallets/{walletId}/transactions)
+	ListTransactions(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, params ListTransactionsParams)
+	// Reverse a transaction
+	// (POST /v1/wallets/{walletId}/transactions/{transactionId}/reversal)
+	ReverseTransaction(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, transactionId openapi_types.UUID)
+	// Transfer allocation to another wallet
+	// (POST /v1/wallets/{walletId}/transfers)
+	TransferBetweenWallets(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID)
+	// List webhook subscriptions
+	// (GET /v1/webhooks)
+	ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request)
+	// Create a webhook subscription
+	// (POST /v1/webhooks)
+	CreateWebhookSubscription(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook subscription
+	// (DELETE /v1/webhooks/{subscriptionId})
+	DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID)
+	// List webhook deliveries
+	// (GET /v1/webhooks/{subscriptionId}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID, params ListWebhookDeliveriesParams)
+	// Send a test event
+	// (POST /v1/webhooks/{subscriptionId}/test)
+	SendWebhookTestEvent(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List categories
+// (GET /v1/categories)
+func (_ Unimplemented) ListCategories(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a category
+// (POST /v1/categories)
+func (_ Unimplemented) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Seed the default categories
+// (POST /v1/categories/defaults)
+func (_ Unimplemented) SeedDefaultCategories(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a category
+// (DELETE /v1/categories/{categoryId})
+func (_ Unimplemented) DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a category
+// (PUT /v1/categories/{categoryId})
+func (_ Unimplemented) UpdateCategory(w http.ResponseWriter, r *http.Request, categoryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List fund providers
+// (GET /v1/fund-providers)
+func (_ Unimplemented) ListFundProviders(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create a new fund provider
 // (POST /v1/fund-providers)
 func (_ Unimplemented) CreateFundProvider(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new wallet
-// (POST /v1/wallets)
-func (_ Unimplemented) CreateWallet(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Get a fund provider
+// (GET /v1/fund-providers/{fundProviderId})
+func (_ Unimplemented) GetFundProvider(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List reconciliations of a fund provider
+// (GET /v1/fund-providers/{fundProviderId}/reconciliations)
+func (_ Unimplemented) ListReconciliations(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations)
+func (_ Unimplemented) StartReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a reconciliation
+// (GET /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId})
+func (_ Unimplemented) GetReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Post an adjustment closing the difference of a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/adjustment)
+func (_ Unimplemented) AdjustReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/cancel)
+func (_ Unimplemented) CancelReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clear or unclear records of a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/clear)
+func (_ Unimplemented) ClearReconciliationRecords(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Finalise a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/finalise)
+func (_ Unimplemented) FinaliseReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID, reconciliationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List import profiles
+// (GET /v1/import-profiles)
+func (_ Unimplemented) ListImportProfiles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an import profile
+// (POST /v1/import-profiles)
+func (_ Unimplemented) CreateImportProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an import profile
+// (DELETE /v1/import-profiles/{profileId})
+func (_ Unimplemented) DeleteImportProfile(w http.ResponseWriter, r *http.Request, profileId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List wallets
+// (GET /v1/wallets)
+func (_ Unimplemented) ListWallets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new wallet
+// (POST /v1/wallets)
+func (_ Unimplemented) CreateWallet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a wallet
+// (GET /v1/wallets/{walletId})
+func (_ Unimplemented) GetWallet(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List accounting periods of a wallet
+// (GET /v1/wallets/{walletId}/accounting-periods)
+func (_ Unimplemented) ListAccountingPeriods(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Open a new accounting period for a wallet
+// (POST /v1/wallets/{walletId}/accounting-periods)
+func (_ Unimplemented) OpenAccountingPeriod(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify the continuity of the accounting periods of a wallet
+// (GET /v1/wallets/{walletId}/accounting-periods/continuity)
+func (_ Unimplemented) VerifyPeriodContinuity(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record transaction records for an accounting period
+// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth})
+func (_ Unimplemented) RecordTransactionRecords(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string, params RecordTransactionRecordsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the budget report of an accounting period
+// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets)
+func (_ Unimplemented) GetBudgetReport(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Plan the category budgets of an accounting period
+// (PUT /v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets)
+func (_ Unimplemented) PlanCategoryBudgets(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Close an accounting period of a wallet
+// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth}/close)
+func (_ Unimplemented) CloseAccountingPeriod(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the ledger of an accounting period
+// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/export)
+func (_ Unimplemented) ExportLedger(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string, params ExportLedgerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Transfer money between fund providers of a wallet
+// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth}/fund-provider-transfers)
+func (_ Unimplemented) TransferBetweenFundProviders(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the PDF statement of an accounting period
+// (GET /v1/wallets/{walletId}/accounting-periods/{yearMonth}/statement)
+func (_ Unimplemented) GetWalletStatement(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Allocate funds to a wallet
+// (POST /v1/wallets/{walletId}/allocate-fund-providers)
+func (_ Unimplemented) AllocateFund(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a fund provider from a wallet
+// (DELETE /v1/wallets/{walletId}/fund-providers/{fundProviderId})
+func (_ Unimplemented) RemoveAllocation(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, fundProviderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Decrease an allocation
+// (POST /v1/wallets/{walletId}/fund-providers/{fundProviderId}/decrease-allocation)
+func (_ Unimplemented) DecreaseAllocation(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, fundProviderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Increase an allocation
+// (POST /v1/wallets/{walletId}/fund-providers/{fundProviderId}/increase-allocation)
+func (_ Unimplemented) IncreaseAllocation(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, fundProviderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import a bank statement
+// (POST /v1/wallets/{walletId}/imports)
+func (_ Unimplemented) ImportTransactions(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, params ImportTransactionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the ledger config of a wallet
+// (PUT /v1/wallets/{walletId}/ledger-config)
+func (_ Unimplemented) UpdateLedgerConfig(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List upcoming occurrences of the recurring templates of a wallet
+// (GET /v1/wallets/{walletId}/recurring-occurrences)
+func (_ Unimplemented) ListUpcomingOccurrences(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, params ListUpcomingOccurrencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List recurring templates of a wallet
+// (GET /v1/wallets/{walletId}/recurring-templates)
+func (_ Unimplemented) ListRecurringTemplates(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates)
+func (_ Unimplemented) CreateRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pause a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/pause)
+func (_ Unimplemented) PauseRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/resume)
+func (_ Unimplemented) ResumeRecurringTemplate(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Skip an occurrence of a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/skip)
+func (_ Unimplemented) SkipRecurringOccurrence(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List transactions of a wallet
+// (GET /v1/wallets/{walletId}/transactions)
+func (_ Unimplemented) ListTransactions(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, params ListTransactionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reverse a transaction
+// (POST /v1/wallets/{walletId}/transactions/{transactionId}/reversal)
+func (_ Unimplemented) ReverseTransaction(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, transactionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Transfer allocation to another wallet
+// (POST /v1/wallets/{walletId}/transfers)
+func (_ Unimplemented) TransferBetweenWallets(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook subscriptions
+// (GET /v1/webhooks)
+func (_ Unimplemented) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a webhook subscription
+// (POST /v1/webhooks)
+func (_ Unimplemented) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook subscription
+// (DELETE /v1/webhooks/{subscriptionId})
+func (_ Unimplemented) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook deliveries
+// (GET /v1/webhooks/{subscriptionId}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a test event
+// (POST /v1/webhooks/{subscriptionId}/test)
+func (_ Unimplemented) SendWebhookTestEvent(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCategory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SeedDefaultCategories operation middleware
+func (siw *ServerInterfaceWrapper) SeedDefaultCategories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SeedDefaultCategories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", chi.URLParam(r, "categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, categoryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCategory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", chi.URLParam(r, "categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategory(w, r, categoryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFundProviders operation middleware
+func (siw *ServerInterfaceWrapper) ListFundProviders(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFundProviders(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateFundProvider operation middleware
+func (siw *ServerInterfaceWrapper) CreateFundProvider(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateFundProvider(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFundProvider operation middleware
+func (siw *ServerInterfaceWrapper) GetFundProvider(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFundProvider(w, r, fundProviderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListReconciliations operation middleware
+func (siw *ServerInterfaceWrapper) ListReconciliations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReconciliations(w, r, fundProviderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) StartReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartReconciliation(w, r, fundProviderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reconciliationId" -------------
+	var reconciliationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reconciliationId", chi.URLParam(r, "reconciliationId"), &reconciliationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reconciliationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReconciliation(w, r, fundProviderId, reconciliationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdjustReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) AdjustReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reconciliationId" -------------
+	var reconciliationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reconciliationId", chi.URLParam(r, "reconciliationId"), &reconciliationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reconciliationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdjustReconciliation(w, r, fundProviderId, reconciliationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) CancelReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reconciliationId" -------------
+	var reconciliationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reconciliationId", chi.URLParam(r, "reconciliationId"), &reconciliationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reconciliationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelReconciliation(w, r, fundProviderId, reconciliationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClearReconciliationRecords operation middleware
+func (siw *ServerInterfaceWrapper) ClearReconciliationRecords(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reconciliationId" -------------
+	var reconciliationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reconciliationId", chi.URLParam(r, "reconciliationId"), &reconciliationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reconciliationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClearReconciliationRecords(w, r, fundProviderId, reconciliationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinaliseReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) FinaliseReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "reconciliationId" -------------
+	var reconciliationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reconciliationId", chi.URLParam(r, "reconciliationId"), &reconciliationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reconciliationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FinaliseReconciliation(w, r, fundProviderId, reconciliationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListImportProfiles operation middleware
+func (siw *ServerInterfaceWrapper) ListImportProfiles(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListImportProfiles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateImportProfile operation middleware
+func (siw *ServerInterfaceWrapper) CreateImportProfile(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateImportProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteImportProfile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteImportProfile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", chi.URLParam(r, "profileId"), &profileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "profileId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteImportProfile(w, r, profileId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWallets operation middleware
+func (siw *ServerInterfaceWrapper) ListWallets(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWallets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWallet operation middleware
+func (siw *ServerInterfaceWrapper) CreateWallet(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWallet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWallet operation middleware
+func (siw *ServerInterfaceWrapper) GetWallet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWallet(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAccountingPeriods operation middleware
+func (siw *ServerInterfaceWrapper) ListAccountingPeriods(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAccountingPeriods(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OpenAccountingPeriod operation middleware
+func (siw *ServerInterfaceWrapper) OpenAccountingPeriod(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OpenAccountingPeriod(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyPeriodContinuity operation middleware
+func (siw *ServerInterfaceWrapper) VerifyPeriodContinuity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyPeriodContinuity(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RecordTransactionRecords operation middleware
+func (siw *ServerInterfaceWrapper) RecordTransactionRecords(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RecordTransactionRecordsParams
+
+	// ------------- Optional query parameter "confirmDuplicates" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "confirmDuplicates", r.URL.Query(), &params.ConfirmDuplicates)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "confirmDuplicates", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "duplicateWindowDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duplicateWindowDays", r.URL.Query(), &params.DuplicateWindowDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "duplicateWindowDays", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordTransactionRecords(w, r, walletId, yearMonth, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBudgetReport operation middleware
+func (siw *ServerInterfaceWrapper) GetBudgetReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBudgetReport(w, r, walletId, yearMonth)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PlanCategoryBudgets operation middleware
+func (siw *ServerInterfaceWrapper) PlanCategoryBudgets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlanCategoryBudgets(w, r, walletId, yearMonth)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloseAccountingPeriod operation middleware
+func (siw *ServerInterfaceWrapper) CloseAccountingPeriod(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloseAccountingPeriod(w, r, walletId, yearMonth)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportLedger operation middleware
+func (siw *ServerInterfaceWrapper) ExportLedger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportLedgerParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportLedger(w, r, walletId, yearMonth, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransferBetweenFundProviders operation middleware
+func (siw *ServerInterfaceWrapper) TransferBetweenFundProviders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransferBetweenFundProviders(w, r, walletId, yearMonth)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWalletStatement operation middleware
+func (siw *ServerInterfaceWrapper) GetWalletStatement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "yearMonth" -------------
+	var yearMonth string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWalletStatement(w, r, walletId, yearMonth)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AllocateFund operation middleware
+func (siw *ServerInterfaceWrapper) AllocateFund(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AllocateFund(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveAllocation operation middleware
+func (siw *ServerInterfaceWrapper) RemoveAllocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveAllocation(w, r, walletId, fundProviderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DecreaseAllocation operation middleware
+func (siw *ServerInterfaceWrapper) DecreaseAllocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecreaseAllocation(w, r, walletId, fundProviderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IncreaseAllocation operation middleware
+func (siw *ServerInterfaceWrapper) IncreaseAllocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "fundProviderId" -------------
+	var fundProviderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fundProviderId", chi.URLParam(r, "fundProviderId"), &fundProviderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IncreaseAllocation(w, r, walletId, fundProviderId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ImportTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTransactionsParams
+
+	// ------------- Optional query parameter "commit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "commit", r.URL.Query(), &params.Commit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTransactions(w, r, walletId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateLedgerConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLedgerConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLedgerConfig(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUpcomingOccurrences operation middleware
+func (siw *ServerInterfaceWrapper) ListUpcomingOccurrences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUpcomingOccurrencesParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", r.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUpcomingOccurrences(w, r, walletId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRecurringTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListRecurringTemplates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRecurringTemplates(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRecurringTemplate(w, r, walletId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PauseRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", chi.URLParam(r, "templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseRecurringTemplate(w, r, walletId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeRecurringTemplate operation middleware
+func (siw *ServerInterfaceWrapper) ResumeRecurringTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", chi.URLParam(r, "templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeRecurringTemplate(w, r, walletId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SkipRecurringOccurrence operation middleware
+func (siw *ServerInterfaceWrapper) SkipRecurringOccurrence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", chi.URLParam(r, "templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SkipRecurringOccurrence(w, r, walletId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
 
-// Open a new accounting period for a wallet
-// (POST /v1/wallets/{walletId}/accounting-periods)
-func (_ Unimplemented) OpenAccountingPeriod(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTransactionsParams
 
-// Record transaction records for an accounting period
-// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth})
-func (_ Unimplemented) RecordTransactionRecords(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID, yearMonth string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// Allocate funds to a wallet
-// (POST /v1/wallets/{walletId}/allocate-fund-providers)
-func (_ Unimplemented) AllocateFund(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-// CreateFundProvider operation middleware
-func (siw *ServerInterfaceWrapper) CreateFundProvider(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "fromYearMonth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fromYearMonth", r.URL.Query(), &params.FromYearMonth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fromYearMonth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "toYearMonth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toYearMonth", r.URL.Query(), &params.ToYearMonth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toYearMonth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fundProviderId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fundProviderId", r.URL.Query(), &params.FundProviderId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fundProviderId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "transactionType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "transactionType", r.URL.Query(), &params.TransactionType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", r.URL.Query(), &params.MinAmount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minAmount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", r.URL.Query(), &params.MaxAmount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAmount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "transactionNo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "transactionNo", r.URL.Query(), &params.TransactionNo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionNo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "categoryId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categoryId", r.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateFundProvider(w, r)
+		siw.Handler.ListTransactions(w, r, walletId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -88,11 +1793,31 @@ func (siw *ServerInterfaceWrapper) CreateFundProvider(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWallet operation middleware
-func (siw *ServerInterfaceWrapper) CreateWallet(w http.ResponseWriter, r *http.Request) {
+// ReverseTransaction operation middleware
+func (siw *ServerInterfaceWrapper) ReverseTransaction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "walletId" -------------
+	var walletId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", chi.URLParam(r, "transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWallet(w, r)
+		siw.Handler.ReverseTransaction(w, r, walletId, transactionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -102,8 +1827,8 @@ func (siw *ServerInterfaceWrapper) CreateWallet(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// OpenAccountingPeriod operation middleware
-func (siw *ServerInterfaceWrapper) OpenAccountingPeriod(w http.ResponseWriter, r *http.Request) {
+// TransferBetweenWallets operation middleware
+func (siw *ServerInterfaceWrapper) TransferBetweenWallets(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -117,7 +1842,7 @@ func (siw *ServerInterfaceWrapper) OpenAccountingPeriod(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.OpenAccountingPeriod(w, r, walletId)
+		siw.Handler.TransferBetweenWallets(w, r, walletId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -127,31 +1852,94 @@ func (siw *ServerInterfaceWrapper) OpenAccountingPeriod(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// RecordTransactionRecords operation middleware
-func (siw *ServerInterfaceWrapper) RecordTransactionRecords(w http.ResponseWriter, r *http.Request) {
+// ListWebhookSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookSubscriptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhookSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "walletId" -------------
-	var walletId openapi_types.UUID
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "yearMonth" -------------
-	var yearMonth string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhookSubscription(w, r, subscriptionId)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "yearMonth", chi.URLParam(r, "yearMonth"), &yearMonth, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "yearMonth", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RecordTransactionRecords(w, r, walletId, yearMonth)
+		siw.Handler.ListWebhookDeliveries(w, r, subscriptionId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -161,22 +1949,22 @@ func (siw *ServerInterfaceWrapper) RecordTransactionRecords(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// AllocateFund operation middleware
-func (siw *ServerInterfaceWrapper) AllocateFund(w http.ResponseWriter, r *http.Request) {
+// SendWebhookTestEvent operation middleware
+func (siw *ServerInterfaceWrapper) SendWebhookTestEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "walletId" -------------
-	var walletId openapi_types.UUID
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "walletId", chi.URLParam(r, "walletId"), &walletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", chi.URLParam(r, "subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "walletId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AllocateFund(w, r, walletId)
+		siw.Handler.SendWebhookTestEvent(w, r, subscriptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -299,21 +2087,159 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/categories", wrapper.ListCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/categories", wrapper.CreateCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/categories/defaults", wrapper.SeedDefaultCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/categories/{categoryId}", wrapper.DeleteCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/categories/{categoryId}", wrapper.UpdateCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/fund-providers", wrapper.ListFundProviders)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/fund-providers", wrapper.CreateFundProvider)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/fund-providers/{fundProviderId}", wrapper.GetFundProvider)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations", wrapper.ListReconciliations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations", wrapper.StartReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}", wrapper.GetReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/adjustment", wrapper.AdjustReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/cancel", wrapper.CancelReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/clear", wrapper.ClearReconciliationRecords)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/fund-providers/{fundProviderId}/reconciliations/{reconciliationId}/finalise", wrapper.FinaliseReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/import-profiles", wrapper.ListImportProfiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/import-profiles", wrapper.CreateImportProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/import-profiles/{profileId}", wrapper.DeleteImportProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets", wrapper.ListWallets)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/wallets", wrapper.CreateWallet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}", wrapper.GetWallet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods", wrapper.ListAccountingPeriods)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods", wrapper.OpenAccountingPeriod)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/continuity", wrapper.VerifyPeriodContinuity)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}", wrapper.RecordTransactionRecords)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets", wrapper.GetBudgetReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets", wrapper.PlanCategoryBudgets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}/close", wrapper.CloseAccountingPeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}/export", wrapper.ExportLedger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}/fund-provider-transfers", wrapper.TransferBetweenFundProviders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/accounting-periods/{yearMonth}/statement", wrapper.GetWalletStatement)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/wallets/{walletId}/allocate-fund-providers", wrapper.AllocateFund)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/wallets/{walletId}/fund-providers/{fundProviderId}", wrapper.RemoveAllocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/fund-providers/{fundProviderId}/decrease-allocation", wrapper.DecreaseAllocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/fund-providers/{fundProviderId}/increase-allocation", wrapper.IncreaseAllocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/imports", wrapper.ImportTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/wallets/{walletId}/ledger-config", wrapper.UpdateLedgerConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/recurring-occurrences", wrapper.ListUpcomingOccurrences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/recurring-templates", wrapper.ListRecurringTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/recurring-templates", wrapper.CreateRecurringTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/recurring-templates/{templateId}/pause", wrapper.PauseRecurringTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/recurring-templates/{templateId}/resume", wrapper.ResumeRecurringTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/recurring-templates/{templateId}/skip", wrapper.SkipRecurringOccurrence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/wallets/{walletId}/transactions", wrapper.ListTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/transactions/{transactionId}/reversal", wrapper.ReverseTransaction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/wallets/{walletId}/transfers", wrapper.TransferBetweenWallets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/webhooks", wrapper.ListWebhookSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/webhooks", wrapper.CreateWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/webhooks/{subscriptionId}", wrapper.DeleteWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/webhooks/{subscriptionId}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/webhooks/{subscriptionId}/test", wrapper.SendWebhookTestEvent)
+	})
 
 	return r
 }
@@ -4,9 +4,198 @@
 package ports
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AmountSign.
+const (
+	AmountSignInverted AmountSign = "INVERTED"
+	AmountSignSigned   AmountSign = "SIGNED"
+	AmountSignSplit    AmountSign = "SPLIT"
+)
+
+// Defines values for CategoryKind.
+const (
+	CategoryKindExpense CategoryKind = "EXPENSE"
+	CategoryKindIncome  CategoryKind = "INCOME"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	ImportRowStatusDuplicate     ImportRowStatus = "DUPLICATE"
+	ImportRowStatusInvalid       ImportRowStatus = "INVALID"
+	ImportRowStatusNew           ImportRowStatus = "NEW"
+	ImportRowStatusOutsidePeriod ImportRowStatus = "OUTSIDE_PERIOD"
+)
+
+// Defines values for ReconciliationStatus.
+const (
+	ReconciliationStatusCancelled  ReconciliationStatus = "CANCELLED"
+	ReconciliationStatusFinalised  ReconciliationStatus = "FINALISED"
+	ReconciliationStatusInProgress ReconciliationStatus = "IN_PROGRESS"
+)
+
+// Defines values for RecurringFrequency.
+const (
+	RecurringFrequencyMonthly RecurringFrequency = "MONTHLY"
+	RecurringFrequencyWeekly  RecurringFrequency = "WEEKLY"
+)
+
+// Defines values for RecurringTemplateStatus.
+const (
+	RecurringTemplateStatusActive RecurringTemplateStatus = "ACTIVE"
+	RecurringTemplateStatusPaused RecurringTemplateStatus = "PAUSED"
+)
+
+// Defines values for StatementFormat.
+const (
+	StatementFormatCAMT053 StatementFormat = "CAMT053"
+	StatementFormatCSV     StatementFormat = "CSV"
+	StatementFormatMT940   StatementFormat = "MT940"
+	StatementFormatOFX     StatementFormat = "OFX"
+	StatementFormatQIF     StatementFormat = "QIF"
+)
+
+// Defines values for TransactionDirection.
+const (
+	TransactionDirectionIn  TransactionDirection = "IN"
+	TransactionDirectionOut TransactionDirection = "OUT"
+)
+
+// Defines values for TransactionType.
+const (
+	TransactionTypeAdjustment  TransactionType = "ADJUSTMENT"
+	TransactionTypeDeposit     TransactionType = "DEPOSIT"
+	TransactionTypeFee         TransactionType = "FEE"
+	TransactionTypeInterest    TransactionType = "INTEREST"
+	TransactionTypeRefund      TransactionType = "REFUND"
+	TransactionTypeTransferIn  TransactionType = "TRANSFER_IN"
+	TransactionTypeTransferOut TransactionType = "TRANSFER_OUT"
+	TransactionTypeWithdrawal  TransactionType = "WITHDRAWAL"
+)
+
+// Defines values for UpcomingOccurrenceStatus.
+const (
+	UpcomingOccurrenceStatusPaused    UpcomingOccurrenceStatus = "PAUSED"
+	UpcomingOccurrenceStatusScheduled UpcomingOccurrenceStatus = "SCHEDULED"
+	UpcomingOccurrenceStatusSkipped   UpcomingOccurrenceStatus = "SKIPPED"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "DEAD"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeFundAllocated       WebhookEventType = "FundAllocated"
+	WebhookEventTypeFundProviderCreated WebhookEventType = "FundProviderCreated"
+	WebhookEventTypePeriodClosed        WebhookEventType = "PeriodClosed"
+	WebhookEventTypePeriodOpened        WebhookEventType = "PeriodOpened"
+	WebhookEventTypeTransactionRecorded WebhookEventType = "TransactionRecorded"
+)
+
+// AccountingPeriod defines model for AccountingPeriod.
+type AccountingPeriod struct {
+	// ClosingBalance Wallet balance at the end of the period, zero while the period is open
+	ClosingBalance int64 `json:"closingBalance"`
+
+	// EndDate The moment the accounting period ends
+	EndDate time.Time `json:"endDate"`
+
+	// Id Accounting period ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Interval Length of the period in months
+	Interval int32 `json:"interval"`
+
+	// OpeningBalance Wallet balance at the beginning of the period
+	OpeningBalance int64 `json:"openingBalance"`
+
+	// StartDay Day of the month the period starts
+	StartDay int32 `json:"startDay"`
+
+	// StartTime The moment the accounting period starts
+	StartTime time.Time `json:"startTime"`
+
+	// Status Status of the accounting period (OPEN, CLOSE)
+	Status string `json:"status"`
+
+	// TotalCredit Sum of incoming transactions in the period
+	TotalCredit int64 `json:"totalCredit"`
+
+	// TotalDebit Sum of outgoing transactions in the period
+	TotalDebit int64 `json:"totalDebit"`
+
+	// TotalExpense Expense of the period, net of reversals. Transfers and adjustments are excluded
+	TotalExpense int64 `json:"totalExpense"`
+
+	// TotalIncome Income of the period, net of reversals. Transfers and adjustments are excluded
+	TotalIncome int64 `json:"totalIncome"`
+
+	// YearMonth The year month string of the accounting period
+	YearMonth string `json:"yearMonth"`
+}
+
+// AccountingPeriodClosingReport defines model for AccountingPeriodClosingReport.
+type AccountingPeriodClosingReport struct {
+	// ClosingBalance Wallet balance at the end of the period
+	ClosingBalance int64 `json:"closingBalance"`
+
+	// Currency Currency code of the wallet
+	Currency string `json:"currency"`
+
+	// EndDate The moment the accounting period ends
+	EndDate time.Time `json:"endDate"`
+
+	// Id Accounting period ID
+	Id openapi_types.UUID `json:"id"`
+
+	// OpeningBalance Wallet balance at the beginning of the period
+	OpeningBalance int64 `json:"openingBalance"`
+
+	// Status Status of the accounting period (OPEN, CLOSE)
+	Status string `json:"status"`
+
+	// TotalCredit Sum of incoming transactions in the period
+	TotalCredit int64 `json:"totalCredit"`
+
+	// TotalDebit Sum of outgoing transactions in the period
+	TotalDebit int64 `json:"totalDebit"`
+
+	// TotalExpense Expense of the period, net of reversals. Transfers and adjustments are excluded
+	TotalExpense int64 `json:"totalExpense"`
+
+	// TotalIncome Income of the period, net of reversals. Transfers and adjustments are excluded
+	TotalIncome int64 `json:"totalIncome"`
+
+	// TransactionCount Number of transaction records in the period
+	TransactionCount int64 `json:"transactionCount"`
+
+	// YearMonth The year month string of the accounting period
+	YearMonth string `json:"yearMonth"`
+}
+
+// AdjustReconciliationRequest defines model for AdjustReconciliationRequest.
+type AdjustReconciliationRequest struct {
+	// Description Description of the adjustment, defaults to one referencing the statement
+	Description *string `json:"description,omitempty"`
+
+	// WalletId Wallet holding the fund provider, the adjustment is booked into its open accounting period
+	WalletId openapi_types.UUID `json:"walletId"`
+}
+
 // AllocateFundRequest defines model for AllocateFundRequest.
 type AllocateFundRequest struct {
 	// Providers List of fund providers to allocate from
@@ -22,6 +211,119 @@ type AllocatedProvider struct {
 	Id openapi_types.UUID `json:"id"`
 }
 
+// AmountSign SIGNED reads one amount column where negative amounts are outflows, INVERTED one where positive amounts are outflows as in credit card exports, SPLIT reads outflows from a debit column and inflows from a credit column
+type AmountSign string
+
+// BudgetReport defines model for BudgetReport.
+type BudgetReport struct {
+	// AccountingPeriodId Accounting period ID
+	AccountingPeriodId openapi_types.UUID     `json:"accountingPeriodId"`
+	Categories         []CategoryBudgetStatus `json:"categories"`
+
+	// Currency Currency code of the amounts
+	Currency string `json:"currency"`
+
+	// TotalActual Sum of the actual amounts of the budgeted categories
+	TotalActual int64 `json:"totalActual"`
+
+	// TotalBudgeted Sum of the budgets
+	TotalBudgeted int64 `json:"totalBudgeted"`
+
+	// TotalRemaining totalBudgeted minus totalActual
+	TotalRemaining int64 `json:"totalRemaining"`
+
+	// YearMonth The accounting period
+	YearMonth string `json:"yearMonth"`
+}
+
+// Category defines model for Category.
+type Category struct {
+	// Children Sub categories of a top level category
+	Children *[]Category `json:"children,omitempty"`
+
+	// Id Category ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind INCOME categories apply to inflows, EXPENSE categories to outflows
+	Kind CategoryKind `json:"kind"`
+
+	// Name Category name
+	Name string `json:"name"`
+
+	// ParentId Parent category, absent on a top level category
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// Version Version for optimistic locking
+	Version int32 `json:"version"`
+}
+
+// CategoryBudget defines model for CategoryBudget.
+type CategoryBudget struct {
+	// Amount Amount planned to be spent during the period
+	Amount int64 `json:"amount"`
+
+	// CategoryId Expense category ID
+	CategoryId openapi_types.UUID `json:"categoryId"`
+}
+
+// CategoryBudgetStatus defines model for CategoryBudgetStatus.
+type CategoryBudgetStatus struct {
+	// Actual Amount spent on the category during the period
+	Actual int64 `json:"actual"`
+
+	// Budgeted Budget of the category
+	Budgeted int64 `json:"budgeted"`
+
+	// CategoryId Category ID
+	CategoryId openapi_types.UUID `json:"categoryId"`
+
+	// Name Category name
+	Name string `json:"name"`
+
+	// OverBudget Whether actual exceeds budgeted
+	OverBudget bool `json:"overBudget"`
+
+	// ParentId Parent of a sub category
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// Remaining budgeted minus actual, negative once over budget
+	Remaining int64 `json:"remaining"`
+}
+
+// CategoryKind INCOME categories apply to inflows, EXPENSE categories to outflows
+type CategoryKind string
+
+// ClearReconciliationRecordsRequest defines model for ClearReconciliationRecordsRequest.
+type ClearReconciliationRecordsRequest struct {
+	// Cleared True when the records are found on the statement, false to mark them as missing again
+	Cleared bool `json:"cleared"`
+
+	// TransactionIds Transaction records of the fund provider
+	TransactionIds []openapi_types.UUID `json:"transactionIds"`
+}
+
+// CloseAccountingPeriodResponse defines model for CloseAccountingPeriodResponse.
+type CloseAccountingPeriodResponse struct {
+	Data struct {
+		ClosingReport AccountingPeriodClosingReport `json:"closingReport"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// CreateCategoryRequest defines model for CreateCategoryRequest.
+type CreateCategoryRequest struct {
+	// Kind INCOME categories apply to inflows, EXPENSE categories to outflows
+	Kind *CategoryKind `json:"kind,omitempty"`
+
+	// Name Category name, unique among its siblings
+	Name string `json:"name"`
+
+	// ParentId Parent of a sub category, must be a top level category. The kind defaults to the kind of the parent
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+}
+
 // CreateFundProviderRequest defines model for CreateFundProviderRequest.
 type CreateFundProviderRequest struct {
 	// Currency Currency code (e.g., USD, VND, KRW)
@@ -37,6 +339,75 @@ type CreateFundProviderRequest struct {
 	Name string `json:"name"`
 }
 
+// CreateImportProfileRequest defines model for CreateImportProfileRequest.
+type CreateImportProfileRequest struct {
+	// AmountColumn Header of the amount column, required unless amountSign is SPLIT
+	AmountColumn *string `json:"amountColumn,omitempty"`
+
+	// AmountSign SIGNED reads one amount column where negative amounts are outflows, INVERTED one where positive amounts are outflows as in credit card exports, SPLIT reads outflows from a debit column and inflows from a credit column
+	AmountSign AmountSign `json:"amountSign"`
+
+	// CreditColumn Header of the credit column, required when amountSign is SPLIT
+	CreditColumn *string `json:"creditColumn,omitempty"`
+
+	// DateColumn Header of the date column
+	DateColumn string `json:"dateColumn"`
+
+	// DateFormat Date format written with DD, MM, YYYY, YY, HH, mm and ss
+	DateFormat string `json:"dateFormat"`
+
+	// DebitColumn Header of the debit column, required when amountSign is SPLIT
+	DebitColumn *string `json:"debitColumn,omitempty"`
+
+	// Delimiter Field delimiter, defaults to a comma
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// DescriptionColumn Header of the description column
+	DescriptionColumn *string `json:"descriptionColumn,omitempty"`
+
+	// Name Profile name, unique per user
+	Name string `json:"name"`
+
+	// ReferenceColumn Header of the bank reference column, the reference becomes the transactionNo
+	ReferenceColumn *string `json:"referenceColumn,omitempty"`
+
+	// SkipRows Number of lines before the header, defaults to 0
+	SkipRows *int32 `json:"skipRows,omitempty"`
+
+	// ThousandsSeparator Empty, a comma, a dot or a space. The other one of comma and dot separates decimals
+	ThousandsSeparator *string `json:"thousandsSeparator,omitempty"`
+}
+
+// CreateRecurringTemplateRequest defines model for CreateRecurringTemplateRequest.
+type CreateRecurringTemplateRequest struct {
+	// Amount Amount of every occurrence
+	Amount int64 `json:"amount"`
+
+	// CategoryId Category of the posted records, its kind must match the transaction type
+	CategoryId *openapi_types.UUID `json:"categoryId,omitempty"`
+
+	// DayOfMonth Day of a MONTHLY occurrence, moved to the last day of shorter months
+	DayOfMonth *int32 `json:"dayOfMonth,omitempty"`
+
+	// Description Description of the posted records
+	Description *string `json:"description,omitempty"`
+
+	// Frequency MONTHLY occurs on dayOfMonth every interval months, WEEKLY every interval weeks on the weekday of startDate
+	Frequency RecurringFrequency `json:"frequency"`
+
+	// FundProviderId Fund provider allocated to the wallet
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// Interval Every interval months or weeks, between 1 and 12, defaults to 1
+	Interval *int32 `json:"interval,omitempty"`
+
+	// StartDate First day an occurrence can fall on
+	StartDate openapi_types.Date `json:"startDate"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType `json:"transactionType"`
+}
+
 // CreateWalletRequest defines model for CreateWalletRequest.
 type CreateWalletRequest struct {
 	// Currency Currency code (e.g., USD, VND, KRW)
@@ -44,6 +415,12 @@ type CreateWalletRequest struct {
 
 	// Name Wallet Name (tai chinh tong, quy van phong, ...)
 	Name string `json:"name"`
+
+	// PeriodInterval Length of the accounting periods in months (1 monthly, 2 bi-monthly, 3 quarterly), defaults to 1
+	PeriodInterval *int32 `json:"periodInterval,omitempty"`
+
+	// PeriodStartDay Day of the month the accounting periods start on, defaults to 1
+	PeriodStartDay *int32 `json:"periodStartDay,omitempty"`
 }
 
 // CreateWalletResponse defines model for CreateWalletResponse.
@@ -52,6 +429,56 @@ type CreateWalletResponse struct {
 	RequestId *string `json:"request_id,omitempty"`
 }
 
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	// EventTypes Event types posted to url, every event is posted when empty or omitted
+	EventTypes *[]WebhookEventType `json:"eventTypes,omitempty"`
+
+	// Secret Key of the HMAC-SHA256 signature, it is not returned afterwards
+	Secret string `json:"secret"`
+
+	// Url Absolute http or https URL the events are posted to
+	Url string `json:"url"`
+}
+
+// DuplicateCandidate defines model for DuplicateCandidate.
+type DuplicateCandidate struct {
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+
+	// Direction IN credits the wallet, OUT debits it. Only required for an ADJUSTMENT, the other types have a fixed direction
+	Direction TransactionDirection `json:"direction"`
+
+	// Id Transaction record ID
+	Id            openapi_types.UUID `json:"id"`
+	OccurredAt    time.Time          `json:"occurredAt"`
+	TransactionNo string             `json:"transactionNo"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType    `json:"transactionType"`
+	WalletId        openapi_types.UUID `json:"walletId"`
+	WalletName      string             `json:"walletName"`
+	YearMonth       string             `json:"yearMonth"`
+}
+
+// DuplicateTransactionsResponse defines model for DuplicateTransactionsResponse.
+type DuplicateTransactionsResponse struct {
+	Data struct {
+		Duplicates []DuplicateWarning `json:"duplicates"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// DuplicateWarning defines model for DuplicateWarning.
+type DuplicateWarning struct {
+	Candidates []DuplicateCandidate `json:"candidates"`
+
+	// Index Position of the transaction in the request
+	Index int `json:"index"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -66,6 +493,338 @@ type ErrorResponse struct {
 	RequestId string `json:"request_id"`
 }
 
+// ExportFormat Format of the ledger export
+type ExportFormat string
+
+// FundProvider defines model for FundProvider.
+type FundProvider struct {
+	// AllocatedBalance Part of the balance reserved by wallets
+	AllocatedBalance int64 `json:"allocatedBalance"`
+
+	// Allocations Wallets the fund provider is allocated to, only returned by the detail endpoint
+	Allocations *[]FundProviderAllocation `json:"allocations,omitempty"`
+
+	// Balance Total balance of the fund provider
+	Balance int64 `json:"balance"`
+
+	// Currency Currency code
+	Currency string `json:"currency"`
+
+	// FpType Type of fund provider (BANK, CASH)
+	FpType string `json:"fpType"`
+
+	// Id Fund provider ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Fund provider name
+	Name string `json:"name"`
+
+	// UnallocatedBalance Part of the balance not reserved by any wallet
+	UnallocatedBalance int64 `json:"unallocatedBalance"`
+
+	// Version Version used for optimistic locking
+	Version int32 `json:"version"`
+}
+
+// FundProviderAllocation defines model for FundProviderAllocation.
+type FundProviderAllocation struct {
+	// Allocated Amount reserved for the wallet
+	Allocated int64 `json:"allocated"`
+
+	// WalletId Wallet ID
+	WalletId openapi_types.UUID `json:"walletId"`
+
+	// WalletName Wallet name
+	WalletName string `json:"walletName"`
+}
+
+// GetBudgetReportResponse defines model for GetBudgetReportResponse.
+type GetBudgetReportResponse struct {
+	Data struct {
+		BudgetReport BudgetReport `json:"budgetReport"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// GetFundProviderResponse defines model for GetFundProviderResponse.
+type GetFundProviderResponse struct {
+	Data struct {
+		FundProvider FundProvider `json:"fundProvider"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// GetReconciliationResponse defines model for GetReconciliationResponse.
+type GetReconciliationResponse struct {
+	Data struct {
+		Reconciliation Reconciliation `json:"reconciliation"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// GetWalletResponse defines model for GetWalletResponse.
+type GetWalletResponse struct {
+	Data struct {
+		Wallet Wallet `json:"wallet"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ImportPreview defines model for ImportPreview.
+type ImportPreview struct {
+	DuplicateCount     int `json:"duplicateCount"`
+	InvalidCount       int `json:"invalidCount"`
+	NewCount           int `json:"newCount"`
+	OutsidePeriodCount int `json:"outsidePeriodCount"`
+
+	// Reconciliation Present when the statement reports balances. Differences are the statement minus the fund provider, the import is refused unless both are zero
+	Reconciliation *ImportReconciliation `json:"reconciliation,omitempty"`
+	Rows           []ImportRow           `json:"rows"`
+
+	// YearMonth Open accounting period the NEW rows are recorded into
+	YearMonth string `json:"yearMonth"`
+}
+
+// ImportPreviewResponse defines model for ImportPreviewResponse.
+type ImportPreviewResponse struct {
+	Data struct {
+		Preview ImportPreview `json:"preview"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ImportProfile defines model for ImportProfile.
+type ImportProfile struct {
+	AmountColumn string `json:"amountColumn"`
+
+	// AmountSign SIGNED reads one amount column where negative amounts are outflows, INVERTED one where positive amounts are outflows as in credit card exports, SPLIT reads outflows from a debit column and inflows from a credit column
+	AmountSign        AmountSign `json:"amountSign"`
+	CreditColumn      string     `json:"creditColumn"`
+	DateColumn        string     `json:"dateColumn"`
+	DateFormat        string     `json:"dateFormat"`
+	DebitColumn       string     `json:"debitColumn"`
+	Delimiter         string     `json:"delimiter"`
+	DescriptionColumn string     `json:"descriptionColumn"`
+
+	// Id Import profile ID
+	Id                 openapi_types.UUID `json:"id"`
+	Name               string             `json:"name"`
+	ReferenceColumn    string             `json:"referenceColumn"`
+	SkipRows           int32              `json:"skipRows"`
+	ThousandsSeparator string             `json:"thousandsSeparator"`
+	Version            int32              `json:"version"`
+}
+
+// ImportReconciliation Present when the statement reports balances. Differences are the statement minus the fund provider, the import is refused unless both are zero
+type ImportReconciliation struct {
+	Balanced    bool      `json:"balanced"`
+	ClosingAsOf time.Time `json:"closingAsOf"`
+
+	// ClosingBalance Closing or ledger balance reported by the statement
+	ClosingBalance int64 `json:"closingBalance"`
+
+	// ClosingDifference Closing balance minus the projected balance
+	ClosingDifference int64 `json:"closingDifference"`
+
+	// FundProviderBalance Fund provider balance before the import
+	FundProviderBalance int64      `json:"fundProviderBalance"`
+	OpeningAsOf         *time.Time `json:"openingAsOf,omitempty"`
+
+	// OpeningBalance Opening balance reported by the statement, missing for OFX
+	OpeningBalance *int64 `json:"openingBalance,omitempty"`
+
+	// OpeningDifference Opening balance, moved by the rows already recorded, minus the fund provider balance
+	OpeningDifference int64 `json:"openingDifference"`
+
+	// ProjectedBalance Fund provider balance once the NEW rows are recorded
+	ProjectedBalance int64 `json:"projectedBalance"`
+
+	// UnrecordedLines Lines of the INVALID and OUTSIDE_PERIOD rows, left out of the import
+	UnrecordedLines []int `json:"unrecordedLines"`
+}
+
+// ImportRow defines model for ImportRow.
+type ImportRow struct {
+	Amount      *int64  `json:"amount,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Direction IN credits the wallet, OUT debits it. Only required for an ADJUSTMENT, the other types have a fixed direction
+	Direction *TransactionDirection `json:"direction,omitempty"`
+
+	// Line Line of the file
+	Line int `json:"line"`
+
+	// OccurredAt Date of the transaction, missing for an INVALID row
+	OccurredAt *time.Time `json:"occurredAt,omitempty"`
+
+	// Problem Why the row is not NEW
+	Problem *string         `json:"problem,omitempty"`
+	Status  ImportRowStatus `json:"status"`
+
+	// TransactionNo Bank reference
+	TransactionNo *string `json:"transactionNo,omitempty"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType *TransactionType `json:"transactionType,omitempty"`
+}
+
+// ImportRowStatus defines model for ImportRowStatus.
+type ImportRowStatus string
+
+// ImportTransactionsRequest defines model for ImportTransactionsRequest.
+type ImportTransactionsRequest struct {
+	// File Statement file, up to 5 MB
+	File openapi_types.File `json:"file"`
+
+	// Format Format of the statement file, defaults to CSV
+	Format *StatementFormat `json:"format,omitempty"`
+
+	// FundProviderId Fund provider of the statement, allocated to the wallet
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// ProfileId Import profile reading the file, required for CSV
+	ProfileId *openapi_types.UUID `json:"profileId,omitempty"`
+}
+
+// LedgerConfig defines model for LedgerConfig.
+type LedgerConfig struct {
+	// PeriodInterval Length of the accounting periods in months (1 monthly, 2 bi-monthly, 3 quarterly)
+	PeriodInterval int32 `json:"periodInterval"`
+
+	// PeriodStartDay Day of the month the accounting periods start on
+	PeriodStartDay int32 `json:"periodStartDay"`
+}
+
+// LedgerExport defines model for LedgerExport.
+type LedgerExport struct {
+	Period AccountingPeriodClosingReport `json:"period"`
+
+	// Transactions Transaction records of the period in the order they were recorded
+	Transactions []Transaction `json:"transactions"`
+}
+
+// ListAccountingPeriodsResponse defines model for ListAccountingPeriodsResponse.
+type ListAccountingPeriodsResponse struct {
+	Data struct {
+		AccountingPeriods []AccountingPeriod `json:"accountingPeriods"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListCategoriesResponse defines model for ListCategoriesResponse.
+type ListCategoriesResponse struct {
+	Data struct {
+		Categories []Category `json:"categories"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListFundProvidersResponse defines model for ListFundProvidersResponse.
+type ListFundProvidersResponse struct {
+	Data struct {
+		FundProviders []FundProvider `json:"fundProviders"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListImportProfilesResponse defines model for ListImportProfilesResponse.
+type ListImportProfilesResponse struct {
+	Data struct {
+		ImportProfiles []ImportProfile `json:"importProfiles"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListReconciliationsResponse defines model for ListReconciliationsResponse.
+type ListReconciliationsResponse struct {
+	Data struct {
+		Reconciliations []Reconciliation `json:"reconciliations"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListRecurringTemplatesResponse defines model for ListRecurringTemplatesResponse.
+type ListRecurringTemplatesResponse struct {
+	Data struct {
+		RecurringTemplates []RecurringTemplate `json:"recurringTemplates"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListTransactionsResponse defines model for ListTransactionsResponse.
+type ListTransactionsResponse struct {
+	Data struct {
+		// NextCursor Cursor of the next page, absent on the last page
+		NextCursor   *openapi_types.UUID `json:"nextCursor,omitempty"`
+		Transactions []Transaction       `json:"transactions"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListUpcomingOccurrencesResponse defines model for ListUpcomingOccurrencesResponse.
+type ListUpcomingOccurrencesResponse struct {
+	Data struct {
+		Occurrences []UpcomingOccurrence `json:"occurrences"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListWalletsResponse defines model for ListWalletsResponse.
+type ListWalletsResponse struct {
+	Data struct {
+		Wallets []Wallet `json:"wallets"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListWebhookDeliveriesResponse defines model for ListWebhookDeliveriesResponse.
+type ListWebhookDeliveriesResponse struct {
+	Data struct {
+		Deliveries []WebhookDelivery `json:"deliveries"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// ListWebhookSubscriptionsResponse defines model for ListWebhookSubscriptionsResponse.
+type ListWebhookSubscriptionsResponse struct {
+	Data struct {
+		Webhooks []WebhookSubscription `json:"webhooks"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
 // OpenAccountingPeriodRequest defines model for OpenAccountingPeriodRequest.
 type OpenAccountingPeriodRequest struct {
 	// Month The month for the accounting period (1-12)
@@ -75,33 +834,593 @@ type OpenAccountingPeriodRequest struct {
 	Year int `json:"year"`
 }
 
+// PeriodContinuityIssue defines model for PeriodContinuityIssue.
+type PeriodContinuityIssue struct {
+	// Kind Kind of issue (GAP, OVERLAP, BALANCE_MISMATCH)
+	Kind string `json:"kind"`
+
+	// OpeningBalance Opening balance of the period
+	OpeningBalance int64 `json:"openingBalance"`
+
+	// PreviousClosingBalance Closing balance of the previous period
+	PreviousClosingBalance int64 `json:"previousClosingBalance"`
+
+	// PreviousEndDate The moment the previous period ends
+	PreviousEndDate time.Time `json:"previousEndDate"`
+
+	// PreviousYearMonth The period before it
+	PreviousYearMonth string `json:"previousYearMonth"`
+
+	// StartTime The moment the period starts
+	StartTime time.Time `json:"startTime"`
+
+	// YearMonth The period with the issue
+	YearMonth string `json:"yearMonth"`
+}
+
+// PeriodContinuityReport defines model for PeriodContinuityReport.
+type PeriodContinuityReport struct {
+	// CheckedPeriods Number of accounting periods checked
+	CheckedPeriods int `json:"checkedPeriods"`
+
+	// Continuous True when no issue was found
+	Continuous bool                    `json:"continuous"`
+	Issues     []PeriodContinuityIssue `json:"issues"`
+}
+
+// PlanCategoryBudgetsRequest defines model for PlanCategoryBudgetsRequest.
+type PlanCategoryBudgetsRequest struct {
+	Budgets []CategoryBudget `json:"budgets"`
+}
+
+// Reconciliation defines model for Reconciliation.
+type Reconciliation struct {
+	// BookBalance Current balance of the fund provider, only returned when getting a reconciliation
+	BookBalance *int64 `json:"bookBalance,omitempty"`
+
+	// ClearedBalance Balance of the fund provider less the uncleared records, only computed while in progress
+	ClearedBalance *int64 `json:"clearedBalance,omitempty"`
+
+	// ClearedCount Number of cleared records
+	ClearedCount int `json:"clearedCount"`
+
+	// CreatedAt When the reconciliation was started
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Currency Currency of the fund provider, only returned when getting a reconciliation
+	Currency *string `json:"currency,omitempty"`
+
+	// Difference Statement balance minus cleared balance, only computed while in progress
+	Difference *int64 `json:"difference,omitempty"`
+
+	// FinalisedAt When the reconciliation was finalised
+	FinalisedAt *time.Time `json:"finalisedAt,omitempty"`
+
+	// FundProviderId Fund provider ID
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// Id Reconciliation ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Records Records of the reconciliation, only returned when getting a reconciliation
+	Records *[]ReconciliationRecord `json:"records,omitempty"`
+
+	// StatementBalance Closing balance of the statement
+	StatementBalance int64 `json:"statementBalance"`
+
+	// StatementDate Day the statement closes on
+	StatementDate openapi_types.Date   `json:"statementDate"`
+	Status        ReconciliationStatus `json:"status"`
+
+	// Version Version for optimistic locking
+	Version int32 `json:"version"`
+}
+
+// ReconciliationRecord defines model for ReconciliationRecord.
+type ReconciliationRecord struct {
+	// Adjustment Whether the record is an adjustment posted by the reconciliation
+	Adjustment bool `json:"adjustment"`
+
+	// Amount Amount of the record
+	Amount int64 `json:"amount"`
+
+	// Cleared Whether the record is found on the statement
+	Cleared bool `json:"cleared"`
+
+	// Description Description of the record
+	Description string `json:"description"`
+
+	// Direction IN credits the wallet, OUT debits it. Only required for an ADJUSTMENT, the other types have a fixed direction
+	Direction TransactionDirection `json:"direction"`
+
+	// Id Transaction record ID
+	Id openapi_types.UUID `json:"id"`
+
+	// OccurredAt When the transaction occurred
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// TransactionNo Reference of the record, e.g. the bank transaction number
+	TransactionNo *string `json:"transactionNo,omitempty"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType `json:"transactionType"`
+
+	// WalletId Wallet the record was booked in
+	WalletId openapi_types.UUID `json:"walletId"`
+
+	// WalletName Wallet name
+	WalletName string `json:"walletName"`
+}
+
+// ReconciliationStatus defines model for ReconciliationStatus.
+type ReconciliationStatus string
+
 // RecordTransactionRecordsRequest defines model for RecordTransactionRecordsRequest.
 type RecordTransactionRecordsRequest struct {
 	// TransactionRecords List of transaction records to record
 	TransactionRecords []TransactionRecord `json:"transactionRecords"`
 }
 
+// RecurringFrequency MONTHLY occurs on dayOfMonth every interval months, WEEKLY every interval weeks on the weekday of startDate
+type RecurringFrequency string
+
+// RecurringTemplate defines model for RecurringTemplate.
+type RecurringTemplate struct {
+	// Amount Amount of every occurrence
+	Amount int64 `json:"amount"`
+
+	// CategoryId Category ID
+	CategoryId *openapi_types.UUID `json:"categoryId,omitempty"`
+
+	// CategoryName Category name
+	CategoryName *string `json:"categoryName,omitempty"`
+
+	// DayOfMonth Day of a MONTHLY occurrence
+	DayOfMonth *int32 `json:"dayOfMonth,omitempty"`
+
+	// Description Description of the posted records
+	Description string `json:"description"`
+
+	// Frequency MONTHLY occurs on dayOfMonth every interval months, WEEKLY every interval weeks on the weekday of startDate
+	Frequency RecurringFrequency `json:"frequency"`
+
+	// FundProviderId Fund provider ID
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// Id Recurring template ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Interval Every interval months or weeks
+	Interval int32 `json:"interval"`
+
+	// PausedAt When the template was paused
+	PausedAt *time.Time `json:"pausedAt,omitempty"`
+
+	// StartDate First day an occurrence can fall on
+	StartDate openapi_types.Date      `json:"startDate"`
+	Status    RecurringTemplateStatus `json:"status"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType `json:"transactionType"`
+
+	// Version Version for optimistic locking
+	Version int32 `json:"version"`
+}
+
+// RecurringTemplateStatus defines model for RecurringTemplateStatus.
+type RecurringTemplateStatus string
+
+// ReverseTransactionRequest defines model for ReverseTransactionRequest.
+type ReverseTransactionRequest struct {
+	// Description Description of the reversal record, defaults to a reference to the original
+	Description *string `json:"description,omitempty"`
+}
+
+// SkipRecurringOccurrenceRequest defines model for SkipRecurringOccurrenceRequest.
+type SkipRecurringOccurrenceRequest struct {
+	// Date Date of the occurrence to skip
+	Date openapi_types.Date `json:"date"`
+}
+
+// StartReconciliationRequest defines model for StartReconciliationRequest.
+type StartReconciliationRequest struct {
+	// StatementBalance Closing balance of the statement
+	StatementBalance int64 `json:"statementBalance"`
+
+	// StatementDate Day the statement closes on
+	StatementDate openapi_types.Date `json:"statementDate"`
+}
+
+// StatementFormat Format of the statement file, defaults to CSV
+type StatementFormat string
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// Amount Transaction amount
+	Amount int64 `json:"amount"`
+
+	// CategoryId Category of the transaction
+	CategoryId *openapi_types.UUID `json:"categoryId,omitempty"`
+
+	// CategoryName Category name
+	CategoryName *string `json:"categoryName,omitempty"`
+
+	// Description Transaction description
+	Description string `json:"description"`
+
+	// Direction IN credits the wallet, OUT debits it. Only required for an ADJUSTMENT, the other types have a fixed direction
+	Direction TransactionDirection `json:"direction"`
+
+	// FpBalance Fund provider balance right after the transaction
+	FpBalance int64 `json:"fpBalance"`
+
+	// FundProviderId Fund provider ID
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// FundProviderName Fund provider name
+	FundProviderName string `json:"fundProviderName"`
+
+	// Id Transaction record ID
+	Id openapi_types.UUID `json:"id"`
+
+	// LinkedId Counterpart record of a transfer
+	LinkedId *openapi_types.UUID `json:"linkedId,omitempty"`
+
+	// OccurredAt Business date of the transaction
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// RecordedAt Moment the transaction was recorded
+	RecordedAt time.Time `json:"recordedAt"`
+
+	// ReversalOfId Record compensated by this reversal
+	ReversalOfId *openapi_types.UUID `json:"reversalOfId,omitempty"`
+
+	// ReversedAt Moment the record was reversed
+	ReversedAt *time.Time `json:"reversedAt,omitempty"`
+
+	// ReversedById Reversal that compensated this record
+	ReversedById *openapi_types.UUID `json:"reversedById,omitempty"`
+
+	// TransactionNo Transaction number or reference
+	TransactionNo string `json:"transactionNo"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType `json:"transactionType"`
+
+	// WalletBalance Wallet balance right after the transaction
+	WalletBalance int64 `json:"walletBalance"`
+
+	// YearMonth The accounting period the transaction belongs to
+	YearMonth string `json:"yearMonth"`
+}
+
+// TransactionDirection IN credits the wallet, OUT debits it. Only required for an ADJUSTMENT, the other types have a fixed direction
+type TransactionDirection string
+
 // TransactionRecord defines model for TransactionRecord.
 type TransactionRecord struct {
 	// Amount Transaction amount
 	Amount int64 `json:"amount"`
 
+	// CategoryId Category of the transaction, an INCOME category for inflows and an EXPENSE category for outflows
+	CategoryId *openapi_types.UUID `json:"categoryId,omitempty"`
+
 	// Description Transaction description
 	Description string `json:"description"`
 
+	// Direction IN credits the wallet, OUT debits it. Only required for an ADJUSTMENT, the other types have a fixed direction
+	Direction *TransactionDirection `json:"direction,omitempty"`
+
 	// FundProviderId Fund provider ID
 	FundProviderId openapi_types.UUID `json:"fundProviderId"`
 
+	// OccurredAt Business date of the transaction, must fall inside the accounting period. Defaults to the moment of recording
+	OccurredAt *time.Time `json:"occurredAt,omitempty"`
+
 	// TransactionNo Transaction number or reference
 	TransactionNo string `json:"transactionNo"`
 
-	// TransactionType Type of transaction (e.g., DEPOSIT, WITHDRAWAL, TRANSFER)
-	TransactionType string `json:"transactionType"`
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType `json:"transactionType"`
+}
+
+// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+type TransactionType string
+
+// TransferBetweenFundProvidersRequest defines model for TransferBetweenFundProvidersRequest.
+type TransferBetweenFundProvidersRequest struct {
+	// Amount Amount to move
+	Amount int64 `json:"amount"`
+
+	// Description Transfer description
+	Description *string `json:"description,omitempty"`
+
+	// Fee Optional fee charged on the source fund provider, booked as an expense
+	Fee *int64 `json:"fee,omitempty"`
+
+	// FromFundProviderId Fund provider the money leaves
+	FromFundProviderId openapi_types.UUID `json:"fromFundProviderId"`
+
+	// OccurredAt Business date of the transfer, must fall inside the accounting period. Defaults to the moment of recording
+	OccurredAt *time.Time `json:"occurredAt,omitempty"`
+
+	// ToFundProviderId Fund provider the money arrives at
+	ToFundProviderId openapi_types.UUID `json:"toFundProviderId"`
+
+	// TransactionNo Transaction number or reference
+	TransactionNo *string `json:"transactionNo,omitempty"`
 }
 
+// TransferBetweenWalletsRequest defines model for TransferBetweenWalletsRequest.
+type TransferBetweenWalletsRequest struct {
+	// Amount Amount to move
+	Amount int64 `json:"amount"`
+
+	// Description Transfer description
+	Description *string `json:"description,omitempty"`
+
+	// FundProviderId Fund provider whose allocation is moved
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// OccurredAt Business date of the transfer, must fall inside an open accounting period of both wallets. Defaults to the moment of recording
+	OccurredAt *time.Time `json:"occurredAt,omitempty"`
+
+	// ToWalletId Destination wallet ID
+	ToWalletId openapi_types.UUID `json:"toWalletId"`
+
+	// TransactionNo Transaction number or reference
+	TransactionNo *string `json:"transactionNo,omitempty"`
+}
+
+// UpcomingOccurrence defines model for UpcomingOccurrence.
+type UpcomingOccurrence struct {
+	// Amount Amount to be posted
+	Amount int64 `json:"amount"`
+
+	// CategoryId Category ID
+	CategoryId *openapi_types.UUID `json:"categoryId,omitempty"`
+
+	// CategoryName Category name
+	CategoryName *string `json:"categoryName,omitempty"`
+
+	// Date Date of the occurrence
+	Date openapi_types.Date `json:"date"`
+
+	// Description Description of the record to be posted
+	Description string `json:"description"`
+
+	// FundProviderId Fund provider ID
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// Status SCHEDULED, SKIPPED, or PAUSED while the template is paused
+	Status UpcomingOccurrenceStatus `json:"status"`
+
+	// TemplateId Recurring template ID
+	TemplateId openapi_types.UUID `json:"templateId"`
+
+	// TransactionType Type of transaction. DEPOSIT and INTEREST are income, WITHDRAWAL and FEE are expense. A REFUND gives back part of an expense, it is filed under an expense category and deducted from the expense total. TRANSFER_IN and TRANSFER_OUT are only written by transfers, ADJUSTMENT corrects a balance either way. Neither transfers nor adjustments count as income or expense
+	TransactionType TransactionType `json:"transactionType"`
+}
+
+// UpcomingOccurrenceStatus SCHEDULED, SKIPPED, or PAUSED while the template is paused
+type UpcomingOccurrenceStatus string
+
+// UpdateAllocationRequest defines model for UpdateAllocationRequest.
+type UpdateAllocationRequest struct {
+	// Amount Amount added to or released from the allocation
+	Amount int64 `json:"amount"`
+}
+
+// UpdateCategoryRequest defines model for UpdateCategoryRequest.
+type UpdateCategoryRequest struct {
+	// Name New category name
+	Name string `json:"name"`
+}
+
+// VerifyPeriodContinuityResponse defines model for VerifyPeriodContinuityResponse.
+type VerifyPeriodContinuityResponse struct {
+	Data struct {
+		Continuity PeriodContinuityReport `json:"continuity"`
+	} `json:"data"`
+
+	// RequestID Request identifier for tracking
+	RequestID string `json:"requestID"`
+}
+
+// Wallet defines model for Wallet.
+type Wallet struct {
+	// Allocations Fund provider allocations of the wallet
+	Allocations []WalletAllocation `json:"allocations"`
+
+	// Balance Wallet balance, equals the sum of its allocations
+	Balance int64 `json:"balance"`
+
+	// Currency Currency code
+	Currency string `json:"currency"`
+
+	// Id Wallet ID
+	Id           openapi_types.UUID `json:"id"`
+	LedgerConfig LedgerConfig       `json:"ledgerConfig"`
+
+	// Name Wallet name
+	Name string `json:"name"`
+
+	// Version Version used for optimistic locking
+	Version int32 `json:"version"`
+}
+
+// WalletAllocation defines model for WalletAllocation.
+type WalletAllocation struct {
+	// Allocated Amount of the fund provider reserved for the wallet
+	Allocated int64 `json:"allocated"`
+
+	// FundProviderId Fund provider ID
+	FundProviderId openapi_types.UUID `json:"fundProviderId"`
+
+	// FundProviderName Fund provider name
+	FundProviderName string `json:"fundProviderName"`
+
+	// FundProviderType Fund provider type (BANK, CASH)
+	FundProviderType string `json:"fundProviderType"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	AttemptLog []WebhookDeliveryAttempt `json:"attemptLog"`
+
+	// Attempts Number of attempts made
+	Attempts  int32     `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt When the endpoint acknowledged the delivery
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId Event ID, the id of the body. It is the same on every delivery of the event
+	EventId openapi_types.UUID `json:"eventId"`
+
+	// EventName Event type, or WebhookTest for a test event
+	EventName string `json:"eventName"`
+
+	// Id Webhook delivery ID, sent in the X-Sumni-Delivery header
+	Id openapi_types.UUID `json:"id"`
+
+	// LastError Error of the last failed attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode Status code of the last response, omitted when the endpoint could not be reached
+	LastStatusCode *int32 `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt When the delivery is attempted next, only set while it is pending
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Status DEAD deliveries failed every attempt and are not retried anymore
+	Status WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	Attempt     int32     `json:"attempt"`
+	AttemptedAt time.Time `json:"attemptedAt"`
+
+	// DurationMs Time the endpoint took to respond, in milliseconds
+	DurationMs int32 `json:"durationMs"`
+
+	// Error Why the attempt failed
+	Error *string `json:"error,omitempty"`
+
+	// StatusCode Status code of the response, omitted when the endpoint could not be reached
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
+// WebhookDeliveryStatus DEAD deliveries failed every attempt and are not retried anymore
+type WebhookDeliveryStatus string
+
+// WebhookEventType FundAllocated when the allocation of a fund provider to a wallet changes, FundProviderCreated, PeriodOpened and PeriodClosed for the accounting periods, TransactionRecorded for every record booked into a wallet, including transfers and reversals
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes Event types posted to url, empty when every event is posted
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Id Webhook subscription ID
+	Id      openapi_types.UUID `json:"id"`
+	Url     string             `json:"url"`
+	Version int32              `json:"version"`
+}
+
+// RecordTransactionRecordsParams defines parameters for RecordTransactionRecords.
+type RecordTransactionRecordsParams struct {
+	// ConfirmDuplicates Records the transactions even if they look like recorded ones, defaults to false
+	ConfirmDuplicates *bool `form:"confirmDuplicates,omitempty" json:"confirmDuplicates,omitempty"`
+
+	// DuplicateWindowDays How many days apart a recorded transaction may have occurred to look like a duplicate, defaults to 3
+	DuplicateWindowDays *int `form:"duplicateWindowDays,omitempty" json:"duplicateWindowDays,omitempty"`
+}
+
+// ExportLedgerParams defines parameters for ExportLedger.
+type ExportLedgerParams struct {
+	// Format Format of the export file
+	Format ExportFormat `form:"format" json:"format"`
+}
+
+// ImportTransactionsParams defines parameters for ImportTransactions.
+type ImportTransactionsParams struct {
+	// Commit Records the NEW rows instead of previewing them, defaults to false
+	Commit *bool `form:"commit,omitempty" json:"commit,omitempty"`
+}
+
+// ListUpcomingOccurrencesParams defines parameters for ListUpcomingOccurrences.
+type ListUpcomingOccurrencesParams struct {
+	// Days Number of days to look ahead, between 1 and 366, defaults to 30
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// ListTransactionsParams defines parameters for ListTransactions.
+type ListTransactionsParams struct {
+	// Cursor The nextCursor returned by the previous page
+	Cursor *openapi_types.UUID `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Page size, defaults to 20 and is capped at 100
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// FromYearMonth First accounting period to include (e.g. "2024,1")
+	FromYearMonth *string `form:"fromYearMonth,omitempty" json:"fromYearMonth,omitempty"`
+
+	// ToYearMonth Last accounting period to include (e.g. "2024,6")
+	ToYearMonth *string `form:"toYearMonth,omitempty" json:"toYearMonth,omitempty"`
+
+	// FundProviderId Only include transactions of this fund provider
+	FundProviderId *openapi_types.UUID `form:"fundProviderId,omitempty" json:"fundProviderId,omitempty"`
+
+	// TransactionType Only include transactions of this type
+	TransactionType *TransactionType `form:"transactionType,omitempty" json:"transactionType,omitempty"`
+
+	// MinAmount Minimum transaction amount, inclusive
+	MinAmount *int64 `form:"minAmount,omitempty" json:"minAmount,omitempty"`
+
+	// MaxAmount Maximum transaction amount, inclusive
+	MaxAmount *int64 `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
+
+	// TransactionNo Only include transactions with this transaction number
+	TransactionNo *string `form:"transactionNo,omitempty" json:"transactionNo,omitempty"`
+
+	// CategoryId Only include transactions of this category, a top level category includes its sub categories
+	CategoryId *openapi_types.UUID `form:"categoryId,omitempty" json:"categoryId,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Status Only list the deliveries with this status
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Number of deliveries, defaults to 50 and is capped at 200
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
+type CreateCategoryJSONRequestBody = CreateCategoryRequest
+
+// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
+type UpdateCategoryJSONRequestBody = UpdateCategoryRequest
+
 // CreateFundProviderJSONRequestBody defines body for CreateFundProvider for application/json ContentType.
 type CreateFundProviderJSONRequestBody = CreateFundProviderRequest
 
+// StartReconciliationJSONRequestBody defines body for StartReconciliation for application/json ContentType.
+type StartReconciliationJSONRequestBody = StartReconciliationRequest
+
+// AdjustReconciliationJSONRequestBody defines body for AdjustReconciliation for application/json ContentType.
+type AdjustReconciliationJSONRequestBody = AdjustReconciliationRequest
+
+// ClearReconciliationRecordsJSONRequestBody defines body for ClearReconciliationRecords for application/json ContentType.
+type ClearReconciliationRecordsJSONRequestBody = ClearReconciliationRecordsRequest
+
+// CreateImportProfileJSONRequestBody defines body for CreateImportProfile for application/json ContentType.
+type CreateImportProfileJSONRequestBody = CreateImportProfileRequest
+
 // CreateWalletJSONRequestBody defines body for CreateWallet for application/json ContentType.
 type CreateWalletJSONRequestBody = CreateWalletRequest
 
@@ -111,5 +1430,38 @@ type OpenAccountingPeriodJSONRequestBody = OpenAccountingPeriodRequest
 // RecordTransactionRecordsJSONRequestBody defines body for RecordTransactionRecords for application/json ContentType.
 type RecordTransactionRecordsJSONRequestBody = RecordTransactionRecordsRequest
 
+// PlanCategoryBudgetsJSONRequestBody defines body for PlanCategoryBudgets for application/json ContentType.
+type PlanCategoryBudgetsJSONRequestBody = PlanCategoryBudgetsRequest
+
+// TransferBetweenFundProvidersJSONRequestBody defines body for TransferBetweenFundProviders for application/json ContentType.
+type TransferBetweenFundProvidersJSONRequestBody = TransferBetweenFundProvidersRequest
+
 // AllocateFundJSONRequestBody defines body for AllocateFund for application/json ContentType.
 type AllocateFundJSONRequestBody = AllocateFundRequest
+
+// DecreaseAllocationJSONRequestBody defines body for DecreaseAllocation for application/json ContentType.
+type DecreaseAllocationJSONRequestBody = UpdateAllocationRequest
+
+// IncreaseAllocationJSONRequestBody defines body for IncreaseAllocation for application/json ContentType.
+type IncreaseAllocationJSONRequestBody = UpdateAllocationRequest
+
+// ImportTransactionsMultipartRequestBody defines body for ImportTransactions for multipart/form-data ContentType.
+type ImportTransactionsMultipartRequestBody = ImportTransactionsRequest
+
+// UpdateLedgerConfigJSONRequestBody defines body for UpdateLedgerConfig for application/json ContentType.
+type UpdateLedgerConfigJSONRequestBody = LedgerConfig
+
+// CreateRecurringTemplateJSONRequestBody defines body for CreateRecurringTemplate for application/json ContentType.
+type CreateRecurringTemplateJSONRequestBody = CreateRecurringTemplateRequest
+
+// SkipRecurringOccurrenceJSONRequestBody defines body for SkipRecurringOccurrence for application/json ContentType.
+type SkipRecurringOccurrenceJSONRequestBody = SkipRecurringOccurrenceRequest
+
+// ReverseTransactionJSONRequestBody defines body for ReverseTransaction for application/json ContentType.
+type ReverseTransactionJSONRequestBody = ReverseTransactionRequest
+
+// TransferBetweenWalletsJSONRequestBody defines body for TransferBetweenWallets for application/json ContentType.
+type TransferBetweenWalletsJSONRequestBody = TransferBetweenWalletsRequest
+
+// CreateWebhookSubscriptionJSONRequestBody defines body for CreateWebhookSubscription for application/json ContentType.
+type CreateWebhookSubscriptionJSONRequestBody = CreateWebhookSubscriptionRequest
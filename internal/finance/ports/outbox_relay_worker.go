@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"log/slog"
+	"sumni-finance-backend/internal/finance/app"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NewOutboxRelayWorker creates the worker periodically relaying the due domain events of the outbox to the subscribers.
+// A failed delivery is stored on the event and retried with a backoff on a later tick.
+func NewOutboxRelayWorker(application app.Application, lock LeaderLock, interval time.Duration) *TickerWorker {
+	return NewTickerWorker("outbox relay", lock, interval, func(ctx context.Context) (int, int, error) {
+		eventIDs, err := application.Queries.OutboxEventsDue.Handle(ctx, query.ListOutboxEventsDue{})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return processEach(eventIDs, func(eventID uuid.UUID) error {
+			err := application.Commands.DispatchOutboxEvent.Handle(ctx, command.DispatchOutboxEventCmd{EventID: eventID})
+			if err != nil {
+				slog.Error("failed to dispatch outbox event", "eventId", eventID, "error", err)
+			}
+
+			return err
+		})
+	})
+}
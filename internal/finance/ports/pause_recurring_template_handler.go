@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Pause a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/pause)
+func (hs HttpServer) PauseRecurringTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	templateId openapi_types.UUID,
+) {
+	if err := hs.application.Commands.PauseRecurringTemplate.Handle(r.Context(), command.PauseRecurringTemplateCmd{
+		WalletID:   walletId,
+		TemplateID: templateId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"log/slog"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/finance/app"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+)
+
+// NewPeriodRolloverWorker creates the worker periodically closing the ended accounting periods and opening the next ones.
+// Each wallet is rolled over on behalf of its owner, so the raised events reach the webhooks of that user.
+func NewPeriodRolloverWorker(application app.Application, lock LeaderLock, interval time.Duration) *TickerWorker {
+	return NewTickerWorker("period rollover", lock, interval, func(ctx context.Context) (int, int, error) {
+		wallets, err := application.Queries.WalletsDueForRollover.Handle(ctx, query.ListWalletsDueForRollover{})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return processEach(wallets, func(w query.WalletDueForRollover) error {
+			walletCtx := ctx
+			if w.UserID != "" {
+				walletCtx = auth.WithUser(ctx, auth.User{ID: w.UserID})
+			}
+
+			err := application.Commands.RolloverAccountingPeriods.Handle(walletCtx, command.RolloverAccountingPeriodsCmd{WalletID: w.WalletID})
+			if err != nil {
+				slog.Error("failed to rollover accounting periods", "walletId", w.WalletID, "error", err)
+			}
+
+			return err
+		})
+	})
+}
@@ -0,0 +1,57 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Plan the category budgets of an accounting period
+// (PUT /v1/wallets/{walletId}/accounting-periods/{yearMonth}/budgets)
+func (hs HttpServer) PlanCategoryBudgets(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	yearMonth string,
+) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	var req PlanCategoryBudgetsRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	budgets := make([]command.CategoryBudgetCmd, 0, len(req.Budgets))
+	for _, b := range req.Budgets {
+		budgets = append(budgets, command.CategoryBudgetCmd{
+			CategoryID: b.CategoryId,
+			Amount:     b.Amount,
+		})
+	}
+
+	if err := hs.application.Commands.PlanCategoryBudgets.Handle(r.Context(), command.PlanCategoryBudgetsCmd{
+		UserID:    user.ID,
+		WalletID:  walletId,
+		YearMonth: yearMonth,
+		Budgets:   budgets,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
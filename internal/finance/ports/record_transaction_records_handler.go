@@ -2,10 +2,14 @@ package ports
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/convert"
 	"sumni-finance-backend/internal/common/server/httperr"
 	"sumni-finance-backend/internal/common/server/response"
 	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -17,7 +21,14 @@ func (hs HttpServer) RecordTransactionRecords(
 	r *http.Request,
 	walletId openapi_types.UUID,
 	yearMonth string,
+	params RecordTransactionRecordsParams,
 ) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	var req RecordTransactionRecordsRequest
 
 	decoder := json.NewDecoder(r.Body)
@@ -35,22 +46,64 @@ func (hs HttpServer) RecordTransactionRecords(
 			FundProviderID:  tr.FundProviderId,
 			Amount:          tr.Amount,
 			TransactionNo:   tr.TransactionNo,
-			TransactionType: tr.TransactionType,
+			TransactionType: string(tr.TransactionType),
+			Direction:       string(convert.SafeDeref(tr.Direction, "")),
 			Description:     tr.Description,
+			OccurredAt:      tr.OccurredAt,
+			CategoryID:      tr.CategoryId,
 		})
 	}
 
 	if err := hs.application.Commands.RecordTransactionRecords.Handle(
 		r.Context(),
 		command.RecordTransactionRecordsCmd{
-			WalletID:           walletId,
-			YearMonth:          yearMonth,
-			TransactionRecords: transactionRecords,
+			UserID:              user.ID,
+			WalletID:            walletId,
+			YearMonth:           yearMonth,
+			TransactionRecords:  transactionRecords,
+			ConfirmDuplicates:   convert.SafeDeref(params.ConfirmDuplicates, false),
+			DuplicateWindowDays: convert.SafeDeref(params.DuplicateWindowDays, 0),
 		},
 	); err != nil {
+		var duplicateErr command.DuplicateTransactionsError
+		if errors.As(err, &duplicateErr) {
+			response.WriteJSON(w, r, http.StatusConflict, response.Envelop{
+				"duplicates": mapDuplicateWarningsToResponse(duplicateErr.Warnings),
+			}, nil)
+			return
+		}
+
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
 	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
 }
+
+func mapDuplicateWarningsToResponse(warnings []query.DuplicateWarning) []DuplicateWarning {
+	resp := make([]DuplicateWarning, 0, len(warnings))
+	for _, warning := range warnings {
+		candidates := make([]DuplicateCandidate, 0, len(warning.Candidates))
+		for _, c := range warning.Candidates {
+			candidates = append(candidates, DuplicateCandidate{
+				Id:              c.ID,
+				WalletId:        c.WalletID,
+				WalletName:      c.WalletName,
+				TransactionNo:   c.TransactionNo,
+				TransactionType: TransactionType(c.TransactionType),
+				Direction:       TransactionDirection(c.Direction),
+				Amount:          c.Amount,
+				Description:     c.Description,
+				OccurredAt:      c.OccurredAt,
+				YearMonth:       c.YearMonth,
+			})
+		}
+
+		resp = append(resp, DuplicateWarning{
+			Index:      warning.Index,
+			Candidates: candidates,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"log/slog"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/finance/app"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+)
+
+// NewRecurringMaterializerWorker creates the worker periodically posting the due occurrences of the recurring templates.
+// A template is retried on the next tick when its wallet has no open accounting period yet.
+// Each template is materialized on behalf of its owner, so the raised events reach the webhooks of that user.
+func NewRecurringMaterializerWorker(application app.Application, lock LeaderLock, interval time.Duration) *TickerWorker {
+	return NewTickerWorker("recurring materializer", lock, interval, func(ctx context.Context) (int, int, error) {
+		templates, err := application.Queries.RecurringTemplatesDue.Handle(ctx, query.ListRecurringTemplatesDue{})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return processEach(templates, func(t query.RecurringTemplateDue) error {
+			templateCtx := auth.WithUser(ctx, auth.User{ID: t.UserID})
+
+			err := application.Commands.MaterializeRecurringTemplate.Handle(templateCtx, command.MaterializeRecurringTemplateCmd{TemplateID: t.TemplateID})
+			if err != nil {
+				slog.Error("failed to materialize recurring template", "templateId", t.TemplateID, "error", err)
+			}
+
+			return err
+		})
+	})
+}
@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Remove a fund provider from a wallet
+// (DELETE /v1/wallets/{walletId}/fund-providers/{fundProviderId})
+func (hs HttpServer) RemoveAllocation(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	fundProviderId openapi_types.UUID,
+) {
+	if err := hs.application.Commands.RemoveAllocation.Handle(
+		r.Context(),
+		command.RemoveAllocationCmd{
+			WalletID:       walletId,
+			FundProviderID: fundProviderId,
+		},
+	); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Resume a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/resume)
+func (hs HttpServer) ResumeRecurringTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	templateId openapi_types.UUID,
+) {
+	if err := hs.application.Commands.ResumeRecurringTemplate.Handle(r.Context(), command.ResumeRecurringTemplateCmd{
+		WalletID:   walletId,
+		TemplateID: templateId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Reverse a transaction
+// (POST /v1/wallets/{walletId}/transactions/{transactionId}/reversal)
+func (hs HttpServer) ReverseTransaction(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	transactionId openapi_types.UUID,
+) {
+	var req ReverseTransactionRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.ReverseTransaction.Handle(
+		r.Context(),
+		command.ReverseTransactionCmd{
+			WalletID:      walletId,
+			TransactionID: transactionId,
+			Description:   convert.SafeDeref(req.Description, ""),
+		},
+	); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
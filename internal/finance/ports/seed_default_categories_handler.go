@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+)
+
+// Seed the default categories
+// (POST /v1/categories/defaults)
+func (hs HttpServer) SeedDefaultCategories(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.SeedDefaultCategories.Handle(r.Context(), command.SeedDefaultCategoriesCmd{
+		UserID: user.ID,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
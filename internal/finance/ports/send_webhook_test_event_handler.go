@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/auth"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Send a test event
+// (POST /v1/webhooks/{subscriptionId}/test)
+func (hs HttpServer) SendWebhookTestEvent(w http.ResponseWriter, r *http.Request, subscriptionId openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.SendWebhookTestEvent.Handle(r.Context(), command.SendWebhookTestEventCmd{
+		UserID:         user.ID,
+		SubscriptionID: subscriptionId,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusAccepted, nil, nil)
+}
@@ -0,0 +1,41 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Skip an occurrence of a recurring template
+// (POST /v1/wallets/{walletId}/recurring-templates/{templateId}/skip)
+func (hs HttpServer) SkipRecurringOccurrence(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	templateId openapi_types.UUID,
+) {
+	var req SkipRecurringOccurrenceRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.SkipRecurringOccurrence.Handle(r.Context(), command.SkipRecurringOccurrenceCmd{
+		WalletID:   walletId,
+		TemplateID: templateId,
+		Date:       req.Date.Time,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,40 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Start a reconciliation
+// (POST /v1/fund-providers/{fundProviderId}/reconciliations)
+func (hs HttpServer) StartReconciliation(w http.ResponseWriter, r *http.Request, fundProviderId openapi_types.UUID) {
+	var req StartReconciliationRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	// The statement closes at the end of a local calendar day, like the accounting periods
+	statementDate := time.Date(req.StatementDate.Year(), req.StatementDate.Month(), req.StatementDate.Day(), 0, 0, 0, 0, time.Local)
+
+	if err := hs.application.Commands.StartReconciliation.Handle(r.Context(), command.StartReconciliationCmd{
+		FundProviderID:   fundProviderId,
+		StatementDate:    statementDate,
+		StatementBalance: req.StatementBalance,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
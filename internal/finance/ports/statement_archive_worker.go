@@ -0,0 +1,54 @@
+package ports
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sumni-finance-backend/internal/finance/app"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+)
+
+// NewStatementArchiveWorker creates the worker periodically rendering and archiving the statements of the closed
+// accounting periods, so a statement handed out for a closed period stays the same afterwards.
+// A statement that fails to render or store is retried on the next tick.
+func NewStatementArchiveWorker(application app.Application, lock LeaderLock, interval time.Duration) *TickerWorker {
+	return NewTickerWorker("statement archive", lock, interval, func(ctx context.Context) (int, int, error) {
+		periods, err := application.Queries.StatementsDueForArchive.Handle(ctx, query.ListStatementsDueForArchive{})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return processEach(periods, func(period query.StatementPeriod) error {
+			err := archiveStatement(ctx, application, period)
+			if err != nil {
+				slog.Error("failed to archive statement", "walletId", period.WalletID, "yearMonth", period.YearMonth, "error", err)
+			}
+
+			return err
+		})
+	})
+}
+
+func archiveStatement(ctx context.Context, application app.Application, period query.StatementPeriod) error {
+	statement, err := application.Queries.WalletStatement.Handle(ctx, query.GetWalletStatement{
+		WalletID:  period.WalletID,
+		YearMonth: period.YearMonth,
+	})
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := writeStatement(&buf, statement, time.Now()); err != nil {
+		return err
+	}
+
+	return application.Commands.ArchiveStatement.Handle(ctx, command.ArchiveStatementCmd{
+		WalletID:           period.WalletID,
+		AccountingPeriodID: statement.Period.ID,
+		YearMonth:          period.YearMonth,
+		Content:            buf.Bytes(),
+	})
+}
@@ -0,0 +1,341 @@
+package ports
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sumni-finance-backend/internal/common/pdf"
+	"sumni-finance-backend/internal/finance/app/query"
+	"sumni-finance-backend/internal/finance/domain/ledger"
+	"time"
+)
+
+// The statement is printed for the family treasurer, its labels are Vietnamese.
+var transactionTypeLabels = map[string]string{
+	ledger.TransactionTypeDeposit.String():     "Nạp tiền",
+	ledger.TransactionTypeWithdrawal.String():  "Rút tiền",
+	ledger.TransactionTypeFee.String():         "Phí",
+	ledger.TransactionTypeInterest.String():    "Lãi",
+	ledger.TransactionTypeRefund.String():      "Hoàn tiền",
+	ledger.TransactionTypeAdjustment.String():  "Điều chỉnh",
+	ledger.TransactionTypeTransferIn.String():  "Chuyển đến",
+	ledger.TransactionTypeTransferOut.String(): "Chuyển đi",
+}
+
+const (
+	statementMargin = 40.0
+	statementBottom = pdf.PageHeight - 50
+	statementWidth  = pdf.PageWidth - 2*statementMargin
+	statementRow    = 14.0
+	statementSize   = 8.0
+)
+
+// statementColumn is a column of a table of the statement, amounts are aligned right.
+type statementColumn struct {
+	title string
+	width float64
+	right bool
+}
+
+var (
+	fundProviderColumns = []statementColumn{
+		{title: "Nguồn tiền", width: 215},
+		{title: "Tiền vào", width: 100, right: true},
+		{title: "Tiền ra", width: 100, right: true},
+		{title: "Chênh lệch", width: 100, right: true},
+	}
+	transactionColumns = []statementColumn{
+		{title: "Ngày", width: 56},
+		{title: "Loại", width: 58},
+		{title: "Diễn giải", width: 126},
+		{title: "Nguồn tiền", width: 70},
+		{title: "Tiền vào", width: 65, right: true},
+		{title: "Tiền ra", width: 65, right: true},
+		{title: "Số dư", width: 75, right: true},
+	}
+	categoryColumns = []statementColumn{
+		{title: "Danh mục", width: 315},
+		{title: "Tiền vào", width: 100, right: true},
+		{title: "Tiền ra", width: 100, right: true},
+	}
+)
+
+// statementLayout places the blocks of the statement one under the other, starting a new page
+// when a block does not fit on the current one.
+type statementLayout struct {
+	doc     *pdf.Document
+	page    *pdf.Page
+	y       float64
+	regular *pdf.Font
+	bold    *pdf.Font
+}
+
+// writeStatement renders the statement as an A4 PDF. The amounts are whole units of the currency of the wallet.
+func writeStatement(w io.Writer, st query.WalletStatement, printedAt time.Time) error {
+	regular, err := pdf.DejaVuSans()
+	if err != nil {
+		return err
+	}
+
+	bold, err := pdf.DejaVuSansBold()
+	if err != nil {
+		return err
+	}
+
+	period := statementPeriod(st.Period.YearMonth)
+	l := &statementLayout{
+		doc:     pdf.NewDocument(fmt.Sprintf("Sao kê ví %s - kỳ %s", st.WalletName, period), printedAt),
+		regular: regular,
+		bold:    bold,
+	}
+	l.newPage()
+
+	l.writeHeader(st, period, printedAt)
+	l.writeSummary(st.Period)
+
+	l.writeTitle("Theo nguồn tiền")
+	l.writeTableHeader(fundProviderColumns)
+	for _, fp := range st.FundProviders {
+		if l.ensure(statementRow) {
+			l.writeTableHeader(fundProviderColumns)
+		}
+		l.writeTableRow(fundProviderColumns,
+			fp.FundProviderName, formatAmount(fp.Inflow), formatAmount(fp.Outflow), formatAmount(fp.Net))
+	}
+
+	l.writeTitle("Giao dịch")
+	l.writeTableHeader(transactionColumns)
+	for _, line := range st.Lines {
+		if l.ensure(statementRow) {
+			l.writeTableHeader(transactionColumns)
+		}
+
+		var inflow, outflow string
+		if line.Direction == ledger.DirectionIn.String() {
+			inflow = formatAmount(line.Amount)
+		} else {
+			outflow = formatAmount(line.Amount)
+		}
+
+		description := line.Description
+		if line.ReversedByID != nil {
+			description = "(Đã hủy) " + description
+		}
+
+		l.writeTableRow(transactionColumns,
+			line.OccurredAt.Format("02/01/2006"),
+			transactionTypeLabel(line.TransactionType),
+			description,
+			line.FundProviderName,
+			inflow,
+			outflow,
+			formatAmount(line.RunningBalance),
+		)
+	}
+	if len(st.Lines) == 0 {
+		l.writeNote("Không có giao dịch trong kỳ.")
+	}
+
+	l.writeTitle("Theo danh mục")
+	l.writeTableHeader(categoryColumns)
+	for _, c := range st.Categories {
+		if l.ensure(statementRow) {
+			l.writeTableHeader(categoryColumns)
+		}
+
+		name := c.Name
+		if c.CategoryID == nil {
+			name = "Chưa phân loại"
+		}
+		l.writeTableRow(categoryColumns, name, formatAmount(c.Inflow), formatAmount(c.Outflow))
+	}
+
+	l.writeFooters(st.WalletName, period)
+
+	_, err = l.doc.WriteTo(w)
+	return err
+}
+
+func (l *statementLayout) newPage() {
+	l.page = l.doc.AddPage()
+	l.y = statementMargin
+}
+
+// ensure starts a new page when height does not fit on the current one, reporting whether it did.
+func (l *statementLayout) ensure(height float64) bool {
+	if l.y+height <= statementBottom {
+		return false
+	}
+
+	l.newPage()
+	return true
+}
+
+func (l *statementLayout) writeHeader(st query.WalletStatement, period string, printedAt time.Time) {
+	l.page.Text(l.bold, 16, statementMargin, l.y+16, "SAO KÊ VÍ")
+	l.page.TextRight(l.regular, statementSize, statementMargin+statementWidth, l.y+16,
+		"Ngày in: "+printedAt.Format("02/01/2006 15:04"))
+	l.y += 34
+
+	l.page.Text(l.bold, 12, statementMargin, l.y, st.WalletName)
+	l.y += 16
+
+	status := "đang mở"
+	if st.Period.Status == ledger.AccountingPeriodClose.String() {
+		status = "đã đóng"
+	}
+	l.page.Text(l.regular, 9, statementMargin, l.y, fmt.Sprintf("Kỳ %s, %s, đến hết ngày %s · Đơn vị: %s",
+		period, status, st.Period.EndDate.Add(-time.Nanosecond).Format("02/01/2006"), currencyLabel(st.Period.Currency)))
+	l.y += 12
+}
+
+// writeSummary writes the balances of the period in four boxes across the page.
+func (l *statementLayout) writeSummary(period query.AccountingPeriodClosingReport) {
+	boxes := []struct {
+		label  string
+		amount int64
+	}{
+		{"Số dư đầu kỳ", period.OpeningBalance},
+		{"Ghi có (tiền vào)", period.TotalCredit},
+		{"Ghi nợ (tiền ra)", period.TotalDebit},
+		{"Số dư cuối kỳ", period.ClosingBalance},
+	}
+
+	const gap, height = 8.0, 40.0
+	width := (statementWidth - 3*gap) / float64(len(boxes))
+	for i, box := range boxes {
+		x := statementMargin + float64(i)*(width+gap)
+		l.page.FillRect(x, l.y, width, height, 0.93)
+		l.page.Text(l.regular, statementSize, x+6, l.y+14, box.label)
+		l.page.TextRight(l.bold, 11, x+width-6, l.y+32, formatMoney(box.amount, period.Currency))
+	}
+	l.y += height + 6
+
+	l.page.Text(l.regular, statementSize, statementMargin, l.y+statementSize, fmt.Sprintf("%d giao dịch", period.TransactionCount))
+	l.y += statementRow
+}
+
+func (l *statementLayout) writeTitle(title string) {
+	// A title is kept with its table header and first row
+	l.ensure(22 + 2*statementRow)
+
+	l.y += 10
+	l.page.Text(l.bold, 11, statementMargin, l.y+11, title)
+	l.y += 18
+}
+
+func (l *statementLayout) writeNote(note string) {
+	l.ensure(statementRow)
+	l.page.Text(l.regular, statementSize, statementMargin+4, l.y+10, note)
+	l.y += statementRow
+}
+
+func (l *statementLayout) writeTableHeader(columns []statementColumn) {
+	l.page.FillRect(statementMargin, l.y, statementWidth, statementRow, 0.85)
+	l.writeCells(columns, l.bold, func(i int) string { return columns[i].title })
+	l.y += statementRow
+}
+
+func (l *statementLayout) writeTableRow(columns []statementColumn, cells ...string) {
+	l.writeCells(columns, l.regular, func(i int) string { return cells[i] })
+	l.y += statementRow
+	l.page.Line(statementMargin, l.y, statementMargin+statementWidth, l.y, 0.3, 0.8)
+}
+
+func (l *statementLayout) writeCells(columns []statementColumn, f *pdf.Font, cell func(i int) string) {
+	const padding = 3.0
+
+	baseline := l.y + 10
+	x := statementMargin
+	for i, c := range columns {
+		text := fitText(f, statementSize, cell(i), c.width-2*padding)
+		switch {
+		case text == "":
+		case c.right:
+			l.page.TextRight(f, statementSize, x+c.width-padding, baseline, text)
+		default:
+			l.page.Text(f, statementSize, x+padding, baseline, text)
+		}
+		x += c.width
+	}
+}
+
+// writeFooters numbers the pages once the statement is laid out and their count is known.
+func (l *statementLayout) writeFooters(walletName string, period string) {
+	pages := l.doc.Pages()
+	for i, page := range pages {
+		y := pdf.PageHeight - 30
+		page.Line(statementMargin, y-10, statementMargin+statementWidth, y-10, 0.3, 0.6)
+		page.Text(l.regular, 7, statementMargin, y, fitText(l.regular, 7, "Sao kê ví "+walletName+" - kỳ "+period, statementWidth-80))
+		page.TextRight(l.regular, 7, statementMargin+statementWidth, y, fmt.Sprintf("Trang %d/%d", i+1, len(pages)))
+	}
+}
+
+// fitText shortens s with an ellipsis until it is at most width wide.
+func fitText(f *pdf.Font, size float64, s string, width float64) string {
+	if f.Width(s, size) <= width {
+		return s
+	}
+
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if short := strings.TrimSpace(string(runes)) + "…"; f.Width(short, size) <= width {
+			return short
+		}
+	}
+
+	return ""
+}
+
+func transactionTypeLabel(transactionType string) string {
+	if label, ok := transactionTypeLabels[transactionType]; ok {
+		return label
+	}
+
+	return transactionType
+}
+
+// statementPeriod formats the year month 2026,4 as 04/2026.
+func statementPeriod(yearMonth string) string {
+	ym, err := ledger.UnmarshalYearMonthFromString(yearMonth)
+	if err != nil {
+		return yearMonth
+	}
+
+	return fmt.Sprintf("%02d/%d", ym.Month(), ym.Year())
+}
+
+func currencyLabel(currency string) string {
+	if currency == "VND" {
+		return "₫"
+	}
+
+	return currency
+}
+
+// formatMoney formats amount as Vietnamese write it, 1.234.567 ₫.
+func formatMoney(amount int64, currency string) string {
+	return formatAmount(amount) + " " + currencyLabel(currency)
+}
+
+// formatAmount groups the thousands of amount with dots, as in 1.234.567.
+func formatAmount(amount int64) string {
+	digits := strconv.FormatInt(amount, 10)
+
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var grouped strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(d)
+	}
+
+	return sign + grouped.String()
+}
@@ -0,0 +1,117 @@
+package ports
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// LeaderLock makes sure only one server instance runs a background job at a time.
+type LeaderLock interface {
+	TryRun(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+}
+
+type WorkerStatus struct {
+	LastRunAt     *time.Time `json:"lastRunAt,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+	Leader        bool       `json:"leader"`
+	Processed     int        `json:"processed"`
+	Failed        int        `json:"failed"`
+	LastError     string     `json:"lastError,omitempty"`
+}
+
+// WorkerFunc processes the items due in one run. err is the error of the last failed item
+// or the one that stopped the run.
+type WorkerFunc func(ctx context.Context) (processed int, failed int, err error)
+
+// TickerWorker runs fn on every tick on the server instance holding the lock and keeps the outcome of the last run.
+type TickerWorker struct {
+	name     string
+	lock     LeaderLock
+	interval time.Duration
+	fn       WorkerFunc
+
+	mu     sync.RWMutex
+	status WorkerStatus
+}
+
+func NewTickerWorker(name string, lock LeaderLock, interval time.Duration, fn WorkerFunc) *TickerWorker {
+	return &TickerWorker{
+		name:     name,
+		lock:     lock,
+		interval: interval,
+		fn:       fn,
+	}
+}
+
+// Run blocks until ctx is cancelled, running once at start and then on every tick.
+func (wk *TickerWorker) Run(ctx context.Context) {
+	if wk.interval <= 0 {
+		slog.Info(wk.name + " worker disabled")
+		return
+	}
+
+	ticker := time.NewTicker(wk.interval)
+	defer ticker.Stop()
+
+	for {
+		wk.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (wk *TickerWorker) RunOnce(ctx context.Context) {
+	startedAt := time.Now()
+	processed, failed := 0, 0
+
+	leader, err := wk.lock.TryRun(ctx, func(ctx context.Context) error {
+		var err error
+		processed, failed, err = wk.fn(ctx)
+		return err
+	})
+
+	wk.mu.Lock()
+	defer wk.mu.Unlock()
+
+	wk.status.LastRunAt = &startedAt
+	wk.status.Leader = leader
+	wk.status.Processed = processed
+	wk.status.Failed = failed
+	wk.status.LastError = ""
+
+	if err != nil {
+		slog.Error(wk.name+" run failed", "error", err)
+		wk.status.LastError = err.Error()
+		return
+	}
+
+	wk.status.LastSuccessAt = &startedAt
+}
+
+func (wk *TickerWorker) Status() WorkerStatus {
+	wk.mu.RLock()
+	defer wk.mu.RUnlock()
+
+	return wk.status
+}
+
+// processEach calls fn for every item, an item that fails is counted and the next one is processed.
+func processEach[T any](items []T, fn func(item T) error) (processed int, failed int, lastErr error) {
+	for _, item := range items {
+		if err := fn(item); err != nil {
+			failed++
+			lastErr = err
+			continue
+		}
+
+		processed++
+	}
+
+	return processed, failed, lastErr
+}
@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Transfer money between fund providers of a wallet
+// (POST /v1/wallets/{walletId}/accounting-periods/{yearMonth}/fund-provider-transfers)
+func (hs HttpServer) TransferBetweenFundProviders(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+	yearMonth string,
+) {
+	var req TransferBetweenFundProvidersRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.TransferBetweenFundProviders.Handle(
+		r.Context(),
+		command.TransferBetweenFundProvidersCmd{
+			WalletID:           walletId,
+			YearMonth:          yearMonth,
+			FromFundProviderID: req.FromFundProviderId,
+			ToFundProviderID:   req.ToFundProviderId,
+			Amount:             req.Amount,
+			Fee:                convert.SafeDeref(req.Fee, 0),
+			TransactionNo:      convert.SafeDeref(req.TransactionNo, ""),
+			Description:        convert.SafeDeref(req.Description, ""),
+			OccurredAt:         req.OccurredAt,
+		},
+	); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/convert"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Transfer allocation to another wallet
+// (POST /v1/wallets/{walletId}/transfers)
+func (hs HttpServer) TransferBetweenWallets(
+	w http.ResponseWriter,
+	r *http.Request,
+	walletId openapi_types.UUID,
+) {
+	var req TransferBetweenWalletsRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.TransferBetweenWallets.Handle(
+		r.Context(),
+		command.TransferBetweenWalletsCmd{
+			FromWalletID:   walletId,
+			ToWalletID:     req.ToWalletId,
+			FundProviderID: req.FundProviderId,
+			Amount:         req.Amount,
+			TransactionNo:  convert.SafeDeref(req.TransactionNo, ""),
+			Description:    convert.SafeDeref(req.Description, ""),
+			OccurredAt:     req.OccurredAt,
+		},
+	); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusCreated, nil, nil)
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"encoding/json"
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/command"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Update the ledger config of a wallet
+// (PUT /v1/wallets/{walletId}/ledger-config)
+func (hs HttpServer) UpdateLedgerConfig(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	var req LedgerConfig
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		httperr.BadRequest("failed-to-parse-json", err, w, r)
+		return
+	}
+
+	if err := hs.application.Commands.UpdateLedgerConfig.Handle(r.Context(), command.UpdateLedgerConfigCmd{
+		WalletID:       walletId,
+		PeriodStartDay: req.PeriodStartDay,
+		PeriodInterval: req.PeriodInterval,
+	}); err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,43 @@
+package ports
+
+import (
+	"net/http"
+	"sumni-finance-backend/internal/common/server/httperr"
+	"sumni-finance-backend/internal/common/server/response"
+	"sumni-finance-backend/internal/finance/app/query"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Verify the continuity of the accounting periods of a wallet
+// (GET /v1/wallets/{walletId}/accounting-periods/continuity)
+func (hs HttpServer) VerifyPeriodContinuity(w http.ResponseWriter, r *http.Request, walletId openapi_types.UUID) {
+	report, err := hs.application.Queries.PeriodContinuity.Handle(r.Context(), query.VerifyPeriodContinuity{
+		WalletID: walletId,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	issues := make([]PeriodContinuityIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, PeriodContinuityIssue{
+			Kind:                   issue.Kind,
+			YearMonth:              issue.YearMonth,
+			PreviousYearMonth:      issue.PreviousYearMonth,
+			StartTime:              issue.StartTime,
+			PreviousEndDate:        issue.PreviousEndDate,
+			OpeningBalance:         issue.OpeningBalance,
+			PreviousClosingBalance: issue.PreviousClosingBalance,
+		})
+	}
+
+	response.WriteJSON(w, r, http.StatusOK, response.Envelop{
+		"continuity": PeriodContinuityReport{
+			CheckedPeriods: report.CheckedPeriods,
+			Continuous:     report.Continuous,
+			Issues:         issues,
+		},
+	}, nil)
+}
@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"log/slog"
+	"sumni-finance-backend/internal/finance/app"
+	"sumni-finance-backend/internal/finance/app/command"
+	"sumni-finance-backend/internal/finance/app/query"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NewWebhookDeliveryWorker creates the worker periodically posting the due webhook deliveries to the endpoints
+// of their subscriptions. A failed attempt is logged on the delivery and retried with a backoff on a later tick.
+func NewWebhookDeliveryWorker(application app.Application, lock LeaderLock, interval time.Duration) *TickerWorker {
+	return NewTickerWorker("webhook delivery", lock, interval, func(ctx context.Context) (int, int, error) {
+		deliveryIDs, err := application.Queries.WebhookDeliveriesDue.Handle(ctx, query.ListWebhookDeliveriesDue{})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return processEach(deliveryIDs, func(deliveryID uuid.UUID) error {
+			err := application.Commands.DeliverWebhook.Handle(ctx, command.DeliverWebhookCmd{DeliveryID: deliveryID})
+			if err != nil {
+				slog.Error("failed to deliver webhook", "deliveryId", deliveryID, "error", err)
+			}
+
+			return err
+		})
+	})
+}